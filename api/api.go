@@ -2,6 +2,7 @@ package api
 
 import (
 	"accounting/api/handler"
+	"accounting/api/middleware"
 	"accounting/ent"
 	"accounting/service"
 
@@ -17,8 +18,45 @@ func SetupRouter(client *ent.Client) *gin.Engine {
 	userService := service.NewUserService(client)
 	userHandler := handler.NewUserHandler(userService)
 
+	balanceService := service.NewBalanceService(client)
+	balanceHandler := handler.NewBalanceHandler(balanceService)
+
 	transactionService := service.NewTransactionService(client)
 	transactionHandler := handler.NewTransactionHandler(transactionService)
+	transferHandler := handler.NewTransferHandler(transactionService)
+
+	exchangeRateService := service.NewExchangeRateService(client)
+	exchangeHandler := handler.NewExchangeHandler(transactionService, exchangeRateService)
+
+	currencyService := service.NewCurrencyService(client)
+	currencyHandler := handler.NewCurrencyHandler(currencyService)
+
+	holdService := service.NewHoldService(client)
+	holdHandler := handler.NewHoldHandler(holdService)
+
+	feeService := service.NewFeeService(client)
+	feeHandler := handler.NewFeeHandler(feeService)
+
+	velocityLimitService := service.NewVelocityLimitService(client)
+	velocityLimitHandler := handler.NewVelocityLimitHandler(velocityLimitService)
+
+	interestService := service.NewInterestService(client)
+	interestHandler := handler.NewInterestHandler(interestService)
+
+	accountingPeriodService := service.NewAccountingPeriodService(client)
+	accountingPeriodHandler := handler.NewAccountingPeriodHandler(accountingPeriodService)
+
+	reportService := service.NewReportService(client)
+	reportHandler := handler.NewReportHandler(reportService)
+
+	statementService := service.NewStatementService(client)
+	statementHandler := handler.NewStatementHandler(statementService)
+
+	scheduleService := service.NewScheduleService(client)
+	scheduleHandler := handler.NewScheduleHandler(scheduleService)
+
+	idempotencyService := service.NewIdempotencyService(client)
+	idempotency := middleware.Idempotency(idempotencyService)
 
 	// API endpoints group
 	api := r.Group("/api")
@@ -27,12 +65,116 @@ func SetupRouter(client *ent.Client) *gin.Engine {
 		users := api.Group("/users")
 		{
 			users.POST("", userHandler.CreateUser)
+			users.GET("/:id/balances", balanceHandler.GetUserBalances)
+			users.PUT("/:id/balances/:currency/overdraft-limit", balanceHandler.SetOverdraftLimit)
+			users.PUT("/:id/balances/:currency/interest-plan", interestHandler.AssignInterestPlan)
+			users.PUT("/:id/tier", userHandler.SetTier)
+			users.POST("/:id/freeze", userHandler.FreezeUser)
+			users.POST("/:id/unfreeze", userHandler.UnfreezeUser)
+			users.POST("/:id/close", userHandler.CloseUser)
+			users.DELETE("/:id", userHandler.DeleteUser)
+			users.POST("/:id/erase", userHandler.EraseUser)
+			users.GET("/:id/statements/:month", statementHandler.GetStatement)
 		}
 
 		// Transactions endpoints
 		transactions := api.Group("/transactions")
 		{
-			transactions.POST("", transactionHandler.CreateTransaction)
+			transactions.POST("", idempotency, transactionHandler.CreateTransaction)
+			transactions.POST("/batch", idempotency, transactionHandler.CreateBatch)
+			transactions.GET("", transactionHandler.FindTransactions)
+			transactions.GET("/:id", transactionHandler.GetTransaction)
+			transactions.POST("/:id/reverse", idempotency, transactionHandler.ReverseTransaction)
+			transactions.POST("/:id/post", idempotency, transactionHandler.PostTransaction)
+			transactions.POST("/:id/fail", idempotency, transactionHandler.FailTransaction)
+		}
+
+		// Transfers endpoints
+		transfers := api.Group("/transfers")
+		{
+			transfers.POST("", idempotency, transferHandler.CreateTransfer)
+		}
+
+		// Holds endpoints
+		holds := api.Group("/holds")
+		{
+			holds.POST("", idempotency, holdHandler.CreateHold)
+			holds.GET("/:id", holdHandler.GetHold)
+			holds.POST("/:id/capture", idempotency, holdHandler.CaptureHold)
+			holds.POST("/:id/void", holdHandler.VoidHold)
+		}
+
+		// Fee rules endpoints
+		feeRules := api.Group("/fee-rules")
+		{
+			feeRules.POST("", feeHandler.CreateFeeRule)
+			feeRules.GET("", feeHandler.GetFeeRules)
+			feeRules.PATCH("/:id", feeHandler.UpdateFeeRule)
+		}
+
+		// Velocity limits endpoints
+		velocityLimits := api.Group("/velocity-limits")
+		{
+			velocityLimits.POST("", velocityLimitHandler.CreateVelocityLimit)
+			velocityLimits.GET("", velocityLimitHandler.GetVelocityLimits)
+			velocityLimits.PATCH("/:id", velocityLimitHandler.UpdateVelocityLimit)
+		}
+
+		// Interest plans endpoints
+		interestPlans := api.Group("/interest-plans")
+		{
+			interestPlans.POST("", interestHandler.CreateInterestPlan)
+			interestPlans.GET("", interestHandler.GetInterestPlans)
+		}
+
+		// Interest accruals endpoints
+		api.POST("/interest/accruals", interestHandler.AccrueInterest)
+
+		// Accounting periods endpoints
+		accountingPeriods := api.Group("/accounting-periods")
+		{
+			accountingPeriods.POST("", accountingPeriodHandler.CreateAccountingPeriod)
+			accountingPeriods.GET("", accountingPeriodHandler.GetAccountingPeriods)
+			accountingPeriods.GET("/:id", accountingPeriodHandler.GetAccountingPeriod)
+			accountingPeriods.POST("/:id/start-close", accountingPeriodHandler.StartCloseAccountingPeriod)
+			accountingPeriods.POST("/:id/reopen", accountingPeriodHandler.ReopenAccountingPeriod)
+			accountingPeriods.POST("/:id/close", accountingPeriodHandler.CloseAccountingPeriod)
+		}
+
+		// Reports endpoints
+		reports := api.Group("/reports")
+		{
+			reports.GET("/trial-balance", reportHandler.GetTrialBalance)
+			reports.GET("/liabilities", reportHandler.GetLiabilities)
+			reports.GET("/flows", reportHandler.GetFlows)
+		}
+
+		// Schedules endpoints
+		schedules := api.Group("/schedules")
+		{
+			schedules.POST("", idempotency, scheduleHandler.CreateSchedule)
+			schedules.GET("/:id", scheduleHandler.GetSchedule)
+			schedules.POST("/:id/cancel", scheduleHandler.CancelSchedule)
+		}
+
+		// Currencies endpoints
+		currencies := api.Group("/currencies")
+		{
+			currencies.GET("", currencyHandler.GetCurrencies)
+			currencies.PATCH("/:code", currencyHandler.UpdateCurrency)
+		}
+
+		// Exchange rates endpoints
+		exchangeRates := api.Group("/exchange-rates")
+		{
+			exchangeRates.POST("", exchangeHandler.CreateExchangeRate)
+			exchangeRates.GET("", exchangeHandler.GetExchangeRate)
+		}
+
+		// Currency exchanges endpoints
+		exchanges := api.Group("/exchanges")
+		{
+			exchanges.POST("", idempotency, exchangeHandler.CreateExchange)
 		}
 	}
 
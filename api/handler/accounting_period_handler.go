@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"accounting/ent"
+	"accounting/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// AccountingPeriodHandler represents the handler for accounting period API
+type AccountingPeriodHandler struct {
+	periodService *service.AccountingPeriodService
+}
+
+// NewAccountingPeriodHandler creates a new accounting period handler
+func NewAccountingPeriodHandler(periodService *service.AccountingPeriodService) *AccountingPeriodHandler {
+	return &AccountingPeriodHandler{
+		periodService: periodService,
+	}
+}
+
+// CreateAccountingPeriodRequest represents a request to create an accounting
+// period; ends_at is exclusive, e.g. 2025-02-01T00:00:00Z for January 2025
+type CreateAccountingPeriodRequest struct {
+	Name     string    `json:"name" binding:"required,max=64"`
+	StartsAt time.Time `json:"starts_at" binding:"required"`
+	EndsAt   time.Time `json:"ends_at" binding:"required"`
+}
+
+// CreateAccountingPeriod handles the request to create a new accounting period
+func (h *AccountingPeriodHandler) CreateAccountingPeriod(c *gin.Context) {
+	var req CreateAccountingPeriodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	period, err := h.periodService.CreatePeriod(c.Request.Context(), req.Name, req.StartsAt, req.EndsAt)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, accountingPeriodResponse(period))
+}
+
+// GetAccountingPeriods handles the request to list all accounting periods
+func (h *AccountingPeriodHandler) GetAccountingPeriods(c *gin.Context) {
+	periods, err := h.periodService.GetPeriods(c.Request.Context())
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	items := make([]gin.H, 0, len(periods))
+	for _, period := range periods {
+		items = append(items, accountingPeriodResponse(period))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"accounting_periods": items,
+	})
+}
+
+// GetAccountingPeriod handles the request to get an accounting period with its trial balance
+func (h *AccountingPeriodHandler) GetAccountingPeriod(c *gin.Context) {
+	h.applyToPeriod(c, h.periodService.GetPeriod)
+}
+
+// StartCloseAccountingPeriod handles the request to lock an ended period for review
+func (h *AccountingPeriodHandler) StartCloseAccountingPeriod(c *gin.Context) {
+	h.applyToPeriod(c, h.periodService.StartClose)
+}
+
+// ReopenAccountingPeriod handles the request to open a period under review again
+func (h *AccountingPeriodHandler) ReopenAccountingPeriod(c *gin.Context) {
+	h.applyToPeriod(c, h.periodService.Reopen)
+}
+
+// CloseAccountingPeriod handles the request to close a period under review for good
+func (h *AccountingPeriodHandler) CloseAccountingPeriod(c *gin.Context) {
+	h.applyToPeriod(c, h.periodService.Close)
+}
+
+// applyToPeriod applies an operation to the accounting period of the request and renders the result
+func (h *AccountingPeriodHandler) applyToPeriod(c *gin.Context,
+	change func(ctx context.Context, id int) (*ent.AccountingPeriod, error)) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid accounting period ID",
+		})
+		return
+	}
+
+	period, err := change(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, accountingPeriodResponse(period))
+}
+
+// accountingPeriodResponse renders an accounting period, with its trial balance
+// and its totals per currency when they are loaded
+func accountingPeriodResponse(period *ent.AccountingPeriod) gin.H {
+	response := gin.H{
+		"id":         period.ID,
+		"name":       period.Name,
+		"starts_at":  period.StartsAt,
+		"ends_at":    period.EndsAt,
+		"status":     period.Status,
+		"closed_at":  period.ClosedAt,
+		"created_at": period.CreatedAt,
+	}
+
+	if lines, err := period.Edges.TrialBalanceLinesOrErr(); err == nil {
+		items := make([]gin.H, 0, len(lines))
+		totals := make(map[string]decimal.Decimal)
+		for _, line := range lines {
+			items = append(items, gin.H{
+				"account_id": line.AccountID,
+				"currency":   line.Currency,
+				"movement":   line.Movement,
+				"balance":    line.Balance,
+			})
+			totals[line.Currency] = totals[line.Currency].Add(line.Balance)
+		}
+		response["trial_balance"] = items
+		response["totals"] = totals
+	}
+
+	return response
+}
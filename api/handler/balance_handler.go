@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"accounting/ent"
+	"accounting/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// BalanceHandler represents the handler for balance API
+type BalanceHandler struct {
+	balanceService *service.BalanceService
+}
+
+// NewBalanceHandler creates a new balance handler
+func NewBalanceHandler(balanceService *service.BalanceService) *BalanceHandler {
+	return &BalanceHandler{
+		balanceService: balanceService,
+	}
+}
+
+// GetUserBalances handles the request to list all balances of a user. With the
+// as_of query parameter (RFC 3339) the balances at that instant are returned.
+func (h *BalanceHandler) GetUserBalances(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid user ID",
+		})
+		return
+	}
+
+	if asOf := c.Query("as_of"); asOf != "" {
+		at, err := time.Parse(time.RFC3339, asOf)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "as_of must be an RFC 3339 timestamp",
+			})
+			return
+		}
+		h.getUserBalancesAsOf(c, userID, at)
+		return
+	}
+
+	balances, err := h.balanceService.GetUserBalances(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	items := make([]gin.H, 0, len(balances))
+	for _, b := range balances {
+		items = append(items, balanceResponse(b))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"user_id":  userID,
+		"balances": items,
+	})
+}
+
+// SetOverdraftLimitRequest represents a request to set the overdraft limit of a balance.
+// The limit is a non-negative decimal string; zero disallows any overdraft.
+type SetOverdraftLimitRequest struct {
+	OverdraftLimit decimal.Decimal `json:"overdraft_limit"`
+}
+
+// SetOverdraftLimit handles the request to set the overdraft limit of a user's balance in a currency
+func (h *BalanceHandler) SetOverdraftLimit(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid user ID",
+		})
+		return
+	}
+
+	var req SetOverdraftLimitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	b, err := h.balanceService.SetOverdraftLimit(c.Request.Context(), userID, c.Param("currency"), req.OverdraftLimit)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, balanceResponse(b))
+}
+
+// getUserBalancesAsOf responds with the balances of a user at a past instant
+func (h *BalanceHandler) getUserBalancesAsOf(c *gin.Context, userID int, at time.Time) {
+	balances, err := h.balanceService.GetUserBalancesAsOf(c.Request.Context(), userID, at)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	items := make([]gin.H, 0, len(balances))
+	for _, b := range balances {
+		items = append(items, gin.H{
+			"currency": b.Currency,
+			"amount":   b.Amount,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"user_id":  userID,
+		"as_of":    at,
+		"balances": items,
+	})
+}
+
+// balanceResponse renders a balance
+func balanceResponse(b *ent.Balance) gin.H {
+	return gin.H{
+		"currency":         b.Currency,
+		"amount":           b.Amount,
+		"available":        b.Available,
+		"overdraft_limit":  b.OverdraftLimit,
+		"interest_plan_id": b.InterestPlanID,
+		"sequence":         b.Sequence,
+		"updated_at":       b.UpdatedAt,
+	}
+}
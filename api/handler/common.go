@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+
+	"accounting/api/middleware"
+	"accounting/ent"
+	"accounting/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// statusForError maps service errors to HTTP status codes
+func statusForError(err error) int {
+	switch {
+	case errors.IsInvalidInput(err), errors.IsUnbalancedEntry(err):
+		return http.StatusBadRequest
+	case errors.IsNotFound(err), ent.IsNotFound(err):
+		return http.StatusNotFound
+	case errors.IsDuplicateResource(err), errors.IsInvalidState(err), ent.IsConstraintError(err):
+		return http.StatusConflict
+	case errors.IsInsufficientFunds(err), errors.IsNegativeBalance(err), errors.IsVelocityLimitExceeded(err):
+		return http.StatusUnprocessableEntity
+	case errors.IsUnauthorized(err):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// operationID returns the idempotency key of the request, which becomes the ID
+// of the created resource, or a new unique ID when the client sent no key
+func operationID(c *gin.Context) string {
+	if key := c.GetString(middleware.IdempotencyKeyContextKey); key != "" {
+		return key
+	}
+	return uuid.New().String()
+}
+
+// queryParam returns the value of a query parameter, or nil when the request does not have it
+func queryParam(c *gin.Context, key string) *string {
+	if value, ok := c.GetQuery(key); ok {
+		return &value
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"net/http"
+
+	"accounting/ent"
+	"accounting/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CurrencyHandler represents the handler for currency registry API
+type CurrencyHandler struct {
+	currencyService *service.CurrencyService
+}
+
+// NewCurrencyHandler creates a new currency handler
+func NewCurrencyHandler(currencyService *service.CurrencyService) *CurrencyHandler {
+	return &CurrencyHandler{
+		currencyService: currencyService,
+	}
+}
+
+// GetCurrencies handles the request to list all registered currencies
+func (h *CurrencyHandler) GetCurrencies(c *gin.Context) {
+	currencies, err := h.currencyService.GetCurrencies(c.Request.Context())
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	items := make([]gin.H, 0, len(currencies))
+	for _, cur := range currencies {
+		items = append(items, currencyResponse(cur))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"currencies": items,
+	})
+}
+
+// UpdateCurrencyRequest represents a request to enable or disable a currency
+type UpdateCurrencyRequest struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+// UpdateCurrency handles the request to enable or disable a currency
+func (h *CurrencyHandler) UpdateCurrency(c *gin.Context) {
+	var req UpdateCurrencyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	cur, err := h.currencyService.SetEnabled(c.Request.Context(), c.Param("code"), *req.Enabled)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, currencyResponse(cur))
+}
+
+// currencyResponse renders a currency
+func currencyResponse(cur *ent.Currency) gin.H {
+	return gin.H{
+		"code":         cur.ID,
+		"numeric_code": cur.NumericCode,
+		"name":         cur.Name,
+		"exponent":     cur.Exponent,
+		"enabled":      cur.Enabled,
+	}
+}
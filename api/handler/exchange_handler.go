@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"accounting/ent"
+	"accounting/repository"
+	"accounting/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeHandler represents the handler for currency exchange API
+type ExchangeHandler struct {
+	transactionService  *service.TransactionService
+	exchangeRateService *service.ExchangeRateService
+}
+
+// NewExchangeHandler creates a new exchange handler
+func NewExchangeHandler(transactionService *service.TransactionService,
+	exchangeRateService *service.ExchangeRateService) *ExchangeHandler {
+
+	return &ExchangeHandler{
+		transactionService:  transactionService,
+		exchangeRateService: exchangeRateService,
+	}
+}
+
+// CreateExchangeRateRequest represents a request to store an exchange rate
+type CreateExchangeRateRequest struct {
+	BaseCurrency  string          `json:"base_currency" binding:"required"`
+	QuoteCurrency string          `json:"quote_currency" binding:"required"`
+	Rate          decimal.Decimal `json:"rate"`
+	Spread        decimal.Decimal `json:"spread"`
+	ValidFrom     *time.Time      `json:"valid_from"`
+	ValidTo       *time.Time      `json:"valid_to"`
+}
+
+// CreateExchangeRate handles the request to store a new exchange rate
+func (h *ExchangeHandler) CreateExchangeRate(c *gin.Context) {
+	var req CreateExchangeRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// The rate becomes valid immediately unless stated otherwise
+	validFrom := time.Now()
+	if req.ValidFrom != nil {
+		validFrom = *req.ValidFrom
+	}
+
+	rate, err := h.exchangeRateService.CreateRate(c.Request.Context(), repository.CreateExchangeRateParams{
+		BaseCurrency:  req.BaseCurrency,
+		QuoteCurrency: req.QuoteCurrency,
+		Rate:          req.Rate,
+		Spread:        req.Spread,
+		ValidFrom:     validFrom,
+		ValidTo:       req.ValidTo,
+	})
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, exchangeRateResponse(rate))
+}
+
+// GetExchangeRate handles the request to get the rate of a currency pair valid at a given time
+func (h *ExchangeHandler) GetExchangeRate(c *gin.Context) {
+	base := c.Query("base")
+	quote := c.Query("quote")
+	if base == "" || quote == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Query parameters 'base' and 'quote' are required",
+		})
+		return
+	}
+
+	at := time.Now()
+	if raw := c.Query("at"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Query parameter 'at' must be an RFC 3339 timestamp",
+			})
+			return
+		}
+		at = parsed
+	}
+
+	rate, err := h.exchangeRateService.GetRate(c.Request.Context(), base, quote, at)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, exchangeRateResponse(rate))
+}
+
+// CreateExchangeRequest represents a request to convert money between two currencies of a user
+type CreateExchangeRequest struct {
+	UserID       int             `json:"user_id" binding:"required"`
+	FromCurrency string          `json:"from_currency" binding:"required"`
+	ToCurrency   string          `json:"to_currency" binding:"required,nefield=FromCurrency"`
+	Amount       decimal.Decimal `json:"amount"`
+}
+
+// CreateExchange handles the request to convert money between two currencies
+func (h *ExchangeHandler) CreateExchange(c *gin.Context) {
+	var req CreateExchangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if !req.Amount.IsPositive() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Amount must be a positive decimal",
+		})
+		return
+	}
+
+	// Use the idempotency key as the exchange ID, or generate a unique one
+	exchangeID := operationID(c)
+
+	legs, err := h.transactionService.Exchange(
+		c.Request.Context(),
+		exchangeID,
+		req.UserID,
+		req.FromCurrency,
+		req.ToCurrency,
+		req.Amount,
+	)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"exchange_id":      exchangeID,
+		"exchange_rate_id": legs.Rate.ID,
+		"applied_rate":     legs.Out.AppliedRate,
+		"out":              transactionResponse(legs.Out),
+		"in":               transactionResponse(legs.In),
+	})
+}
+
+// exchangeRateResponse renders an exchange rate
+func exchangeRateResponse(rate *ent.ExchangeRate) gin.H {
+	return gin.H{
+		"id":             rate.ID,
+		"base_currency":  rate.BaseCurrency,
+		"quote_currency": rate.QuoteCurrency,
+		"rate":           rate.Rate,
+		"spread":         rate.Spread,
+		"applied_rate":   repository.AppliedRate(rate),
+		"valid_from":     rate.ValidFrom,
+		"valid_to":       rate.ValidTo,
+	}
+}
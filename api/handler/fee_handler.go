@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"accounting/ent"
+	"accounting/ent/feerule"
+	"accounting/fee"
+	"accounting/repository"
+	"accounting/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// FeeHandler represents the handler for fee rule API
+type FeeHandler struct {
+	feeService *service.FeeService
+}
+
+// NewFeeHandler creates a new fee handler
+func NewFeeHandler(feeService *service.FeeService) *FeeHandler {
+	return &FeeHandler{
+		feeService: feeService,
+	}
+}
+
+// CreateFeeRuleRequest represents a request to create a fee rule. Percentages
+// are in percent, e.g. "1.5" for 1.5%; amounts are decimal strings.
+type CreateFeeRuleRequest struct {
+	Name            string           `json:"name" binding:"required"`
+	TransactionType string           `json:"transaction_type" binding:"required,oneof=deposit withdrawal transfer_out exchange_out"`
+	Currency        *string          `json:"currency"`
+	Tier            *string          `json:"tier"`
+	Kind            string           `json:"kind" binding:"required,oneof=fixed percentage tiered"`
+	FixedAmount     decimal.Decimal  `json:"fixed_amount"`
+	Percentage      decimal.Decimal  `json:"percentage"`
+	Tiers           []fee.Tier       `json:"tiers"`
+	MinFee          *decimal.Decimal `json:"min_fee"`
+	MaxFee          *decimal.Decimal `json:"max_fee"`
+	Priority        int              `json:"priority"`
+}
+
+// CreateFeeRule handles the request to create a new fee rule
+func (h *FeeHandler) CreateFeeRule(c *gin.Context) {
+	var req CreateFeeRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	rule, err := h.feeService.CreateRule(c.Request.Context(), repository.CreateFeeRuleParams{
+		Name:            req.Name,
+		TransactionType: feerule.TransactionType(req.TransactionType),
+		Currency:        req.Currency,
+		Tier:            req.Tier,
+		Kind:            feerule.Kind(req.Kind),
+		FixedAmount:     req.FixedAmount,
+		Percentage:      req.Percentage,
+		Tiers:           req.Tiers,
+		MinFee:          req.MinFee,
+		MaxFee:          req.MaxFee,
+		Priority:        req.Priority,
+	})
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, feeRuleResponse(rule))
+}
+
+// GetFeeRules handles the request to list all fee rules
+func (h *FeeHandler) GetFeeRules(c *gin.Context) {
+	rules, err := h.feeService.GetRules(c.Request.Context())
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	items := make([]gin.H, 0, len(rules))
+	for _, rule := range rules {
+		items = append(items, feeRuleResponse(rule))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"fee_rules": items,
+	})
+}
+
+// UpdateFeeRuleRequest represents a request to turn a fee rule on or off
+type UpdateFeeRuleRequest struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+// UpdateFeeRule handles the request to turn a fee rule on or off
+func (h *FeeHandler) UpdateFeeRule(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid fee rule ID",
+		})
+		return
+	}
+
+	var req UpdateFeeRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	rule, err := h.feeService.SetEnabled(c.Request.Context(), id, *req.Enabled)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, feeRuleResponse(rule))
+}
+
+// feeRuleResponse renders a fee rule
+func feeRuleResponse(rule *ent.FeeRule) gin.H {
+	return gin.H{
+		"id":               rule.ID,
+		"name":             rule.Name,
+		"transaction_type": rule.TransactionType,
+		"currency":         rule.Currency,
+		"tier":             rule.Tier,
+		"kind":             rule.Kind,
+		"fixed_amount":     rule.FixedAmount,
+		"percentage":       rule.Percentage,
+		"tiers":            rule.Tiers,
+		"min_fee":          rule.MinFee,
+		"max_fee":          rule.MaxFee,
+		"priority":         rule.Priority,
+		"enabled":          rule.Enabled,
+	}
+}
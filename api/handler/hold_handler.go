@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"accounting/ent"
+	"accounting/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// HoldHandler represents the handler for authorization hold API
+type HoldHandler struct {
+	holdService *service.HoldService
+}
+
+// NewHoldHandler creates a new hold handler
+func NewHoldHandler(holdService *service.HoldService) *HoldHandler {
+	return &HoldHandler{
+		holdService: holdService,
+	}
+}
+
+// CreateHoldRequest represents a request to reserve funds
+type CreateHoldRequest struct {
+	UserID     int             `json:"user_id" binding:"required"`
+	Amount     decimal.Decimal `json:"amount"`
+	Currency   string          `json:"currency" binding:"required"`
+	TTLSeconds int             `json:"ttl_seconds" binding:"gte=0"`
+}
+
+// CreateHold handles the request to place a new hold
+func (h *HoldHandler) CreateHold(c *gin.Context) {
+	var req CreateHoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if !req.Amount.IsPositive() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Amount must be a positive decimal",
+		})
+		return
+	}
+
+	hold, err := h.holdService.PlaceHold(
+		c.Request.Context(),
+		operationID(c),
+		req.UserID,
+		req.Currency,
+		req.Amount,
+		time.Duration(req.TTLSeconds)*time.Second,
+	)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, holdResponse(hold))
+}
+
+// GetHold handles the request to get a hold by its ID
+func (h *HoldHandler) GetHold(c *gin.Context) {
+	hold, err := h.holdService.GetHold(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, holdResponse(hold))
+}
+
+// CaptureHoldRequest represents a request to capture a hold; an empty amount captures it fully
+type CaptureHoldRequest struct {
+	Amount *decimal.Decimal `json:"amount"`
+}
+
+// CaptureHold handles the request to capture a hold into a withdrawal
+func (h *HoldHandler) CaptureHold(c *gin.Context) {
+	var req CaptureHoldRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+	}
+
+	hold, err := h.holdService.CaptureHold(c.Request.Context(), c.Param("id"), req.Amount)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, holdResponse(hold))
+}
+
+// VoidHold handles the request to release a hold
+func (h *HoldHandler) VoidHold(c *gin.Context) {
+	hold, err := h.holdService.VoidHold(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, holdResponse(hold))
+}
+
+// holdResponse renders a hold
+func holdResponse(hold *ent.Hold) gin.H {
+	return gin.H{
+		"id":              hold.ID,
+		"user_id":         hold.UserID,
+		"amount":          hold.Amount,
+		"captured_amount": hold.CapturedAmount,
+		"currency":        hold.Currency,
+		"status":          hold.Status,
+		"transaction_id":  hold.TransactionID,
+		"expires_at":      hold.ExpiresAt,
+		"created_at":      hold.CreatedAt,
+	}
+}
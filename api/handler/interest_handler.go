@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/interestplan"
+	"accounting/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// InterestHandler represents the handler for interest API
+type InterestHandler struct {
+	interestService *service.InterestService
+}
+
+// NewInterestHandler creates a new interest handler
+func NewInterestHandler(interestService *service.InterestService) *InterestHandler {
+	return &InterestHandler{
+		interestService: interestService,
+	}
+}
+
+// CreateInterestPlanRequest represents a request to create an interest plan.
+// The annual percentage is in percent, e.g. "2.5" for 2.5% per year.
+type CreateInterestPlanRequest struct {
+	Name             string          `json:"name" binding:"required"`
+	AnnualPercentage decimal.Decimal `json:"annual_percentage"`
+	DayCount         string          `json:"day_count" binding:"required,oneof=act_365 thirty_360"`
+}
+
+// CreateInterestPlan handles the request to create a new interest plan
+func (h *InterestHandler) CreateInterestPlan(c *gin.Context) {
+	var req CreateInterestPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	plan, err := h.interestService.CreatePlan(c.Request.Context(), req.Name, req.AnnualPercentage,
+		interestplan.DayCount(req.DayCount))
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, interestPlanResponse(plan))
+}
+
+// GetInterestPlans handles the request to list all interest plans
+func (h *InterestHandler) GetInterestPlans(c *gin.Context) {
+	plans, err := h.interestService.GetPlans(c.Request.Context())
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	items := make([]gin.H, 0, len(plans))
+	for _, plan := range plans {
+		items = append(items, interestPlanResponse(plan))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"interest_plans": items,
+	})
+}
+
+// AssignInterestPlanRequest represents a request to set the interest plan of a
+// balance; a null plan ID stops the balance from earning interest
+type AssignInterestPlanRequest struct {
+	InterestPlanID *int `json:"interest_plan_id"`
+}
+
+// AssignInterestPlan handles the request to set the interest plan of a user's balance in a currency
+func (h *InterestHandler) AssignInterestPlan(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid user ID",
+		})
+		return
+	}
+
+	var req AssignInterestPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	b, err := h.interestService.AssignPlan(c.Request.Context(), userID, c.Param("currency"), req.InterestPlanID)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, balanceResponse(b))
+}
+
+// AccrueInterestRequest represents a request to accrue a day of interest; the
+// date is formatted as YYYY-MM-DD and interpreted in UTC
+type AccrueInterestRequest struct {
+	Date string `json:"date" binding:"required"`
+}
+
+// AccrueInterest handles the request to accrue, or re-run, a day of interest
+func (h *InterestHandler) AccrueInterest(c *gin.Context) {
+	var req AccrueInterestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	date, err := time.Parse(time.DateOnly, req.Date)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid date, expected YYYY-MM-DD",
+		})
+		return
+	}
+
+	result, err := h.interestService.AccrueDay(c.Request.Context(), date)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// interestPlanResponse renders an interest plan
+func interestPlanResponse(plan *ent.InterestPlan) gin.H {
+	return gin.H{
+		"id":                plan.ID,
+		"name":              plan.Name,
+		"annual_percentage": plan.AnnualPercentage,
+		"day_count":         plan.DayCount,
+		"created_at":        plan.CreatedAt,
+	}
+}
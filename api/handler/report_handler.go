@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"accounting/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReportHandler represents the handler for report API
+type ReportHandler struct {
+	reportService *service.ReportService
+}
+
+// NewReportHandler creates a new report handler
+func NewReportHandler(reportService *service.ReportService) *ReportHandler {
+	return &ReportHandler{
+		reportService: reportService,
+	}
+}
+
+// csvReport is a report that can be rendered as CSV as well as JSON
+type csvReport interface {
+	WriteCSV(w io.Writer) error
+}
+
+// GetTrialBalance handles the request for the trial balance at the as_of
+// query parameter (RFC 3339), or now when it is missing
+func (h *ReportHandler) GetTrialBalance(c *gin.Context) {
+	asOf, ok := timeQueryParam(c, "as_of", time.Now())
+	if !ok {
+		return
+	}
+
+	report, err := h.reportService.TrialBalance(c.Request.Context(), asOf)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	writeReport(c, report)
+}
+
+// GetLiabilities handles the request for the money owed to users at the as_of
+// query parameter (RFC 3339), or now when it is missing
+func (h *ReportHandler) GetLiabilities(c *gin.Context) {
+	asOf, ok := timeQueryParam(c, "as_of", time.Now())
+	if !ok {
+		return
+	}
+
+	report, err := h.reportService.Liabilities(c.Request.Context(), asOf)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	writeReport(c, report)
+}
+
+// GetFlows handles the request for the deposits and withdrawals from the from
+// query parameter until the until query parameter (both RFC 3339; until
+// defaults to now)
+func (h *ReportHandler) GetFlows(c *gin.Context) {
+	if c.Query("from") == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "from is required",
+		})
+		return
+	}
+	from, ok := timeQueryParam(c, "from", time.Time{})
+	if !ok {
+		return
+	}
+	until, ok := timeQueryParam(c, "until", time.Now())
+	if !ok {
+		return
+	}
+
+	report, err := h.reportService.Flows(c.Request.Context(), from, until)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	writeReport(c, report)
+}
+
+// timeQueryParam parses an RFC 3339 query parameter, returning def when it is
+// missing. An invalid value is answered with 400 and false is returned.
+func timeQueryParam(c *gin.Context, key string, def time.Time) (time.Time, bool) {
+	value := c.Query(key)
+	if value == "" {
+		return def, true
+	}
+
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": key + " must be an RFC 3339 timestamp",
+		})
+		return time.Time{}, false
+	}
+	return at, true
+}
+
+// writeReport renders a report as JSON, or as CSV with the format=csv query parameter
+func writeReport(c *gin.Context, report csvReport) {
+	switch c.DefaultQuery("format", "json") {
+	case "json":
+		c.JSON(http.StatusOK, report)
+	case "csv":
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Status(http.StatusOK)
+		if err := report.WriteCSV(c.Writer); err != nil {
+			_ = c.Error(err)
+		}
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "format must be json or csv",
+		})
+	}
+}
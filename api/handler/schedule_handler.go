@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/schedule"
+	"accounting/repository"
+	"accounting/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// ScheduleHandler represents the handler for scheduled transaction API
+type ScheduleHandler struct {
+	scheduleService *service.ScheduleService
+}
+
+// NewScheduleHandler creates a new schedule handler
+func NewScheduleHandler(scheduleService *service.ScheduleService) *ScheduleHandler {
+	return &ScheduleHandler{
+		scheduleService: scheduleService,
+	}
+}
+
+// CreateScheduleRequest represents a request to schedule deposits or withdrawals.
+// The recurrence follows RRULE: frequency, interval (default 1), an optional count
+// of occurrences and an optional end. A "once" schedule runs a single time at start_at.
+type CreateScheduleRequest struct {
+	UserID    int             `json:"user_id" binding:"required"`
+	Type      string          `json:"type" binding:"required,oneof=deposit withdrawal"`
+	Amount    decimal.Decimal `json:"amount"`
+	Currency  string          `json:"currency" binding:"required"`
+	Frequency string          `json:"frequency" binding:"required,oneof=once daily weekly monthly yearly"`
+	Interval  int             `json:"interval" binding:"gte=0"`
+	Count     *int            `json:"count" binding:"omitempty,gt=0"`
+	StartAt   time.Time       `json:"start_at" binding:"required"`
+	EndAt     *time.Time      `json:"end_at"`
+}
+
+// CreateSchedule handles the request to create a new schedule
+func (h *ScheduleHandler) CreateSchedule(c *gin.Context) {
+	var req CreateScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if !req.Amount.IsPositive() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Amount must be a positive decimal",
+		})
+		return
+	}
+
+	sch, err := h.scheduleService.CreateSchedule(c.Request.Context(), repository.CreateScheduleParams{
+		UserID:    req.UserID,
+		Type:      schedule.Type(req.Type),
+		Amount:    req.Amount,
+		Currency:  req.Currency,
+		Frequency: schedule.Frequency(req.Frequency),
+		Interval:  req.Interval,
+		Count:     req.Count,
+		StartAt:   req.StartAt,
+		EndAt:     req.EndAt,
+	})
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, scheduleResponse(sch))
+}
+
+// GetSchedule handles the request to get a schedule with its runs
+func (h *ScheduleHandler) GetSchedule(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid schedule ID",
+		})
+		return
+	}
+
+	sch, err := h.scheduleService.GetSchedule(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, scheduleResponse(sch))
+}
+
+// CancelSchedule handles the request to stop an active schedule
+func (h *ScheduleHandler) CancelSchedule(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid schedule ID",
+		})
+		return
+	}
+
+	sch, err := h.scheduleService.CancelSchedule(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, scheduleResponse(sch))
+}
+
+// scheduleResponse renders a schedule, with its runs when they are loaded
+func scheduleResponse(s *ent.Schedule) gin.H {
+	response := gin.H{
+		"id":              s.ID,
+		"user_id":         s.UserID,
+		"type":            s.Type,
+		"amount":          s.Amount,
+		"currency":        s.Currency,
+		"frequency":       s.Frequency,
+		"interval":        s.Interval,
+		"count":           s.Count,
+		"start_at":        s.StartAt,
+		"end_at":          s.EndAt,
+		"status":          s.Status,
+		"next_occurrence": s.NextOccurrence,
+		"next_run_at":     s.NextRunAt,
+		"attempts":        s.Attempts,
+		"last_error":      s.LastError,
+		"created_at":      s.CreatedAt,
+	}
+
+	if s.Edges.Runs != nil {
+		runs := make([]gin.H, 0, len(s.Edges.Runs))
+		for _, run := range s.Edges.Runs {
+			runs = append(runs, gin.H{
+				"occurrence":     run.Occurrence,
+				"scheduled_at":   run.ScheduledAt,
+				"status":         run.Status,
+				"transaction_id": run.TransactionID,
+				"error":          run.Error,
+				"created_at":     run.CreatedAt,
+			})
+		}
+		response["runs"] = runs
+	}
+
+	return response
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+
+	"accounting/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StatementHandler represents the handler for account statement API
+type StatementHandler struct {
+	statementService *service.StatementService
+}
+
+// NewStatementHandler creates a new statement handler
+func NewStatementHandler(statementService *service.StatementService) *StatementHandler {
+	return &StatementHandler{
+		statementService: statementService,
+	}
+}
+
+// GetStatement handles the request for the monthly statement of a user in the
+// currency query parameter. The statement is JSON unless the format query
+// parameter asks for csv or pdf, which are sent as attachments.
+func (h *StatementHandler) GetStatement(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid user ID",
+		})
+		return
+	}
+
+	currency := c.Query("currency")
+	if currency == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "currency is required",
+		})
+		return
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" && format != "pdf" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "format must be json, csv or pdf",
+		})
+		return
+	}
+
+	s, err := h.statementService.GetMonthlyStatement(c.Request.Context(), userID, currency, c.Param("month"))
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if format == "json" {
+		c.JSON(http.StatusOK, s)
+		return
+	}
+
+	// Render into memory first, so a failure can still be answered with an error status
+	var buf bytes.Buffer
+	contentType := "text/csv; charset=utf-8"
+	if format == "csv" {
+		err = s.WriteCSV(&buf)
+	} else {
+		contentType = "application/pdf"
+		err = s.WritePDF(&buf)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+s.FileName(format)+`"`)
+	c.Data(http.StatusOK, contentType, buf.Bytes())
+}
@@ -1,14 +1,19 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
+	"accounting/ent"
 	"accounting/ent/transaction"
+	"accounting/repository"
 	"accounting/service"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 	jsoniter "github.com/json-iterator/go"
+	"github.com/shopspring/decimal"
 )
 
 // TransactionHandler represents the handler for transaction API
@@ -23,12 +28,37 @@ func NewTransactionHandler(transactionService *service.TransactionService) *Tran
 	}
 }
 
-// CreateTransactionRequest represents a request to create a transaction
+// CreateTransactionRequest represents a request to create a transaction.
+// Amount is an exact decimal and should be sent as a string (e.g. "10.25").
+// ID is optional; when set it is used as the idempotency key and transaction ID.
+// It must not contain ":", which separates the parts of derived IDs.
+// Status "pending" records the transaction without applying it to the balance.
+// EffectiveAt back-dates a posted transaction into an open accounting period.
 type CreateTransactionRequest struct {
-	UserID   int     `json:"user_id" binding:"required"`
-	Amount   float64 `json:"amount" binding:"required,gt=0"`
-	Currency string  `json:"currency" binding:"required"`
-	Type     string  `json:"type" binding:"required,oneof=deposit withdrawal"`
+	ID          string          `json:"id" binding:"omitempty,max=255,excludes=:"`
+	UserID      int             `json:"user_id" binding:"required"`
+	Amount      decimal.Decimal `json:"amount"`
+	Currency    string          `json:"currency" binding:"required"`
+	Type        string          `json:"type" binding:"required,oneof=deposit withdrawal"`
+	Status      string          `json:"status" binding:"omitempty,oneof=pending posted"`
+	Description *string         `json:"description"`
+	Category    *string         `json:"category"`
+	Source      *string         `json:"source"`
+	ExternalRef *string         `json:"external_ref"`
+	Metadata    map[string]any  `json:"metadata"`
+	EffectiveAt *time.Time      `json:"effective_at"`
+}
+
+// details returns the descriptive fields of the request
+func (r CreateTransactionRequest) details() repository.TransactionDetails {
+	return repository.TransactionDetails{
+		Description: r.Description,
+		Category:    r.Category,
+		Source:      r.Source,
+		ExternalRef: r.ExternalRef,
+		Metadata:    r.Metadata,
+		EffectiveAt: r.EffectiveAt,
+	}
 }
 
 // CreateTransaction handles the request to create a new transaction
@@ -41,6 +71,13 @@ func (h *TransactionHandler) CreateTransaction(c *gin.Context) {
 		return
 	}
 
+	if !req.Amount.IsPositive() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Amount must be a positive decimal",
+		})
+		return
+	}
+
 	// Convert string transaction type to Ent type
 	var txType transaction.Type
 	switch req.Type {
@@ -55,19 +92,25 @@ func (h *TransactionHandler) CreateTransaction(c *gin.Context) {
 		return
 	}
 
-	// Generate a unique ID for the transaction
-	transactionID := uuid.New().String()
+	// Use the idempotency key as the transaction ID, or generate a unique one
+	transactionID := operationID(c)
 
-	tx, err := h.transactionService.Create(
+	create := h.transactionService.Create
+	if req.Status == string(transaction.StatusPending) {
+		create = h.transactionService.CreatePending
+	}
+
+	tx, err := create(
 		c.Request.Context(),
 		transactionID,
 		req.UserID,
 		req.Currency,
 		req.Amount,
 		txType,
+		req.details(),
 	)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		c.JSON(statusForError(err), gin.H{
 			"error": err.Error(),
 		})
 		return
@@ -82,6 +125,7 @@ func (h *TransactionHandler) CreateTransaction(c *gin.Context) {
 				"amount":     tx.Amount,
 				"currency":   tx.Currency,
 				"type":       tx.Type,
+				"status":     tx.Status,
 				"created_at": tx.CreatedAt,
 			})
 			if err == nil {
@@ -98,6 +142,274 @@ func (h *TransactionHandler) CreateTransaction(c *gin.Context) {
 		"amount":     tx.Amount,
 		"currency":   tx.Currency,
 		"type":       tx.Type,
+		"status":     tx.Status,
 		"created_at": tx.CreatedAt,
 	})
 }
+
+// CreateBatchRequest represents a request to create several deposits and
+// withdrawals at once. Items without an ID get "<batch ID>:<index>", where the
+// batch ID is the idempotency key of the request if it has one. BestEffort
+// commits the successful items even if others fail.
+type CreateBatchRequest struct {
+	Items      []CreateTransactionRequest `json:"items" binding:"required,dive"`
+	BestEffort bool                       `json:"best_effort"`
+}
+
+// CreateBatch handles the request to create a batch of transactions in one DB transaction
+func (h *TransactionHandler) CreateBatch(c *gin.Context) {
+	var req CreateBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	batchID := operationID(c)
+	items := make([]repository.CreateTransactionParams, 0, len(req.Items))
+	for i, item := range req.Items {
+		id := item.ID
+		if id == "" {
+			id = fmt.Sprintf("%s%s%d", batchID, repository.DerivedIDSeparator, i)
+		}
+		items = append(items, repository.CreateTransactionParams{
+			ID:       id,
+			UserID:   item.UserID,
+			Amount:   item.Amount,
+			Currency: item.Currency,
+			Type:     transaction.Type(item.Type),
+			Details:  item.details(),
+			Pending:  item.Status == string(transaction.StatusPending),
+		})
+	}
+
+	results, err := h.transactionService.CreateBatch(c.Request.Context(), items, req.BestEffort)
+	if results == nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	applied, failed := 0, 0
+	rendered := make([]gin.H, 0, len(results))
+	for i, result := range results {
+		item := gin.H{
+			"index": i,
+			"id":    items[i].ID,
+		}
+		switch {
+		case result.Transaction != nil:
+			applied++
+			item["status"] = "applied"
+			item["transaction"] = transactionResponse(result.Transaction)
+		case result.Err != nil:
+			failed++
+			item["status"] = "failed"
+			item["error"] = result.Err.Error()
+		default:
+			item["status"] = "not_applied"
+		}
+		rendered = append(rendered, item)
+	}
+
+	response := gin.H{
+		"batch_id":    batchID,
+		"best_effort": req.BestEffort,
+		"applied":     applied,
+		"failed":      failed,
+		"results":     rendered,
+	}
+	if err != nil {
+		response["error"] = err.Error()
+		c.JSON(statusForError(err), response)
+		return
+	}
+
+	status := http.StatusCreated
+	if failed > 0 {
+		status = http.StatusOK
+	}
+	c.JSON(status, response)
+}
+
+// FindTransactions handles the request to search transactions. The user_id,
+// type, currency, category, source and external_ref query parameters and
+// metadata[<key>]=<value> pairs narrow the search; limit caps the number of
+// returned transactions, newest first.
+func (h *TransactionHandler) FindTransactions(c *gin.Context) {
+	var filter repository.TransactionFilter
+	if value, ok := c.GetQuery("user_id"); ok {
+		userID, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid user ID",
+			})
+			return
+		}
+		filter.UserID = &userID
+	}
+	if value, ok := c.GetQuery("type"); ok {
+		txType := transaction.Type(value)
+		if err := transaction.TypeValidator(txType); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		filter.Type = &txType
+	}
+	if value, ok := c.GetQuery("limit"); ok {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid limit",
+			})
+			return
+		}
+		filter.Limit = limit
+	}
+	filter.Currency = queryParam(c, "currency")
+	filter.Category = queryParam(c, "category")
+	filter.Source = queryParam(c, "source")
+	filter.ExternalRef = queryParam(c, "external_ref")
+	filter.Metadata = c.QueryMap("metadata")
+
+	txs, err := h.transactionService.FindTransactions(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	items := make([]gin.H, 0, len(txs))
+	for _, tx := range txs {
+		items = append(items, transactionResponse(tx))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"transactions": items,
+	})
+}
+
+// GetTransaction handles the request to get a transaction by its ID
+func (h *TransactionHandler) GetTransaction(c *gin.Context) {
+	tx, err := h.transactionService.GetTransactionByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, transactionResponse(tx))
+}
+
+// ReverseTransactionRequest represents a request to reverse a transaction;
+// an empty amount reverses the whole remaining amount
+type ReverseTransactionRequest struct {
+	Amount *decimal.Decimal `json:"amount"`
+}
+
+// ReverseTransaction handles the request to fully or partially reverse a transaction
+func (h *TransactionHandler) ReverseTransaction(c *gin.Context) {
+	var req ReverseTransactionRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+	}
+
+	if req.Amount != nil && !req.Amount.IsPositive() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Amount must be a positive decimal",
+		})
+		return
+	}
+
+	// Use the idempotency key as the ID of the compensating transaction, or generate a unique one
+	reversalID := operationID(c)
+
+	result, err := h.transactionService.Reverse(c.Request.Context(), reversalID, c.Param("id"), req.Amount)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"reversal": transactionResponse(result.Reversal),
+		"original": transactionResponse(result.Original),
+	})
+}
+
+// PostTransaction handles the request to apply a pending transaction to the balance
+func (h *TransactionHandler) PostTransaction(c *gin.Context) {
+	tx, err := h.transactionService.Post(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, transactionResponse(tx))
+}
+
+// FailTransactionRequest represents a request to mark a pending transaction as failed
+type FailTransactionRequest struct {
+	Reason string `json:"reason" binding:"required,max=1024"`
+}
+
+// FailTransaction handles the request to mark a pending transaction as failed
+func (h *TransactionHandler) FailTransaction(c *gin.Context) {
+	var req FailTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	tx, err := h.transactionService.Fail(c.Request.Context(), c.Param("id"), req.Reason)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, transactionResponse(tx))
+}
+
+// transactionResponse renders a transaction
+func transactionResponse(tx *ent.Transaction) gin.H {
+	return gin.H{
+		"id":               tx.ID,
+		"user_id":          tx.UserID,
+		"amount":           tx.Amount,
+		"currency":         tx.Currency,
+		"type":             tx.Type,
+		"status":           tx.Status,
+		"reversed_amount":  tx.ReversedAmount,
+		"reversal_of_id":   tx.ReversalOfID,
+		"fee_of_id":        tx.FeeOfID,
+		"failure_reason":   tx.FailureReason,
+		"description":      tx.Description,
+		"category":         tx.Category,
+		"source":           tx.Source,
+		"external_ref":     tx.ExternalRef,
+		"metadata":         tx.Metadata,
+		"balance_after":    tx.BalanceAfter,
+		"balance_sequence": tx.BalanceSequence,
+		"created_at":       tx.CreatedAt,
+		"posted_at":        tx.PostedAt,
+		"effective_at":     tx.EffectiveAt,
+	}
+}
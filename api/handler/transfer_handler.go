@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"net/http"
+
+	"accounting/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// TransferHandler represents the handler for transfer API
+type TransferHandler struct {
+	transactionService *service.TransactionService
+}
+
+// NewTransferHandler creates a new transfer handler
+func NewTransferHandler(transactionService *service.TransactionService) *TransferHandler {
+	return &TransferHandler{
+		transactionService: transactionService,
+	}
+}
+
+// CreateTransferRequest represents a request to transfer money between two users
+type CreateTransferRequest struct {
+	FromUserID int             `json:"from_user_id" binding:"required"`
+	ToUserID   int             `json:"to_user_id" binding:"required,nefield=FromUserID"`
+	Amount     decimal.Decimal `json:"amount"`
+	Currency   string          `json:"currency" binding:"required"`
+}
+
+// CreateTransfer handles the request to create a new transfer
+func (h *TransferHandler) CreateTransfer(c *gin.Context) {
+	var req CreateTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if !req.Amount.IsPositive() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Amount must be a positive decimal",
+		})
+		return
+	}
+
+	// Use the idempotency key as the transfer ID, or generate a unique one
+	transferID := operationID(c)
+
+	legs, err := h.transactionService.Transfer(
+		c.Request.Context(),
+		transferID,
+		req.FromUserID,
+		req.ToUserID,
+		req.Currency,
+		req.Amount,
+	)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"transfer_id": transferID,
+		"out":         transactionResponse(legs.Out),
+		"in":          transactionResponse(legs.In),
+	})
+}
@@ -1,8 +1,12 @@
 package handler
 
 import (
+	"context"
 	"net/http"
+	"strconv"
 
+	"accounting/ent"
+	"accounting/repository"
 	"accounting/service"
 
 	"github.com/gin-gonic/gin"
@@ -70,3 +74,193 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 		"age":   user.Age,
 	})
 }
+
+// SetTierRequest represents a request to change the pricing tier of a user
+type SetTierRequest struct {
+	Tier string `json:"tier" binding:"required,max=64"`
+}
+
+// SetTier handles the request to change the pricing tier of a user
+func (h *UserHandler) SetTier(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid user ID",
+		})
+		return
+	}
+
+	var req SetTierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	user, err := h.userService.SetTier(c.Request.Context(), id, req.Tier)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, userResponse(user))
+}
+
+// ChangeStatusRequest represents a request to freeze or unfreeze the account of a user
+type ChangeStatusRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// FreezeUser handles the request to restrict the account of a user to deposits
+func (h *UserHandler) FreezeUser(c *gin.Context) {
+	h.changeStatus(c, h.userService.Freeze)
+}
+
+// UnfreezeUser handles the request to lift the restrictions of a frozen account
+func (h *UserHandler) UnfreezeUser(c *gin.Context) {
+	h.changeStatus(c, h.userService.Unfreeze)
+}
+
+// changeStatus binds a status change request and applies it with change
+func (h *UserHandler) changeStatus(c *gin.Context,
+	change func(ctx context.Context, id int, reason string) (*ent.User, error)) {
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid user ID",
+		})
+		return
+	}
+
+	var req ChangeStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	user, err := change(c.Request.Context(), id, req.Reason)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, userResponse(user))
+}
+
+// CloseUserRequest represents a request to close the account of a user. Without
+// a sweep user every balance has to be zero; with one, positive balances are
+// transferred to that user.
+type CloseUserRequest struct {
+	Reason        string `json:"reason" binding:"required,max=500"`
+	SweepToUserID *int   `json:"sweep_to_user_id"`
+}
+
+// CloseUser handles the request to close the account of a user
+func (h *UserHandler) CloseUser(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid user ID",
+		})
+		return
+	}
+
+	var req CloseUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	closed, err := h.userService.Close(c.Request.Context(), repository.CloseUserParams{
+		UserID:        id,
+		Reason:        req.Reason,
+		SweepToUserID: req.SweepToUserID,
+	})
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	sweeps := make([]gin.H, 0, len(closed.Sweeps))
+	for _, legs := range closed.Sweeps {
+		sweeps = append(sweeps, gin.H{
+			"transfer_id": legs.Out.TransferID,
+			"to_user_id":  legs.In.UserID,
+			"amount":      legs.Out.Amount,
+			"currency":    legs.Out.Currency,
+		})
+	}
+
+	response := userResponse(closed.User)
+	response["sweeps"] = sweeps
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteUser handles the request to soft-delete a closed account
+func (h *UserHandler) DeleteUser(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid user ID",
+		})
+		return
+	}
+
+	if err := h.userService.Delete(c.Request.Context(), id); err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// EraseUser handles the request to erase the personal data of a closed account
+func (h *UserHandler) EraseUser(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid user ID",
+		})
+		return
+	}
+
+	user, err := h.userService.Erase(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, userResponse(user))
+}
+
+// userResponse renders a user
+func userResponse(user *ent.User) gin.H {
+	return gin.H{
+		"id":                user.ID,
+		"name":              user.Name,
+		"email":             user.Email,
+		"age":               user.Age,
+		"tier":              user.Tier,
+		"status":            user.Status,
+		"status_reason":     user.StatusReason,
+		"status_changed_at": user.StatusChangedAt,
+		"deleted_at":        user.DeletedAt,
+		"erased_at":         user.ErasedAt,
+	}
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/velocitylimit"
+	"accounting/repository"
+	"accounting/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// VelocityLimitHandler represents the handler for velocity limit API
+type VelocityLimitHandler struct {
+	velocityLimitService *service.VelocityLimitService
+}
+
+// NewVelocityLimitHandler creates a new velocity limit handler
+func NewVelocityLimitHandler(velocityLimitService *service.VelocityLimitService) *VelocityLimitHandler {
+	return &VelocityLimitHandler{
+		velocityLimitService: velocityLimitService,
+	}
+}
+
+// CreateVelocityLimitRequest represents a request to create a velocity limit.
+// The window is in seconds, e.g. 86400 for a rolling 24 hours; max_amount is a
+// decimal string in the limit's currency.
+type CreateVelocityLimitRequest struct {
+	Name            string           `json:"name" binding:"required"`
+	TransactionType string           `json:"transaction_type" binding:"required,oneof=deposit withdrawal"`
+	Currency        string           `json:"currency" binding:"required"`
+	Tier            *string          `json:"tier"`
+	WindowSeconds   int64            `json:"window_seconds" binding:"required"`
+	MaxCount        *int             `json:"max_count"`
+	MaxAmount       *decimal.Decimal `json:"max_amount"`
+}
+
+// CreateVelocityLimit handles the request to create a new velocity limit
+func (h *VelocityLimitHandler) CreateVelocityLimit(c *gin.Context) {
+	var req CreateVelocityLimitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	limit, err := h.velocityLimitService.CreateLimit(c.Request.Context(), repository.CreateVelocityLimitParams{
+		Name:            req.Name,
+		TransactionType: velocitylimit.TransactionType(req.TransactionType),
+		Currency:        req.Currency,
+		Tier:            req.Tier,
+		Window:          time.Duration(req.WindowSeconds) * time.Second,
+		MaxCount:        req.MaxCount,
+		MaxAmount:       req.MaxAmount,
+	})
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, velocityLimitResponse(limit))
+}
+
+// GetVelocityLimits handles the request to list all velocity limits
+func (h *VelocityLimitHandler) GetVelocityLimits(c *gin.Context) {
+	limits, err := h.velocityLimitService.GetLimits(c.Request.Context())
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	items := make([]gin.H, 0, len(limits))
+	for _, limit := range limits {
+		items = append(items, velocityLimitResponse(limit))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"velocity_limits": items,
+	})
+}
+
+// UpdateVelocityLimitRequest represents a request to turn a velocity limit on or off
+type UpdateVelocityLimitRequest struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+// UpdateVelocityLimit handles the request to turn a velocity limit on or off
+func (h *VelocityLimitHandler) UpdateVelocityLimit(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid velocity limit ID",
+		})
+		return
+	}
+
+	var req UpdateVelocityLimitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	limit, err := h.velocityLimitService.SetEnabled(c.Request.Context(), id, *req.Enabled)
+	if err != nil {
+		c.JSON(statusForError(err), gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, velocityLimitResponse(limit))
+}
+
+// velocityLimitResponse renders a velocity limit
+func velocityLimitResponse(limit *ent.VelocityLimit) gin.H {
+	return gin.H{
+		"id":               limit.ID,
+		"name":             limit.Name,
+		"transaction_type": limit.TransactionType,
+		"currency":         limit.Currency,
+		"tier":             limit.Tier,
+		"window_seconds":   limit.WindowSeconds,
+		"max_count":        limit.MaxCount,
+		"max_amount":       limit.MaxAmount,
+		"enabled":          limit.Enabled,
+	}
+}
@@ -0,0 +1,165 @@
+// Package middleware contains Gin middleware shared by the API endpoints.
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"accounting/errors"
+	"accounting/repository"
+	"accounting/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IdempotencyKeyHeader is the request header carrying the client-supplied idempotency key
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader is set on responses replayed from a previous request
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// IdempotencyKeyContextKey is the Gin context key under which the idempotency key of the request is stored
+const IdempotencyKeyContextKey = "idempotency_key"
+
+// maxIdempotencyKeyLength is the maximum length of an idempotency key
+const maxIdempotencyKeyLength = 255
+
+// Idempotency makes a POST endpoint safe to retry. The key is taken from the
+// Idempotency-Key header or, when absent, from the "id" field of the JSON body.
+// Keys are scoped by route, so the same key may be used on different endpoints.
+// The first request with a key is executed and its response stored; retries
+// with the same key and payload get the stored response with the original
+// status, while reusing the key for a different payload results in 409.
+// Requests without a key are passed through unchanged.
+func Idempotency(idempotencyService *service.IdempotencyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": "Failed reading request body",
+			})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		normalized, bodyID := normalizeBody(body)
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			key = bodyID
+		}
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": "Idempotency key must be at most 255 characters",
+			})
+			return
+		}
+		// The key becomes the ID of the created resource, from which further IDs are derived
+		if strings.Contains(key, repository.DerivedIDSeparator) {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": fmt.Sprintf("Idempotency key must not contain %q", repository.DerivedIDSeparator),
+			})
+			return
+		}
+
+		ctx := c.Request.Context()
+		scoped := service.ScopedIdempotencyKey(c.Request.Method, c.FullPath(), key)
+		fingerprint := service.Fingerprint(c.Request.Method, c.Request.URL.Path, normalized)
+
+		stored, err := idempotencyService.Begin(ctx, scoped, fingerprint)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.IsDuplicateResource(err) {
+				status = http.StatusConflict
+			}
+			c.AbortWithStatusJSON(status, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if stored != nil {
+			c.Header(IdempotentReplayedHeader, "true")
+			c.Data(stored.Status, "application/json; charset=utf-8", stored.Body)
+			c.Abort()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Set(IdempotencyKeyContextKey, key)
+
+		c.Next()
+
+		// The outcome is recorded even when the client went away meanwhile;
+		// otherwise the key would stay in progress until its lease expires
+		ctx = context.WithoutCancel(ctx)
+
+		// Server errors are not stored, so the client can retry with the same key
+		status := recorder.Status()
+		if status >= http.StatusInternalServerError {
+			if err := idempotencyService.Abort(ctx, scoped); err != nil {
+				log.Printf("Failed releasing idempotency key %q: %v", key, err)
+			}
+			return
+		}
+
+		err = idempotencyService.Complete(ctx, scoped, service.StoredResponse{
+			Status: status,
+			Body:   recorder.body.Bytes(),
+		})
+		if err != nil {
+			log.Printf("Failed storing response for idempotency key %q: %v", key, err)
+		}
+	}
+}
+
+// normalizeBody re-encodes a JSON body with sorted keys, so that formatting
+// differences do not change the fingerprint, and returns its "id" field
+func normalizeBody(body []byte) ([]byte, string) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return body, ""
+	}
+
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return body, ""
+	}
+
+	var id string
+	if object, ok := value.(map[string]interface{}); ok {
+		id, _ = object["id"].(string)
+	}
+
+	return normalized, id
+}
+
+// responseRecorder keeps a copy of the response body written by the handler
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write writes the data to the connection and keeps a copy of it
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString writes the string to the connection and keeps a copy of it
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -11,6 +11,8 @@ import (
 
 	"accounting/api"
 	"accounting/ent"
+	_ "accounting/ent/runtime"
+	"accounting/service"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/gin-gonic/gin"
@@ -44,6 +46,11 @@ func main() {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 
+	// Register ISO 4217 currencies that are not registered yet
+	if err := service.NewCurrencyService(client).SeedISO4217(context.Background()); err != nil {
+		log.Fatalf("failed seeding currencies: %v", err)
+	}
+
 	// Configure faster JSON decoder
 	binding.EnableDecoderUseNumber = true
 
@@ -79,11 +86,21 @@ func main() {
 	}()
 	log.Println("Server is running on :8081")
 
+	// Run background workers until shutdown
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	go runHoldExpiry(workersCtx, service.NewHoldService(client), holdExpiryInterval)
+	go runBalanceSnapshots(workersCtx, service.NewBalanceService(client), balanceSnapshotInterval)
+	go runSchedules(workersCtx, service.NewScheduleService(client), scheduleInterval)
+	go runInterestAccrual(workersCtx, service.NewInterestService(client), interestAccrualInterval)
+	go runReconciliation(workersCtx, service.NewReconciliationService(client), reconciliationInterval)
+
 	// Configure signal handling for graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("Shutting down server...")
+	stopWorkers()
 
 	// Create a deadline for shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
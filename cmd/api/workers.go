@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"accounting/service"
+)
+
+const (
+	// holdExpiryInterval is how often active holds are checked for expiration
+	holdExpiryInterval = 30 * time.Second
+
+	// balanceSnapshotInterval is how often balance snapshots are taken
+	balanceSnapshotInterval = time.Hour
+
+	// balanceSnapshotLag keeps snapshots behind the current time, so postings of
+	// DB transactions that are still in flight are not left out of a snapshot
+	balanceSnapshotLag = 5 * time.Minute
+
+	// scheduleInterval is how often due schedules are executed
+	scheduleInterval = 30 * time.Second
+
+	// interestAccrualInterval is how often the previous day's interest accrual is run
+	interestAccrualInterval = time.Hour
+
+	// reconciliationInterval is how often balances are checked against their transactions
+	reconciliationInterval = 6 * time.Hour
+)
+
+// runHoldExpiry periodically releases holds whose TTL has passed until ctx is cancelled
+func runHoldExpiry(ctx context.Context, holdService *service.HoldService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			expired, err := holdService.ExpireHolds(ctx, now)
+			if err != nil {
+				log.Printf("Failed expiring holds: %v", err)
+				continue
+			}
+			if expired > 0 {
+				log.Printf("Expired %d holds", expired)
+			}
+		}
+	}
+}
+
+// runBalanceSnapshots periodically captures the balances of all user accounts until ctx is cancelled
+func runBalanceSnapshots(ctx context.Context, balanceService *service.BalanceService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			taken, err := balanceService.TakeSnapshots(ctx, now.Add(-balanceSnapshotLag).Truncate(time.Second))
+			if err != nil {
+				log.Printf("Failed taking balance snapshots: %v", err)
+				continue
+			}
+			if taken > 0 {
+				log.Printf("Took %d balance snapshots", taken)
+			}
+		}
+	}
+}
+
+// runReconciliation periodically checks all balances against their transactions
+// until ctx is cancelled. Mismatches are only logged; repairs are left to the
+// reconcile command, so a human decides on every repair.
+func runReconciliation(ctx context.Context, reconciliationService *service.ReconciliationService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := reconciliationService.Reconcile(ctx, false)
+			if err != nil {
+				log.Printf("Failed reconciling balances: %v", err)
+				continue
+			}
+			for _, mismatch := range report.Mismatches {
+				data, _ := json.Marshal(mismatch)
+				log.Printf("Balance mismatch: %s", data)
+			}
+			log.Printf("Reconciled %d balances, %d mismatches", report.Checked, len(report.Mismatches))
+		}
+	}
+}
+
+// runSchedules periodically executes the occurrences of due schedules until ctx is cancelled
+func runSchedules(ctx context.Context, scheduleService *service.ScheduleService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			attempts, err := scheduleService.RunDue(ctx, now)
+			if err != nil {
+				log.Printf("Failed running schedules: %v", err)
+				continue
+			}
+			if attempts > 0 {
+				log.Printf("Executed %d scheduled occurrences", attempts)
+			}
+		}
+	}
+}
+
+// runInterestAccrual periodically accrues the interest of every day up to the
+// previous UTC day that was not accrued yet, until ctx is cancelled. Days missed
+// while the server was down are caught up on the next run, in order.
+func runInterestAccrual(ctx context.Context, interestService *service.InterestService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			results, err := interestService.AccrueMissedDays(ctx, now)
+			for _, result := range results {
+				if result.Accrued > 0 || result.Capitalized > 0 {
+					log.Printf("Accrued interest on %d balances for %s, paid out %d",
+						result.Accrued, result.Date.Format(time.DateOnly), result.Capitalized)
+				}
+			}
+			if err != nil {
+				log.Printf("Failed accruing interest: %v", err)
+			}
+		}
+	}
+}
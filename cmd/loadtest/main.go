@@ -10,6 +10,7 @@ import (
 	"net"
 	"net/http"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -45,16 +46,16 @@ type CreateUserResponse struct {
 }
 
 type CreateTransactionRequest struct {
-	UserID   int     `json:"user_id"`
-	Amount   float64 `json:"amount"`
-	Currency string  `json:"currency"`
-	Type     string  `json:"type"`
+	UserID   int    `json:"user_id"`
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+	Type     string `json:"type"`
 }
 
 type CreateTransactionResponse struct {
 	ID        string    `json:"id"`
 	UserID    int       `json:"user_id"`
-	Amount    float64   `json:"amount"`
+	Amount    string    `json:"amount"`
 	Currency  string    `json:"currency"`
 	Type      string    `json:"type"`
 	CreatedAt time.Time `json:"created_at"`
@@ -188,9 +189,14 @@ func createUser(client *http.Client, metrics *Metrics) (*CreateUserResponse, err
 // Function for creating a transaction
 func createTransaction(client *http.Client, userID int, metrics *Metrics) (*CreateTransactionResponse, error) {
 	// Generate random transaction data
-	amount := rand.Float64() * 1000
 	currencies := []string{"USD", "EUR", "GBP", "JPY"}
 	currency := currencies[rand.Intn(len(currencies))]
+	// Amounts must not have more decimal places than the currency's minor unit
+	decimals := 2
+	if currency == "JPY" {
+		decimals = 0
+	}
+	amount := strconv.FormatFloat(rand.Float64()*1000, 'f', decimals, 64)
 	types := []string{"deposit", "withdrawal"}
 	txType := types[0]
 
@@ -213,6 +219,7 @@ func createTransaction(client *http.Client, userID int, metrics *Metrics) (*Crea
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", uuid.New().String())
 
 	resp, err := client.Do(req)
 	elapsed := time.Since(start)
@@ -253,7 +260,7 @@ func worker(id int, userIDs <-chan int, wg *sync.WaitGroup, client *http.Client,
 				continue
 			}
 			if *verbose {
-				log.Printf("Worker %d: created transaction %s for user %d, type: %s, amount: %.2f %s",
+				log.Printf("Worker %d: created transaction %s for user %d, type: %s, amount: %s %s",
 					id, tx.ID, userID, tx.Type, tx.Amount, tx.Currency)
 			}
 		}
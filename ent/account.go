@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/account"
+	"accounting/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Account is the model entity for the Account schema.
+type Account struct {
+	config `json:"-"`
+	// ID of the ent.
+	// ID of the account
+	ID int `json:"id,omitempty"`
+	// Unique account code (e.g. user:42:USD, system:external_cash:USD)
+	Code string `json:"code,omitempty"`
+	// Type of the account: user wallet or system account
+	Type account.Type `json:"type,omitempty"`
+	// Name of the account (e.g. wallet, external_cash)
+	Name string `json:"name,omitempty"`
+	// ID of the user owning the account, empty for system accounts
+	UserID *int `json:"user_id,omitempty"`
+	// Currency of the account
+	Currency string `json:"currency,omitempty"`
+	// Time of the account creation
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccountQuery when eager-loading is set.
+	Edges        AccountEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AccountEdges holds the relations/edges for other nodes in the graph.
+type AccountEdges struct {
+	// User owning the account
+	User *User `json:"user,omitempty"`
+	// Postings made against the account
+	Postings []*Posting `json:"postings,omitempty"`
+	// Periodic snapshots of the account balance
+	Snapshots []*BalanceSnapshot `json:"snapshots,omitempty"`
+	// Balances of the account in the trial balances of closed accounting periods
+	TrialBalanceLines []*TrialBalanceLine `json:"trial_balance_lines,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// PostingsOrErr returns the Postings value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) PostingsOrErr() ([]*Posting, error) {
+	if e.loadedTypes[1] {
+		return e.Postings, nil
+	}
+	return nil, &NotLoadedError{edge: "postings"}
+}
+
+// SnapshotsOrErr returns the Snapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) SnapshotsOrErr() ([]*BalanceSnapshot, error) {
+	if e.loadedTypes[2] {
+		return e.Snapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "snapshots"}
+}
+
+// TrialBalanceLinesOrErr returns the TrialBalanceLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) TrialBalanceLinesOrErr() ([]*TrialBalanceLine, error) {
+	if e.loadedTypes[3] {
+		return e.TrialBalanceLines, nil
+	}
+	return nil, &NotLoadedError{edge: "trial_balance_lines"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Account) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case account.FieldID, account.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case account.FieldCode, account.FieldType, account.FieldName, account.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case account.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Account fields.
+func (a *Account) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case account.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int(value.Int64)
+		case account.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				a.Code = value.String
+			}
+		case account.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				a.Type = account.Type(value.String)
+			}
+		case account.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				a.Name = value.String
+			}
+		case account.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				a.UserID = new(int)
+				*a.UserID = int(value.Int64)
+			}
+		case account.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				a.Currency = value.String
+			}
+		case account.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				a.CreatedAt = value.Time
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Account.
+// This includes values selected through modifiers, order, etc.
+func (a *Account) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Account entity.
+func (a *Account) QueryUser() *UserQuery {
+	return NewAccountClient(a.config).QueryUser(a)
+}
+
+// QueryPostings queries the "postings" edge of the Account entity.
+func (a *Account) QueryPostings() *PostingQuery {
+	return NewAccountClient(a.config).QueryPostings(a)
+}
+
+// QuerySnapshots queries the "snapshots" edge of the Account entity.
+func (a *Account) QuerySnapshots() *BalanceSnapshotQuery {
+	return NewAccountClient(a.config).QuerySnapshots(a)
+}
+
+// QueryTrialBalanceLines queries the "trial_balance_lines" edge of the Account entity.
+func (a *Account) QueryTrialBalanceLines() *TrialBalanceLineQuery {
+	return NewAccountClient(a.config).QueryTrialBalanceLines(a)
+}
+
+// Update returns a builder for updating this Account.
+// Note that you need to call Account.Unwrap() before calling this method if this Account
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Account) Update() *AccountUpdateOne {
+	return NewAccountClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Account entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Account) Unwrap() *Account {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Account is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Account) String() string {
+	var builder strings.Builder
+	builder.WriteString("Account(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("code=")
+	builder.WriteString(a.Code)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", a.Type))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(a.Name)
+	builder.WriteString(", ")
+	if v := a.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(a.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Accounts is a parsable slice of Account.
+type Accounts []*Account
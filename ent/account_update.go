@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/account"
+	"accounting/ent/balancesnapshot"
+	"accounting/ent/posting"
+	"accounting/ent/predicate"
+	"accounting/ent/trialbalanceline"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccountUpdate is the builder for updating Account entities.
+type AccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccountMutation
+}
+
+// Where appends a list predicates to the AccountUpdate builder.
+func (au *AccountUpdate) Where(ps ...predicate.Account) *AccountUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by IDs.
+func (au *AccountUpdate) AddPostingIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddPostingIDs(ids...)
+	return au
+}
+
+// AddPostings adds the "postings" edges to the Posting entity.
+func (au *AccountUpdate) AddPostings(p ...*Posting) *AccountUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return au.AddPostingIDs(ids...)
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the BalanceSnapshot entity by IDs.
+func (au *AccountUpdate) AddSnapshotIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddSnapshotIDs(ids...)
+	return au
+}
+
+// AddSnapshots adds the "snapshots" edges to the BalanceSnapshot entity.
+func (au *AccountUpdate) AddSnapshots(b ...*BalanceSnapshot) *AccountUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return au.AddSnapshotIDs(ids...)
+}
+
+// AddTrialBalanceLineIDs adds the "trial_balance_lines" edge to the TrialBalanceLine entity by IDs.
+func (au *AccountUpdate) AddTrialBalanceLineIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddTrialBalanceLineIDs(ids...)
+	return au
+}
+
+// AddTrialBalanceLines adds the "trial_balance_lines" edges to the TrialBalanceLine entity.
+func (au *AccountUpdate) AddTrialBalanceLines(t ...*TrialBalanceLine) *AccountUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return au.AddTrialBalanceLineIDs(ids...)
+}
+
+// Mutation returns the AccountMutation object of the builder.
+func (au *AccountUpdate) Mutation() *AccountMutation {
+	return au.mutation
+}
+
+// ClearPostings clears all "postings" edges to the Posting entity.
+func (au *AccountUpdate) ClearPostings() *AccountUpdate {
+	au.mutation.ClearPostings()
+	return au
+}
+
+// RemovePostingIDs removes the "postings" edge to Posting entities by IDs.
+func (au *AccountUpdate) RemovePostingIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemovePostingIDs(ids...)
+	return au
+}
+
+// RemovePostings removes "postings" edges to Posting entities.
+func (au *AccountUpdate) RemovePostings(p ...*Posting) *AccountUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return au.RemovePostingIDs(ids...)
+}
+
+// ClearSnapshots clears all "snapshots" edges to the BalanceSnapshot entity.
+func (au *AccountUpdate) ClearSnapshots() *AccountUpdate {
+	au.mutation.ClearSnapshots()
+	return au
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to BalanceSnapshot entities by IDs.
+func (au *AccountUpdate) RemoveSnapshotIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveSnapshotIDs(ids...)
+	return au
+}
+
+// RemoveSnapshots removes "snapshots" edges to BalanceSnapshot entities.
+func (au *AccountUpdate) RemoveSnapshots(b ...*BalanceSnapshot) *AccountUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return au.RemoveSnapshotIDs(ids...)
+}
+
+// ClearTrialBalanceLines clears all "trial_balance_lines" edges to the TrialBalanceLine entity.
+func (au *AccountUpdate) ClearTrialBalanceLines() *AccountUpdate {
+	au.mutation.ClearTrialBalanceLines()
+	return au
+}
+
+// RemoveTrialBalanceLineIDs removes the "trial_balance_lines" edge to TrialBalanceLine entities by IDs.
+func (au *AccountUpdate) RemoveTrialBalanceLineIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveTrialBalanceLineIDs(ids...)
+	return au
+}
+
+// RemoveTrialBalanceLines removes "trial_balance_lines" edges to TrialBalanceLine entities.
+func (au *AccountUpdate) RemoveTrialBalanceLines(t ...*TrialBalanceLine) *AccountUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return au.RemoveTrialBalanceLineIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AccountUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AccountUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AccountUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if au.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !au.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SnapshotsTable,
+			Columns: []string{account.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !au.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SnapshotsTable,
+			Columns: []string{account.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SnapshotsTable,
+			Columns: []string{account.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.TrialBalanceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TrialBalanceLinesTable,
+			Columns: []string{account.TrialBalanceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedTrialBalanceLinesIDs(); len(nodes) > 0 && !au.mutation.TrialBalanceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TrialBalanceLinesTable,
+			Columns: []string{account.TrialBalanceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.TrialBalanceLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TrialBalanceLinesTable,
+			Columns: []string{account.TrialBalanceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{account.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AccountUpdateOne is the builder for updating a single Account entity.
+type AccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccountMutation
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by IDs.
+func (auo *AccountUpdateOne) AddPostingIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddPostingIDs(ids...)
+	return auo
+}
+
+// AddPostings adds the "postings" edges to the Posting entity.
+func (auo *AccountUpdateOne) AddPostings(p ...*Posting) *AccountUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return auo.AddPostingIDs(ids...)
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the BalanceSnapshot entity by IDs.
+func (auo *AccountUpdateOne) AddSnapshotIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddSnapshotIDs(ids...)
+	return auo
+}
+
+// AddSnapshots adds the "snapshots" edges to the BalanceSnapshot entity.
+func (auo *AccountUpdateOne) AddSnapshots(b ...*BalanceSnapshot) *AccountUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return auo.AddSnapshotIDs(ids...)
+}
+
+// AddTrialBalanceLineIDs adds the "trial_balance_lines" edge to the TrialBalanceLine entity by IDs.
+func (auo *AccountUpdateOne) AddTrialBalanceLineIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddTrialBalanceLineIDs(ids...)
+	return auo
+}
+
+// AddTrialBalanceLines adds the "trial_balance_lines" edges to the TrialBalanceLine entity.
+func (auo *AccountUpdateOne) AddTrialBalanceLines(t ...*TrialBalanceLine) *AccountUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return auo.AddTrialBalanceLineIDs(ids...)
+}
+
+// Mutation returns the AccountMutation object of the builder.
+func (auo *AccountUpdateOne) Mutation() *AccountMutation {
+	return auo.mutation
+}
+
+// ClearPostings clears all "postings" edges to the Posting entity.
+func (auo *AccountUpdateOne) ClearPostings() *AccountUpdateOne {
+	auo.mutation.ClearPostings()
+	return auo
+}
+
+// RemovePostingIDs removes the "postings" edge to Posting entities by IDs.
+func (auo *AccountUpdateOne) RemovePostingIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemovePostingIDs(ids...)
+	return auo
+}
+
+// RemovePostings removes "postings" edges to Posting entities.
+func (auo *AccountUpdateOne) RemovePostings(p ...*Posting) *AccountUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return auo.RemovePostingIDs(ids...)
+}
+
+// ClearSnapshots clears all "snapshots" edges to the BalanceSnapshot entity.
+func (auo *AccountUpdateOne) ClearSnapshots() *AccountUpdateOne {
+	auo.mutation.ClearSnapshots()
+	return auo
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to BalanceSnapshot entities by IDs.
+func (auo *AccountUpdateOne) RemoveSnapshotIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveSnapshotIDs(ids...)
+	return auo
+}
+
+// RemoveSnapshots removes "snapshots" edges to BalanceSnapshot entities.
+func (auo *AccountUpdateOne) RemoveSnapshots(b ...*BalanceSnapshot) *AccountUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return auo.RemoveSnapshotIDs(ids...)
+}
+
+// ClearTrialBalanceLines clears all "trial_balance_lines" edges to the TrialBalanceLine entity.
+func (auo *AccountUpdateOne) ClearTrialBalanceLines() *AccountUpdateOne {
+	auo.mutation.ClearTrialBalanceLines()
+	return auo
+}
+
+// RemoveTrialBalanceLineIDs removes the "trial_balance_lines" edge to TrialBalanceLine entities by IDs.
+func (auo *AccountUpdateOne) RemoveTrialBalanceLineIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveTrialBalanceLineIDs(ids...)
+	return auo
+}
+
+// RemoveTrialBalanceLines removes "trial_balance_lines" edges to TrialBalanceLine entities.
+func (auo *AccountUpdateOne) RemoveTrialBalanceLines(t ...*TrialBalanceLine) *AccountUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return auo.RemoveTrialBalanceLineIDs(ids...)
+}
+
+// Where appends a list predicates to the AccountUpdate builder.
+func (auo *AccountUpdateOne) Where(ps ...predicate.Account) *AccountUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AccountUpdateOne) Select(field string, fields ...string) *AccountUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Account entity.
+func (auo *AccountUpdateOne) Save(ctx context.Context) (*Account, error) {
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AccountUpdateOne) SaveX(ctx context.Context) *Account {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AccountUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err error) {
+	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Account.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, account.FieldID)
+		for _, f := range fields {
+			if !account.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != account.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if auo.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !auo.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SnapshotsTable,
+			Columns: []string{account.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !auo.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SnapshotsTable,
+			Columns: []string{account.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SnapshotsTable,
+			Columns: []string{account.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.TrialBalanceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TrialBalanceLinesTable,
+			Columns: []string{account.TrialBalanceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedTrialBalanceLinesIDs(); len(nodes) > 0 && !auo.mutation.TrialBalanceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TrialBalanceLinesTable,
+			Columns: []string{account.TrialBalanceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.TrialBalanceLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TrialBalanceLinesTable,
+			Columns: []string{account.TrialBalanceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Account{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{account.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
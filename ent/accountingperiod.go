@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/accountingperiod"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AccountingPeriod is the model entity for the AccountingPeriod schema.
+type AccountingPeriod struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Unique name of the period (e.g. 2025-01)
+	Name string `json:"name,omitempty"`
+	// First instant of the period
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// Instant the period ends at, exclusive
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// Status of the period: open, closing (locked for review) or closed
+	Status accountingperiod.Status `json:"status,omitempty"`
+	// Time the period was closed
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// Time of the period creation
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccountingPeriodQuery when eager-loading is set.
+	Edges        AccountingPeriodEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AccountingPeriodEdges holds the relations/edges for other nodes in the graph.
+type AccountingPeriodEdges struct {
+	// Trial balance captured when the period was closed
+	TrialBalanceLines []*TrialBalanceLine `json:"trial_balance_lines,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TrialBalanceLinesOrErr returns the TrialBalanceLines value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountingPeriodEdges) TrialBalanceLinesOrErr() ([]*TrialBalanceLine, error) {
+	if e.loadedTypes[0] {
+		return e.TrialBalanceLines, nil
+	}
+	return nil, &NotLoadedError{edge: "trial_balance_lines"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccountingPeriod) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accountingperiod.FieldID:
+			values[i] = new(sql.NullInt64)
+		case accountingperiod.FieldName, accountingperiod.FieldStatus:
+			values[i] = new(sql.NullString)
+		case accountingperiod.FieldStartsAt, accountingperiod.FieldEndsAt, accountingperiod.FieldClosedAt, accountingperiod.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccountingPeriod fields.
+func (ap *AccountingPeriod) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accountingperiod.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ap.ID = int(value.Int64)
+		case accountingperiod.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ap.Name = value.String
+			}
+		case accountingperiod.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				ap.StartsAt = value.Time
+			}
+		case accountingperiod.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				ap.EndsAt = value.Time
+			}
+		case accountingperiod.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ap.Status = accountingperiod.Status(value.String)
+			}
+		case accountingperiod.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				ap.ClosedAt = new(time.Time)
+				*ap.ClosedAt = value.Time
+			}
+		case accountingperiod.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ap.CreatedAt = value.Time
+			}
+		default:
+			ap.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccountingPeriod.
+// This includes values selected through modifiers, order, etc.
+func (ap *AccountingPeriod) Value(name string) (ent.Value, error) {
+	return ap.selectValues.Get(name)
+}
+
+// QueryTrialBalanceLines queries the "trial_balance_lines" edge of the AccountingPeriod entity.
+func (ap *AccountingPeriod) QueryTrialBalanceLines() *TrialBalanceLineQuery {
+	return NewAccountingPeriodClient(ap.config).QueryTrialBalanceLines(ap)
+}
+
+// Update returns a builder for updating this AccountingPeriod.
+// Note that you need to call AccountingPeriod.Unwrap() before calling this method if this AccountingPeriod
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ap *AccountingPeriod) Update() *AccountingPeriodUpdateOne {
+	return NewAccountingPeriodClient(ap.config).UpdateOne(ap)
+}
+
+// Unwrap unwraps the AccountingPeriod entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ap *AccountingPeriod) Unwrap() *AccountingPeriod {
+	_tx, ok := ap.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccountingPeriod is not a transactional entity")
+	}
+	ap.config.driver = _tx.drv
+	return ap
+}
+
+// String implements the fmt.Stringer.
+func (ap *AccountingPeriod) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccountingPeriod(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ap.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ap.Name)
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(ap.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(ap.EndsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ap.Status))
+	builder.WriteString(", ")
+	if v := ap.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ap.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccountingPeriods is a parsable slice of AccountingPeriod.
+type AccountingPeriods []*AccountingPeriod
@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountingperiod
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the accountingperiod type in the database.
+	Label = "accounting_period"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldClosedAt holds the string denoting the closed_at field in the database.
+	FieldClosedAt = "closed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTrialBalanceLines holds the string denoting the trial_balance_lines edge name in mutations.
+	EdgeTrialBalanceLines = "trial_balance_lines"
+	// Table holds the table name of the accountingperiod in the database.
+	Table = "accounting_periods"
+	// TrialBalanceLinesTable is the table that holds the trial_balance_lines relation/edge.
+	TrialBalanceLinesTable = "trial_balance_lines"
+	// TrialBalanceLinesInverseTable is the table name for the TrialBalanceLine entity.
+	// It exists in this package in order to avoid circular dependency with the "trialbalanceline" package.
+	TrialBalanceLinesInverseTable = "trial_balance_lines"
+	// TrialBalanceLinesColumn is the table column denoting the trial_balance_lines relation/edge.
+	TrialBalanceLinesColumn = "period_id"
+)
+
+// Columns holds all SQL columns for accountingperiod fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldStartsAt,
+	FieldEndsAt,
+	FieldStatus,
+	FieldClosedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusOpen is the default value of the Status enum.
+const DefaultStatus = StatusOpen
+
+// Status values.
+const (
+	StatusOpen    Status = "open"
+	StatusClosing Status = "closing"
+	StatusClosed  Status = "closed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOpen, StatusClosing, StatusClosed:
+		return nil
+	default:
+		return fmt.Errorf("accountingperiod: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the AccountingPeriod queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByEndsAt orders the results by the ends_at field.
+func ByEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByClosedAt orders the results by the closed_at field.
+func ByClosedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTrialBalanceLinesCount orders the results by trial_balance_lines count.
+func ByTrialBalanceLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTrialBalanceLinesStep(), opts...)
+	}
+}
+
+// ByTrialBalanceLines orders the results by trial_balance_lines terms.
+func ByTrialBalanceLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTrialBalanceLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTrialBalanceLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TrialBalanceLinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TrialBalanceLinesTable, TrialBalanceLinesColumn),
+	)
+}
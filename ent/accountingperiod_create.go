@@ -0,0 +1,687 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/accountingperiod"
+	"accounting/ent/trialbalanceline"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccountingPeriodCreate is the builder for creating a AccountingPeriod entity.
+type AccountingPeriodCreate struct {
+	config
+	mutation *AccountingPeriodMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (apc *AccountingPeriodCreate) SetName(s string) *AccountingPeriodCreate {
+	apc.mutation.SetName(s)
+	return apc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (apc *AccountingPeriodCreate) SetStartsAt(t time.Time) *AccountingPeriodCreate {
+	apc.mutation.SetStartsAt(t)
+	return apc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (apc *AccountingPeriodCreate) SetEndsAt(t time.Time) *AccountingPeriodCreate {
+	apc.mutation.SetEndsAt(t)
+	return apc
+}
+
+// SetStatus sets the "status" field.
+func (apc *AccountingPeriodCreate) SetStatus(a accountingperiod.Status) *AccountingPeriodCreate {
+	apc.mutation.SetStatus(a)
+	return apc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (apc *AccountingPeriodCreate) SetNillableStatus(a *accountingperiod.Status) *AccountingPeriodCreate {
+	if a != nil {
+		apc.SetStatus(*a)
+	}
+	return apc
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (apc *AccountingPeriodCreate) SetClosedAt(t time.Time) *AccountingPeriodCreate {
+	apc.mutation.SetClosedAt(t)
+	return apc
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (apc *AccountingPeriodCreate) SetNillableClosedAt(t *time.Time) *AccountingPeriodCreate {
+	if t != nil {
+		apc.SetClosedAt(*t)
+	}
+	return apc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (apc *AccountingPeriodCreate) SetCreatedAt(t time.Time) *AccountingPeriodCreate {
+	apc.mutation.SetCreatedAt(t)
+	return apc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (apc *AccountingPeriodCreate) SetNillableCreatedAt(t *time.Time) *AccountingPeriodCreate {
+	if t != nil {
+		apc.SetCreatedAt(*t)
+	}
+	return apc
+}
+
+// AddTrialBalanceLineIDs adds the "trial_balance_lines" edge to the TrialBalanceLine entity by IDs.
+func (apc *AccountingPeriodCreate) AddTrialBalanceLineIDs(ids ...int) *AccountingPeriodCreate {
+	apc.mutation.AddTrialBalanceLineIDs(ids...)
+	return apc
+}
+
+// AddTrialBalanceLines adds the "trial_balance_lines" edges to the TrialBalanceLine entity.
+func (apc *AccountingPeriodCreate) AddTrialBalanceLines(t ...*TrialBalanceLine) *AccountingPeriodCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return apc.AddTrialBalanceLineIDs(ids...)
+}
+
+// Mutation returns the AccountingPeriodMutation object of the builder.
+func (apc *AccountingPeriodCreate) Mutation() *AccountingPeriodMutation {
+	return apc.mutation
+}
+
+// Save creates the AccountingPeriod in the database.
+func (apc *AccountingPeriodCreate) Save(ctx context.Context) (*AccountingPeriod, error) {
+	apc.defaults()
+	return withHooks(ctx, apc.sqlSave, apc.mutation, apc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (apc *AccountingPeriodCreate) SaveX(ctx context.Context) *AccountingPeriod {
+	v, err := apc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (apc *AccountingPeriodCreate) Exec(ctx context.Context) error {
+	_, err := apc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apc *AccountingPeriodCreate) ExecX(ctx context.Context) {
+	if err := apc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apc *AccountingPeriodCreate) defaults() {
+	if _, ok := apc.mutation.Status(); !ok {
+		v := accountingperiod.DefaultStatus
+		apc.mutation.SetStatus(v)
+	}
+	if _, ok := apc.mutation.CreatedAt(); !ok {
+		v := accountingperiod.DefaultCreatedAt()
+		apc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apc *AccountingPeriodCreate) check() error {
+	if _, ok := apc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AccountingPeriod.name"`)}
+	}
+	if v, ok := apc.mutation.Name(); ok {
+		if err := accountingperiod.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AccountingPeriod.name": %w`, err)}
+		}
+	}
+	if _, ok := apc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "AccountingPeriod.starts_at"`)}
+	}
+	if _, ok := apc.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`ent: missing required field "AccountingPeriod.ends_at"`)}
+	}
+	if _, ok := apc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AccountingPeriod.status"`)}
+	}
+	if v, ok := apc.mutation.Status(); ok {
+		if err := accountingperiod.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AccountingPeriod.status": %w`, err)}
+		}
+	}
+	if _, ok := apc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccountingPeriod.created_at"`)}
+	}
+	return nil
+}
+
+func (apc *AccountingPeriodCreate) sqlSave(ctx context.Context) (*AccountingPeriod, error) {
+	if err := apc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := apc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, apc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	apc.mutation.id = &_node.ID
+	apc.mutation.done = true
+	return _node, nil
+}
+
+func (apc *AccountingPeriodCreate) createSpec() (*AccountingPeriod, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountingPeriod{config: apc.config}
+		_spec = sqlgraph.NewCreateSpec(accountingperiod.Table, sqlgraph.NewFieldSpec(accountingperiod.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = apc.conflict
+	if value, ok := apc.mutation.Name(); ok {
+		_spec.SetField(accountingperiod.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := apc.mutation.StartsAt(); ok {
+		_spec.SetField(accountingperiod.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := apc.mutation.EndsAt(); ok {
+		_spec.SetField(accountingperiod.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = value
+	}
+	if value, ok := apc.mutation.Status(); ok {
+		_spec.SetField(accountingperiod.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := apc.mutation.ClosedAt(); ok {
+		_spec.SetField(accountingperiod.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if value, ok := apc.mutation.CreatedAt(); ok {
+		_spec.SetField(accountingperiod.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := apc.mutation.TrialBalanceLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accountingperiod.TrialBalanceLinesTable,
+			Columns: []string{accountingperiod.TrialBalanceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountingPeriod.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountingPeriodUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (apc *AccountingPeriodCreate) OnConflict(opts ...sql.ConflictOption) *AccountingPeriodUpsertOne {
+	apc.conflict = opts
+	return &AccountingPeriodUpsertOne{
+		create: apc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountingPeriod.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (apc *AccountingPeriodCreate) OnConflictColumns(columns ...string) *AccountingPeriodUpsertOne {
+	apc.conflict = append(apc.conflict, sql.ConflictColumns(columns...))
+	return &AccountingPeriodUpsertOne{
+		create: apc,
+	}
+}
+
+type (
+	// AccountingPeriodUpsertOne is the builder for "upsert"-ing
+	//  one AccountingPeriod node.
+	AccountingPeriodUpsertOne struct {
+		create *AccountingPeriodCreate
+	}
+
+	// AccountingPeriodUpsert is the "OnConflict" setter.
+	AccountingPeriodUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *AccountingPeriodUpsert) SetStatus(v accountingperiod.Status) *AccountingPeriodUpsert {
+	u.Set(accountingperiod.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AccountingPeriodUpsert) UpdateStatus() *AccountingPeriodUpsert {
+	u.SetExcluded(accountingperiod.FieldStatus)
+	return u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *AccountingPeriodUpsert) SetClosedAt(v time.Time) *AccountingPeriodUpsert {
+	u.Set(accountingperiod.FieldClosedAt, v)
+	return u
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *AccountingPeriodUpsert) UpdateClosedAt() *AccountingPeriodUpsert {
+	u.SetExcluded(accountingperiod.FieldClosedAt)
+	return u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *AccountingPeriodUpsert) ClearClosedAt() *AccountingPeriodUpsert {
+	u.SetNull(accountingperiod.FieldClosedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AccountingPeriod.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AccountingPeriodUpsertOne) UpdateNewValues() *AccountingPeriodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(accountingperiod.FieldName)
+		}
+		if _, exists := u.create.mutation.StartsAt(); exists {
+			s.SetIgnore(accountingperiod.FieldStartsAt)
+		}
+		if _, exists := u.create.mutation.EndsAt(); exists {
+			s.SetIgnore(accountingperiod.FieldEndsAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(accountingperiod.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountingPeriod.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccountingPeriodUpsertOne) Ignore() *AccountingPeriodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountingPeriodUpsertOne) DoNothing() *AccountingPeriodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountingPeriodCreate.OnConflict
+// documentation for more info.
+func (u *AccountingPeriodUpsertOne) Update(set func(*AccountingPeriodUpsert)) *AccountingPeriodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountingPeriodUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *AccountingPeriodUpsertOne) SetStatus(v accountingperiod.Status) *AccountingPeriodUpsertOne {
+	return u.Update(func(s *AccountingPeriodUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AccountingPeriodUpsertOne) UpdateStatus() *AccountingPeriodUpsertOne {
+	return u.Update(func(s *AccountingPeriodUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *AccountingPeriodUpsertOne) SetClosedAt(v time.Time) *AccountingPeriodUpsertOne {
+	return u.Update(func(s *AccountingPeriodUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *AccountingPeriodUpsertOne) UpdateClosedAt() *AccountingPeriodUpsertOne {
+	return u.Update(func(s *AccountingPeriodUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *AccountingPeriodUpsertOne) ClearClosedAt() *AccountingPeriodUpsertOne {
+	return u.Update(func(s *AccountingPeriodUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountingPeriodUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountingPeriodCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountingPeriodUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccountingPeriodUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccountingPeriodUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AccountingPeriodCreateBulk is the builder for creating many AccountingPeriod entities in bulk.
+type AccountingPeriodCreateBulk struct {
+	config
+	err      error
+	builders []*AccountingPeriodCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AccountingPeriod entities in the database.
+func (apcb *AccountingPeriodCreateBulk) Save(ctx context.Context) ([]*AccountingPeriod, error) {
+	if apcb.err != nil {
+		return nil, apcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(apcb.builders))
+	nodes := make([]*AccountingPeriod, len(apcb.builders))
+	mutators := make([]Mutator, len(apcb.builders))
+	for i := range apcb.builders {
+		func(i int, root context.Context) {
+			builder := apcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountingPeriodMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, apcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = apcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, apcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, apcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apcb *AccountingPeriodCreateBulk) SaveX(ctx context.Context) []*AccountingPeriod {
+	v, err := apcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (apcb *AccountingPeriodCreateBulk) Exec(ctx context.Context) error {
+	_, err := apcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apcb *AccountingPeriodCreateBulk) ExecX(ctx context.Context) {
+	if err := apcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountingPeriod.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountingPeriodUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (apcb *AccountingPeriodCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccountingPeriodUpsertBulk {
+	apcb.conflict = opts
+	return &AccountingPeriodUpsertBulk{
+		create: apcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountingPeriod.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (apcb *AccountingPeriodCreateBulk) OnConflictColumns(columns ...string) *AccountingPeriodUpsertBulk {
+	apcb.conflict = append(apcb.conflict, sql.ConflictColumns(columns...))
+	return &AccountingPeriodUpsertBulk{
+		create: apcb,
+	}
+}
+
+// AccountingPeriodUpsertBulk is the builder for "upsert"-ing
+// a bulk of AccountingPeriod nodes.
+type AccountingPeriodUpsertBulk struct {
+	create *AccountingPeriodCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AccountingPeriod.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AccountingPeriodUpsertBulk) UpdateNewValues() *AccountingPeriodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(accountingperiod.FieldName)
+			}
+			if _, exists := b.mutation.StartsAt(); exists {
+				s.SetIgnore(accountingperiod.FieldStartsAt)
+			}
+			if _, exists := b.mutation.EndsAt(); exists {
+				s.SetIgnore(accountingperiod.FieldEndsAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(accountingperiod.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountingPeriod.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccountingPeriodUpsertBulk) Ignore() *AccountingPeriodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountingPeriodUpsertBulk) DoNothing() *AccountingPeriodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountingPeriodCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccountingPeriodUpsertBulk) Update(set func(*AccountingPeriodUpsert)) *AccountingPeriodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountingPeriodUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *AccountingPeriodUpsertBulk) SetStatus(v accountingperiod.Status) *AccountingPeriodUpsertBulk {
+	return u.Update(func(s *AccountingPeriodUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AccountingPeriodUpsertBulk) UpdateStatus() *AccountingPeriodUpsertBulk {
+	return u.Update(func(s *AccountingPeriodUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *AccountingPeriodUpsertBulk) SetClosedAt(v time.Time) *AccountingPeriodUpsertBulk {
+	return u.Update(func(s *AccountingPeriodUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *AccountingPeriodUpsertBulk) UpdateClosedAt() *AccountingPeriodUpsertBulk {
+	return u.Update(func(s *AccountingPeriodUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *AccountingPeriodUpsertBulk) ClearClosedAt() *AccountingPeriodUpsertBulk {
+	return u.Update(func(s *AccountingPeriodUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountingPeriodUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccountingPeriodCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountingPeriodCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountingPeriodUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/accountingperiod"
+	"accounting/ent/predicate"
+	"accounting/ent/trialbalanceline"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccountingPeriodQuery is the builder for querying AccountingPeriod entities.
+type AccountingPeriodQuery struct {
+	config
+	ctx                   *QueryContext
+	order                 []accountingperiod.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.AccountingPeriod
+	withTrialBalanceLines *TrialBalanceLineQuery
+	modifiers             []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AccountingPeriodQuery builder.
+func (apq *AccountingPeriodQuery) Where(ps ...predicate.AccountingPeriod) *AccountingPeriodQuery {
+	apq.predicates = append(apq.predicates, ps...)
+	return apq
+}
+
+// Limit the number of records to be returned by this query.
+func (apq *AccountingPeriodQuery) Limit(limit int) *AccountingPeriodQuery {
+	apq.ctx.Limit = &limit
+	return apq
+}
+
+// Offset to start from.
+func (apq *AccountingPeriodQuery) Offset(offset int) *AccountingPeriodQuery {
+	apq.ctx.Offset = &offset
+	return apq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (apq *AccountingPeriodQuery) Unique(unique bool) *AccountingPeriodQuery {
+	apq.ctx.Unique = &unique
+	return apq
+}
+
+// Order specifies how the records should be ordered.
+func (apq *AccountingPeriodQuery) Order(o ...accountingperiod.OrderOption) *AccountingPeriodQuery {
+	apq.order = append(apq.order, o...)
+	return apq
+}
+
+// QueryTrialBalanceLines chains the current query on the "trial_balance_lines" edge.
+func (apq *AccountingPeriodQuery) QueryTrialBalanceLines() *TrialBalanceLineQuery {
+	query := (&TrialBalanceLineClient{config: apq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := apq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := apq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accountingperiod.Table, accountingperiod.FieldID, selector),
+			sqlgraph.To(trialbalanceline.Table, trialbalanceline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, accountingperiod.TrialBalanceLinesTable, accountingperiod.TrialBalanceLinesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(apq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AccountingPeriod entity from the query.
+// Returns a *NotFoundError when no AccountingPeriod was found.
+func (apq *AccountingPeriodQuery) First(ctx context.Context) (*AccountingPeriod, error) {
+	nodes, err := apq.Limit(1).All(setContextOp(ctx, apq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{accountingperiod.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (apq *AccountingPeriodQuery) FirstX(ctx context.Context) *AccountingPeriod {
+	node, err := apq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AccountingPeriod ID from the query.
+// Returns a *NotFoundError when no AccountingPeriod ID was found.
+func (apq *AccountingPeriodQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = apq.Limit(1).IDs(setContextOp(ctx, apq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{accountingperiod.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (apq *AccountingPeriodQuery) FirstIDX(ctx context.Context) int {
+	id, err := apq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AccountingPeriod entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AccountingPeriod entity is found.
+// Returns a *NotFoundError when no AccountingPeriod entities are found.
+func (apq *AccountingPeriodQuery) Only(ctx context.Context) (*AccountingPeriod, error) {
+	nodes, err := apq.Limit(2).All(setContextOp(ctx, apq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{accountingperiod.Label}
+	default:
+		return nil, &NotSingularError{accountingperiod.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (apq *AccountingPeriodQuery) OnlyX(ctx context.Context) *AccountingPeriod {
+	node, err := apq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AccountingPeriod ID in the query.
+// Returns a *NotSingularError when more than one AccountingPeriod ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (apq *AccountingPeriodQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = apq.Limit(2).IDs(setContextOp(ctx, apq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{accountingperiod.Label}
+	default:
+		err = &NotSingularError{accountingperiod.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (apq *AccountingPeriodQuery) OnlyIDX(ctx context.Context) int {
+	id, err := apq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AccountingPeriods.
+func (apq *AccountingPeriodQuery) All(ctx context.Context) ([]*AccountingPeriod, error) {
+	ctx = setContextOp(ctx, apq.ctx, ent.OpQueryAll)
+	if err := apq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AccountingPeriod, *AccountingPeriodQuery]()
+	return withInterceptors[[]*AccountingPeriod](ctx, apq, qr, apq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (apq *AccountingPeriodQuery) AllX(ctx context.Context) []*AccountingPeriod {
+	nodes, err := apq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AccountingPeriod IDs.
+func (apq *AccountingPeriodQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if apq.ctx.Unique == nil && apq.path != nil {
+		apq.Unique(true)
+	}
+	ctx = setContextOp(ctx, apq.ctx, ent.OpQueryIDs)
+	if err = apq.Select(accountingperiod.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (apq *AccountingPeriodQuery) IDsX(ctx context.Context) []int {
+	ids, err := apq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (apq *AccountingPeriodQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, apq.ctx, ent.OpQueryCount)
+	if err := apq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, apq, querierCount[*AccountingPeriodQuery](), apq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (apq *AccountingPeriodQuery) CountX(ctx context.Context) int {
+	count, err := apq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (apq *AccountingPeriodQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, apq.ctx, ent.OpQueryExist)
+	switch _, err := apq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (apq *AccountingPeriodQuery) ExistX(ctx context.Context) bool {
+	exist, err := apq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AccountingPeriodQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (apq *AccountingPeriodQuery) Clone() *AccountingPeriodQuery {
+	if apq == nil {
+		return nil
+	}
+	return &AccountingPeriodQuery{
+		config:                apq.config,
+		ctx:                   apq.ctx.Clone(),
+		order:                 append([]accountingperiod.OrderOption{}, apq.order...),
+		inters:                append([]Interceptor{}, apq.inters...),
+		predicates:            append([]predicate.AccountingPeriod{}, apq.predicates...),
+		withTrialBalanceLines: apq.withTrialBalanceLines.Clone(),
+		// clone intermediate query.
+		sql:  apq.sql.Clone(),
+		path: apq.path,
+	}
+}
+
+// WithTrialBalanceLines tells the query-builder to eager-load the nodes that are connected to
+// the "trial_balance_lines" edge. The optional arguments are used to configure the query builder of the edge.
+func (apq *AccountingPeriodQuery) WithTrialBalanceLines(opts ...func(*TrialBalanceLineQuery)) *AccountingPeriodQuery {
+	query := (&TrialBalanceLineClient{config: apq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	apq.withTrialBalanceLines = query
+	return apq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AccountingPeriod.Query().
+//		GroupBy(accountingperiod.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (apq *AccountingPeriodQuery) GroupBy(field string, fields ...string) *AccountingPeriodGroupBy {
+	apq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AccountingPeriodGroupBy{build: apq}
+	grbuild.flds = &apq.ctx.Fields
+	grbuild.label = accountingperiod.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.AccountingPeriod.Query().
+//		Select(accountingperiod.FieldName).
+//		Scan(ctx, &v)
+func (apq *AccountingPeriodQuery) Select(fields ...string) *AccountingPeriodSelect {
+	apq.ctx.Fields = append(apq.ctx.Fields, fields...)
+	sbuild := &AccountingPeriodSelect{AccountingPeriodQuery: apq}
+	sbuild.label = accountingperiod.Label
+	sbuild.flds, sbuild.scan = &apq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AccountingPeriodSelect configured with the given aggregations.
+func (apq *AccountingPeriodQuery) Aggregate(fns ...AggregateFunc) *AccountingPeriodSelect {
+	return apq.Select().Aggregate(fns...)
+}
+
+func (apq *AccountingPeriodQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range apq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, apq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range apq.ctx.Fields {
+		if !accountingperiod.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if apq.path != nil {
+		prev, err := apq.path(ctx)
+		if err != nil {
+			return err
+		}
+		apq.sql = prev
+	}
+	return nil
+}
+
+func (apq *AccountingPeriodQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AccountingPeriod, error) {
+	var (
+		nodes       = []*AccountingPeriod{}
+		_spec       = apq.querySpec()
+		loadedTypes = [1]bool{
+			apq.withTrialBalanceLines != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AccountingPeriod).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AccountingPeriod{config: apq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(apq.modifiers) > 0 {
+		_spec.Modifiers = apq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, apq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := apq.withTrialBalanceLines; query != nil {
+		if err := apq.loadTrialBalanceLines(ctx, query, nodes,
+			func(n *AccountingPeriod) { n.Edges.TrialBalanceLines = []*TrialBalanceLine{} },
+			func(n *AccountingPeriod, e *TrialBalanceLine) {
+				n.Edges.TrialBalanceLines = append(n.Edges.TrialBalanceLines, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (apq *AccountingPeriodQuery) loadTrialBalanceLines(ctx context.Context, query *TrialBalanceLineQuery, nodes []*AccountingPeriod, init func(*AccountingPeriod), assign func(*AccountingPeriod, *TrialBalanceLine)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*AccountingPeriod)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(trialbalanceline.FieldPeriodID)
+	}
+	query.Where(predicate.TrialBalanceLine(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(accountingperiod.TrialBalanceLinesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PeriodID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "period_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (apq *AccountingPeriodQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := apq.querySpec()
+	if len(apq.modifiers) > 0 {
+		_spec.Modifiers = apq.modifiers
+	}
+	_spec.Node.Columns = apq.ctx.Fields
+	if len(apq.ctx.Fields) > 0 {
+		_spec.Unique = apq.ctx.Unique != nil && *apq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, apq.driver, _spec)
+}
+
+func (apq *AccountingPeriodQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(accountingperiod.Table, accountingperiod.Columns, sqlgraph.NewFieldSpec(accountingperiod.FieldID, field.TypeInt))
+	_spec.From = apq.sql
+	if unique := apq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if apq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := apq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accountingperiod.FieldID)
+		for i := range fields {
+			if fields[i] != accountingperiod.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := apq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := apq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := apq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := apq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (apq *AccountingPeriodQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(apq.driver.Dialect())
+	t1 := builder.Table(accountingperiod.Table)
+	columns := apq.ctx.Fields
+	if len(columns) == 0 {
+		columns = accountingperiod.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if apq.sql != nil {
+		selector = apq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if apq.ctx.Unique != nil && *apq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range apq.modifiers {
+		m(selector)
+	}
+	for _, p := range apq.predicates {
+		p(selector)
+	}
+	for _, p := range apq.order {
+		p(selector)
+	}
+	if offset := apq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := apq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (apq *AccountingPeriodQuery) ForUpdate(opts ...sql.LockOption) *AccountingPeriodQuery {
+	if apq.driver.Dialect() == dialect.Postgres {
+		apq.Unique(false)
+	}
+	apq.modifiers = append(apq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return apq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (apq *AccountingPeriodQuery) ForShare(opts ...sql.LockOption) *AccountingPeriodQuery {
+	if apq.driver.Dialect() == dialect.Postgres {
+		apq.Unique(false)
+	}
+	apq.modifiers = append(apq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return apq
+}
+
+// AccountingPeriodGroupBy is the group-by builder for AccountingPeriod entities.
+type AccountingPeriodGroupBy struct {
+	selector
+	build *AccountingPeriodQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (apgb *AccountingPeriodGroupBy) Aggregate(fns ...AggregateFunc) *AccountingPeriodGroupBy {
+	apgb.fns = append(apgb.fns, fns...)
+	return apgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (apgb *AccountingPeriodGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, apgb.build.ctx, ent.OpQueryGroupBy)
+	if err := apgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AccountingPeriodQuery, *AccountingPeriodGroupBy](ctx, apgb.build, apgb, apgb.build.inters, v)
+}
+
+func (apgb *AccountingPeriodGroupBy) sqlScan(ctx context.Context, root *AccountingPeriodQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(apgb.fns))
+	for _, fn := range apgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*apgb.flds)+len(apgb.fns))
+		for _, f := range *apgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*apgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := apgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AccountingPeriodSelect is the builder for selecting fields of AccountingPeriod entities.
+type AccountingPeriodSelect struct {
+	*AccountingPeriodQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (aps *AccountingPeriodSelect) Aggregate(fns ...AggregateFunc) *AccountingPeriodSelect {
+	aps.fns = append(aps.fns, fns...)
+	return aps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aps *AccountingPeriodSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aps.ctx, ent.OpQuerySelect)
+	if err := aps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AccountingPeriodQuery, *AccountingPeriodSelect](ctx, aps.AccountingPeriodQuery, aps, aps.inters, v)
+}
+
+func (aps *AccountingPeriodSelect) sqlScan(ctx context.Context, root *AccountingPeriodQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(aps.fns))
+	for _, fn := range aps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*aps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,451 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/accountingperiod"
+	"accounting/ent/predicate"
+	"accounting/ent/trialbalanceline"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccountingPeriodUpdate is the builder for updating AccountingPeriod entities.
+type AccountingPeriodUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccountingPeriodMutation
+}
+
+// Where appends a list predicates to the AccountingPeriodUpdate builder.
+func (apu *AccountingPeriodUpdate) Where(ps ...predicate.AccountingPeriod) *AccountingPeriodUpdate {
+	apu.mutation.Where(ps...)
+	return apu
+}
+
+// SetStatus sets the "status" field.
+func (apu *AccountingPeriodUpdate) SetStatus(a accountingperiod.Status) *AccountingPeriodUpdate {
+	apu.mutation.SetStatus(a)
+	return apu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (apu *AccountingPeriodUpdate) SetNillableStatus(a *accountingperiod.Status) *AccountingPeriodUpdate {
+	if a != nil {
+		apu.SetStatus(*a)
+	}
+	return apu
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (apu *AccountingPeriodUpdate) SetClosedAt(t time.Time) *AccountingPeriodUpdate {
+	apu.mutation.SetClosedAt(t)
+	return apu
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (apu *AccountingPeriodUpdate) SetNillableClosedAt(t *time.Time) *AccountingPeriodUpdate {
+	if t != nil {
+		apu.SetClosedAt(*t)
+	}
+	return apu
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (apu *AccountingPeriodUpdate) ClearClosedAt() *AccountingPeriodUpdate {
+	apu.mutation.ClearClosedAt()
+	return apu
+}
+
+// AddTrialBalanceLineIDs adds the "trial_balance_lines" edge to the TrialBalanceLine entity by IDs.
+func (apu *AccountingPeriodUpdate) AddTrialBalanceLineIDs(ids ...int) *AccountingPeriodUpdate {
+	apu.mutation.AddTrialBalanceLineIDs(ids...)
+	return apu
+}
+
+// AddTrialBalanceLines adds the "trial_balance_lines" edges to the TrialBalanceLine entity.
+func (apu *AccountingPeriodUpdate) AddTrialBalanceLines(t ...*TrialBalanceLine) *AccountingPeriodUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return apu.AddTrialBalanceLineIDs(ids...)
+}
+
+// Mutation returns the AccountingPeriodMutation object of the builder.
+func (apu *AccountingPeriodUpdate) Mutation() *AccountingPeriodMutation {
+	return apu.mutation
+}
+
+// ClearTrialBalanceLines clears all "trial_balance_lines" edges to the TrialBalanceLine entity.
+func (apu *AccountingPeriodUpdate) ClearTrialBalanceLines() *AccountingPeriodUpdate {
+	apu.mutation.ClearTrialBalanceLines()
+	return apu
+}
+
+// RemoveTrialBalanceLineIDs removes the "trial_balance_lines" edge to TrialBalanceLine entities by IDs.
+func (apu *AccountingPeriodUpdate) RemoveTrialBalanceLineIDs(ids ...int) *AccountingPeriodUpdate {
+	apu.mutation.RemoveTrialBalanceLineIDs(ids...)
+	return apu
+}
+
+// RemoveTrialBalanceLines removes "trial_balance_lines" edges to TrialBalanceLine entities.
+func (apu *AccountingPeriodUpdate) RemoveTrialBalanceLines(t ...*TrialBalanceLine) *AccountingPeriodUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return apu.RemoveTrialBalanceLineIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (apu *AccountingPeriodUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, apu.sqlSave, apu.mutation, apu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apu *AccountingPeriodUpdate) SaveX(ctx context.Context) int {
+	affected, err := apu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (apu *AccountingPeriodUpdate) Exec(ctx context.Context) error {
+	_, err := apu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apu *AccountingPeriodUpdate) ExecX(ctx context.Context) {
+	if err := apu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apu *AccountingPeriodUpdate) check() error {
+	if v, ok := apu.mutation.Status(); ok {
+		if err := accountingperiod.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AccountingPeriod.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (apu *AccountingPeriodUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := apu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountingperiod.Table, accountingperiod.Columns, sqlgraph.NewFieldSpec(accountingperiod.FieldID, field.TypeInt))
+	if ps := apu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := apu.mutation.Status(); ok {
+		_spec.SetField(accountingperiod.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := apu.mutation.ClosedAt(); ok {
+		_spec.SetField(accountingperiod.FieldClosedAt, field.TypeTime, value)
+	}
+	if apu.mutation.ClosedAtCleared() {
+		_spec.ClearField(accountingperiod.FieldClosedAt, field.TypeTime)
+	}
+	if apu.mutation.TrialBalanceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accountingperiod.TrialBalanceLinesTable,
+			Columns: []string{accountingperiod.TrialBalanceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apu.mutation.RemovedTrialBalanceLinesIDs(); len(nodes) > 0 && !apu.mutation.TrialBalanceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accountingperiod.TrialBalanceLinesTable,
+			Columns: []string{accountingperiod.TrialBalanceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apu.mutation.TrialBalanceLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accountingperiod.TrialBalanceLinesTable,
+			Columns: []string{accountingperiod.TrialBalanceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, apu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountingperiod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	apu.mutation.done = true
+	return n, nil
+}
+
+// AccountingPeriodUpdateOne is the builder for updating a single AccountingPeriod entity.
+type AccountingPeriodUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccountingPeriodMutation
+}
+
+// SetStatus sets the "status" field.
+func (apuo *AccountingPeriodUpdateOne) SetStatus(a accountingperiod.Status) *AccountingPeriodUpdateOne {
+	apuo.mutation.SetStatus(a)
+	return apuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (apuo *AccountingPeriodUpdateOne) SetNillableStatus(a *accountingperiod.Status) *AccountingPeriodUpdateOne {
+	if a != nil {
+		apuo.SetStatus(*a)
+	}
+	return apuo
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (apuo *AccountingPeriodUpdateOne) SetClosedAt(t time.Time) *AccountingPeriodUpdateOne {
+	apuo.mutation.SetClosedAt(t)
+	return apuo
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (apuo *AccountingPeriodUpdateOne) SetNillableClosedAt(t *time.Time) *AccountingPeriodUpdateOne {
+	if t != nil {
+		apuo.SetClosedAt(*t)
+	}
+	return apuo
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (apuo *AccountingPeriodUpdateOne) ClearClosedAt() *AccountingPeriodUpdateOne {
+	apuo.mutation.ClearClosedAt()
+	return apuo
+}
+
+// AddTrialBalanceLineIDs adds the "trial_balance_lines" edge to the TrialBalanceLine entity by IDs.
+func (apuo *AccountingPeriodUpdateOne) AddTrialBalanceLineIDs(ids ...int) *AccountingPeriodUpdateOne {
+	apuo.mutation.AddTrialBalanceLineIDs(ids...)
+	return apuo
+}
+
+// AddTrialBalanceLines adds the "trial_balance_lines" edges to the TrialBalanceLine entity.
+func (apuo *AccountingPeriodUpdateOne) AddTrialBalanceLines(t ...*TrialBalanceLine) *AccountingPeriodUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return apuo.AddTrialBalanceLineIDs(ids...)
+}
+
+// Mutation returns the AccountingPeriodMutation object of the builder.
+func (apuo *AccountingPeriodUpdateOne) Mutation() *AccountingPeriodMutation {
+	return apuo.mutation
+}
+
+// ClearTrialBalanceLines clears all "trial_balance_lines" edges to the TrialBalanceLine entity.
+func (apuo *AccountingPeriodUpdateOne) ClearTrialBalanceLines() *AccountingPeriodUpdateOne {
+	apuo.mutation.ClearTrialBalanceLines()
+	return apuo
+}
+
+// RemoveTrialBalanceLineIDs removes the "trial_balance_lines" edge to TrialBalanceLine entities by IDs.
+func (apuo *AccountingPeriodUpdateOne) RemoveTrialBalanceLineIDs(ids ...int) *AccountingPeriodUpdateOne {
+	apuo.mutation.RemoveTrialBalanceLineIDs(ids...)
+	return apuo
+}
+
+// RemoveTrialBalanceLines removes "trial_balance_lines" edges to TrialBalanceLine entities.
+func (apuo *AccountingPeriodUpdateOne) RemoveTrialBalanceLines(t ...*TrialBalanceLine) *AccountingPeriodUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return apuo.RemoveTrialBalanceLineIDs(ids...)
+}
+
+// Where appends a list predicates to the AccountingPeriodUpdate builder.
+func (apuo *AccountingPeriodUpdateOne) Where(ps ...predicate.AccountingPeriod) *AccountingPeriodUpdateOne {
+	apuo.mutation.Where(ps...)
+	return apuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (apuo *AccountingPeriodUpdateOne) Select(field string, fields ...string) *AccountingPeriodUpdateOne {
+	apuo.fields = append([]string{field}, fields...)
+	return apuo
+}
+
+// Save executes the query and returns the updated AccountingPeriod entity.
+func (apuo *AccountingPeriodUpdateOne) Save(ctx context.Context) (*AccountingPeriod, error) {
+	return withHooks(ctx, apuo.sqlSave, apuo.mutation, apuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apuo *AccountingPeriodUpdateOne) SaveX(ctx context.Context) *AccountingPeriod {
+	node, err := apuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (apuo *AccountingPeriodUpdateOne) Exec(ctx context.Context) error {
+	_, err := apuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apuo *AccountingPeriodUpdateOne) ExecX(ctx context.Context) {
+	if err := apuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apuo *AccountingPeriodUpdateOne) check() error {
+	if v, ok := apuo.mutation.Status(); ok {
+		if err := accountingperiod.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AccountingPeriod.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (apuo *AccountingPeriodUpdateOne) sqlSave(ctx context.Context) (_node *AccountingPeriod, err error) {
+	if err := apuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountingperiod.Table, accountingperiod.Columns, sqlgraph.NewFieldSpec(accountingperiod.FieldID, field.TypeInt))
+	id, ok := apuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccountingPeriod.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := apuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accountingperiod.FieldID)
+		for _, f := range fields {
+			if !accountingperiod.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accountingperiod.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := apuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := apuo.mutation.Status(); ok {
+		_spec.SetField(accountingperiod.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := apuo.mutation.ClosedAt(); ok {
+		_spec.SetField(accountingperiod.FieldClosedAt, field.TypeTime, value)
+	}
+	if apuo.mutation.ClosedAtCleared() {
+		_spec.ClearField(accountingperiod.FieldClosedAt, field.TypeTime)
+	}
+	if apuo.mutation.TrialBalanceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accountingperiod.TrialBalanceLinesTable,
+			Columns: []string{accountingperiod.TrialBalanceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apuo.mutation.RemovedTrialBalanceLinesIDs(); len(nodes) > 0 && !apuo.mutation.TrialBalanceLinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accountingperiod.TrialBalanceLinesTable,
+			Columns: []string{accountingperiod.TrialBalanceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apuo.mutation.TrialBalanceLinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   accountingperiod.TrialBalanceLinesTable,
+			Columns: []string{accountingperiod.TrialBalanceLinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AccountingPeriod{config: apuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, apuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountingperiod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	apuo.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"accounting/ent/balance"
+	"accounting/ent/interestplan"
 	"accounting/ent/user"
 	"fmt"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
 )
 
 // Balance is the model entity for the Balance schema.
@@ -24,7 +26,15 @@ type Balance struct {
 	// Currency code (e.g. USD, EUR, RUB)
 	Currency string `json:"currency,omitempty"`
 	// Amount of the balance in the specified currency
-	Amount float64 `json:"amount,omitempty"`
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Amount minus the funds reserved by active holds
+	Available decimal.Decimal `json:"available,omitempty"`
+	// How far below zero the available funds may go; zero allows no overdraft
+	OverdraftLimit decimal.Decimal `json:"overdraft_limit,omitempty"`
+	// ID of the interest plan the balance earns interest under
+	InterestPlanID *int `json:"interest_plan_id,omitempty"`
+	// Number of changes applied to the amount; the last transaction has this sequence
+	Sequence int64 `json:"sequence,omitempty"`
 	// Time of the balance creation
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Time of the last balance update
@@ -39,9 +49,13 @@ type Balance struct {
 type BalanceEdges struct {
 	// User, to which the balance belongs
 	User *User `json:"user,omitempty"`
+	// Interest plan the balance earns interest under
+	InterestPlan *InterestPlan `json:"interest_plan,omitempty"`
+	// Daily interest accruals of the balance
+	InterestAccruals []*InterestAccrual `json:"interest_accruals,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -55,14 +69,34 @@ func (e BalanceEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// InterestPlanOrErr returns the InterestPlan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BalanceEdges) InterestPlanOrErr() (*InterestPlan, error) {
+	if e.InterestPlan != nil {
+		return e.InterestPlan, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: interestplan.Label}
+	}
+	return nil, &NotLoadedError{edge: "interest_plan"}
+}
+
+// InterestAccrualsOrErr returns the InterestAccruals value or an error if the edge
+// was not loaded in eager-loading.
+func (e BalanceEdges) InterestAccrualsOrErr() ([]*InterestAccrual, error) {
+	if e.loadedTypes[2] {
+		return e.InterestAccruals, nil
+	}
+	return nil, &NotLoadedError{edge: "interest_accruals"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Balance) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case balance.FieldAmount:
-			values[i] = new(sql.NullFloat64)
-		case balance.FieldID, balance.FieldUserID:
+		case balance.FieldAmount, balance.FieldAvailable, balance.FieldOverdraftLimit:
+			values[i] = new(decimal.Decimal)
+		case balance.FieldID, balance.FieldUserID, balance.FieldInterestPlanID, balance.FieldSequence:
 			values[i] = new(sql.NullInt64)
 		case balance.FieldCurrency:
 			values[i] = new(sql.NullString)
@@ -102,10 +136,35 @@ func (b *Balance) assignValues(columns []string, values []any) error {
 				b.Currency = value.String
 			}
 		case balance.FieldAmount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				b.Amount = *value
+			}
+		case balance.FieldAvailable:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field available", values[i])
+			} else if value != nil {
+				b.Available = *value
+			}
+		case balance.FieldOverdraftLimit:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field overdraft_limit", values[i])
+			} else if value != nil {
+				b.OverdraftLimit = *value
+			}
+		case balance.FieldInterestPlanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interest_plan_id", values[i])
 			} else if value.Valid {
-				b.Amount = value.Float64
+				b.InterestPlanID = new(int)
+				*b.InterestPlanID = int(value.Int64)
+			}
+		case balance.FieldSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence", values[i])
+			} else if value.Valid {
+				b.Sequence = value.Int64
 			}
 		case balance.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -137,6 +196,16 @@ func (b *Balance) QueryUser() *UserQuery {
 	return NewBalanceClient(b.config).QueryUser(b)
 }
 
+// QueryInterestPlan queries the "interest_plan" edge of the Balance entity.
+func (b *Balance) QueryInterestPlan() *InterestPlanQuery {
+	return NewBalanceClient(b.config).QueryInterestPlan(b)
+}
+
+// QueryInterestAccruals queries the "interest_accruals" edge of the Balance entity.
+func (b *Balance) QueryInterestAccruals() *InterestAccrualQuery {
+	return NewBalanceClient(b.config).QueryInterestAccruals(b)
+}
+
 // Update returns a builder for updating this Balance.
 // Note that you need to call Balance.Unwrap() before calling this method if this Balance
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -169,6 +238,20 @@ func (b *Balance) String() string {
 	builder.WriteString("amount=")
 	builder.WriteString(fmt.Sprintf("%v", b.Amount))
 	builder.WriteString(", ")
+	builder.WriteString("available=")
+	builder.WriteString(fmt.Sprintf("%v", b.Available))
+	builder.WriteString(", ")
+	builder.WriteString("overdraft_limit=")
+	builder.WriteString(fmt.Sprintf("%v", b.OverdraftLimit))
+	builder.WriteString(", ")
+	if v := b.InterestPlanID; v != nil {
+		builder.WriteString("interest_plan_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sequence=")
+	builder.WriteString(fmt.Sprintf("%v", b.Sequence))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -20,12 +21,24 @@ const (
 	FieldCurrency = "currency"
 	// FieldAmount holds the string denoting the amount field in the database.
 	FieldAmount = "amount"
+	// FieldAvailable holds the string denoting the available field in the database.
+	FieldAvailable = "available"
+	// FieldOverdraftLimit holds the string denoting the overdraft_limit field in the database.
+	FieldOverdraftLimit = "overdraft_limit"
+	// FieldInterestPlanID holds the string denoting the interest_plan_id field in the database.
+	FieldInterestPlanID = "interest_plan_id"
+	// FieldSequence holds the string denoting the sequence field in the database.
+	FieldSequence = "sequence"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeInterestPlan holds the string denoting the interest_plan edge name in mutations.
+	EdgeInterestPlan = "interest_plan"
+	// EdgeInterestAccruals holds the string denoting the interest_accruals edge name in mutations.
+	EdgeInterestAccruals = "interest_accruals"
 	// Table holds the table name of the balance in the database.
 	Table = "balances"
 	// UserTable is the table that holds the user relation/edge.
@@ -35,6 +48,20 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_id"
+	// InterestPlanTable is the table that holds the interest_plan relation/edge.
+	InterestPlanTable = "balances"
+	// InterestPlanInverseTable is the table name for the InterestPlan entity.
+	// It exists in this package in order to avoid circular dependency with the "interestplan" package.
+	InterestPlanInverseTable = "interest_plans"
+	// InterestPlanColumn is the table column denoting the interest_plan relation/edge.
+	InterestPlanColumn = "interest_plan_id"
+	// InterestAccrualsTable is the table that holds the interest_accruals relation/edge.
+	InterestAccrualsTable = "interest_accruals"
+	// InterestAccrualsInverseTable is the table name for the InterestAccrual entity.
+	// It exists in this package in order to avoid circular dependency with the "interestaccrual" package.
+	InterestAccrualsInverseTable = "interest_accruals"
+	// InterestAccrualsColumn is the table column denoting the interest_accruals relation/edge.
+	InterestAccrualsColumn = "balance_id"
 )
 
 // Columns holds all SQL columns for balance fields.
@@ -43,6 +70,10 @@ var Columns = []string{
 	FieldUserID,
 	FieldCurrency,
 	FieldAmount,
+	FieldAvailable,
+	FieldOverdraftLimit,
+	FieldInterestPlanID,
+	FieldSequence,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -61,7 +92,15 @@ var (
 	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
 	CurrencyValidator func(string) error
 	// DefaultAmount holds the default value on creation for the "amount" field.
-	DefaultAmount float64
+	DefaultAmount func() decimal.Decimal
+	// DefaultAvailable holds the default value on creation for the "available" field.
+	DefaultAvailable func() decimal.Decimal
+	// DefaultOverdraftLimit holds the default value on creation for the "overdraft_limit" field.
+	DefaultOverdraftLimit func() decimal.Decimal
+	// DefaultSequence holds the default value on creation for the "sequence" field.
+	DefaultSequence int64
+	// SequenceValidator is a validator for the "sequence" field. It is called by the builders before save.
+	SequenceValidator func(int64) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -93,6 +132,26 @@ func ByAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAmount, opts...).ToFunc()
 }
 
+// ByAvailable orders the results by the available field.
+func ByAvailable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailable, opts...).ToFunc()
+}
+
+// ByOverdraftLimit orders the results by the overdraft_limit field.
+func ByOverdraftLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverdraftLimit, opts...).ToFunc()
+}
+
+// ByInterestPlanID orders the results by the interest_plan_id field.
+func ByInterestPlanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterestPlanID, opts...).ToFunc()
+}
+
+// BySequence orders the results by the sequence field.
+func BySequence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSequence, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -109,6 +168,27 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByInterestPlanField orders the results by interest_plan field.
+func ByInterestPlanField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInterestPlanStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInterestAccrualsCount orders the results by interest_accruals count.
+func ByInterestAccrualsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInterestAccrualsStep(), opts...)
+	}
+}
+
+// ByInterestAccruals orders the results by interest_accruals terms.
+func ByInterestAccruals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInterestAccrualsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -116,3 +196,17 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newInterestPlanStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InterestPlanInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, InterestPlanTable, InterestPlanColumn),
+	)
+}
+func newInterestAccrualsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InterestAccrualsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InterestAccrualsTable, InterestAccrualsColumn),
+	)
+}
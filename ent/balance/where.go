@@ -8,6 +8,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -66,10 +67,30 @@ func Currency(v string) predicate.Balance {
 }
 
 // Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
-func Amount(v float64) predicate.Balance {
+func Amount(v decimal.Decimal) predicate.Balance {
 	return predicate.Balance(sql.FieldEQ(FieldAmount, v))
 }
 
+// Available applies equality check predicate on the "available" field. It's identical to AvailableEQ.
+func Available(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldEQ(FieldAvailable, v))
+}
+
+// OverdraftLimit applies equality check predicate on the "overdraft_limit" field. It's identical to OverdraftLimitEQ.
+func OverdraftLimit(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldEQ(FieldOverdraftLimit, v))
+}
+
+// InterestPlanID applies equality check predicate on the "interest_plan_id" field. It's identical to InterestPlanIDEQ.
+func InterestPlanID(v int) predicate.Balance {
+	return predicate.Balance(sql.FieldEQ(FieldInterestPlanID, v))
+}
+
+// Sequence applies equality check predicate on the "sequence" field. It's identical to SequenceEQ.
+func Sequence(v int64) predicate.Balance {
+	return predicate.Balance(sql.FieldEQ(FieldSequence, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Balance {
 	return predicate.Balance(sql.FieldEQ(FieldCreatedAt, v))
@@ -166,45 +187,195 @@ func CurrencyContainsFold(v string) predicate.Balance {
 }
 
 // AmountEQ applies the EQ predicate on the "amount" field.
-func AmountEQ(v float64) predicate.Balance {
+func AmountEQ(v decimal.Decimal) predicate.Balance {
 	return predicate.Balance(sql.FieldEQ(FieldAmount, v))
 }
 
 // AmountNEQ applies the NEQ predicate on the "amount" field.
-func AmountNEQ(v float64) predicate.Balance {
+func AmountNEQ(v decimal.Decimal) predicate.Balance {
 	return predicate.Balance(sql.FieldNEQ(FieldAmount, v))
 }
 
 // AmountIn applies the In predicate on the "amount" field.
-func AmountIn(vs ...float64) predicate.Balance {
+func AmountIn(vs ...decimal.Decimal) predicate.Balance {
 	return predicate.Balance(sql.FieldIn(FieldAmount, vs...))
 }
 
 // AmountNotIn applies the NotIn predicate on the "amount" field.
-func AmountNotIn(vs ...float64) predicate.Balance {
+func AmountNotIn(vs ...decimal.Decimal) predicate.Balance {
 	return predicate.Balance(sql.FieldNotIn(FieldAmount, vs...))
 }
 
 // AmountGT applies the GT predicate on the "amount" field.
-func AmountGT(v float64) predicate.Balance {
+func AmountGT(v decimal.Decimal) predicate.Balance {
 	return predicate.Balance(sql.FieldGT(FieldAmount, v))
 }
 
 // AmountGTE applies the GTE predicate on the "amount" field.
-func AmountGTE(v float64) predicate.Balance {
+func AmountGTE(v decimal.Decimal) predicate.Balance {
 	return predicate.Balance(sql.FieldGTE(FieldAmount, v))
 }
 
 // AmountLT applies the LT predicate on the "amount" field.
-func AmountLT(v float64) predicate.Balance {
+func AmountLT(v decimal.Decimal) predicate.Balance {
 	return predicate.Balance(sql.FieldLT(FieldAmount, v))
 }
 
 // AmountLTE applies the LTE predicate on the "amount" field.
-func AmountLTE(v float64) predicate.Balance {
+func AmountLTE(v decimal.Decimal) predicate.Balance {
 	return predicate.Balance(sql.FieldLTE(FieldAmount, v))
 }
 
+// AvailableEQ applies the EQ predicate on the "available" field.
+func AvailableEQ(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldEQ(FieldAvailable, v))
+}
+
+// AvailableNEQ applies the NEQ predicate on the "available" field.
+func AvailableNEQ(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldNEQ(FieldAvailable, v))
+}
+
+// AvailableIn applies the In predicate on the "available" field.
+func AvailableIn(vs ...decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldIn(FieldAvailable, vs...))
+}
+
+// AvailableNotIn applies the NotIn predicate on the "available" field.
+func AvailableNotIn(vs ...decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldNotIn(FieldAvailable, vs...))
+}
+
+// AvailableGT applies the GT predicate on the "available" field.
+func AvailableGT(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldGT(FieldAvailable, v))
+}
+
+// AvailableGTE applies the GTE predicate on the "available" field.
+func AvailableGTE(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldGTE(FieldAvailable, v))
+}
+
+// AvailableLT applies the LT predicate on the "available" field.
+func AvailableLT(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldLT(FieldAvailable, v))
+}
+
+// AvailableLTE applies the LTE predicate on the "available" field.
+func AvailableLTE(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldLTE(FieldAvailable, v))
+}
+
+// OverdraftLimitEQ applies the EQ predicate on the "overdraft_limit" field.
+func OverdraftLimitEQ(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldEQ(FieldOverdraftLimit, v))
+}
+
+// OverdraftLimitNEQ applies the NEQ predicate on the "overdraft_limit" field.
+func OverdraftLimitNEQ(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldNEQ(FieldOverdraftLimit, v))
+}
+
+// OverdraftLimitIn applies the In predicate on the "overdraft_limit" field.
+func OverdraftLimitIn(vs ...decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldIn(FieldOverdraftLimit, vs...))
+}
+
+// OverdraftLimitNotIn applies the NotIn predicate on the "overdraft_limit" field.
+func OverdraftLimitNotIn(vs ...decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldNotIn(FieldOverdraftLimit, vs...))
+}
+
+// OverdraftLimitGT applies the GT predicate on the "overdraft_limit" field.
+func OverdraftLimitGT(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldGT(FieldOverdraftLimit, v))
+}
+
+// OverdraftLimitGTE applies the GTE predicate on the "overdraft_limit" field.
+func OverdraftLimitGTE(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldGTE(FieldOverdraftLimit, v))
+}
+
+// OverdraftLimitLT applies the LT predicate on the "overdraft_limit" field.
+func OverdraftLimitLT(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldLT(FieldOverdraftLimit, v))
+}
+
+// OverdraftLimitLTE applies the LTE predicate on the "overdraft_limit" field.
+func OverdraftLimitLTE(v decimal.Decimal) predicate.Balance {
+	return predicate.Balance(sql.FieldLTE(FieldOverdraftLimit, v))
+}
+
+// InterestPlanIDEQ applies the EQ predicate on the "interest_plan_id" field.
+func InterestPlanIDEQ(v int) predicate.Balance {
+	return predicate.Balance(sql.FieldEQ(FieldInterestPlanID, v))
+}
+
+// InterestPlanIDNEQ applies the NEQ predicate on the "interest_plan_id" field.
+func InterestPlanIDNEQ(v int) predicate.Balance {
+	return predicate.Balance(sql.FieldNEQ(FieldInterestPlanID, v))
+}
+
+// InterestPlanIDIn applies the In predicate on the "interest_plan_id" field.
+func InterestPlanIDIn(vs ...int) predicate.Balance {
+	return predicate.Balance(sql.FieldIn(FieldInterestPlanID, vs...))
+}
+
+// InterestPlanIDNotIn applies the NotIn predicate on the "interest_plan_id" field.
+func InterestPlanIDNotIn(vs ...int) predicate.Balance {
+	return predicate.Balance(sql.FieldNotIn(FieldInterestPlanID, vs...))
+}
+
+// InterestPlanIDIsNil applies the IsNil predicate on the "interest_plan_id" field.
+func InterestPlanIDIsNil() predicate.Balance {
+	return predicate.Balance(sql.FieldIsNull(FieldInterestPlanID))
+}
+
+// InterestPlanIDNotNil applies the NotNil predicate on the "interest_plan_id" field.
+func InterestPlanIDNotNil() predicate.Balance {
+	return predicate.Balance(sql.FieldNotNull(FieldInterestPlanID))
+}
+
+// SequenceEQ applies the EQ predicate on the "sequence" field.
+func SequenceEQ(v int64) predicate.Balance {
+	return predicate.Balance(sql.FieldEQ(FieldSequence, v))
+}
+
+// SequenceNEQ applies the NEQ predicate on the "sequence" field.
+func SequenceNEQ(v int64) predicate.Balance {
+	return predicate.Balance(sql.FieldNEQ(FieldSequence, v))
+}
+
+// SequenceIn applies the In predicate on the "sequence" field.
+func SequenceIn(vs ...int64) predicate.Balance {
+	return predicate.Balance(sql.FieldIn(FieldSequence, vs...))
+}
+
+// SequenceNotIn applies the NotIn predicate on the "sequence" field.
+func SequenceNotIn(vs ...int64) predicate.Balance {
+	return predicate.Balance(sql.FieldNotIn(FieldSequence, vs...))
+}
+
+// SequenceGT applies the GT predicate on the "sequence" field.
+func SequenceGT(v int64) predicate.Balance {
+	return predicate.Balance(sql.FieldGT(FieldSequence, v))
+}
+
+// SequenceGTE applies the GTE predicate on the "sequence" field.
+func SequenceGTE(v int64) predicate.Balance {
+	return predicate.Balance(sql.FieldGTE(FieldSequence, v))
+}
+
+// SequenceLT applies the LT predicate on the "sequence" field.
+func SequenceLT(v int64) predicate.Balance {
+	return predicate.Balance(sql.FieldLT(FieldSequence, v))
+}
+
+// SequenceLTE applies the LTE predicate on the "sequence" field.
+func SequenceLTE(v int64) predicate.Balance {
+	return predicate.Balance(sql.FieldLTE(FieldSequence, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Balance {
 	return predicate.Balance(sql.FieldEQ(FieldCreatedAt, v))
@@ -308,6 +479,52 @@ func HasUserWith(preds ...predicate.User) predicate.Balance {
 	})
 }
 
+// HasInterestPlan applies the HasEdge predicate on the "interest_plan" edge.
+func HasInterestPlan() predicate.Balance {
+	return predicate.Balance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InterestPlanTable, InterestPlanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInterestPlanWith applies the HasEdge predicate on the "interest_plan" edge with a given conditions (other predicates).
+func HasInterestPlanWith(preds ...predicate.InterestPlan) predicate.Balance {
+	return predicate.Balance(func(s *sql.Selector) {
+		step := newInterestPlanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInterestAccruals applies the HasEdge predicate on the "interest_accruals" edge.
+func HasInterestAccruals() predicate.Balance {
+	return predicate.Balance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InterestAccrualsTable, InterestAccrualsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInterestAccrualsWith applies the HasEdge predicate on the "interest_accruals" edge with a given conditions (other predicates).
+func HasInterestAccrualsWith(preds ...predicate.InterestAccrual) predicate.Balance {
+	return predicate.Balance(func(s *sql.Selector) {
+		step := newInterestAccrualsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Balance) predicate.Balance {
 	return predicate.Balance(sql.AndPredicates(predicates...))
@@ -3,15 +3,19 @@
 package ent
 
 import (
-	"context"
 	"accounting/ent/balance"
+	"accounting/ent/interestaccrual"
+	"accounting/ent/interestplan"
 	"accounting/ent/user"
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
 )
 
 // BalanceCreate is the builder for creating a Balance entity.
@@ -19,6 +23,7 @@ type BalanceCreate struct {
 	config
 	mutation *BalanceMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -34,15 +39,71 @@ func (bc *BalanceCreate) SetCurrency(s string) *BalanceCreate {
 }
 
 // SetAmount sets the "amount" field.
-func (bc *BalanceCreate) SetAmount(f float64) *BalanceCreate {
-	bc.mutation.SetAmount(f)
+func (bc *BalanceCreate) SetAmount(d decimal.Decimal) *BalanceCreate {
+	bc.mutation.SetAmount(d)
 	return bc
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (bc *BalanceCreate) SetNillableAmount(f *float64) *BalanceCreate {
-	if f != nil {
-		bc.SetAmount(*f)
+func (bc *BalanceCreate) SetNillableAmount(d *decimal.Decimal) *BalanceCreate {
+	if d != nil {
+		bc.SetAmount(*d)
+	}
+	return bc
+}
+
+// SetAvailable sets the "available" field.
+func (bc *BalanceCreate) SetAvailable(d decimal.Decimal) *BalanceCreate {
+	bc.mutation.SetAvailable(d)
+	return bc
+}
+
+// SetNillableAvailable sets the "available" field if the given value is not nil.
+func (bc *BalanceCreate) SetNillableAvailable(d *decimal.Decimal) *BalanceCreate {
+	if d != nil {
+		bc.SetAvailable(*d)
+	}
+	return bc
+}
+
+// SetOverdraftLimit sets the "overdraft_limit" field.
+func (bc *BalanceCreate) SetOverdraftLimit(d decimal.Decimal) *BalanceCreate {
+	bc.mutation.SetOverdraftLimit(d)
+	return bc
+}
+
+// SetNillableOverdraftLimit sets the "overdraft_limit" field if the given value is not nil.
+func (bc *BalanceCreate) SetNillableOverdraftLimit(d *decimal.Decimal) *BalanceCreate {
+	if d != nil {
+		bc.SetOverdraftLimit(*d)
+	}
+	return bc
+}
+
+// SetInterestPlanID sets the "interest_plan_id" field.
+func (bc *BalanceCreate) SetInterestPlanID(i int) *BalanceCreate {
+	bc.mutation.SetInterestPlanID(i)
+	return bc
+}
+
+// SetNillableInterestPlanID sets the "interest_plan_id" field if the given value is not nil.
+func (bc *BalanceCreate) SetNillableInterestPlanID(i *int) *BalanceCreate {
+	if i != nil {
+		bc.SetInterestPlanID(*i)
+	}
+	return bc
+}
+
+// SetSequence sets the "sequence" field.
+func (bc *BalanceCreate) SetSequence(i int64) *BalanceCreate {
+	bc.mutation.SetSequence(i)
+	return bc
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (bc *BalanceCreate) SetNillableSequence(i *int64) *BalanceCreate {
+	if i != nil {
+		bc.SetSequence(*i)
 	}
 	return bc
 }
@@ -86,6 +147,26 @@ func (bc *BalanceCreate) SetUser(u *User) *BalanceCreate {
 	return bc.SetUserID(u.ID)
 }
 
+// SetInterestPlan sets the "interest_plan" edge to the InterestPlan entity.
+func (bc *BalanceCreate) SetInterestPlan(i *InterestPlan) *BalanceCreate {
+	return bc.SetInterestPlanID(i.ID)
+}
+
+// AddInterestAccrualIDs adds the "interest_accruals" edge to the InterestAccrual entity by IDs.
+func (bc *BalanceCreate) AddInterestAccrualIDs(ids ...int) *BalanceCreate {
+	bc.mutation.AddInterestAccrualIDs(ids...)
+	return bc
+}
+
+// AddInterestAccruals adds the "interest_accruals" edges to the InterestAccrual entity.
+func (bc *BalanceCreate) AddInterestAccruals(i ...*InterestAccrual) *BalanceCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return bc.AddInterestAccrualIDs(ids...)
+}
+
 // Mutation returns the BalanceMutation object of the builder.
 func (bc *BalanceCreate) Mutation() *BalanceMutation {
 	return bc.mutation
@@ -122,9 +203,21 @@ func (bc *BalanceCreate) ExecX(ctx context.Context) {
 // defaults sets the default values of the builder before save.
 func (bc *BalanceCreate) defaults() {
 	if _, ok := bc.mutation.Amount(); !ok {
-		v := balance.DefaultAmount
+		v := balance.DefaultAmount()
 		bc.mutation.SetAmount(v)
 	}
+	if _, ok := bc.mutation.Available(); !ok {
+		v := balance.DefaultAvailable()
+		bc.mutation.SetAvailable(v)
+	}
+	if _, ok := bc.mutation.OverdraftLimit(); !ok {
+		v := balance.DefaultOverdraftLimit()
+		bc.mutation.SetOverdraftLimit(v)
+	}
+	if _, ok := bc.mutation.Sequence(); !ok {
+		v := balance.DefaultSequence
+		bc.mutation.SetSequence(v)
+	}
 	if _, ok := bc.mutation.CreatedAt(); !ok {
 		v := balance.DefaultCreatedAt()
 		bc.mutation.SetCreatedAt(v)
@@ -151,6 +244,20 @@ func (bc *BalanceCreate) check() error {
 	if _, ok := bc.mutation.Amount(); !ok {
 		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Balance.amount"`)}
 	}
+	if _, ok := bc.mutation.Available(); !ok {
+		return &ValidationError{Name: "available", err: errors.New(`ent: missing required field "Balance.available"`)}
+	}
+	if _, ok := bc.mutation.OverdraftLimit(); !ok {
+		return &ValidationError{Name: "overdraft_limit", err: errors.New(`ent: missing required field "Balance.overdraft_limit"`)}
+	}
+	if _, ok := bc.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`ent: missing required field "Balance.sequence"`)}
+	}
+	if v, ok := bc.mutation.Sequence(); ok {
+		if err := balance.SequenceValidator(v); err != nil {
+			return &ValidationError{Name: "sequence", err: fmt.Errorf(`ent: validator failed for field "Balance.sequence": %w`, err)}
+		}
+	}
 	if _, ok := bc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Balance.created_at"`)}
 	}
@@ -188,6 +295,7 @@ func (bc *BalanceCreate) createSpec() (*Balance, *sqlgraph.CreateSpec) {
 		_node = &Balance{config: bc.config}
 		_spec = sqlgraph.NewCreateSpec(balance.Table, sqlgraph.NewFieldSpec(balance.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = bc.conflict
 	if id, ok := bc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -200,6 +308,18 @@ func (bc *BalanceCreate) createSpec() (*Balance, *sqlgraph.CreateSpec) {
 		_spec.SetField(balance.FieldAmount, field.TypeFloat64, value)
 		_node.Amount = value
 	}
+	if value, ok := bc.mutation.Available(); ok {
+		_spec.SetField(balance.FieldAvailable, field.TypeFloat64, value)
+		_node.Available = value
+	}
+	if value, ok := bc.mutation.OverdraftLimit(); ok {
+		_spec.SetField(balance.FieldOverdraftLimit, field.TypeFloat64, value)
+		_node.OverdraftLimit = value
+	}
+	if value, ok := bc.mutation.Sequence(); ok {
+		_spec.SetField(balance.FieldSequence, field.TypeInt64, value)
+		_node.Sequence = value
+	}
 	if value, ok := bc.mutation.CreatedAt(); ok {
 		_spec.SetField(balance.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -225,14 +345,454 @@ func (bc *BalanceCreate) createSpec() (*Balance, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := bc.mutation.InterestPlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balance.InterestPlanTable,
+			Columns: []string{balance.InterestPlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interestplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InterestPlanID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.InterestAccrualsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   balance.InterestAccrualsTable,
+			Columns: []string{balance.InterestAccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interestaccrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Balance.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BalanceUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (bc *BalanceCreate) OnConflict(opts ...sql.ConflictOption) *BalanceUpsertOne {
+	bc.conflict = opts
+	return &BalanceUpsertOne{
+		create: bc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Balance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bc *BalanceCreate) OnConflictColumns(columns ...string) *BalanceUpsertOne {
+	bc.conflict = append(bc.conflict, sql.ConflictColumns(columns...))
+	return &BalanceUpsertOne{
+		create: bc,
+	}
+}
+
+type (
+	// BalanceUpsertOne is the builder for "upsert"-ing
+	//  one Balance node.
+	BalanceUpsertOne struct {
+		create *BalanceCreate
+	}
+
+	// BalanceUpsert is the "OnConflict" setter.
+	BalanceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *BalanceUpsert) SetUserID(v int) *BalanceUpsert {
+	u.Set(balance.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BalanceUpsert) UpdateUserID() *BalanceUpsert {
+	u.SetExcluded(balance.FieldUserID)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BalanceUpsert) SetCurrency(v string) *BalanceUpsert {
+	u.Set(balance.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BalanceUpsert) UpdateCurrency() *BalanceUpsert {
+	u.SetExcluded(balance.FieldCurrency)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *BalanceUpsert) SetAmount(v decimal.Decimal) *BalanceUpsert {
+	u.Set(balance.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BalanceUpsert) UpdateAmount() *BalanceUpsert {
+	u.SetExcluded(balance.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BalanceUpsert) AddAmount(v decimal.Decimal) *BalanceUpsert {
+	u.Add(balance.FieldAmount, v)
+	return u
+}
+
+// SetAvailable sets the "available" field.
+func (u *BalanceUpsert) SetAvailable(v decimal.Decimal) *BalanceUpsert {
+	u.Set(balance.FieldAvailable, v)
+	return u
+}
+
+// UpdateAvailable sets the "available" field to the value that was provided on create.
+func (u *BalanceUpsert) UpdateAvailable() *BalanceUpsert {
+	u.SetExcluded(balance.FieldAvailable)
+	return u
+}
+
+// AddAvailable adds v to the "available" field.
+func (u *BalanceUpsert) AddAvailable(v decimal.Decimal) *BalanceUpsert {
+	u.Add(balance.FieldAvailable, v)
+	return u
+}
+
+// SetOverdraftLimit sets the "overdraft_limit" field.
+func (u *BalanceUpsert) SetOverdraftLimit(v decimal.Decimal) *BalanceUpsert {
+	u.Set(balance.FieldOverdraftLimit, v)
+	return u
+}
+
+// UpdateOverdraftLimit sets the "overdraft_limit" field to the value that was provided on create.
+func (u *BalanceUpsert) UpdateOverdraftLimit() *BalanceUpsert {
+	u.SetExcluded(balance.FieldOverdraftLimit)
+	return u
+}
+
+// AddOverdraftLimit adds v to the "overdraft_limit" field.
+func (u *BalanceUpsert) AddOverdraftLimit(v decimal.Decimal) *BalanceUpsert {
+	u.Add(balance.FieldOverdraftLimit, v)
+	return u
+}
+
+// SetInterestPlanID sets the "interest_plan_id" field.
+func (u *BalanceUpsert) SetInterestPlanID(v int) *BalanceUpsert {
+	u.Set(balance.FieldInterestPlanID, v)
+	return u
+}
+
+// UpdateInterestPlanID sets the "interest_plan_id" field to the value that was provided on create.
+func (u *BalanceUpsert) UpdateInterestPlanID() *BalanceUpsert {
+	u.SetExcluded(balance.FieldInterestPlanID)
+	return u
+}
+
+// ClearInterestPlanID clears the value of the "interest_plan_id" field.
+func (u *BalanceUpsert) ClearInterestPlanID() *BalanceUpsert {
+	u.SetNull(balance.FieldInterestPlanID)
+	return u
+}
+
+// SetSequence sets the "sequence" field.
+func (u *BalanceUpsert) SetSequence(v int64) *BalanceUpsert {
+	u.Set(balance.FieldSequence, v)
+	return u
+}
+
+// UpdateSequence sets the "sequence" field to the value that was provided on create.
+func (u *BalanceUpsert) UpdateSequence() *BalanceUpsert {
+	u.SetExcluded(balance.FieldSequence)
+	return u
+}
+
+// AddSequence adds v to the "sequence" field.
+func (u *BalanceUpsert) AddSequence(v int64) *BalanceUpsert {
+	u.Add(balance.FieldSequence, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BalanceUpsert) SetUpdatedAt(v time.Time) *BalanceUpsert {
+	u.Set(balance.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BalanceUpsert) UpdateUpdatedAt() *BalanceUpsert {
+	u.SetExcluded(balance.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Balance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(balance.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BalanceUpsertOne) UpdateNewValues() *BalanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(balance.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(balance.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Balance.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BalanceUpsertOne) Ignore() *BalanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BalanceUpsertOne) DoNothing() *BalanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BalanceCreate.OnConflict
+// documentation for more info.
+func (u *BalanceUpsertOne) Update(set func(*BalanceUpsert)) *BalanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BalanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BalanceUpsertOne) SetUserID(v int) *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BalanceUpsertOne) UpdateUserID() *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BalanceUpsertOne) SetCurrency(v string) *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BalanceUpsertOne) UpdateCurrency() *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BalanceUpsertOne) SetAmount(v decimal.Decimal) *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BalanceUpsertOne) AddAmount(v decimal.Decimal) *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BalanceUpsertOne) UpdateAmount() *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetAvailable sets the "available" field.
+func (u *BalanceUpsertOne) SetAvailable(v decimal.Decimal) *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetAvailable(v)
+	})
+}
+
+// AddAvailable adds v to the "available" field.
+func (u *BalanceUpsertOne) AddAvailable(v decimal.Decimal) *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.AddAvailable(v)
+	})
+}
+
+// UpdateAvailable sets the "available" field to the value that was provided on create.
+func (u *BalanceUpsertOne) UpdateAvailable() *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateAvailable()
+	})
+}
+
+// SetOverdraftLimit sets the "overdraft_limit" field.
+func (u *BalanceUpsertOne) SetOverdraftLimit(v decimal.Decimal) *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetOverdraftLimit(v)
+	})
+}
+
+// AddOverdraftLimit adds v to the "overdraft_limit" field.
+func (u *BalanceUpsertOne) AddOverdraftLimit(v decimal.Decimal) *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.AddOverdraftLimit(v)
+	})
+}
+
+// UpdateOverdraftLimit sets the "overdraft_limit" field to the value that was provided on create.
+func (u *BalanceUpsertOne) UpdateOverdraftLimit() *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateOverdraftLimit()
+	})
+}
+
+// SetInterestPlanID sets the "interest_plan_id" field.
+func (u *BalanceUpsertOne) SetInterestPlanID(v int) *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetInterestPlanID(v)
+	})
+}
+
+// UpdateInterestPlanID sets the "interest_plan_id" field to the value that was provided on create.
+func (u *BalanceUpsertOne) UpdateInterestPlanID() *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateInterestPlanID()
+	})
+}
+
+// ClearInterestPlanID clears the value of the "interest_plan_id" field.
+func (u *BalanceUpsertOne) ClearInterestPlanID() *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.ClearInterestPlanID()
+	})
+}
+
+// SetSequence sets the "sequence" field.
+func (u *BalanceUpsertOne) SetSequence(v int64) *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetSequence(v)
+	})
+}
+
+// AddSequence adds v to the "sequence" field.
+func (u *BalanceUpsertOne) AddSequence(v int64) *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.AddSequence(v)
+	})
+}
+
+// UpdateSequence sets the "sequence" field to the value that was provided on create.
+func (u *BalanceUpsertOne) UpdateSequence() *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateSequence()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BalanceUpsertOne) SetUpdatedAt(v time.Time) *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BalanceUpsertOne) UpdateUpdatedAt() *BalanceUpsertOne {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BalanceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BalanceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BalanceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BalanceUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BalanceUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // BalanceCreateBulk is the builder for creating many Balance entities in bulk.
 type BalanceCreateBulk struct {
 	config
 	err      error
 	builders []*BalanceCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Balance entities in the database.
@@ -262,6 +822,7 @@ func (bcb *BalanceCreateBulk) Save(ctx context.Context) ([]*Balance, error) {
 					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -315,3 +876,267 @@ func (bcb *BalanceCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Balance.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BalanceUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (bcb *BalanceCreateBulk) OnConflict(opts ...sql.ConflictOption) *BalanceUpsertBulk {
+	bcb.conflict = opts
+	return &BalanceUpsertBulk{
+		create: bcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Balance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bcb *BalanceCreateBulk) OnConflictColumns(columns ...string) *BalanceUpsertBulk {
+	bcb.conflict = append(bcb.conflict, sql.ConflictColumns(columns...))
+	return &BalanceUpsertBulk{
+		create: bcb,
+	}
+}
+
+// BalanceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Balance nodes.
+type BalanceUpsertBulk struct {
+	create *BalanceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Balance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(balance.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BalanceUpsertBulk) UpdateNewValues() *BalanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(balance.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(balance.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Balance.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BalanceUpsertBulk) Ignore() *BalanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BalanceUpsertBulk) DoNothing() *BalanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BalanceCreateBulk.OnConflict
+// documentation for more info.
+func (u *BalanceUpsertBulk) Update(set func(*BalanceUpsert)) *BalanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BalanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BalanceUpsertBulk) SetUserID(v int) *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BalanceUpsertBulk) UpdateUserID() *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BalanceUpsertBulk) SetCurrency(v string) *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BalanceUpsertBulk) UpdateCurrency() *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BalanceUpsertBulk) SetAmount(v decimal.Decimal) *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BalanceUpsertBulk) AddAmount(v decimal.Decimal) *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BalanceUpsertBulk) UpdateAmount() *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetAvailable sets the "available" field.
+func (u *BalanceUpsertBulk) SetAvailable(v decimal.Decimal) *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetAvailable(v)
+	})
+}
+
+// AddAvailable adds v to the "available" field.
+func (u *BalanceUpsertBulk) AddAvailable(v decimal.Decimal) *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.AddAvailable(v)
+	})
+}
+
+// UpdateAvailable sets the "available" field to the value that was provided on create.
+func (u *BalanceUpsertBulk) UpdateAvailable() *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateAvailable()
+	})
+}
+
+// SetOverdraftLimit sets the "overdraft_limit" field.
+func (u *BalanceUpsertBulk) SetOverdraftLimit(v decimal.Decimal) *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetOverdraftLimit(v)
+	})
+}
+
+// AddOverdraftLimit adds v to the "overdraft_limit" field.
+func (u *BalanceUpsertBulk) AddOverdraftLimit(v decimal.Decimal) *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.AddOverdraftLimit(v)
+	})
+}
+
+// UpdateOverdraftLimit sets the "overdraft_limit" field to the value that was provided on create.
+func (u *BalanceUpsertBulk) UpdateOverdraftLimit() *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateOverdraftLimit()
+	})
+}
+
+// SetInterestPlanID sets the "interest_plan_id" field.
+func (u *BalanceUpsertBulk) SetInterestPlanID(v int) *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetInterestPlanID(v)
+	})
+}
+
+// UpdateInterestPlanID sets the "interest_plan_id" field to the value that was provided on create.
+func (u *BalanceUpsertBulk) UpdateInterestPlanID() *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateInterestPlanID()
+	})
+}
+
+// ClearInterestPlanID clears the value of the "interest_plan_id" field.
+func (u *BalanceUpsertBulk) ClearInterestPlanID() *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.ClearInterestPlanID()
+	})
+}
+
+// SetSequence sets the "sequence" field.
+func (u *BalanceUpsertBulk) SetSequence(v int64) *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetSequence(v)
+	})
+}
+
+// AddSequence adds v to the "sequence" field.
+func (u *BalanceUpsertBulk) AddSequence(v int64) *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.AddSequence(v)
+	})
+}
+
+// UpdateSequence sets the "sequence" field to the value that was provided on create.
+func (u *BalanceUpsertBulk) UpdateSequence() *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateSequence()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BalanceUpsertBulk) SetUpdatedAt(v time.Time) *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BalanceUpsertBulk) UpdateUpdatedAt() *BalanceUpsertBulk {
+	return u.Update(func(s *BalanceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BalanceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BalanceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BalanceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BalanceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
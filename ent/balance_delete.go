@@ -3,9 +3,9 @@
 package ent
 
 import (
-	"context"
 	"accounting/ent/balance"
 	"accounting/ent/predicate"
+	"context"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -3,14 +3,18 @@
 package ent
 
 import (
-	"context"
 	"accounting/ent/balance"
+	"accounting/ent/interestaccrual"
+	"accounting/ent/interestplan"
 	"accounting/ent/predicate"
 	"accounting/ent/user"
+	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -19,11 +23,14 @@ import (
 // BalanceQuery is the builder for querying Balance entities.
 type BalanceQuery struct {
 	config
-	ctx        *QueryContext
-	order      []balance.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Balance
-	withUser   *UserQuery
+	ctx                  *QueryContext
+	order                []balance.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Balance
+	withUser             *UserQuery
+	withInterestPlan     *InterestPlanQuery
+	withInterestAccruals *InterestAccrualQuery
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +89,50 @@ func (bq *BalanceQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryInterestPlan chains the current query on the "interest_plan" edge.
+func (bq *BalanceQuery) QueryInterestPlan() *InterestPlanQuery {
+	query := (&InterestPlanClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(balance.Table, balance.FieldID, selector),
+			sqlgraph.To(interestplan.Table, interestplan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, balance.InterestPlanTable, balance.InterestPlanColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInterestAccruals chains the current query on the "interest_accruals" edge.
+func (bq *BalanceQuery) QueryInterestAccruals() *InterestAccrualQuery {
+	query := (&InterestAccrualClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(balance.Table, balance.FieldID, selector),
+			sqlgraph.To(interestaccrual.Table, interestaccrual.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, balance.InterestAccrualsTable, balance.InterestAccrualsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Balance entity from the query.
 // Returns a *NotFoundError when no Balance was found.
 func (bq *BalanceQuery) First(ctx context.Context) (*Balance, error) {
@@ -269,12 +320,14 @@ func (bq *BalanceQuery) Clone() *BalanceQuery {
 		return nil
 	}
 	return &BalanceQuery{
-		config:     bq.config,
-		ctx:        bq.ctx.Clone(),
-		order:      append([]balance.OrderOption{}, bq.order...),
-		inters:     append([]Interceptor{}, bq.inters...),
-		predicates: append([]predicate.Balance{}, bq.predicates...),
-		withUser:   bq.withUser.Clone(),
+		config:               bq.config,
+		ctx:                  bq.ctx.Clone(),
+		order:                append([]balance.OrderOption{}, bq.order...),
+		inters:               append([]Interceptor{}, bq.inters...),
+		predicates:           append([]predicate.Balance{}, bq.predicates...),
+		withUser:             bq.withUser.Clone(),
+		withInterestPlan:     bq.withInterestPlan.Clone(),
+		withInterestAccruals: bq.withInterestAccruals.Clone(),
 		// clone intermediate query.
 		sql:  bq.sql.Clone(),
 		path: bq.path,
@@ -292,6 +345,28 @@ func (bq *BalanceQuery) WithUser(opts ...func(*UserQuery)) *BalanceQuery {
 	return bq
 }
 
+// WithInterestPlan tells the query-builder to eager-load the nodes that are connected to
+// the "interest_plan" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BalanceQuery) WithInterestPlan(opts ...func(*InterestPlanQuery)) *BalanceQuery {
+	query := (&InterestPlanClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withInterestPlan = query
+	return bq
+}
+
+// WithInterestAccruals tells the query-builder to eager-load the nodes that are connected to
+// the "interest_accruals" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BalanceQuery) WithInterestAccruals(opts ...func(*InterestAccrualQuery)) *BalanceQuery {
+	query := (&InterestAccrualClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withInterestAccruals = query
+	return bq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +445,10 @@ func (bq *BalanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Bala
 	var (
 		nodes       = []*Balance{}
 		_spec       = bq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			bq.withUser != nil,
+			bq.withInterestPlan != nil,
+			bq.withInterestAccruals != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -383,6 +460,9 @@ func (bq *BalanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Bala
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(bq.modifiers) > 0 {
+		_spec.Modifiers = bq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -398,6 +478,19 @@ func (bq *BalanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Bala
 			return nil, err
 		}
 	}
+	if query := bq.withInterestPlan; query != nil {
+		if err := bq.loadInterestPlan(ctx, query, nodes, nil,
+			func(n *Balance, e *InterestPlan) { n.Edges.InterestPlan = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bq.withInterestAccruals; query != nil {
+		if err := bq.loadInterestAccruals(ctx, query, nodes,
+			func(n *Balance) { n.Edges.InterestAccruals = []*InterestAccrual{} },
+			func(n *Balance, e *InterestAccrual) { n.Edges.InterestAccruals = append(n.Edges.InterestAccruals, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -430,9 +523,74 @@ func (bq *BalanceQuery) loadUser(ctx context.Context, query *UserQuery, nodes []
 	}
 	return nil
 }
+func (bq *BalanceQuery) loadInterestPlan(ctx context.Context, query *InterestPlanQuery, nodes []*Balance, init func(*Balance), assign func(*Balance, *InterestPlan)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Balance)
+	for i := range nodes {
+		if nodes[i].InterestPlanID == nil {
+			continue
+		}
+		fk := *nodes[i].InterestPlanID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(interestplan.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "interest_plan_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (bq *BalanceQuery) loadInterestAccruals(ctx context.Context, query *InterestAccrualQuery, nodes []*Balance, init func(*Balance), assign func(*Balance, *InterestAccrual)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Balance)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(interestaccrual.FieldBalanceID)
+	}
+	query.Where(predicate.InterestAccrual(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(balance.InterestAccrualsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BalanceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "balance_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (bq *BalanceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := bq.querySpec()
+	if len(bq.modifiers) > 0 {
+		_spec.Modifiers = bq.modifiers
+	}
 	_spec.Node.Columns = bq.ctx.Fields
 	if len(bq.ctx.Fields) > 0 {
 		_spec.Unique = bq.ctx.Unique != nil && *bq.ctx.Unique
@@ -459,6 +617,9 @@ func (bq *BalanceQuery) querySpec() *sqlgraph.QuerySpec {
 		if bq.withUser != nil {
 			_spec.Node.AddColumnOnce(balance.FieldUserID)
 		}
+		if bq.withInterestPlan != nil {
+			_spec.Node.AddColumnOnce(balance.FieldInterestPlanID)
+		}
 	}
 	if ps := bq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -498,6 +659,9 @@ func (bq *BalanceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if bq.ctx.Unique != nil && *bq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range bq.modifiers {
+		m(selector)
+	}
 	for _, p := range bq.predicates {
 		p(selector)
 	}
@@ -515,6 +679,32 @@ func (bq *BalanceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (bq *BalanceQuery) ForUpdate(opts ...sql.LockOption) *BalanceQuery {
+	if bq.driver.Dialect() == dialect.Postgres {
+		bq.Unique(false)
+	}
+	bq.modifiers = append(bq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return bq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (bq *BalanceQuery) ForShare(opts ...sql.LockOption) *BalanceQuery {
+	if bq.driver.Dialect() == dialect.Postgres {
+		bq.Unique(false)
+	}
+	bq.modifiers = append(bq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return bq
+}
+
 // BalanceGroupBy is the group-by builder for Balance entities.
 type BalanceGroupBy struct {
 	selector
@@ -3,10 +3,12 @@
 package ent
 
 import (
-	"context"
 	"accounting/ent/balance"
+	"accounting/ent/interestaccrual"
+	"accounting/ent/interestplan"
 	"accounting/ent/predicate"
 	"accounting/ent/user"
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -14,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
 )
 
 // BalanceUpdate is the builder for updating Balance entities.
@@ -58,23 +61,106 @@ func (bu *BalanceUpdate) SetNillableCurrency(s *string) *BalanceUpdate {
 }
 
 // SetAmount sets the "amount" field.
-func (bu *BalanceUpdate) SetAmount(f float64) *BalanceUpdate {
+func (bu *BalanceUpdate) SetAmount(d decimal.Decimal) *BalanceUpdate {
 	bu.mutation.ResetAmount()
-	bu.mutation.SetAmount(f)
+	bu.mutation.SetAmount(d)
 	return bu
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (bu *BalanceUpdate) SetNillableAmount(f *float64) *BalanceUpdate {
-	if f != nil {
-		bu.SetAmount(*f)
+func (bu *BalanceUpdate) SetNillableAmount(d *decimal.Decimal) *BalanceUpdate {
+	if d != nil {
+		bu.SetAmount(*d)
+	}
+	return bu
+}
+
+// AddAmount adds d to the "amount" field.
+func (bu *BalanceUpdate) AddAmount(d decimal.Decimal) *BalanceUpdate {
+	bu.mutation.AddAmount(d)
+	return bu
+}
+
+// SetAvailable sets the "available" field.
+func (bu *BalanceUpdate) SetAvailable(d decimal.Decimal) *BalanceUpdate {
+	bu.mutation.ResetAvailable()
+	bu.mutation.SetAvailable(d)
+	return bu
+}
+
+// SetNillableAvailable sets the "available" field if the given value is not nil.
+func (bu *BalanceUpdate) SetNillableAvailable(d *decimal.Decimal) *BalanceUpdate {
+	if d != nil {
+		bu.SetAvailable(*d)
+	}
+	return bu
+}
+
+// AddAvailable adds d to the "available" field.
+func (bu *BalanceUpdate) AddAvailable(d decimal.Decimal) *BalanceUpdate {
+	bu.mutation.AddAvailable(d)
+	return bu
+}
+
+// SetOverdraftLimit sets the "overdraft_limit" field.
+func (bu *BalanceUpdate) SetOverdraftLimit(d decimal.Decimal) *BalanceUpdate {
+	bu.mutation.ResetOverdraftLimit()
+	bu.mutation.SetOverdraftLimit(d)
+	return bu
+}
+
+// SetNillableOverdraftLimit sets the "overdraft_limit" field if the given value is not nil.
+func (bu *BalanceUpdate) SetNillableOverdraftLimit(d *decimal.Decimal) *BalanceUpdate {
+	if d != nil {
+		bu.SetOverdraftLimit(*d)
+	}
+	return bu
+}
+
+// AddOverdraftLimit adds d to the "overdraft_limit" field.
+func (bu *BalanceUpdate) AddOverdraftLimit(d decimal.Decimal) *BalanceUpdate {
+	bu.mutation.AddOverdraftLimit(d)
+	return bu
+}
+
+// SetInterestPlanID sets the "interest_plan_id" field.
+func (bu *BalanceUpdate) SetInterestPlanID(i int) *BalanceUpdate {
+	bu.mutation.SetInterestPlanID(i)
+	return bu
+}
+
+// SetNillableInterestPlanID sets the "interest_plan_id" field if the given value is not nil.
+func (bu *BalanceUpdate) SetNillableInterestPlanID(i *int) *BalanceUpdate {
+	if i != nil {
+		bu.SetInterestPlanID(*i)
 	}
 	return bu
 }
 
-// AddAmount adds f to the "amount" field.
-func (bu *BalanceUpdate) AddAmount(f float64) *BalanceUpdate {
-	bu.mutation.AddAmount(f)
+// ClearInterestPlanID clears the value of the "interest_plan_id" field.
+func (bu *BalanceUpdate) ClearInterestPlanID() *BalanceUpdate {
+	bu.mutation.ClearInterestPlanID()
+	return bu
+}
+
+// SetSequence sets the "sequence" field.
+func (bu *BalanceUpdate) SetSequence(i int64) *BalanceUpdate {
+	bu.mutation.ResetSequence()
+	bu.mutation.SetSequence(i)
+	return bu
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (bu *BalanceUpdate) SetNillableSequence(i *int64) *BalanceUpdate {
+	if i != nil {
+		bu.SetSequence(*i)
+	}
+	return bu
+}
+
+// AddSequence adds i to the "sequence" field.
+func (bu *BalanceUpdate) AddSequence(i int64) *BalanceUpdate {
+	bu.mutation.AddSequence(i)
 	return bu
 }
 
@@ -89,6 +175,26 @@ func (bu *BalanceUpdate) SetUser(u *User) *BalanceUpdate {
 	return bu.SetUserID(u.ID)
 }
 
+// SetInterestPlan sets the "interest_plan" edge to the InterestPlan entity.
+func (bu *BalanceUpdate) SetInterestPlan(i *InterestPlan) *BalanceUpdate {
+	return bu.SetInterestPlanID(i.ID)
+}
+
+// AddInterestAccrualIDs adds the "interest_accruals" edge to the InterestAccrual entity by IDs.
+func (bu *BalanceUpdate) AddInterestAccrualIDs(ids ...int) *BalanceUpdate {
+	bu.mutation.AddInterestAccrualIDs(ids...)
+	return bu
+}
+
+// AddInterestAccruals adds the "interest_accruals" edges to the InterestAccrual entity.
+func (bu *BalanceUpdate) AddInterestAccruals(i ...*InterestAccrual) *BalanceUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return bu.AddInterestAccrualIDs(ids...)
+}
+
 // Mutation returns the BalanceMutation object of the builder.
 func (bu *BalanceUpdate) Mutation() *BalanceMutation {
 	return bu.mutation
@@ -100,6 +206,33 @@ func (bu *BalanceUpdate) ClearUser() *BalanceUpdate {
 	return bu
 }
 
+// ClearInterestPlan clears the "interest_plan" edge to the InterestPlan entity.
+func (bu *BalanceUpdate) ClearInterestPlan() *BalanceUpdate {
+	bu.mutation.ClearInterestPlan()
+	return bu
+}
+
+// ClearInterestAccruals clears all "interest_accruals" edges to the InterestAccrual entity.
+func (bu *BalanceUpdate) ClearInterestAccruals() *BalanceUpdate {
+	bu.mutation.ClearInterestAccruals()
+	return bu
+}
+
+// RemoveInterestAccrualIDs removes the "interest_accruals" edge to InterestAccrual entities by IDs.
+func (bu *BalanceUpdate) RemoveInterestAccrualIDs(ids ...int) *BalanceUpdate {
+	bu.mutation.RemoveInterestAccrualIDs(ids...)
+	return bu
+}
+
+// RemoveInterestAccruals removes "interest_accruals" edges to InterestAccrual entities.
+func (bu *BalanceUpdate) RemoveInterestAccruals(i ...*InterestAccrual) *BalanceUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return bu.RemoveInterestAccrualIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (bu *BalanceUpdate) Save(ctx context.Context) (int, error) {
 	bu.defaults()
@@ -143,6 +276,11 @@ func (bu *BalanceUpdate) check() error {
 			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Balance.currency": %w`, err)}
 		}
 	}
+	if v, ok := bu.mutation.Sequence(); ok {
+		if err := balance.SequenceValidator(v); err != nil {
+			return &ValidationError{Name: "sequence", err: fmt.Errorf(`ent: validator failed for field "Balance.sequence": %w`, err)}
+		}
+	}
 	if bu.mutation.UserCleared() && len(bu.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Balance.user"`)
 	}
@@ -170,6 +308,24 @@ func (bu *BalanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := bu.mutation.AddedAmount(); ok {
 		_spec.AddField(balance.FieldAmount, field.TypeFloat64, value)
 	}
+	if value, ok := bu.mutation.Available(); ok {
+		_spec.SetField(balance.FieldAvailable, field.TypeFloat64, value)
+	}
+	if value, ok := bu.mutation.AddedAvailable(); ok {
+		_spec.AddField(balance.FieldAvailable, field.TypeFloat64, value)
+	}
+	if value, ok := bu.mutation.OverdraftLimit(); ok {
+		_spec.SetField(balance.FieldOverdraftLimit, field.TypeFloat64, value)
+	}
+	if value, ok := bu.mutation.AddedOverdraftLimit(); ok {
+		_spec.AddField(balance.FieldOverdraftLimit, field.TypeFloat64, value)
+	}
+	if value, ok := bu.mutation.Sequence(); ok {
+		_spec.SetField(balance.FieldSequence, field.TypeInt64, value)
+	}
+	if value, ok := bu.mutation.AddedSequence(); ok {
+		_spec.AddField(balance.FieldSequence, field.TypeInt64, value)
+	}
 	if value, ok := bu.mutation.UpdatedAt(); ok {
 		_spec.SetField(balance.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -202,6 +358,80 @@ func (bu *BalanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if bu.mutation.InterestPlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balance.InterestPlanTable,
+			Columns: []string{balance.InterestPlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interestplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.InterestPlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balance.InterestPlanTable,
+			Columns: []string{balance.InterestPlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interestplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.InterestAccrualsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   balance.InterestAccrualsTable,
+			Columns: []string{balance.InterestAccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interestaccrual.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedInterestAccrualsIDs(); len(nodes) > 0 && !bu.mutation.InterestAccrualsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   balance.InterestAccrualsTable,
+			Columns: []string{balance.InterestAccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interestaccrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.InterestAccrualsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   balance.InterestAccrualsTable,
+			Columns: []string{balance.InterestAccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interestaccrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{balance.Label}
@@ -251,23 +481,106 @@ func (buo *BalanceUpdateOne) SetNillableCurrency(s *string) *BalanceUpdateOne {
 }
 
 // SetAmount sets the "amount" field.
-func (buo *BalanceUpdateOne) SetAmount(f float64) *BalanceUpdateOne {
+func (buo *BalanceUpdateOne) SetAmount(d decimal.Decimal) *BalanceUpdateOne {
 	buo.mutation.ResetAmount()
-	buo.mutation.SetAmount(f)
+	buo.mutation.SetAmount(d)
 	return buo
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (buo *BalanceUpdateOne) SetNillableAmount(f *float64) *BalanceUpdateOne {
-	if f != nil {
-		buo.SetAmount(*f)
+func (buo *BalanceUpdateOne) SetNillableAmount(d *decimal.Decimal) *BalanceUpdateOne {
+	if d != nil {
+		buo.SetAmount(*d)
+	}
+	return buo
+}
+
+// AddAmount adds d to the "amount" field.
+func (buo *BalanceUpdateOne) AddAmount(d decimal.Decimal) *BalanceUpdateOne {
+	buo.mutation.AddAmount(d)
+	return buo
+}
+
+// SetAvailable sets the "available" field.
+func (buo *BalanceUpdateOne) SetAvailable(d decimal.Decimal) *BalanceUpdateOne {
+	buo.mutation.ResetAvailable()
+	buo.mutation.SetAvailable(d)
+	return buo
+}
+
+// SetNillableAvailable sets the "available" field if the given value is not nil.
+func (buo *BalanceUpdateOne) SetNillableAvailable(d *decimal.Decimal) *BalanceUpdateOne {
+	if d != nil {
+		buo.SetAvailable(*d)
+	}
+	return buo
+}
+
+// AddAvailable adds d to the "available" field.
+func (buo *BalanceUpdateOne) AddAvailable(d decimal.Decimal) *BalanceUpdateOne {
+	buo.mutation.AddAvailable(d)
+	return buo
+}
+
+// SetOverdraftLimit sets the "overdraft_limit" field.
+func (buo *BalanceUpdateOne) SetOverdraftLimit(d decimal.Decimal) *BalanceUpdateOne {
+	buo.mutation.ResetOverdraftLimit()
+	buo.mutation.SetOverdraftLimit(d)
+	return buo
+}
+
+// SetNillableOverdraftLimit sets the "overdraft_limit" field if the given value is not nil.
+func (buo *BalanceUpdateOne) SetNillableOverdraftLimit(d *decimal.Decimal) *BalanceUpdateOne {
+	if d != nil {
+		buo.SetOverdraftLimit(*d)
+	}
+	return buo
+}
+
+// AddOverdraftLimit adds d to the "overdraft_limit" field.
+func (buo *BalanceUpdateOne) AddOverdraftLimit(d decimal.Decimal) *BalanceUpdateOne {
+	buo.mutation.AddOverdraftLimit(d)
+	return buo
+}
+
+// SetInterestPlanID sets the "interest_plan_id" field.
+func (buo *BalanceUpdateOne) SetInterestPlanID(i int) *BalanceUpdateOne {
+	buo.mutation.SetInterestPlanID(i)
+	return buo
+}
+
+// SetNillableInterestPlanID sets the "interest_plan_id" field if the given value is not nil.
+func (buo *BalanceUpdateOne) SetNillableInterestPlanID(i *int) *BalanceUpdateOne {
+	if i != nil {
+		buo.SetInterestPlanID(*i)
 	}
 	return buo
 }
 
-// AddAmount adds f to the "amount" field.
-func (buo *BalanceUpdateOne) AddAmount(f float64) *BalanceUpdateOne {
-	buo.mutation.AddAmount(f)
+// ClearInterestPlanID clears the value of the "interest_plan_id" field.
+func (buo *BalanceUpdateOne) ClearInterestPlanID() *BalanceUpdateOne {
+	buo.mutation.ClearInterestPlanID()
+	return buo
+}
+
+// SetSequence sets the "sequence" field.
+func (buo *BalanceUpdateOne) SetSequence(i int64) *BalanceUpdateOne {
+	buo.mutation.ResetSequence()
+	buo.mutation.SetSequence(i)
+	return buo
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (buo *BalanceUpdateOne) SetNillableSequence(i *int64) *BalanceUpdateOne {
+	if i != nil {
+		buo.SetSequence(*i)
+	}
+	return buo
+}
+
+// AddSequence adds i to the "sequence" field.
+func (buo *BalanceUpdateOne) AddSequence(i int64) *BalanceUpdateOne {
+	buo.mutation.AddSequence(i)
 	return buo
 }
 
@@ -282,6 +595,26 @@ func (buo *BalanceUpdateOne) SetUser(u *User) *BalanceUpdateOne {
 	return buo.SetUserID(u.ID)
 }
 
+// SetInterestPlan sets the "interest_plan" edge to the InterestPlan entity.
+func (buo *BalanceUpdateOne) SetInterestPlan(i *InterestPlan) *BalanceUpdateOne {
+	return buo.SetInterestPlanID(i.ID)
+}
+
+// AddInterestAccrualIDs adds the "interest_accruals" edge to the InterestAccrual entity by IDs.
+func (buo *BalanceUpdateOne) AddInterestAccrualIDs(ids ...int) *BalanceUpdateOne {
+	buo.mutation.AddInterestAccrualIDs(ids...)
+	return buo
+}
+
+// AddInterestAccruals adds the "interest_accruals" edges to the InterestAccrual entity.
+func (buo *BalanceUpdateOne) AddInterestAccruals(i ...*InterestAccrual) *BalanceUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return buo.AddInterestAccrualIDs(ids...)
+}
+
 // Mutation returns the BalanceMutation object of the builder.
 func (buo *BalanceUpdateOne) Mutation() *BalanceMutation {
 	return buo.mutation
@@ -293,6 +626,33 @@ func (buo *BalanceUpdateOne) ClearUser() *BalanceUpdateOne {
 	return buo
 }
 
+// ClearInterestPlan clears the "interest_plan" edge to the InterestPlan entity.
+func (buo *BalanceUpdateOne) ClearInterestPlan() *BalanceUpdateOne {
+	buo.mutation.ClearInterestPlan()
+	return buo
+}
+
+// ClearInterestAccruals clears all "interest_accruals" edges to the InterestAccrual entity.
+func (buo *BalanceUpdateOne) ClearInterestAccruals() *BalanceUpdateOne {
+	buo.mutation.ClearInterestAccruals()
+	return buo
+}
+
+// RemoveInterestAccrualIDs removes the "interest_accruals" edge to InterestAccrual entities by IDs.
+func (buo *BalanceUpdateOne) RemoveInterestAccrualIDs(ids ...int) *BalanceUpdateOne {
+	buo.mutation.RemoveInterestAccrualIDs(ids...)
+	return buo
+}
+
+// RemoveInterestAccruals removes "interest_accruals" edges to InterestAccrual entities.
+func (buo *BalanceUpdateOne) RemoveInterestAccruals(i ...*InterestAccrual) *BalanceUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return buo.RemoveInterestAccrualIDs(ids...)
+}
+
 // Where appends a list predicates to the BalanceUpdate builder.
 func (buo *BalanceUpdateOne) Where(ps ...predicate.Balance) *BalanceUpdateOne {
 	buo.mutation.Where(ps...)
@@ -349,6 +709,11 @@ func (buo *BalanceUpdateOne) check() error {
 			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Balance.currency": %w`, err)}
 		}
 	}
+	if v, ok := buo.mutation.Sequence(); ok {
+		if err := balance.SequenceValidator(v); err != nil {
+			return &ValidationError{Name: "sequence", err: fmt.Errorf(`ent: validator failed for field "Balance.sequence": %w`, err)}
+		}
+	}
 	if buo.mutation.UserCleared() && len(buo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Balance.user"`)
 	}
@@ -393,6 +758,24 @@ func (buo *BalanceUpdateOne) sqlSave(ctx context.Context) (_node *Balance, err e
 	if value, ok := buo.mutation.AddedAmount(); ok {
 		_spec.AddField(balance.FieldAmount, field.TypeFloat64, value)
 	}
+	if value, ok := buo.mutation.Available(); ok {
+		_spec.SetField(balance.FieldAvailable, field.TypeFloat64, value)
+	}
+	if value, ok := buo.mutation.AddedAvailable(); ok {
+		_spec.AddField(balance.FieldAvailable, field.TypeFloat64, value)
+	}
+	if value, ok := buo.mutation.OverdraftLimit(); ok {
+		_spec.SetField(balance.FieldOverdraftLimit, field.TypeFloat64, value)
+	}
+	if value, ok := buo.mutation.AddedOverdraftLimit(); ok {
+		_spec.AddField(balance.FieldOverdraftLimit, field.TypeFloat64, value)
+	}
+	if value, ok := buo.mutation.Sequence(); ok {
+		_spec.SetField(balance.FieldSequence, field.TypeInt64, value)
+	}
+	if value, ok := buo.mutation.AddedSequence(); ok {
+		_spec.AddField(balance.FieldSequence, field.TypeInt64, value)
+	}
 	if value, ok := buo.mutation.UpdatedAt(); ok {
 		_spec.SetField(balance.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -425,6 +808,80 @@ func (buo *BalanceUpdateOne) sqlSave(ctx context.Context) (_node *Balance, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if buo.mutation.InterestPlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balance.InterestPlanTable,
+			Columns: []string{balance.InterestPlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interestplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.InterestPlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balance.InterestPlanTable,
+			Columns: []string{balance.InterestPlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interestplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.InterestAccrualsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   balance.InterestAccrualsTable,
+			Columns: []string{balance.InterestAccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interestaccrual.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedInterestAccrualsIDs(); len(nodes) > 0 && !buo.mutation.InterestAccrualsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   balance.InterestAccrualsTable,
+			Columns: []string{balance.InterestAccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interestaccrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.InterestAccrualsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   balance.InterestAccrualsTable,
+			Columns: []string{balance.InterestAccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(interestaccrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Balance{config: buo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
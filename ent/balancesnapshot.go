@@ -0,0 +1,169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/account"
+	"accounting/ent/balancesnapshot"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// BalanceSnapshot is the model entity for the BalanceSnapshot schema.
+type BalanceSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID of the account, whose balance is captured
+	AccountID int `json:"account_id,omitempty"`
+	// Sum of all postings to the account effective up to and including taken_at; back-dated postings are added later
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Instant the balance is captured at
+	TakenAt time.Time `json:"taken_at,omitempty"`
+	// Time of the snapshot creation
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BalanceSnapshotQuery when eager-loading is set.
+	Edges        BalanceSnapshotEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BalanceSnapshotEdges holds the relations/edges for other nodes in the graph.
+type BalanceSnapshotEdges struct {
+	// Account, whose balance is captured
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BalanceSnapshotEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BalanceSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case balancesnapshot.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case balancesnapshot.FieldID, balancesnapshot.FieldAccountID:
+			values[i] = new(sql.NullInt64)
+		case balancesnapshot.FieldTakenAt, balancesnapshot.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BalanceSnapshot fields.
+func (bs *BalanceSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case balancesnapshot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bs.ID = int(value.Int64)
+		case balancesnapshot.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				bs.AccountID = int(value.Int64)
+			}
+		case balancesnapshot.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				bs.Amount = *value
+			}
+		case balancesnapshot.FieldTakenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field taken_at", values[i])
+			} else if value.Valid {
+				bs.TakenAt = value.Time
+			}
+		case balancesnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				bs.CreatedAt = value.Time
+			}
+		default:
+			bs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BalanceSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (bs *BalanceSnapshot) Value(name string) (ent.Value, error) {
+	return bs.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the BalanceSnapshot entity.
+func (bs *BalanceSnapshot) QueryAccount() *AccountQuery {
+	return NewBalanceSnapshotClient(bs.config).QueryAccount(bs)
+}
+
+// Update returns a builder for updating this BalanceSnapshot.
+// Note that you need to call BalanceSnapshot.Unwrap() before calling this method if this BalanceSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bs *BalanceSnapshot) Update() *BalanceSnapshotUpdateOne {
+	return NewBalanceSnapshotClient(bs.config).UpdateOne(bs)
+}
+
+// Unwrap unwraps the BalanceSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bs *BalanceSnapshot) Unwrap() *BalanceSnapshot {
+	_tx, ok := bs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BalanceSnapshot is not a transactional entity")
+	}
+	bs.config.driver = _tx.drv
+	return bs
+}
+
+// String implements the fmt.Stringer.
+func (bs *BalanceSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("BalanceSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bs.ID))
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", bs.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", bs.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("taken_at=")
+	builder.WriteString(bs.TakenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(bs.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BalanceSnapshots is a parsable slice of BalanceSnapshot.
+type BalanceSnapshots []*BalanceSnapshot
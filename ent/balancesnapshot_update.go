@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/balancesnapshot"
+	"accounting/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// BalanceSnapshotUpdate is the builder for updating BalanceSnapshot entities.
+type BalanceSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BalanceSnapshotMutation
+}
+
+// Where appends a list predicates to the BalanceSnapshotUpdate builder.
+func (bsu *BalanceSnapshotUpdate) Where(ps ...predicate.BalanceSnapshot) *BalanceSnapshotUpdate {
+	bsu.mutation.Where(ps...)
+	return bsu
+}
+
+// SetAmount sets the "amount" field.
+func (bsu *BalanceSnapshotUpdate) SetAmount(d decimal.Decimal) *BalanceSnapshotUpdate {
+	bsu.mutation.ResetAmount()
+	bsu.mutation.SetAmount(d)
+	return bsu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (bsu *BalanceSnapshotUpdate) SetNillableAmount(d *decimal.Decimal) *BalanceSnapshotUpdate {
+	if d != nil {
+		bsu.SetAmount(*d)
+	}
+	return bsu
+}
+
+// AddAmount adds d to the "amount" field.
+func (bsu *BalanceSnapshotUpdate) AddAmount(d decimal.Decimal) *BalanceSnapshotUpdate {
+	bsu.mutation.AddAmount(d)
+	return bsu
+}
+
+// Mutation returns the BalanceSnapshotMutation object of the builder.
+func (bsu *BalanceSnapshotUpdate) Mutation() *BalanceSnapshotMutation {
+	return bsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bsu *BalanceSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bsu.sqlSave, bsu.mutation, bsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bsu *BalanceSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := bsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bsu *BalanceSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := bsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsu *BalanceSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := bsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsu *BalanceSnapshotUpdate) check() error {
+	if bsu.mutation.AccountCleared() && len(bsu.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BalanceSnapshot.account"`)
+	}
+	return nil
+}
+
+func (bsu *BalanceSnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(balancesnapshot.Table, balancesnapshot.Columns, sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeInt))
+	if ps := bsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bsu.mutation.Amount(); ok {
+		_spec.SetField(balancesnapshot.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := bsu.mutation.AddedAmount(); ok {
+		_spec.AddField(balancesnapshot.FieldAmount, field.TypeFloat64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bsu.mutation.done = true
+	return n, nil
+}
+
+// BalanceSnapshotUpdateOne is the builder for updating a single BalanceSnapshot entity.
+type BalanceSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BalanceSnapshotMutation
+}
+
+// SetAmount sets the "amount" field.
+func (bsuo *BalanceSnapshotUpdateOne) SetAmount(d decimal.Decimal) *BalanceSnapshotUpdateOne {
+	bsuo.mutation.ResetAmount()
+	bsuo.mutation.SetAmount(d)
+	return bsuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (bsuo *BalanceSnapshotUpdateOne) SetNillableAmount(d *decimal.Decimal) *BalanceSnapshotUpdateOne {
+	if d != nil {
+		bsuo.SetAmount(*d)
+	}
+	return bsuo
+}
+
+// AddAmount adds d to the "amount" field.
+func (bsuo *BalanceSnapshotUpdateOne) AddAmount(d decimal.Decimal) *BalanceSnapshotUpdateOne {
+	bsuo.mutation.AddAmount(d)
+	return bsuo
+}
+
+// Mutation returns the BalanceSnapshotMutation object of the builder.
+func (bsuo *BalanceSnapshotUpdateOne) Mutation() *BalanceSnapshotMutation {
+	return bsuo.mutation
+}
+
+// Where appends a list predicates to the BalanceSnapshotUpdate builder.
+func (bsuo *BalanceSnapshotUpdateOne) Where(ps ...predicate.BalanceSnapshot) *BalanceSnapshotUpdateOne {
+	bsuo.mutation.Where(ps...)
+	return bsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bsuo *BalanceSnapshotUpdateOne) Select(field string, fields ...string) *BalanceSnapshotUpdateOne {
+	bsuo.fields = append([]string{field}, fields...)
+	return bsuo
+}
+
+// Save executes the query and returns the updated BalanceSnapshot entity.
+func (bsuo *BalanceSnapshotUpdateOne) Save(ctx context.Context) (*BalanceSnapshot, error) {
+	return withHooks(ctx, bsuo.sqlSave, bsuo.mutation, bsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bsuo *BalanceSnapshotUpdateOne) SaveX(ctx context.Context) *BalanceSnapshot {
+	node, err := bsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bsuo *BalanceSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := bsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsuo *BalanceSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := bsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsuo *BalanceSnapshotUpdateOne) check() error {
+	if bsuo.mutation.AccountCleared() && len(bsuo.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BalanceSnapshot.account"`)
+	}
+	return nil
+}
+
+func (bsuo *BalanceSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *BalanceSnapshot, err error) {
+	if err := bsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(balancesnapshot.Table, balancesnapshot.Columns, sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeInt))
+	id, ok := bsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BalanceSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balancesnapshot.FieldID)
+		for _, f := range fields {
+			if !balancesnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != balancesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bsuo.mutation.Amount(); ok {
+		_spec.SetField(balancesnapshot.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := bsuo.mutation.AddedAmount(); ok {
+		_spec.AddField(balancesnapshot.FieldAmount, field.TypeFloat64, value)
+	}
+	_node = &BalanceSnapshot{config: bsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bsuo.mutation.done = true
+	return _node, nil
+}
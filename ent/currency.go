@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/currency"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Currency is the model entity for the Currency schema.
+type Currency struct {
+	config `json:"-"`
+	// ID of the ent.
+	// ISO 4217 alphabetic code of the currency (e.g. USD)
+	ID string `json:"code,omitempty"`
+	// ISO 4217 numeric code of the currency (e.g. 840)
+	NumericCode string `json:"numeric_code,omitempty"`
+	// Name of the currency
+	Name string `json:"name,omitempty"`
+	// Number of decimal places of the minor unit (e.g. 2 for USD, 0 for JPY)
+	Exponent int `json:"exponent,omitempty"`
+	// Whether new transactions in the currency are accepted
+	Enabled bool `json:"enabled,omitempty"`
+	// Time of the currency creation
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Time of the last currency update
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Currency) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case currency.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case currency.FieldExponent:
+			values[i] = new(sql.NullInt64)
+		case currency.FieldID, currency.FieldNumericCode, currency.FieldName:
+			values[i] = new(sql.NullString)
+		case currency.FieldCreatedAt, currency.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Currency fields.
+func (c *Currency) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case currency.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				c.ID = value.String
+			}
+		case currency.FieldNumericCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field numeric_code", values[i])
+			} else if value.Valid {
+				c.NumericCode = value.String
+			}
+		case currency.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case currency.FieldExponent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field exponent", values[i])
+			} else if value.Valid {
+				c.Exponent = int(value.Int64)
+			}
+		case currency.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				c.Enabled = value.Bool
+			}
+		case currency.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case currency.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Currency.
+// This includes values selected through modifiers, order, etc.
+func (c *Currency) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Currency.
+// Note that you need to call Currency.Unwrap() before calling this method if this Currency
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Currency) Update() *CurrencyUpdateOne {
+	return NewCurrencyClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Currency entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Currency) Unwrap() *Currency {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Currency is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Currency) String() string {
+	var builder strings.Builder
+	builder.WriteString("Currency(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("numeric_code=")
+	builder.WriteString(c.NumericCode)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("exponent=")
+	builder.WriteString(fmt.Sprintf("%v", c.Exponent))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", c.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Currencies is a parsable slice of Currency.
+type Currencies []*Currency
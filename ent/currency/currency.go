@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package currency
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the currency type in the database.
+	Label = "currency"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNumericCode holds the string denoting the numeric_code field in the database.
+	FieldNumericCode = "numeric_code"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldExponent holds the string denoting the exponent field in the database.
+	FieldExponent = "exponent"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the currency in the database.
+	Table = "currencies"
+)
+
+// Columns holds all SQL columns for currency fields.
+var Columns = []string{
+	FieldID,
+	FieldNumericCode,
+	FieldName,
+	FieldExponent,
+	FieldEnabled,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultNumericCode holds the default value on creation for the "numeric_code" field.
+	DefaultNumericCode string
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// ExponentValidator is a validator for the "exponent" field. It is called by the builders before save.
+	ExponentValidator func(int) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Currency queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNumericCode orders the results by the numeric_code field.
+func ByNumericCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumericCode, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByExponent orders the results by the exponent field.
+func ByExponent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExponent, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
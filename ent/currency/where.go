@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package currency
+
+import (
+	"accounting/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Currency {
+	return predicate.Currency(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Currency {
+	return predicate.Currency(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Currency {
+	return predicate.Currency(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Currency {
+	return predicate.Currency(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Currency {
+	return predicate.Currency(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Currency {
+	return predicate.Currency(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Currency {
+	return predicate.Currency(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Currency {
+	return predicate.Currency(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Currency {
+	return predicate.Currency(sql.FieldContainsFold(FieldID, id))
+}
+
+// NumericCode applies equality check predicate on the "numeric_code" field. It's identical to NumericCodeEQ.
+func NumericCode(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldNumericCode, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldName, v))
+}
+
+// Exponent applies equality check predicate on the "exponent" field. It's identical to ExponentEQ.
+func Exponent(v int) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldExponent, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NumericCodeEQ applies the EQ predicate on the "numeric_code" field.
+func NumericCodeEQ(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldNumericCode, v))
+}
+
+// NumericCodeNEQ applies the NEQ predicate on the "numeric_code" field.
+func NumericCodeNEQ(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldNEQ(FieldNumericCode, v))
+}
+
+// NumericCodeIn applies the In predicate on the "numeric_code" field.
+func NumericCodeIn(vs ...string) predicate.Currency {
+	return predicate.Currency(sql.FieldIn(FieldNumericCode, vs...))
+}
+
+// NumericCodeNotIn applies the NotIn predicate on the "numeric_code" field.
+func NumericCodeNotIn(vs ...string) predicate.Currency {
+	return predicate.Currency(sql.FieldNotIn(FieldNumericCode, vs...))
+}
+
+// NumericCodeGT applies the GT predicate on the "numeric_code" field.
+func NumericCodeGT(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldGT(FieldNumericCode, v))
+}
+
+// NumericCodeGTE applies the GTE predicate on the "numeric_code" field.
+func NumericCodeGTE(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldGTE(FieldNumericCode, v))
+}
+
+// NumericCodeLT applies the LT predicate on the "numeric_code" field.
+func NumericCodeLT(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldLT(FieldNumericCode, v))
+}
+
+// NumericCodeLTE applies the LTE predicate on the "numeric_code" field.
+func NumericCodeLTE(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldLTE(FieldNumericCode, v))
+}
+
+// NumericCodeContains applies the Contains predicate on the "numeric_code" field.
+func NumericCodeContains(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldContains(FieldNumericCode, v))
+}
+
+// NumericCodeHasPrefix applies the HasPrefix predicate on the "numeric_code" field.
+func NumericCodeHasPrefix(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldHasPrefix(FieldNumericCode, v))
+}
+
+// NumericCodeHasSuffix applies the HasSuffix predicate on the "numeric_code" field.
+func NumericCodeHasSuffix(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldHasSuffix(FieldNumericCode, v))
+}
+
+// NumericCodeEqualFold applies the EqualFold predicate on the "numeric_code" field.
+func NumericCodeEqualFold(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldEqualFold(FieldNumericCode, v))
+}
+
+// NumericCodeContainsFold applies the ContainsFold predicate on the "numeric_code" field.
+func NumericCodeContainsFold(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldContainsFold(FieldNumericCode, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Currency {
+	return predicate.Currency(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Currency {
+	return predicate.Currency(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Currency {
+	return predicate.Currency(sql.FieldContainsFold(FieldName, v))
+}
+
+// ExponentEQ applies the EQ predicate on the "exponent" field.
+func ExponentEQ(v int) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldExponent, v))
+}
+
+// ExponentNEQ applies the NEQ predicate on the "exponent" field.
+func ExponentNEQ(v int) predicate.Currency {
+	return predicate.Currency(sql.FieldNEQ(FieldExponent, v))
+}
+
+// ExponentIn applies the In predicate on the "exponent" field.
+func ExponentIn(vs ...int) predicate.Currency {
+	return predicate.Currency(sql.FieldIn(FieldExponent, vs...))
+}
+
+// ExponentNotIn applies the NotIn predicate on the "exponent" field.
+func ExponentNotIn(vs ...int) predicate.Currency {
+	return predicate.Currency(sql.FieldNotIn(FieldExponent, vs...))
+}
+
+// ExponentGT applies the GT predicate on the "exponent" field.
+func ExponentGT(v int) predicate.Currency {
+	return predicate.Currency(sql.FieldGT(FieldExponent, v))
+}
+
+// ExponentGTE applies the GTE predicate on the "exponent" field.
+func ExponentGTE(v int) predicate.Currency {
+	return predicate.Currency(sql.FieldGTE(FieldExponent, v))
+}
+
+// ExponentLT applies the LT predicate on the "exponent" field.
+func ExponentLT(v int) predicate.Currency {
+	return predicate.Currency(sql.FieldLT(FieldExponent, v))
+}
+
+// ExponentLTE applies the LTE predicate on the "exponent" field.
+func ExponentLTE(v int) predicate.Currency {
+	return predicate.Currency(sql.FieldLTE(FieldExponent, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.Currency {
+	return predicate.Currency(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Currency {
+	return predicate.Currency(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Currency) predicate.Currency {
+	return predicate.Currency(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Currency) predicate.Currency {
+	return predicate.Currency(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Currency) predicate.Currency {
+	return predicate.Currency(sql.NotPredicates(p))
+}
@@ -0,0 +1,810 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/currency"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CurrencyCreate is the builder for creating a Currency entity.
+type CurrencyCreate struct {
+	config
+	mutation *CurrencyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetNumericCode sets the "numeric_code" field.
+func (cc *CurrencyCreate) SetNumericCode(s string) *CurrencyCreate {
+	cc.mutation.SetNumericCode(s)
+	return cc
+}
+
+// SetNillableNumericCode sets the "numeric_code" field if the given value is not nil.
+func (cc *CurrencyCreate) SetNillableNumericCode(s *string) *CurrencyCreate {
+	if s != nil {
+		cc.SetNumericCode(*s)
+	}
+	return cc
+}
+
+// SetName sets the "name" field.
+func (cc *CurrencyCreate) SetName(s string) *CurrencyCreate {
+	cc.mutation.SetName(s)
+	return cc
+}
+
+// SetExponent sets the "exponent" field.
+func (cc *CurrencyCreate) SetExponent(i int) *CurrencyCreate {
+	cc.mutation.SetExponent(i)
+	return cc
+}
+
+// SetEnabled sets the "enabled" field.
+func (cc *CurrencyCreate) SetEnabled(b bool) *CurrencyCreate {
+	cc.mutation.SetEnabled(b)
+	return cc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (cc *CurrencyCreate) SetNillableEnabled(b *bool) *CurrencyCreate {
+	if b != nil {
+		cc.SetEnabled(*b)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CurrencyCreate) SetCreatedAt(t time.Time) *CurrencyCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CurrencyCreate) SetNillableCreatedAt(t *time.Time) *CurrencyCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CurrencyCreate) SetUpdatedAt(t time.Time) *CurrencyCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CurrencyCreate) SetNillableUpdatedAt(t *time.Time) *CurrencyCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *CurrencyCreate) SetID(s string) *CurrencyCreate {
+	cc.mutation.SetID(s)
+	return cc
+}
+
+// Mutation returns the CurrencyMutation object of the builder.
+func (cc *CurrencyCreate) Mutation() *CurrencyMutation {
+	return cc.mutation
+}
+
+// Save creates the Currency in the database.
+func (cc *CurrencyCreate) Save(ctx context.Context) (*Currency, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CurrencyCreate) SaveX(ctx context.Context) *Currency {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CurrencyCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CurrencyCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CurrencyCreate) defaults() {
+	if _, ok := cc.mutation.NumericCode(); !ok {
+		v := currency.DefaultNumericCode
+		cc.mutation.SetNumericCode(v)
+	}
+	if _, ok := cc.mutation.Enabled(); !ok {
+		v := currency.DefaultEnabled
+		cc.mutation.SetEnabled(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := currency.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := currency.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CurrencyCreate) check() error {
+	if _, ok := cc.mutation.NumericCode(); !ok {
+		return &ValidationError{Name: "numeric_code", err: errors.New(`ent: missing required field "Currency.numeric_code"`)}
+	}
+	if _, ok := cc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Currency.name"`)}
+	}
+	if v, ok := cc.mutation.Name(); ok {
+		if err := currency.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Currency.name": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Exponent(); !ok {
+		return &ValidationError{Name: "exponent", err: errors.New(`ent: missing required field "Currency.exponent"`)}
+	}
+	if v, ok := cc.mutation.Exponent(); ok {
+		if err := currency.ExponentValidator(v); err != nil {
+			return &ValidationError{Name: "exponent", err: fmt.Errorf(`ent: validator failed for field "Currency.exponent": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Currency.enabled"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Currency.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Currency.updated_at"`)}
+	}
+	if v, ok := cc.mutation.ID(); ok {
+		if err := currency.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Currency.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cc *CurrencyCreate) sqlSave(ctx context.Context) (*Currency, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Currency.ID type: %T", _spec.ID.Value)
+		}
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CurrencyCreate) createSpec() (*Currency, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Currency{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(currency.Table, sqlgraph.NewFieldSpec(currency.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = cc.conflict
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cc.mutation.NumericCode(); ok {
+		_spec.SetField(currency.FieldNumericCode, field.TypeString, value)
+		_node.NumericCode = value
+	}
+	if value, ok := cc.mutation.Name(); ok {
+		_spec.SetField(currency.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cc.mutation.Exponent(); ok {
+		_spec.SetField(currency.FieldExponent, field.TypeInt, value)
+		_node.Exponent = value
+	}
+	if value, ok := cc.mutation.Enabled(); ok {
+		_spec.SetField(currency.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(currency.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(currency.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Currency.Create().
+//		SetNumericCode(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CurrencyUpsert) {
+//			SetNumericCode(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *CurrencyCreate) OnConflict(opts ...sql.ConflictOption) *CurrencyUpsertOne {
+	cc.conflict = opts
+	return &CurrencyUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Currency.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *CurrencyCreate) OnConflictColumns(columns ...string) *CurrencyUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CurrencyUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CurrencyUpsertOne is the builder for "upsert"-ing
+	//  one Currency node.
+	CurrencyUpsertOne struct {
+		create *CurrencyCreate
+	}
+
+	// CurrencyUpsert is the "OnConflict" setter.
+	CurrencyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetNumericCode sets the "numeric_code" field.
+func (u *CurrencyUpsert) SetNumericCode(v string) *CurrencyUpsert {
+	u.Set(currency.FieldNumericCode, v)
+	return u
+}
+
+// UpdateNumericCode sets the "numeric_code" field to the value that was provided on create.
+func (u *CurrencyUpsert) UpdateNumericCode() *CurrencyUpsert {
+	u.SetExcluded(currency.FieldNumericCode)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CurrencyUpsert) SetName(v string) *CurrencyUpsert {
+	u.Set(currency.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CurrencyUpsert) UpdateName() *CurrencyUpsert {
+	u.SetExcluded(currency.FieldName)
+	return u
+}
+
+// SetExponent sets the "exponent" field.
+func (u *CurrencyUpsert) SetExponent(v int) *CurrencyUpsert {
+	u.Set(currency.FieldExponent, v)
+	return u
+}
+
+// UpdateExponent sets the "exponent" field to the value that was provided on create.
+func (u *CurrencyUpsert) UpdateExponent() *CurrencyUpsert {
+	u.SetExcluded(currency.FieldExponent)
+	return u
+}
+
+// AddExponent adds v to the "exponent" field.
+func (u *CurrencyUpsert) AddExponent(v int) *CurrencyUpsert {
+	u.Add(currency.FieldExponent, v)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CurrencyUpsert) SetEnabled(v bool) *CurrencyUpsert {
+	u.Set(currency.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CurrencyUpsert) UpdateEnabled() *CurrencyUpsert {
+	u.SetExcluded(currency.FieldEnabled)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CurrencyUpsert) SetUpdatedAt(v time.Time) *CurrencyUpsert {
+	u.Set(currency.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CurrencyUpsert) UpdateUpdatedAt() *CurrencyUpsert {
+	u.SetExcluded(currency.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Currency.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(currency.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CurrencyUpsertOne) UpdateNewValues() *CurrencyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(currency.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(currency.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Currency.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CurrencyUpsertOne) Ignore() *CurrencyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CurrencyUpsertOne) DoNothing() *CurrencyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CurrencyCreate.OnConflict
+// documentation for more info.
+func (u *CurrencyUpsertOne) Update(set func(*CurrencyUpsert)) *CurrencyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CurrencyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNumericCode sets the "numeric_code" field.
+func (u *CurrencyUpsertOne) SetNumericCode(v string) *CurrencyUpsertOne {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.SetNumericCode(v)
+	})
+}
+
+// UpdateNumericCode sets the "numeric_code" field to the value that was provided on create.
+func (u *CurrencyUpsertOne) UpdateNumericCode() *CurrencyUpsertOne {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.UpdateNumericCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CurrencyUpsertOne) SetName(v string) *CurrencyUpsertOne {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CurrencyUpsertOne) UpdateName() *CurrencyUpsertOne {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetExponent sets the "exponent" field.
+func (u *CurrencyUpsertOne) SetExponent(v int) *CurrencyUpsertOne {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.SetExponent(v)
+	})
+}
+
+// AddExponent adds v to the "exponent" field.
+func (u *CurrencyUpsertOne) AddExponent(v int) *CurrencyUpsertOne {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.AddExponent(v)
+	})
+}
+
+// UpdateExponent sets the "exponent" field to the value that was provided on create.
+func (u *CurrencyUpsertOne) UpdateExponent() *CurrencyUpsertOne {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.UpdateExponent()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CurrencyUpsertOne) SetEnabled(v bool) *CurrencyUpsertOne {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CurrencyUpsertOne) UpdateEnabled() *CurrencyUpsertOne {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CurrencyUpsertOne) SetUpdatedAt(v time.Time) *CurrencyUpsertOne {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CurrencyUpsertOne) UpdateUpdatedAt() *CurrencyUpsertOne {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CurrencyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CurrencyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CurrencyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CurrencyUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CurrencyUpsertOne.ID is not supported by MySQL driver. Use CurrencyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CurrencyUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CurrencyCreateBulk is the builder for creating many Currency entities in bulk.
+type CurrencyCreateBulk struct {
+	config
+	err      error
+	builders []*CurrencyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Currency entities in the database.
+func (ccb *CurrencyCreateBulk) Save(ctx context.Context) ([]*Currency, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Currency, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CurrencyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CurrencyCreateBulk) SaveX(ctx context.Context) []*Currency {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CurrencyCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CurrencyCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Currency.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CurrencyUpsert) {
+//			SetNumericCode(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *CurrencyCreateBulk) OnConflict(opts ...sql.ConflictOption) *CurrencyUpsertBulk {
+	ccb.conflict = opts
+	return &CurrencyUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Currency.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *CurrencyCreateBulk) OnConflictColumns(columns ...string) *CurrencyUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CurrencyUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CurrencyUpsertBulk is the builder for "upsert"-ing
+// a bulk of Currency nodes.
+type CurrencyUpsertBulk struct {
+	create *CurrencyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Currency.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(currency.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CurrencyUpsertBulk) UpdateNewValues() *CurrencyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(currency.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(currency.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Currency.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CurrencyUpsertBulk) Ignore() *CurrencyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CurrencyUpsertBulk) DoNothing() *CurrencyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CurrencyCreateBulk.OnConflict
+// documentation for more info.
+func (u *CurrencyUpsertBulk) Update(set func(*CurrencyUpsert)) *CurrencyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CurrencyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNumericCode sets the "numeric_code" field.
+func (u *CurrencyUpsertBulk) SetNumericCode(v string) *CurrencyUpsertBulk {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.SetNumericCode(v)
+	})
+}
+
+// UpdateNumericCode sets the "numeric_code" field to the value that was provided on create.
+func (u *CurrencyUpsertBulk) UpdateNumericCode() *CurrencyUpsertBulk {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.UpdateNumericCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CurrencyUpsertBulk) SetName(v string) *CurrencyUpsertBulk {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CurrencyUpsertBulk) UpdateName() *CurrencyUpsertBulk {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetExponent sets the "exponent" field.
+func (u *CurrencyUpsertBulk) SetExponent(v int) *CurrencyUpsertBulk {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.SetExponent(v)
+	})
+}
+
+// AddExponent adds v to the "exponent" field.
+func (u *CurrencyUpsertBulk) AddExponent(v int) *CurrencyUpsertBulk {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.AddExponent(v)
+	})
+}
+
+// UpdateExponent sets the "exponent" field to the value that was provided on create.
+func (u *CurrencyUpsertBulk) UpdateExponent() *CurrencyUpsertBulk {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.UpdateExponent()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CurrencyUpsertBulk) SetEnabled(v bool) *CurrencyUpsertBulk {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CurrencyUpsertBulk) UpdateEnabled() *CurrencyUpsertBulk {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CurrencyUpsertBulk) SetUpdatedAt(v time.Time) *CurrencyUpsertBulk {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CurrencyUpsertBulk) UpdateUpdatedAt() *CurrencyUpsertBulk {
+	return u.Update(func(s *CurrencyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CurrencyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CurrencyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CurrencyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CurrencyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/currency"
+	"accounting/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CurrencyUpdate is the builder for updating Currency entities.
+type CurrencyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CurrencyMutation
+}
+
+// Where appends a list predicates to the CurrencyUpdate builder.
+func (cu *CurrencyUpdate) Where(ps ...predicate.Currency) *CurrencyUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetNumericCode sets the "numeric_code" field.
+func (cu *CurrencyUpdate) SetNumericCode(s string) *CurrencyUpdate {
+	cu.mutation.SetNumericCode(s)
+	return cu
+}
+
+// SetNillableNumericCode sets the "numeric_code" field if the given value is not nil.
+func (cu *CurrencyUpdate) SetNillableNumericCode(s *string) *CurrencyUpdate {
+	if s != nil {
+		cu.SetNumericCode(*s)
+	}
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CurrencyUpdate) SetName(s string) *CurrencyUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *CurrencyUpdate) SetNillableName(s *string) *CurrencyUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// SetExponent sets the "exponent" field.
+func (cu *CurrencyUpdate) SetExponent(i int) *CurrencyUpdate {
+	cu.mutation.ResetExponent()
+	cu.mutation.SetExponent(i)
+	return cu
+}
+
+// SetNillableExponent sets the "exponent" field if the given value is not nil.
+func (cu *CurrencyUpdate) SetNillableExponent(i *int) *CurrencyUpdate {
+	if i != nil {
+		cu.SetExponent(*i)
+	}
+	return cu
+}
+
+// AddExponent adds i to the "exponent" field.
+func (cu *CurrencyUpdate) AddExponent(i int) *CurrencyUpdate {
+	cu.mutation.AddExponent(i)
+	return cu
+}
+
+// SetEnabled sets the "enabled" field.
+func (cu *CurrencyUpdate) SetEnabled(b bool) *CurrencyUpdate {
+	cu.mutation.SetEnabled(b)
+	return cu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (cu *CurrencyUpdate) SetNillableEnabled(b *bool) *CurrencyUpdate {
+	if b != nil {
+		cu.SetEnabled(*b)
+	}
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CurrencyUpdate) SetUpdatedAt(t time.Time) *CurrencyUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// Mutation returns the CurrencyMutation object of the builder.
+func (cu *CurrencyUpdate) Mutation() *CurrencyMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CurrencyUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CurrencyUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CurrencyUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CurrencyUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CurrencyUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := currency.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CurrencyUpdate) check() error {
+	if v, ok := cu.mutation.Name(); ok {
+		if err := currency.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Currency.name": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Exponent(); ok {
+		if err := currency.ExponentValidator(v); err != nil {
+			return &ValidationError{Name: "exponent", err: fmt.Errorf(`ent: validator failed for field "Currency.exponent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cu *CurrencyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(currency.Table, currency.Columns, sqlgraph.NewFieldSpec(currency.FieldID, field.TypeString))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.NumericCode(); ok {
+		_spec.SetField(currency.FieldNumericCode, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(currency.FieldName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Exponent(); ok {
+		_spec.SetField(currency.FieldExponent, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedExponent(); ok {
+		_spec.AddField(currency.FieldExponent, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.Enabled(); ok {
+		_spec.SetField(currency.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(currency.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{currency.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CurrencyUpdateOne is the builder for updating a single Currency entity.
+type CurrencyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CurrencyMutation
+}
+
+// SetNumericCode sets the "numeric_code" field.
+func (cuo *CurrencyUpdateOne) SetNumericCode(s string) *CurrencyUpdateOne {
+	cuo.mutation.SetNumericCode(s)
+	return cuo
+}
+
+// SetNillableNumericCode sets the "numeric_code" field if the given value is not nil.
+func (cuo *CurrencyUpdateOne) SetNillableNumericCode(s *string) *CurrencyUpdateOne {
+	if s != nil {
+		cuo.SetNumericCode(*s)
+	}
+	return cuo
+}
+
+// SetName sets the "name" field.
+func (cuo *CurrencyUpdateOne) SetName(s string) *CurrencyUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *CurrencyUpdateOne) SetNillableName(s *string) *CurrencyUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// SetExponent sets the "exponent" field.
+func (cuo *CurrencyUpdateOne) SetExponent(i int) *CurrencyUpdateOne {
+	cuo.mutation.ResetExponent()
+	cuo.mutation.SetExponent(i)
+	return cuo
+}
+
+// SetNillableExponent sets the "exponent" field if the given value is not nil.
+func (cuo *CurrencyUpdateOne) SetNillableExponent(i *int) *CurrencyUpdateOne {
+	if i != nil {
+		cuo.SetExponent(*i)
+	}
+	return cuo
+}
+
+// AddExponent adds i to the "exponent" field.
+func (cuo *CurrencyUpdateOne) AddExponent(i int) *CurrencyUpdateOne {
+	cuo.mutation.AddExponent(i)
+	return cuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (cuo *CurrencyUpdateOne) SetEnabled(b bool) *CurrencyUpdateOne {
+	cuo.mutation.SetEnabled(b)
+	return cuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (cuo *CurrencyUpdateOne) SetNillableEnabled(b *bool) *CurrencyUpdateOne {
+	if b != nil {
+		cuo.SetEnabled(*b)
+	}
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CurrencyUpdateOne) SetUpdatedAt(t time.Time) *CurrencyUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// Mutation returns the CurrencyMutation object of the builder.
+func (cuo *CurrencyUpdateOne) Mutation() *CurrencyMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the CurrencyUpdate builder.
+func (cuo *CurrencyUpdateOne) Where(ps ...predicate.Currency) *CurrencyUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CurrencyUpdateOne) Select(field string, fields ...string) *CurrencyUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Currency entity.
+func (cuo *CurrencyUpdateOne) Save(ctx context.Context) (*Currency, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CurrencyUpdateOne) SaveX(ctx context.Context) *Currency {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CurrencyUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CurrencyUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CurrencyUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := currency.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CurrencyUpdateOne) check() error {
+	if v, ok := cuo.mutation.Name(); ok {
+		if err := currency.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Currency.name": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Exponent(); ok {
+		if err := currency.ExponentValidator(v); err != nil {
+			return &ValidationError{Name: "exponent", err: fmt.Errorf(`ent: validator failed for field "Currency.exponent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cuo *CurrencyUpdateOne) sqlSave(ctx context.Context) (_node *Currency, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(currency.Table, currency.Columns, sqlgraph.NewFieldSpec(currency.FieldID, field.TypeString))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Currency.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, currency.FieldID)
+		for _, f := range fields {
+			if !currency.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != currency.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.NumericCode(); ok {
+		_spec.SetField(currency.FieldNumericCode, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(currency.FieldName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Exponent(); ok {
+		_spec.SetField(currency.FieldExponent, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedExponent(); ok {
+		_spec.AddField(currency.FieldExponent, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.Enabled(); ok {
+		_spec.SetField(currency.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(currency.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Currency{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{currency.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
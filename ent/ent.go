@@ -3,10 +3,26 @@
 package ent
 
 import (
-	"context"
+	"accounting/ent/account"
+	"accounting/ent/accountingperiod"
 	"accounting/ent/balance"
+	"accounting/ent/balancesnapshot"
+	"accounting/ent/currency"
+	"accounting/ent/exchangerate"
+	"accounting/ent/feerule"
+	"accounting/ent/hold"
+	"accounting/ent/idempotencykey"
+	"accounting/ent/interestaccrual"
+	"accounting/ent/interestplan"
+	"accounting/ent/journalentry"
+	"accounting/ent/posting"
+	"accounting/ent/schedule"
+	"accounting/ent/schedulerun"
 	"accounting/ent/transaction"
+	"accounting/ent/trialbalanceline"
 	"accounting/ent/user"
+	"accounting/ent/velocitylimit"
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -75,9 +91,25 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			balance.Table:     balance.ValidColumn,
-			transaction.Table: transaction.ValidColumn,
-			user.Table:        user.ValidColumn,
+			account.Table:          account.ValidColumn,
+			accountingperiod.Table: accountingperiod.ValidColumn,
+			balance.Table:          balance.ValidColumn,
+			balancesnapshot.Table:  balancesnapshot.ValidColumn,
+			currency.Table:         currency.ValidColumn,
+			exchangerate.Table:     exchangerate.ValidColumn,
+			feerule.Table:          feerule.ValidColumn,
+			hold.Table:             hold.ValidColumn,
+			idempotencykey.Table:   idempotencykey.ValidColumn,
+			interestaccrual.Table:  interestaccrual.ValidColumn,
+			interestplan.Table:     interestplan.ValidColumn,
+			journalentry.Table:     journalentry.ValidColumn,
+			posting.Table:          posting.ValidColumn,
+			schedule.Table:         schedule.ValidColumn,
+			schedulerun.Table:      schedulerun.ValidColumn,
+			transaction.Table:      transaction.ValidColumn,
+			trialbalanceline.Table: trialbalanceline.ValidColumn,
+			user.Table:             user.ValidColumn,
+			velocitylimit.Table:    velocitylimit.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
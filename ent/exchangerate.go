@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/exchangerate"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRate is the model entity for the ExchangeRate schema.
+type ExchangeRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	// ID of the exchange rate
+	ID int `json:"id,omitempty"`
+	// Currency being sold (e.g. USD)
+	BaseCurrency string `json:"base_currency,omitempty"`
+	// Currency being bought (e.g. EUR)
+	QuoteCurrency string `json:"quote_currency,omitempty"`
+	// Mid rate: units of the quote currency for one unit of the base currency
+	Rate decimal.Decimal `json:"rate,omitempty"`
+	// Fraction of the mid rate kept on conversion (e.g. 0.005 for 0.5%)
+	Spread decimal.Decimal `json:"spread,omitempty"`
+	// Start of the validity period of the rate
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of the validity period of the rate (exclusive), empty if open-ended
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Time of the exchange rate creation
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExchangeRateQuery when eager-loading is set.
+	Edges        ExchangeRateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ExchangeRateEdges holds the relations/edges for other nodes in the graph.
+type ExchangeRateEdges struct {
+	// Exchange transactions that applied the rate
+	Transactions []*Transaction `json:"transactions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TransactionsOrErr returns the Transactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ExchangeRateEdges) TransactionsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[0] {
+		return e.Transactions, nil
+	}
+	return nil, &NotLoadedError{edge: "transactions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExchangeRate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldRate, exchangerate.FieldSpread:
+			values[i] = new(decimal.Decimal)
+		case exchangerate.FieldID:
+			values[i] = new(sql.NullInt64)
+		case exchangerate.FieldBaseCurrency, exchangerate.FieldQuoteCurrency:
+			values[i] = new(sql.NullString)
+		case exchangerate.FieldValidFrom, exchangerate.FieldValidTo, exchangerate.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExchangeRate fields.
+func (er *ExchangeRate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			er.ID = int(value.Int64)
+		case exchangerate.FieldBaseCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_currency", values[i])
+			} else if value.Valid {
+				er.BaseCurrency = value.String
+			}
+		case exchangerate.FieldQuoteCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quote_currency", values[i])
+			} else if value.Valid {
+				er.QuoteCurrency = value.String
+			}
+		case exchangerate.FieldRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value != nil {
+				er.Rate = *value
+			}
+		case exchangerate.FieldSpread:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field spread", values[i])
+			} else if value != nil {
+				er.Spread = *value
+			}
+		case exchangerate.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				er.ValidFrom = value.Time
+			}
+		case exchangerate.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				er.ValidTo = new(time.Time)
+				*er.ValidTo = value.Time
+			}
+		case exchangerate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				er.CreatedAt = value.Time
+			}
+		default:
+			er.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExchangeRate.
+// This includes values selected through modifiers, order, etc.
+func (er *ExchangeRate) Value(name string) (ent.Value, error) {
+	return er.selectValues.Get(name)
+}
+
+// QueryTransactions queries the "transactions" edge of the ExchangeRate entity.
+func (er *ExchangeRate) QueryTransactions() *TransactionQuery {
+	return NewExchangeRateClient(er.config).QueryTransactions(er)
+}
+
+// Update returns a builder for updating this ExchangeRate.
+// Note that you need to call ExchangeRate.Unwrap() before calling this method if this ExchangeRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (er *ExchangeRate) Update() *ExchangeRateUpdateOne {
+	return NewExchangeRateClient(er.config).UpdateOne(er)
+}
+
+// Unwrap unwraps the ExchangeRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (er *ExchangeRate) Unwrap() *ExchangeRate {
+	_tx, ok := er.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExchangeRate is not a transactional entity")
+	}
+	er.config.driver = _tx.drv
+	return er
+}
+
+// String implements the fmt.Stringer.
+func (er *ExchangeRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExchangeRate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", er.ID))
+	builder.WriteString("base_currency=")
+	builder.WriteString(er.BaseCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("quote_currency=")
+	builder.WriteString(er.QuoteCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", er.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("spread=")
+	builder.WriteString(fmt.Sprintf("%v", er.Spread))
+	builder.WriteString(", ")
+	builder.WriteString("valid_from=")
+	builder.WriteString(er.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := er.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(er.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExchangeRates is a parsable slice of ExchangeRate.
+type ExchangeRates []*ExchangeRate
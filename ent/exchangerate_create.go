@@ -0,0 +1,697 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/exchangerate"
+	"accounting/ent/transaction"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRateCreate is the builder for creating a ExchangeRate entity.
+type ExchangeRateCreate struct {
+	config
+	mutation *ExchangeRateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetBaseCurrency sets the "base_currency" field.
+func (erc *ExchangeRateCreate) SetBaseCurrency(s string) *ExchangeRateCreate {
+	erc.mutation.SetBaseCurrency(s)
+	return erc
+}
+
+// SetQuoteCurrency sets the "quote_currency" field.
+func (erc *ExchangeRateCreate) SetQuoteCurrency(s string) *ExchangeRateCreate {
+	erc.mutation.SetQuoteCurrency(s)
+	return erc
+}
+
+// SetRate sets the "rate" field.
+func (erc *ExchangeRateCreate) SetRate(d decimal.Decimal) *ExchangeRateCreate {
+	erc.mutation.SetRate(d)
+	return erc
+}
+
+// SetSpread sets the "spread" field.
+func (erc *ExchangeRateCreate) SetSpread(d decimal.Decimal) *ExchangeRateCreate {
+	erc.mutation.SetSpread(d)
+	return erc
+}
+
+// SetNillableSpread sets the "spread" field if the given value is not nil.
+func (erc *ExchangeRateCreate) SetNillableSpread(d *decimal.Decimal) *ExchangeRateCreate {
+	if d != nil {
+		erc.SetSpread(*d)
+	}
+	return erc
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (erc *ExchangeRateCreate) SetValidFrom(t time.Time) *ExchangeRateCreate {
+	erc.mutation.SetValidFrom(t)
+	return erc
+}
+
+// SetValidTo sets the "valid_to" field.
+func (erc *ExchangeRateCreate) SetValidTo(t time.Time) *ExchangeRateCreate {
+	erc.mutation.SetValidTo(t)
+	return erc
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (erc *ExchangeRateCreate) SetNillableValidTo(t *time.Time) *ExchangeRateCreate {
+	if t != nil {
+		erc.SetValidTo(*t)
+	}
+	return erc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (erc *ExchangeRateCreate) SetCreatedAt(t time.Time) *ExchangeRateCreate {
+	erc.mutation.SetCreatedAt(t)
+	return erc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (erc *ExchangeRateCreate) SetNillableCreatedAt(t *time.Time) *ExchangeRateCreate {
+	if t != nil {
+		erc.SetCreatedAt(*t)
+	}
+	return erc
+}
+
+// SetID sets the "id" field.
+func (erc *ExchangeRateCreate) SetID(i int) *ExchangeRateCreate {
+	erc.mutation.SetID(i)
+	return erc
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (erc *ExchangeRateCreate) AddTransactionIDs(ids ...string) *ExchangeRateCreate {
+	erc.mutation.AddTransactionIDs(ids...)
+	return erc
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (erc *ExchangeRateCreate) AddTransactions(t ...*Transaction) *ExchangeRateCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return erc.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (erc *ExchangeRateCreate) Mutation() *ExchangeRateMutation {
+	return erc.mutation
+}
+
+// Save creates the ExchangeRate in the database.
+func (erc *ExchangeRateCreate) Save(ctx context.Context) (*ExchangeRate, error) {
+	erc.defaults()
+	return withHooks(ctx, erc.sqlSave, erc.mutation, erc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (erc *ExchangeRateCreate) SaveX(ctx context.Context) *ExchangeRate {
+	v, err := erc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (erc *ExchangeRateCreate) Exec(ctx context.Context) error {
+	_, err := erc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (erc *ExchangeRateCreate) ExecX(ctx context.Context) {
+	if err := erc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (erc *ExchangeRateCreate) defaults() {
+	if _, ok := erc.mutation.Spread(); !ok {
+		v := exchangerate.DefaultSpread()
+		erc.mutation.SetSpread(v)
+	}
+	if _, ok := erc.mutation.CreatedAt(); !ok {
+		v := exchangerate.DefaultCreatedAt()
+		erc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (erc *ExchangeRateCreate) check() error {
+	if _, ok := erc.mutation.BaseCurrency(); !ok {
+		return &ValidationError{Name: "base_currency", err: errors.New(`ent: missing required field "ExchangeRate.base_currency"`)}
+	}
+	if v, ok := erc.mutation.BaseCurrency(); ok {
+		if err := exchangerate.BaseCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "base_currency", err: fmt.Errorf(`ent: validator failed for field "ExchangeRate.base_currency": %w`, err)}
+		}
+	}
+	if _, ok := erc.mutation.QuoteCurrency(); !ok {
+		return &ValidationError{Name: "quote_currency", err: errors.New(`ent: missing required field "ExchangeRate.quote_currency"`)}
+	}
+	if v, ok := erc.mutation.QuoteCurrency(); ok {
+		if err := exchangerate.QuoteCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "quote_currency", err: fmt.Errorf(`ent: validator failed for field "ExchangeRate.quote_currency": %w`, err)}
+		}
+	}
+	if _, ok := erc.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "ExchangeRate.rate"`)}
+	}
+	if _, ok := erc.mutation.Spread(); !ok {
+		return &ValidationError{Name: "spread", err: errors.New(`ent: missing required field "ExchangeRate.spread"`)}
+	}
+	if _, ok := erc.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ent: missing required field "ExchangeRate.valid_from"`)}
+	}
+	if _, ok := erc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExchangeRate.created_at"`)}
+	}
+	return nil
+}
+
+func (erc *ExchangeRateCreate) sqlSave(ctx context.Context) (*ExchangeRate, error) {
+	if err := erc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := erc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, erc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	erc.mutation.id = &_node.ID
+	erc.mutation.done = true
+	return _node, nil
+}
+
+func (erc *ExchangeRateCreate) createSpec() (*ExchangeRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExchangeRate{config: erc.config}
+		_spec = sqlgraph.NewCreateSpec(exchangerate.Table, sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = erc.conflict
+	if id, ok := erc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := erc.mutation.BaseCurrency(); ok {
+		_spec.SetField(exchangerate.FieldBaseCurrency, field.TypeString, value)
+		_node.BaseCurrency = value
+	}
+	if value, ok := erc.mutation.QuoteCurrency(); ok {
+		_spec.SetField(exchangerate.FieldQuoteCurrency, field.TypeString, value)
+		_node.QuoteCurrency = value
+	}
+	if value, ok := erc.mutation.Rate(); ok {
+		_spec.SetField(exchangerate.FieldRate, field.TypeFloat64, value)
+		_node.Rate = value
+	}
+	if value, ok := erc.mutation.Spread(); ok {
+		_spec.SetField(exchangerate.FieldSpread, field.TypeFloat64, value)
+		_node.Spread = value
+	}
+	if value, ok := erc.mutation.ValidFrom(); ok {
+		_spec.SetField(exchangerate.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := erc.mutation.ValidTo(); ok {
+		_spec.SetField(exchangerate.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := erc.mutation.CreatedAt(); ok {
+		_spec.SetField(exchangerate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := erc.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangerate.TransactionsTable,
+			Columns: []string{exchangerate.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExchangeRate.Create().
+//		SetBaseCurrency(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExchangeRateUpsert) {
+//			SetBaseCurrency(v+v).
+//		}).
+//		Exec(ctx)
+func (erc *ExchangeRateCreate) OnConflict(opts ...sql.ConflictOption) *ExchangeRateUpsertOne {
+	erc.conflict = opts
+	return &ExchangeRateUpsertOne{
+		create: erc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (erc *ExchangeRateCreate) OnConflictColumns(columns ...string) *ExchangeRateUpsertOne {
+	erc.conflict = append(erc.conflict, sql.ConflictColumns(columns...))
+	return &ExchangeRateUpsertOne{
+		create: erc,
+	}
+}
+
+type (
+	// ExchangeRateUpsertOne is the builder for "upsert"-ing
+	//  one ExchangeRate node.
+	ExchangeRateUpsertOne struct {
+		create *ExchangeRateCreate
+	}
+
+	// ExchangeRateUpsert is the "OnConflict" setter.
+	ExchangeRateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetValidTo sets the "valid_to" field.
+func (u *ExchangeRateUpsert) SetValidTo(v time.Time) *ExchangeRateUpsert {
+	u.Set(exchangerate.FieldValidTo, v)
+	return u
+}
+
+// UpdateValidTo sets the "valid_to" field to the value that was provided on create.
+func (u *ExchangeRateUpsert) UpdateValidTo() *ExchangeRateUpsert {
+	u.SetExcluded(exchangerate.FieldValidTo)
+	return u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (u *ExchangeRateUpsert) ClearValidTo() *ExchangeRateUpsert {
+	u.SetNull(exchangerate.FieldValidTo)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exchangerate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExchangeRateUpsertOne) UpdateNewValues() *ExchangeRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(exchangerate.FieldID)
+		}
+		if _, exists := u.create.mutation.BaseCurrency(); exists {
+			s.SetIgnore(exchangerate.FieldBaseCurrency)
+		}
+		if _, exists := u.create.mutation.QuoteCurrency(); exists {
+			s.SetIgnore(exchangerate.FieldQuoteCurrency)
+		}
+		if _, exists := u.create.mutation.Rate(); exists {
+			s.SetIgnore(exchangerate.FieldRate)
+		}
+		if _, exists := u.create.mutation.Spread(); exists {
+			s.SetIgnore(exchangerate.FieldSpread)
+		}
+		if _, exists := u.create.mutation.ValidFrom(); exists {
+			s.SetIgnore(exchangerate.FieldValidFrom)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(exchangerate.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExchangeRateUpsertOne) Ignore() *ExchangeRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExchangeRateUpsertOne) DoNothing() *ExchangeRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExchangeRateCreate.OnConflict
+// documentation for more info.
+func (u *ExchangeRateUpsertOne) Update(set func(*ExchangeRateUpsert)) *ExchangeRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExchangeRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (u *ExchangeRateUpsertOne) SetValidTo(v time.Time) *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetValidTo(v)
+	})
+}
+
+// UpdateValidTo sets the "valid_to" field to the value that was provided on create.
+func (u *ExchangeRateUpsertOne) UpdateValidTo() *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateValidTo()
+	})
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (u *ExchangeRateUpsertOne) ClearValidTo() *ExchangeRateUpsertOne {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.ClearValidTo()
+	})
+}
+
+// Exec executes the query.
+func (u *ExchangeRateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExchangeRateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExchangeRateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExchangeRateUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExchangeRateUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExchangeRateCreateBulk is the builder for creating many ExchangeRate entities in bulk.
+type ExchangeRateCreateBulk struct {
+	config
+	err      error
+	builders []*ExchangeRateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExchangeRate entities in the database.
+func (ercb *ExchangeRateCreateBulk) Save(ctx context.Context) ([]*ExchangeRate, error) {
+	if ercb.err != nil {
+		return nil, ercb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ercb.builders))
+	nodes := make([]*ExchangeRate, len(ercb.builders))
+	mutators := make([]Mutator, len(ercb.builders))
+	for i := range ercb.builders {
+		func(i int, root context.Context) {
+			builder := ercb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExchangeRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ercb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ercb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ercb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ercb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ercb *ExchangeRateCreateBulk) SaveX(ctx context.Context) []*ExchangeRate {
+	v, err := ercb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ercb *ExchangeRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := ercb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ercb *ExchangeRateCreateBulk) ExecX(ctx context.Context) {
+	if err := ercb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExchangeRate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExchangeRateUpsert) {
+//			SetBaseCurrency(v+v).
+//		}).
+//		Exec(ctx)
+func (ercb *ExchangeRateCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExchangeRateUpsertBulk {
+	ercb.conflict = opts
+	return &ExchangeRateUpsertBulk{
+		create: ercb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ercb *ExchangeRateCreateBulk) OnConflictColumns(columns ...string) *ExchangeRateUpsertBulk {
+	ercb.conflict = append(ercb.conflict, sql.ConflictColumns(columns...))
+	return &ExchangeRateUpsertBulk{
+		create: ercb,
+	}
+}
+
+// ExchangeRateUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExchangeRate nodes.
+type ExchangeRateUpsertBulk struct {
+	create *ExchangeRateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exchangerate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExchangeRateUpsertBulk) UpdateNewValues() *ExchangeRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(exchangerate.FieldID)
+			}
+			if _, exists := b.mutation.BaseCurrency(); exists {
+				s.SetIgnore(exchangerate.FieldBaseCurrency)
+			}
+			if _, exists := b.mutation.QuoteCurrency(); exists {
+				s.SetIgnore(exchangerate.FieldQuoteCurrency)
+			}
+			if _, exists := b.mutation.Rate(); exists {
+				s.SetIgnore(exchangerate.FieldRate)
+			}
+			if _, exists := b.mutation.Spread(); exists {
+				s.SetIgnore(exchangerate.FieldSpread)
+			}
+			if _, exists := b.mutation.ValidFrom(); exists {
+				s.SetIgnore(exchangerate.FieldValidFrom)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(exchangerate.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExchangeRate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExchangeRateUpsertBulk) Ignore() *ExchangeRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExchangeRateUpsertBulk) DoNothing() *ExchangeRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExchangeRateCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExchangeRateUpsertBulk) Update(set func(*ExchangeRateUpsert)) *ExchangeRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExchangeRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (u *ExchangeRateUpsertBulk) SetValidTo(v time.Time) *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.SetValidTo(v)
+	})
+}
+
+// UpdateValidTo sets the "valid_to" field to the value that was provided on create.
+func (u *ExchangeRateUpsertBulk) UpdateValidTo() *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.UpdateValidTo()
+	})
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (u *ExchangeRateUpsertBulk) ClearValidTo() *ExchangeRateUpsertBulk {
+	return u.Update(func(s *ExchangeRateUpsert) {
+		s.ClearValidTo()
+	})
+}
+
+// Exec executes the query.
+func (u *ExchangeRateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExchangeRateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExchangeRateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExchangeRateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
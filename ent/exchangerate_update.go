@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/exchangerate"
+	"accounting/ent/predicate"
+	"accounting/ent/transaction"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExchangeRateUpdate is the builder for updating ExchangeRate entities.
+type ExchangeRateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExchangeRateMutation
+}
+
+// Where appends a list predicates to the ExchangeRateUpdate builder.
+func (eru *ExchangeRateUpdate) Where(ps ...predicate.ExchangeRate) *ExchangeRateUpdate {
+	eru.mutation.Where(ps...)
+	return eru
+}
+
+// SetValidTo sets the "valid_to" field.
+func (eru *ExchangeRateUpdate) SetValidTo(t time.Time) *ExchangeRateUpdate {
+	eru.mutation.SetValidTo(t)
+	return eru
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (eru *ExchangeRateUpdate) SetNillableValidTo(t *time.Time) *ExchangeRateUpdate {
+	if t != nil {
+		eru.SetValidTo(*t)
+	}
+	return eru
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (eru *ExchangeRateUpdate) ClearValidTo() *ExchangeRateUpdate {
+	eru.mutation.ClearValidTo()
+	return eru
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (eru *ExchangeRateUpdate) AddTransactionIDs(ids ...string) *ExchangeRateUpdate {
+	eru.mutation.AddTransactionIDs(ids...)
+	return eru
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (eru *ExchangeRateUpdate) AddTransactions(t ...*Transaction) *ExchangeRateUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return eru.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (eru *ExchangeRateUpdate) Mutation() *ExchangeRateMutation {
+	return eru.mutation
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (eru *ExchangeRateUpdate) ClearTransactions() *ExchangeRateUpdate {
+	eru.mutation.ClearTransactions()
+	return eru
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (eru *ExchangeRateUpdate) RemoveTransactionIDs(ids ...string) *ExchangeRateUpdate {
+	eru.mutation.RemoveTransactionIDs(ids...)
+	return eru
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (eru *ExchangeRateUpdate) RemoveTransactions(t ...*Transaction) *ExchangeRateUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return eru.RemoveTransactionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eru *ExchangeRateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eru.sqlSave, eru.mutation, eru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eru *ExchangeRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := eru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eru *ExchangeRateUpdate) Exec(ctx context.Context) error {
+	_, err := eru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eru *ExchangeRateUpdate) ExecX(ctx context.Context) {
+	if err := eru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (eru *ExchangeRateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(exchangerate.Table, exchangerate.Columns, sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt))
+	if ps := eru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eru.mutation.ValidTo(); ok {
+		_spec.SetField(exchangerate.FieldValidTo, field.TypeTime, value)
+	}
+	if eru.mutation.ValidToCleared() {
+		_spec.ClearField(exchangerate.FieldValidTo, field.TypeTime)
+	}
+	if eru.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangerate.TransactionsTable,
+			Columns: []string{exchangerate.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eru.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !eru.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangerate.TransactionsTable,
+			Columns: []string{exchangerate.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eru.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangerate.TransactionsTable,
+			Columns: []string{exchangerate.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangerate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eru.mutation.done = true
+	return n, nil
+}
+
+// ExchangeRateUpdateOne is the builder for updating a single ExchangeRate entity.
+type ExchangeRateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExchangeRateMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (eruo *ExchangeRateUpdateOne) SetValidTo(t time.Time) *ExchangeRateUpdateOne {
+	eruo.mutation.SetValidTo(t)
+	return eruo
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (eruo *ExchangeRateUpdateOne) SetNillableValidTo(t *time.Time) *ExchangeRateUpdateOne {
+	if t != nil {
+		eruo.SetValidTo(*t)
+	}
+	return eruo
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (eruo *ExchangeRateUpdateOne) ClearValidTo() *ExchangeRateUpdateOne {
+	eruo.mutation.ClearValidTo()
+	return eruo
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (eruo *ExchangeRateUpdateOne) AddTransactionIDs(ids ...string) *ExchangeRateUpdateOne {
+	eruo.mutation.AddTransactionIDs(ids...)
+	return eruo
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (eruo *ExchangeRateUpdateOne) AddTransactions(t ...*Transaction) *ExchangeRateUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return eruo.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (eruo *ExchangeRateUpdateOne) Mutation() *ExchangeRateMutation {
+	return eruo.mutation
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (eruo *ExchangeRateUpdateOne) ClearTransactions() *ExchangeRateUpdateOne {
+	eruo.mutation.ClearTransactions()
+	return eruo
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (eruo *ExchangeRateUpdateOne) RemoveTransactionIDs(ids ...string) *ExchangeRateUpdateOne {
+	eruo.mutation.RemoveTransactionIDs(ids...)
+	return eruo
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (eruo *ExchangeRateUpdateOne) RemoveTransactions(t ...*Transaction) *ExchangeRateUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return eruo.RemoveTransactionIDs(ids...)
+}
+
+// Where appends a list predicates to the ExchangeRateUpdate builder.
+func (eruo *ExchangeRateUpdateOne) Where(ps ...predicate.ExchangeRate) *ExchangeRateUpdateOne {
+	eruo.mutation.Where(ps...)
+	return eruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eruo *ExchangeRateUpdateOne) Select(field string, fields ...string) *ExchangeRateUpdateOne {
+	eruo.fields = append([]string{field}, fields...)
+	return eruo
+}
+
+// Save executes the query and returns the updated ExchangeRate entity.
+func (eruo *ExchangeRateUpdateOne) Save(ctx context.Context) (*ExchangeRate, error) {
+	return withHooks(ctx, eruo.sqlSave, eruo.mutation, eruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eruo *ExchangeRateUpdateOne) SaveX(ctx context.Context) *ExchangeRate {
+	node, err := eruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eruo *ExchangeRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := eruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eruo *ExchangeRateUpdateOne) ExecX(ctx context.Context) {
+	if err := eruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (eruo *ExchangeRateUpdateOne) sqlSave(ctx context.Context) (_node *ExchangeRate, err error) {
+	_spec := sqlgraph.NewUpdateSpec(exchangerate.Table, exchangerate.Columns, sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt))
+	id, ok := eruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExchangeRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exchangerate.FieldID)
+		for _, f := range fields {
+			if !exchangerate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exchangerate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eruo.mutation.ValidTo(); ok {
+		_spec.SetField(exchangerate.FieldValidTo, field.TypeTime, value)
+	}
+	if eruo.mutation.ValidToCleared() {
+		_spec.ClearField(exchangerate.FieldValidTo, field.TypeTime)
+	}
+	if eruo.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangerate.TransactionsTable,
+			Columns: []string{exchangerate.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eruo.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !eruo.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangerate.TransactionsTable,
+			Columns: []string{exchangerate.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eruo.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangerate.TransactionsTable,
+			Columns: []string{exchangerate.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ExchangeRate{config: eruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangerate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eruo.mutation.done = true
+	return _node, nil
+}
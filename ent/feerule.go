@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/feerule"
+	"accounting/fee"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// FeeRule is the model entity for the FeeRule schema.
+type FeeRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Human-readable name of the rule
+	Name string `json:"name,omitempty"`
+	// Type of the transactions the fee is charged on
+	TransactionType feerule.TransactionType `json:"transaction_type,omitempty"`
+	// Currency the rule is limited to; empty matches every currency
+	Currency *string `json:"currency,omitempty"`
+	// User tier the rule is limited to; empty matches every tier
+	Tier *string `json:"tier,omitempty"`
+	// How the fee is computed: fixed, percentage, tiered
+	Kind feerule.Kind `json:"kind,omitempty"`
+	// Fixed fee; for percentage rules it is charged on top of the percentage
+	FixedAmount decimal.Decimal `json:"fixed_amount,omitempty"`
+	// Percentage of the amount charged by percentage rules, e.g. 1.5 for 1.5%
+	Percentage decimal.Decimal `json:"percentage,omitempty"`
+	// Bands of tiered rules, ordered by their upper bound
+	Tiers []fee.Tier `json:"tiers,omitempty"`
+	// Lower cap of the fee
+	MinFee *decimal.Decimal `json:"min_fee,omitempty"`
+	// Upper cap of the fee
+	MaxFee *decimal.Decimal `json:"max_fee,omitempty"`
+	// Rule applied when several match; the highest priority wins
+	Priority int `json:"priority,omitempty"`
+	// Whether the rule is applied
+	Enabled bool `json:"enabled,omitempty"`
+	// Time of the rule creation
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Time of the last rule update
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FeeRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case feerule.FieldMinFee, feerule.FieldMaxFee:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case feerule.FieldTiers:
+			values[i] = new([]byte)
+		case feerule.FieldFixedAmount, feerule.FieldPercentage:
+			values[i] = new(decimal.Decimal)
+		case feerule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case feerule.FieldID, feerule.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case feerule.FieldName, feerule.FieldTransactionType, feerule.FieldCurrency, feerule.FieldTier, feerule.FieldKind:
+			values[i] = new(sql.NullString)
+		case feerule.FieldCreatedAt, feerule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FeeRule fields.
+func (fr *FeeRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case feerule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			fr.ID = int(value.Int64)
+		case feerule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				fr.Name = value.String
+			}
+		case feerule.FieldTransactionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_type", values[i])
+			} else if value.Valid {
+				fr.TransactionType = feerule.TransactionType(value.String)
+			}
+		case feerule.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				fr.Currency = new(string)
+				*fr.Currency = value.String
+			}
+		case feerule.FieldTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tier", values[i])
+			} else if value.Valid {
+				fr.Tier = new(string)
+				*fr.Tier = value.String
+			}
+		case feerule.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				fr.Kind = feerule.Kind(value.String)
+			}
+		case feerule.FieldFixedAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field fixed_amount", values[i])
+			} else if value != nil {
+				fr.FixedAmount = *value
+			}
+		case feerule.FieldPercentage:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field percentage", values[i])
+			} else if value != nil {
+				fr.Percentage = *value
+			}
+		case feerule.FieldTiers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tiers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &fr.Tiers); err != nil {
+					return fmt.Errorf("unmarshal field tiers: %w", err)
+				}
+			}
+		case feerule.FieldMinFee:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field min_fee", values[i])
+			} else if value.Valid {
+				fr.MinFee = new(decimal.Decimal)
+				*fr.MinFee = *value.S.(*decimal.Decimal)
+			}
+		case feerule.FieldMaxFee:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field max_fee", values[i])
+			} else if value.Valid {
+				fr.MaxFee = new(decimal.Decimal)
+				*fr.MaxFee = *value.S.(*decimal.Decimal)
+			}
+		case feerule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				fr.Priority = int(value.Int64)
+			}
+		case feerule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				fr.Enabled = value.Bool
+			}
+		case feerule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fr.CreatedAt = value.Time
+			}
+		case feerule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				fr.UpdatedAt = value.Time
+			}
+		default:
+			fr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FeeRule.
+// This includes values selected through modifiers, order, etc.
+func (fr *FeeRule) Value(name string) (ent.Value, error) {
+	return fr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FeeRule.
+// Note that you need to call FeeRule.Unwrap() before calling this method if this FeeRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fr *FeeRule) Update() *FeeRuleUpdateOne {
+	return NewFeeRuleClient(fr.config).UpdateOne(fr)
+}
+
+// Unwrap unwraps the FeeRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fr *FeeRule) Unwrap() *FeeRule {
+	_tx, ok := fr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FeeRule is not a transactional entity")
+	}
+	fr.config.driver = _tx.drv
+	return fr
+}
+
+// String implements the fmt.Stringer.
+func (fr *FeeRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("FeeRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fr.ID))
+	builder.WriteString("name=")
+	builder.WriteString(fr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("transaction_type=")
+	builder.WriteString(fmt.Sprintf("%v", fr.TransactionType))
+	builder.WriteString(", ")
+	if v := fr.Currency; v != nil {
+		builder.WriteString("currency=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := fr.Tier; v != nil {
+		builder.WriteString("tier=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", fr.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("fixed_amount=")
+	builder.WriteString(fmt.Sprintf("%v", fr.FixedAmount))
+	builder.WriteString(", ")
+	builder.WriteString("percentage=")
+	builder.WriteString(fmt.Sprintf("%v", fr.Percentage))
+	builder.WriteString(", ")
+	builder.WriteString("tiers=")
+	builder.WriteString(fmt.Sprintf("%v", fr.Tiers))
+	builder.WriteString(", ")
+	if v := fr.MinFee; v != nil {
+		builder.WriteString("min_fee=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := fr.MaxFee; v != nil {
+		builder.WriteString("max_fee=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", fr.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", fr.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FeeRules is a parsable slice of FeeRule.
+type FeeRules []*FeeRule
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package feerule
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the feerule type in the database.
+	Label = "fee_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldTransactionType holds the string denoting the transaction_type field in the database.
+	FieldTransactionType = "transaction_type"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldTier holds the string denoting the tier field in the database.
+	FieldTier = "tier"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldFixedAmount holds the string denoting the fixed_amount field in the database.
+	FieldFixedAmount = "fixed_amount"
+	// FieldPercentage holds the string denoting the percentage field in the database.
+	FieldPercentage = "percentage"
+	// FieldTiers holds the string denoting the tiers field in the database.
+	FieldTiers = "tiers"
+	// FieldMinFee holds the string denoting the min_fee field in the database.
+	FieldMinFee = "min_fee"
+	// FieldMaxFee holds the string denoting the max_fee field in the database.
+	FieldMaxFee = "max_fee"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the feerule in the database.
+	Table = "fee_rules"
+)
+
+// Columns holds all SQL columns for feerule fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldTransactionType,
+	FieldCurrency,
+	FieldTier,
+	FieldKind,
+	FieldFixedAmount,
+	FieldPercentage,
+	FieldTiers,
+	FieldMinFee,
+	FieldMaxFee,
+	FieldPriority,
+	FieldEnabled,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultFixedAmount holds the default value on creation for the "fixed_amount" field.
+	DefaultFixedAmount func() decimal.Decimal
+	// DefaultPercentage holds the default value on creation for the "percentage" field.
+	DefaultPercentage func() decimal.Decimal
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// TransactionType defines the type for the "transaction_type" enum field.
+type TransactionType string
+
+// TransactionType values.
+const (
+	TransactionTypeDeposit     TransactionType = "deposit"
+	TransactionTypeWithdrawal  TransactionType = "withdrawal"
+	TransactionTypeTransferOut TransactionType = "transfer_out"
+	TransactionTypeExchangeOut TransactionType = "exchange_out"
+)
+
+func (tt TransactionType) String() string {
+	return string(tt)
+}
+
+// TransactionTypeValidator is a validator for the "transaction_type" field enum values. It is called by the builders before save.
+func TransactionTypeValidator(tt TransactionType) error {
+	switch tt {
+	case TransactionTypeDeposit, TransactionTypeWithdrawal, TransactionTypeTransferOut, TransactionTypeExchangeOut:
+		return nil
+	default:
+		return fmt.Errorf("feerule: invalid enum value for transaction_type field: %q", tt)
+	}
+}
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindFixed      Kind = "fixed"
+	KindPercentage Kind = "percentage"
+	KindTiered     Kind = "tiered"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindFixed, KindPercentage, KindTiered:
+		return nil
+	default:
+		return fmt.Errorf("feerule: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the FeeRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByTransactionType orders the results by the transaction_type field.
+func ByTransactionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionType, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByTier orders the results by the tier field.
+func ByTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTier, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByFixedAmount orders the results by the fixed_amount field.
+func ByFixedAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFixedAmount, opts...).ToFunc()
+}
+
+// ByPercentage orders the results by the percentage field.
+func ByPercentage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPercentage, opts...).ToFunc()
+}
+
+// ByMinFee orders the results by the min_fee field.
+func ByMinFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinFee, opts...).ToFunc()
+}
+
+// ByMaxFee orders the results by the max_fee field.
+func ByMaxFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxFee, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
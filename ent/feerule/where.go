@@ -0,0 +1,701 @@
+// Code generated by ent, DO NOT EDIT.
+
+package feerule
+
+import (
+	"accounting/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldName, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Tier applies equality check predicate on the "tier" field. It's identical to TierEQ.
+func Tier(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldTier, v))
+}
+
+// FixedAmount applies equality check predicate on the "fixed_amount" field. It's identical to FixedAmountEQ.
+func FixedAmount(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldFixedAmount, v))
+}
+
+// Percentage applies equality check predicate on the "percentage" field. It's identical to PercentageEQ.
+func Percentage(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldPercentage, v))
+}
+
+// MinFee applies equality check predicate on the "min_fee" field. It's identical to MinFeeEQ.
+func MinFee(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldMinFee, v))
+}
+
+// MaxFee applies equality check predicate on the "max_fee" field. It's identical to MaxFeeEQ.
+func MaxFee(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldMaxFee, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// TransactionTypeEQ applies the EQ predicate on the "transaction_type" field.
+func TransactionTypeEQ(v TransactionType) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldTransactionType, v))
+}
+
+// TransactionTypeNEQ applies the NEQ predicate on the "transaction_type" field.
+func TransactionTypeNEQ(v TransactionType) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldTransactionType, v))
+}
+
+// TransactionTypeIn applies the In predicate on the "transaction_type" field.
+func TransactionTypeIn(vs ...TransactionType) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIn(FieldTransactionType, vs...))
+}
+
+// TransactionTypeNotIn applies the NotIn predicate on the "transaction_type" field.
+func TransactionTypeNotIn(vs ...TransactionType) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotIn(FieldTransactionType, vs...))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// TierEQ applies the EQ predicate on the "tier" field.
+func TierEQ(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldTier, v))
+}
+
+// TierNEQ applies the NEQ predicate on the "tier" field.
+func TierNEQ(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldTier, v))
+}
+
+// TierIn applies the In predicate on the "tier" field.
+func TierIn(vs ...string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIn(FieldTier, vs...))
+}
+
+// TierNotIn applies the NotIn predicate on the "tier" field.
+func TierNotIn(vs ...string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotIn(FieldTier, vs...))
+}
+
+// TierGT applies the GT predicate on the "tier" field.
+func TierGT(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGT(FieldTier, v))
+}
+
+// TierGTE applies the GTE predicate on the "tier" field.
+func TierGTE(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGTE(FieldTier, v))
+}
+
+// TierLT applies the LT predicate on the "tier" field.
+func TierLT(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLT(FieldTier, v))
+}
+
+// TierLTE applies the LTE predicate on the "tier" field.
+func TierLTE(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLTE(FieldTier, v))
+}
+
+// TierContains applies the Contains predicate on the "tier" field.
+func TierContains(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldContains(FieldTier, v))
+}
+
+// TierHasPrefix applies the HasPrefix predicate on the "tier" field.
+func TierHasPrefix(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldHasPrefix(FieldTier, v))
+}
+
+// TierHasSuffix applies the HasSuffix predicate on the "tier" field.
+func TierHasSuffix(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldHasSuffix(FieldTier, v))
+}
+
+// TierIsNil applies the IsNil predicate on the "tier" field.
+func TierIsNil() predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIsNull(FieldTier))
+}
+
+// TierNotNil applies the NotNil predicate on the "tier" field.
+func TierNotNil() predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotNull(FieldTier))
+}
+
+// TierEqualFold applies the EqualFold predicate on the "tier" field.
+func TierEqualFold(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEqualFold(FieldTier, v))
+}
+
+// TierContainsFold applies the ContainsFold predicate on the "tier" field.
+func TierContainsFold(v string) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldContainsFold(FieldTier, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// FixedAmountEQ applies the EQ predicate on the "fixed_amount" field.
+func FixedAmountEQ(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldFixedAmount, v))
+}
+
+// FixedAmountNEQ applies the NEQ predicate on the "fixed_amount" field.
+func FixedAmountNEQ(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldFixedAmount, v))
+}
+
+// FixedAmountIn applies the In predicate on the "fixed_amount" field.
+func FixedAmountIn(vs ...decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIn(FieldFixedAmount, vs...))
+}
+
+// FixedAmountNotIn applies the NotIn predicate on the "fixed_amount" field.
+func FixedAmountNotIn(vs ...decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotIn(FieldFixedAmount, vs...))
+}
+
+// FixedAmountGT applies the GT predicate on the "fixed_amount" field.
+func FixedAmountGT(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGT(FieldFixedAmount, v))
+}
+
+// FixedAmountGTE applies the GTE predicate on the "fixed_amount" field.
+func FixedAmountGTE(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGTE(FieldFixedAmount, v))
+}
+
+// FixedAmountLT applies the LT predicate on the "fixed_amount" field.
+func FixedAmountLT(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLT(FieldFixedAmount, v))
+}
+
+// FixedAmountLTE applies the LTE predicate on the "fixed_amount" field.
+func FixedAmountLTE(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLTE(FieldFixedAmount, v))
+}
+
+// PercentageEQ applies the EQ predicate on the "percentage" field.
+func PercentageEQ(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldPercentage, v))
+}
+
+// PercentageNEQ applies the NEQ predicate on the "percentage" field.
+func PercentageNEQ(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldPercentage, v))
+}
+
+// PercentageIn applies the In predicate on the "percentage" field.
+func PercentageIn(vs ...decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIn(FieldPercentage, vs...))
+}
+
+// PercentageNotIn applies the NotIn predicate on the "percentage" field.
+func PercentageNotIn(vs ...decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotIn(FieldPercentage, vs...))
+}
+
+// PercentageGT applies the GT predicate on the "percentage" field.
+func PercentageGT(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGT(FieldPercentage, v))
+}
+
+// PercentageGTE applies the GTE predicate on the "percentage" field.
+func PercentageGTE(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGTE(FieldPercentage, v))
+}
+
+// PercentageLT applies the LT predicate on the "percentage" field.
+func PercentageLT(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLT(FieldPercentage, v))
+}
+
+// PercentageLTE applies the LTE predicate on the "percentage" field.
+func PercentageLTE(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLTE(FieldPercentage, v))
+}
+
+// TiersIsNil applies the IsNil predicate on the "tiers" field.
+func TiersIsNil() predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIsNull(FieldTiers))
+}
+
+// TiersNotNil applies the NotNil predicate on the "tiers" field.
+func TiersNotNil() predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotNull(FieldTiers))
+}
+
+// MinFeeEQ applies the EQ predicate on the "min_fee" field.
+func MinFeeEQ(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldMinFee, v))
+}
+
+// MinFeeNEQ applies the NEQ predicate on the "min_fee" field.
+func MinFeeNEQ(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldMinFee, v))
+}
+
+// MinFeeIn applies the In predicate on the "min_fee" field.
+func MinFeeIn(vs ...decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIn(FieldMinFee, vs...))
+}
+
+// MinFeeNotIn applies the NotIn predicate on the "min_fee" field.
+func MinFeeNotIn(vs ...decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotIn(FieldMinFee, vs...))
+}
+
+// MinFeeGT applies the GT predicate on the "min_fee" field.
+func MinFeeGT(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGT(FieldMinFee, v))
+}
+
+// MinFeeGTE applies the GTE predicate on the "min_fee" field.
+func MinFeeGTE(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGTE(FieldMinFee, v))
+}
+
+// MinFeeLT applies the LT predicate on the "min_fee" field.
+func MinFeeLT(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLT(FieldMinFee, v))
+}
+
+// MinFeeLTE applies the LTE predicate on the "min_fee" field.
+func MinFeeLTE(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLTE(FieldMinFee, v))
+}
+
+// MinFeeIsNil applies the IsNil predicate on the "min_fee" field.
+func MinFeeIsNil() predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIsNull(FieldMinFee))
+}
+
+// MinFeeNotNil applies the NotNil predicate on the "min_fee" field.
+func MinFeeNotNil() predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotNull(FieldMinFee))
+}
+
+// MaxFeeEQ applies the EQ predicate on the "max_fee" field.
+func MaxFeeEQ(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldMaxFee, v))
+}
+
+// MaxFeeNEQ applies the NEQ predicate on the "max_fee" field.
+func MaxFeeNEQ(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldMaxFee, v))
+}
+
+// MaxFeeIn applies the In predicate on the "max_fee" field.
+func MaxFeeIn(vs ...decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIn(FieldMaxFee, vs...))
+}
+
+// MaxFeeNotIn applies the NotIn predicate on the "max_fee" field.
+func MaxFeeNotIn(vs ...decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotIn(FieldMaxFee, vs...))
+}
+
+// MaxFeeGT applies the GT predicate on the "max_fee" field.
+func MaxFeeGT(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGT(FieldMaxFee, v))
+}
+
+// MaxFeeGTE applies the GTE predicate on the "max_fee" field.
+func MaxFeeGTE(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGTE(FieldMaxFee, v))
+}
+
+// MaxFeeLT applies the LT predicate on the "max_fee" field.
+func MaxFeeLT(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLT(FieldMaxFee, v))
+}
+
+// MaxFeeLTE applies the LTE predicate on the "max_fee" field.
+func MaxFeeLTE(v decimal.Decimal) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLTE(FieldMaxFee, v))
+}
+
+// MaxFeeIsNil applies the IsNil predicate on the "max_fee" field.
+func MaxFeeIsNil() predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIsNull(FieldMaxFee))
+}
+
+// MaxFeeNotNil applies the NotNil predicate on the "max_fee" field.
+func MaxFeeNotNil() predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotNull(FieldMaxFee))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLTE(FieldPriority, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FeeRule {
+	return predicate.FeeRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FeeRule) predicate.FeeRule {
+	return predicate.FeeRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FeeRule) predicate.FeeRule {
+	return predicate.FeeRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FeeRule) predicate.FeeRule {
+	return predicate.FeeRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,1433 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/feerule"
+	"accounting/fee"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// FeeRuleCreate is the builder for creating a FeeRule entity.
+type FeeRuleCreate struct {
+	config
+	mutation *FeeRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (frc *FeeRuleCreate) SetName(s string) *FeeRuleCreate {
+	frc.mutation.SetName(s)
+	return frc
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (frc *FeeRuleCreate) SetTransactionType(ft feerule.TransactionType) *FeeRuleCreate {
+	frc.mutation.SetTransactionType(ft)
+	return frc
+}
+
+// SetCurrency sets the "currency" field.
+func (frc *FeeRuleCreate) SetCurrency(s string) *FeeRuleCreate {
+	frc.mutation.SetCurrency(s)
+	return frc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (frc *FeeRuleCreate) SetNillableCurrency(s *string) *FeeRuleCreate {
+	if s != nil {
+		frc.SetCurrency(*s)
+	}
+	return frc
+}
+
+// SetTier sets the "tier" field.
+func (frc *FeeRuleCreate) SetTier(s string) *FeeRuleCreate {
+	frc.mutation.SetTier(s)
+	return frc
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (frc *FeeRuleCreate) SetNillableTier(s *string) *FeeRuleCreate {
+	if s != nil {
+		frc.SetTier(*s)
+	}
+	return frc
+}
+
+// SetKind sets the "kind" field.
+func (frc *FeeRuleCreate) SetKind(f feerule.Kind) *FeeRuleCreate {
+	frc.mutation.SetKind(f)
+	return frc
+}
+
+// SetFixedAmount sets the "fixed_amount" field.
+func (frc *FeeRuleCreate) SetFixedAmount(d decimal.Decimal) *FeeRuleCreate {
+	frc.mutation.SetFixedAmount(d)
+	return frc
+}
+
+// SetNillableFixedAmount sets the "fixed_amount" field if the given value is not nil.
+func (frc *FeeRuleCreate) SetNillableFixedAmount(d *decimal.Decimal) *FeeRuleCreate {
+	if d != nil {
+		frc.SetFixedAmount(*d)
+	}
+	return frc
+}
+
+// SetPercentage sets the "percentage" field.
+func (frc *FeeRuleCreate) SetPercentage(d decimal.Decimal) *FeeRuleCreate {
+	frc.mutation.SetPercentage(d)
+	return frc
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (frc *FeeRuleCreate) SetNillablePercentage(d *decimal.Decimal) *FeeRuleCreate {
+	if d != nil {
+		frc.SetPercentage(*d)
+	}
+	return frc
+}
+
+// SetTiers sets the "tiers" field.
+func (frc *FeeRuleCreate) SetTiers(f []fee.Tier) *FeeRuleCreate {
+	frc.mutation.SetTiers(f)
+	return frc
+}
+
+// SetMinFee sets the "min_fee" field.
+func (frc *FeeRuleCreate) SetMinFee(d decimal.Decimal) *FeeRuleCreate {
+	frc.mutation.SetMinFee(d)
+	return frc
+}
+
+// SetNillableMinFee sets the "min_fee" field if the given value is not nil.
+func (frc *FeeRuleCreate) SetNillableMinFee(d *decimal.Decimal) *FeeRuleCreate {
+	if d != nil {
+		frc.SetMinFee(*d)
+	}
+	return frc
+}
+
+// SetMaxFee sets the "max_fee" field.
+func (frc *FeeRuleCreate) SetMaxFee(d decimal.Decimal) *FeeRuleCreate {
+	frc.mutation.SetMaxFee(d)
+	return frc
+}
+
+// SetNillableMaxFee sets the "max_fee" field if the given value is not nil.
+func (frc *FeeRuleCreate) SetNillableMaxFee(d *decimal.Decimal) *FeeRuleCreate {
+	if d != nil {
+		frc.SetMaxFee(*d)
+	}
+	return frc
+}
+
+// SetPriority sets the "priority" field.
+func (frc *FeeRuleCreate) SetPriority(i int) *FeeRuleCreate {
+	frc.mutation.SetPriority(i)
+	return frc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (frc *FeeRuleCreate) SetNillablePriority(i *int) *FeeRuleCreate {
+	if i != nil {
+		frc.SetPriority(*i)
+	}
+	return frc
+}
+
+// SetEnabled sets the "enabled" field.
+func (frc *FeeRuleCreate) SetEnabled(b bool) *FeeRuleCreate {
+	frc.mutation.SetEnabled(b)
+	return frc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (frc *FeeRuleCreate) SetNillableEnabled(b *bool) *FeeRuleCreate {
+	if b != nil {
+		frc.SetEnabled(*b)
+	}
+	return frc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (frc *FeeRuleCreate) SetCreatedAt(t time.Time) *FeeRuleCreate {
+	frc.mutation.SetCreatedAt(t)
+	return frc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (frc *FeeRuleCreate) SetNillableCreatedAt(t *time.Time) *FeeRuleCreate {
+	if t != nil {
+		frc.SetCreatedAt(*t)
+	}
+	return frc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (frc *FeeRuleCreate) SetUpdatedAt(t time.Time) *FeeRuleCreate {
+	frc.mutation.SetUpdatedAt(t)
+	return frc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (frc *FeeRuleCreate) SetNillableUpdatedAt(t *time.Time) *FeeRuleCreate {
+	if t != nil {
+		frc.SetUpdatedAt(*t)
+	}
+	return frc
+}
+
+// Mutation returns the FeeRuleMutation object of the builder.
+func (frc *FeeRuleCreate) Mutation() *FeeRuleMutation {
+	return frc.mutation
+}
+
+// Save creates the FeeRule in the database.
+func (frc *FeeRuleCreate) Save(ctx context.Context) (*FeeRule, error) {
+	frc.defaults()
+	return withHooks(ctx, frc.sqlSave, frc.mutation, frc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (frc *FeeRuleCreate) SaveX(ctx context.Context) *FeeRule {
+	v, err := frc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (frc *FeeRuleCreate) Exec(ctx context.Context) error {
+	_, err := frc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frc *FeeRuleCreate) ExecX(ctx context.Context) {
+	if err := frc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (frc *FeeRuleCreate) defaults() {
+	if _, ok := frc.mutation.FixedAmount(); !ok {
+		v := feerule.DefaultFixedAmount()
+		frc.mutation.SetFixedAmount(v)
+	}
+	if _, ok := frc.mutation.Percentage(); !ok {
+		v := feerule.DefaultPercentage()
+		frc.mutation.SetPercentage(v)
+	}
+	if _, ok := frc.mutation.Priority(); !ok {
+		v := feerule.DefaultPriority
+		frc.mutation.SetPriority(v)
+	}
+	if _, ok := frc.mutation.Enabled(); !ok {
+		v := feerule.DefaultEnabled
+		frc.mutation.SetEnabled(v)
+	}
+	if _, ok := frc.mutation.CreatedAt(); !ok {
+		v := feerule.DefaultCreatedAt()
+		frc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := frc.mutation.UpdatedAt(); !ok {
+		v := feerule.DefaultUpdatedAt()
+		frc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (frc *FeeRuleCreate) check() error {
+	if _, ok := frc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "FeeRule.name"`)}
+	}
+	if v, ok := frc.mutation.Name(); ok {
+		if err := feerule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FeeRule.name": %w`, err)}
+		}
+	}
+	if _, ok := frc.mutation.TransactionType(); !ok {
+		return &ValidationError{Name: "transaction_type", err: errors.New(`ent: missing required field "FeeRule.transaction_type"`)}
+	}
+	if v, ok := frc.mutation.TransactionType(); ok {
+		if err := feerule.TransactionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_type", err: fmt.Errorf(`ent: validator failed for field "FeeRule.transaction_type": %w`, err)}
+		}
+	}
+	if v, ok := frc.mutation.Currency(); ok {
+		if err := feerule.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "FeeRule.currency": %w`, err)}
+		}
+	}
+	if _, ok := frc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "FeeRule.kind"`)}
+	}
+	if v, ok := frc.mutation.Kind(); ok {
+		if err := feerule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "FeeRule.kind": %w`, err)}
+		}
+	}
+	if _, ok := frc.mutation.FixedAmount(); !ok {
+		return &ValidationError{Name: "fixed_amount", err: errors.New(`ent: missing required field "FeeRule.fixed_amount"`)}
+	}
+	if _, ok := frc.mutation.Percentage(); !ok {
+		return &ValidationError{Name: "percentage", err: errors.New(`ent: missing required field "FeeRule.percentage"`)}
+	}
+	if _, ok := frc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "FeeRule.priority"`)}
+	}
+	if _, ok := frc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "FeeRule.enabled"`)}
+	}
+	if _, ok := frc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FeeRule.created_at"`)}
+	}
+	if _, ok := frc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FeeRule.updated_at"`)}
+	}
+	return nil
+}
+
+func (frc *FeeRuleCreate) sqlSave(ctx context.Context) (*FeeRule, error) {
+	if err := frc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := frc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, frc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	frc.mutation.id = &_node.ID
+	frc.mutation.done = true
+	return _node, nil
+}
+
+func (frc *FeeRuleCreate) createSpec() (*FeeRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FeeRule{config: frc.config}
+		_spec = sqlgraph.NewCreateSpec(feerule.Table, sqlgraph.NewFieldSpec(feerule.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = frc.conflict
+	if value, ok := frc.mutation.Name(); ok {
+		_spec.SetField(feerule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := frc.mutation.TransactionType(); ok {
+		_spec.SetField(feerule.FieldTransactionType, field.TypeEnum, value)
+		_node.TransactionType = value
+	}
+	if value, ok := frc.mutation.Currency(); ok {
+		_spec.SetField(feerule.FieldCurrency, field.TypeString, value)
+		_node.Currency = &value
+	}
+	if value, ok := frc.mutation.Tier(); ok {
+		_spec.SetField(feerule.FieldTier, field.TypeString, value)
+		_node.Tier = &value
+	}
+	if value, ok := frc.mutation.Kind(); ok {
+		_spec.SetField(feerule.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := frc.mutation.FixedAmount(); ok {
+		_spec.SetField(feerule.FieldFixedAmount, field.TypeFloat64, value)
+		_node.FixedAmount = value
+	}
+	if value, ok := frc.mutation.Percentage(); ok {
+		_spec.SetField(feerule.FieldPercentage, field.TypeFloat64, value)
+		_node.Percentage = value
+	}
+	if value, ok := frc.mutation.Tiers(); ok {
+		_spec.SetField(feerule.FieldTiers, field.TypeJSON, value)
+		_node.Tiers = value
+	}
+	if value, ok := frc.mutation.MinFee(); ok {
+		_spec.SetField(feerule.FieldMinFee, field.TypeFloat64, value)
+		_node.MinFee = &value
+	}
+	if value, ok := frc.mutation.MaxFee(); ok {
+		_spec.SetField(feerule.FieldMaxFee, field.TypeFloat64, value)
+		_node.MaxFee = &value
+	}
+	if value, ok := frc.mutation.Priority(); ok {
+		_spec.SetField(feerule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := frc.mutation.Enabled(); ok {
+		_spec.SetField(feerule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := frc.mutation.CreatedAt(); ok {
+		_spec.SetField(feerule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := frc.mutation.UpdatedAt(); ok {
+		_spec.SetField(feerule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FeeRule.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FeeRuleUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (frc *FeeRuleCreate) OnConflict(opts ...sql.ConflictOption) *FeeRuleUpsertOne {
+	frc.conflict = opts
+	return &FeeRuleUpsertOne{
+		create: frc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FeeRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (frc *FeeRuleCreate) OnConflictColumns(columns ...string) *FeeRuleUpsertOne {
+	frc.conflict = append(frc.conflict, sql.ConflictColumns(columns...))
+	return &FeeRuleUpsertOne{
+		create: frc,
+	}
+}
+
+type (
+	// FeeRuleUpsertOne is the builder for "upsert"-ing
+	//  one FeeRule node.
+	FeeRuleUpsertOne struct {
+		create *FeeRuleCreate
+	}
+
+	// FeeRuleUpsert is the "OnConflict" setter.
+	FeeRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *FeeRuleUpsert) SetName(v string) *FeeRuleUpsert {
+	u.Set(feerule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FeeRuleUpsert) UpdateName() *FeeRuleUpsert {
+	u.SetExcluded(feerule.FieldName)
+	return u
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (u *FeeRuleUpsert) SetTransactionType(v feerule.TransactionType) *FeeRuleUpsert {
+	u.Set(feerule.FieldTransactionType, v)
+	return u
+}
+
+// UpdateTransactionType sets the "transaction_type" field to the value that was provided on create.
+func (u *FeeRuleUpsert) UpdateTransactionType() *FeeRuleUpsert {
+	u.SetExcluded(feerule.FieldTransactionType)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *FeeRuleUpsert) SetCurrency(v string) *FeeRuleUpsert {
+	u.Set(feerule.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *FeeRuleUpsert) UpdateCurrency() *FeeRuleUpsert {
+	u.SetExcluded(feerule.FieldCurrency)
+	return u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *FeeRuleUpsert) ClearCurrency() *FeeRuleUpsert {
+	u.SetNull(feerule.FieldCurrency)
+	return u
+}
+
+// SetTier sets the "tier" field.
+func (u *FeeRuleUpsert) SetTier(v string) *FeeRuleUpsert {
+	u.Set(feerule.FieldTier, v)
+	return u
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *FeeRuleUpsert) UpdateTier() *FeeRuleUpsert {
+	u.SetExcluded(feerule.FieldTier)
+	return u
+}
+
+// ClearTier clears the value of the "tier" field.
+func (u *FeeRuleUpsert) ClearTier() *FeeRuleUpsert {
+	u.SetNull(feerule.FieldTier)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *FeeRuleUpsert) SetKind(v feerule.Kind) *FeeRuleUpsert {
+	u.Set(feerule.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *FeeRuleUpsert) UpdateKind() *FeeRuleUpsert {
+	u.SetExcluded(feerule.FieldKind)
+	return u
+}
+
+// SetFixedAmount sets the "fixed_amount" field.
+func (u *FeeRuleUpsert) SetFixedAmount(v decimal.Decimal) *FeeRuleUpsert {
+	u.Set(feerule.FieldFixedAmount, v)
+	return u
+}
+
+// UpdateFixedAmount sets the "fixed_amount" field to the value that was provided on create.
+func (u *FeeRuleUpsert) UpdateFixedAmount() *FeeRuleUpsert {
+	u.SetExcluded(feerule.FieldFixedAmount)
+	return u
+}
+
+// AddFixedAmount adds v to the "fixed_amount" field.
+func (u *FeeRuleUpsert) AddFixedAmount(v decimal.Decimal) *FeeRuleUpsert {
+	u.Add(feerule.FieldFixedAmount, v)
+	return u
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *FeeRuleUpsert) SetPercentage(v decimal.Decimal) *FeeRuleUpsert {
+	u.Set(feerule.FieldPercentage, v)
+	return u
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *FeeRuleUpsert) UpdatePercentage() *FeeRuleUpsert {
+	u.SetExcluded(feerule.FieldPercentage)
+	return u
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *FeeRuleUpsert) AddPercentage(v decimal.Decimal) *FeeRuleUpsert {
+	u.Add(feerule.FieldPercentage, v)
+	return u
+}
+
+// SetTiers sets the "tiers" field.
+func (u *FeeRuleUpsert) SetTiers(v []fee.Tier) *FeeRuleUpsert {
+	u.Set(feerule.FieldTiers, v)
+	return u
+}
+
+// UpdateTiers sets the "tiers" field to the value that was provided on create.
+func (u *FeeRuleUpsert) UpdateTiers() *FeeRuleUpsert {
+	u.SetExcluded(feerule.FieldTiers)
+	return u
+}
+
+// ClearTiers clears the value of the "tiers" field.
+func (u *FeeRuleUpsert) ClearTiers() *FeeRuleUpsert {
+	u.SetNull(feerule.FieldTiers)
+	return u
+}
+
+// SetMinFee sets the "min_fee" field.
+func (u *FeeRuleUpsert) SetMinFee(v decimal.Decimal) *FeeRuleUpsert {
+	u.Set(feerule.FieldMinFee, v)
+	return u
+}
+
+// UpdateMinFee sets the "min_fee" field to the value that was provided on create.
+func (u *FeeRuleUpsert) UpdateMinFee() *FeeRuleUpsert {
+	u.SetExcluded(feerule.FieldMinFee)
+	return u
+}
+
+// AddMinFee adds v to the "min_fee" field.
+func (u *FeeRuleUpsert) AddMinFee(v decimal.Decimal) *FeeRuleUpsert {
+	u.Add(feerule.FieldMinFee, v)
+	return u
+}
+
+// ClearMinFee clears the value of the "min_fee" field.
+func (u *FeeRuleUpsert) ClearMinFee() *FeeRuleUpsert {
+	u.SetNull(feerule.FieldMinFee)
+	return u
+}
+
+// SetMaxFee sets the "max_fee" field.
+func (u *FeeRuleUpsert) SetMaxFee(v decimal.Decimal) *FeeRuleUpsert {
+	u.Set(feerule.FieldMaxFee, v)
+	return u
+}
+
+// UpdateMaxFee sets the "max_fee" field to the value that was provided on create.
+func (u *FeeRuleUpsert) UpdateMaxFee() *FeeRuleUpsert {
+	u.SetExcluded(feerule.FieldMaxFee)
+	return u
+}
+
+// AddMaxFee adds v to the "max_fee" field.
+func (u *FeeRuleUpsert) AddMaxFee(v decimal.Decimal) *FeeRuleUpsert {
+	u.Add(feerule.FieldMaxFee, v)
+	return u
+}
+
+// ClearMaxFee clears the value of the "max_fee" field.
+func (u *FeeRuleUpsert) ClearMaxFee() *FeeRuleUpsert {
+	u.SetNull(feerule.FieldMaxFee)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *FeeRuleUpsert) SetPriority(v int) *FeeRuleUpsert {
+	u.Set(feerule.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *FeeRuleUpsert) UpdatePriority() *FeeRuleUpsert {
+	u.SetExcluded(feerule.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *FeeRuleUpsert) AddPriority(v int) *FeeRuleUpsert {
+	u.Add(feerule.FieldPriority, v)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *FeeRuleUpsert) SetEnabled(v bool) *FeeRuleUpsert {
+	u.Set(feerule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *FeeRuleUpsert) UpdateEnabled() *FeeRuleUpsert {
+	u.SetExcluded(feerule.FieldEnabled)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FeeRuleUpsert) SetUpdatedAt(v time.Time) *FeeRuleUpsert {
+	u.Set(feerule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FeeRuleUpsert) UpdateUpdatedAt() *FeeRuleUpsert {
+	u.SetExcluded(feerule.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.FeeRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FeeRuleUpsertOne) UpdateNewValues() *FeeRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(feerule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FeeRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FeeRuleUpsertOne) Ignore() *FeeRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FeeRuleUpsertOne) DoNothing() *FeeRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FeeRuleCreate.OnConflict
+// documentation for more info.
+func (u *FeeRuleUpsertOne) Update(set func(*FeeRuleUpsert)) *FeeRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FeeRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *FeeRuleUpsertOne) SetName(v string) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FeeRuleUpsertOne) UpdateName() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (u *FeeRuleUpsertOne) SetTransactionType(v feerule.TransactionType) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetTransactionType(v)
+	})
+}
+
+// UpdateTransactionType sets the "transaction_type" field to the value that was provided on create.
+func (u *FeeRuleUpsertOne) UpdateTransactionType() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateTransactionType()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *FeeRuleUpsertOne) SetCurrency(v string) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *FeeRuleUpsertOne) UpdateCurrency() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *FeeRuleUpsertOne) ClearCurrency() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetTier sets the "tier" field.
+func (u *FeeRuleUpsertOne) SetTier(v string) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetTier(v)
+	})
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *FeeRuleUpsertOne) UpdateTier() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateTier()
+	})
+}
+
+// ClearTier clears the value of the "tier" field.
+func (u *FeeRuleUpsertOne) ClearTier() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.ClearTier()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *FeeRuleUpsertOne) SetKind(v feerule.Kind) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *FeeRuleUpsertOne) UpdateKind() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetFixedAmount sets the "fixed_amount" field.
+func (u *FeeRuleUpsertOne) SetFixedAmount(v decimal.Decimal) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetFixedAmount(v)
+	})
+}
+
+// AddFixedAmount adds v to the "fixed_amount" field.
+func (u *FeeRuleUpsertOne) AddFixedAmount(v decimal.Decimal) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.AddFixedAmount(v)
+	})
+}
+
+// UpdateFixedAmount sets the "fixed_amount" field to the value that was provided on create.
+func (u *FeeRuleUpsertOne) UpdateFixedAmount() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateFixedAmount()
+	})
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *FeeRuleUpsertOne) SetPercentage(v decimal.Decimal) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetPercentage(v)
+	})
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *FeeRuleUpsertOne) AddPercentage(v decimal.Decimal) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.AddPercentage(v)
+	})
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *FeeRuleUpsertOne) UpdatePercentage() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdatePercentage()
+	})
+}
+
+// SetTiers sets the "tiers" field.
+func (u *FeeRuleUpsertOne) SetTiers(v []fee.Tier) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetTiers(v)
+	})
+}
+
+// UpdateTiers sets the "tiers" field to the value that was provided on create.
+func (u *FeeRuleUpsertOne) UpdateTiers() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateTiers()
+	})
+}
+
+// ClearTiers clears the value of the "tiers" field.
+func (u *FeeRuleUpsertOne) ClearTiers() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.ClearTiers()
+	})
+}
+
+// SetMinFee sets the "min_fee" field.
+func (u *FeeRuleUpsertOne) SetMinFee(v decimal.Decimal) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetMinFee(v)
+	})
+}
+
+// AddMinFee adds v to the "min_fee" field.
+func (u *FeeRuleUpsertOne) AddMinFee(v decimal.Decimal) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.AddMinFee(v)
+	})
+}
+
+// UpdateMinFee sets the "min_fee" field to the value that was provided on create.
+func (u *FeeRuleUpsertOne) UpdateMinFee() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateMinFee()
+	})
+}
+
+// ClearMinFee clears the value of the "min_fee" field.
+func (u *FeeRuleUpsertOne) ClearMinFee() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.ClearMinFee()
+	})
+}
+
+// SetMaxFee sets the "max_fee" field.
+func (u *FeeRuleUpsertOne) SetMaxFee(v decimal.Decimal) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetMaxFee(v)
+	})
+}
+
+// AddMaxFee adds v to the "max_fee" field.
+func (u *FeeRuleUpsertOne) AddMaxFee(v decimal.Decimal) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.AddMaxFee(v)
+	})
+}
+
+// UpdateMaxFee sets the "max_fee" field to the value that was provided on create.
+func (u *FeeRuleUpsertOne) UpdateMaxFee() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateMaxFee()
+	})
+}
+
+// ClearMaxFee clears the value of the "max_fee" field.
+func (u *FeeRuleUpsertOne) ClearMaxFee() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.ClearMaxFee()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *FeeRuleUpsertOne) SetPriority(v int) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *FeeRuleUpsertOne) AddPriority(v int) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *FeeRuleUpsertOne) UpdatePriority() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *FeeRuleUpsertOne) SetEnabled(v bool) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *FeeRuleUpsertOne) UpdateEnabled() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FeeRuleUpsertOne) SetUpdatedAt(v time.Time) *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FeeRuleUpsertOne) UpdateUpdatedAt() *FeeRuleUpsertOne {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *FeeRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FeeRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FeeRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FeeRuleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FeeRuleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// FeeRuleCreateBulk is the builder for creating many FeeRule entities in bulk.
+type FeeRuleCreateBulk struct {
+	config
+	err      error
+	builders []*FeeRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FeeRule entities in the database.
+func (frcb *FeeRuleCreateBulk) Save(ctx context.Context) ([]*FeeRule, error) {
+	if frcb.err != nil {
+		return nil, frcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(frcb.builders))
+	nodes := make([]*FeeRule, len(frcb.builders))
+	mutators := make([]Mutator, len(frcb.builders))
+	for i := range frcb.builders {
+		func(i int, root context.Context) {
+			builder := frcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FeeRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, frcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = frcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, frcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, frcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (frcb *FeeRuleCreateBulk) SaveX(ctx context.Context) []*FeeRule {
+	v, err := frcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (frcb *FeeRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := frcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frcb *FeeRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := frcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FeeRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FeeRuleUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (frcb *FeeRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *FeeRuleUpsertBulk {
+	frcb.conflict = opts
+	return &FeeRuleUpsertBulk{
+		create: frcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FeeRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (frcb *FeeRuleCreateBulk) OnConflictColumns(columns ...string) *FeeRuleUpsertBulk {
+	frcb.conflict = append(frcb.conflict, sql.ConflictColumns(columns...))
+	return &FeeRuleUpsertBulk{
+		create: frcb,
+	}
+}
+
+// FeeRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of FeeRule nodes.
+type FeeRuleUpsertBulk struct {
+	create *FeeRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FeeRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FeeRuleUpsertBulk) UpdateNewValues() *FeeRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(feerule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FeeRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FeeRuleUpsertBulk) Ignore() *FeeRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FeeRuleUpsertBulk) DoNothing() *FeeRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FeeRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *FeeRuleUpsertBulk) Update(set func(*FeeRuleUpsert)) *FeeRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FeeRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *FeeRuleUpsertBulk) SetName(v string) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FeeRuleUpsertBulk) UpdateName() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (u *FeeRuleUpsertBulk) SetTransactionType(v feerule.TransactionType) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetTransactionType(v)
+	})
+}
+
+// UpdateTransactionType sets the "transaction_type" field to the value that was provided on create.
+func (u *FeeRuleUpsertBulk) UpdateTransactionType() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateTransactionType()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *FeeRuleUpsertBulk) SetCurrency(v string) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *FeeRuleUpsertBulk) UpdateCurrency() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *FeeRuleUpsertBulk) ClearCurrency() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetTier sets the "tier" field.
+func (u *FeeRuleUpsertBulk) SetTier(v string) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetTier(v)
+	})
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *FeeRuleUpsertBulk) UpdateTier() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateTier()
+	})
+}
+
+// ClearTier clears the value of the "tier" field.
+func (u *FeeRuleUpsertBulk) ClearTier() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.ClearTier()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *FeeRuleUpsertBulk) SetKind(v feerule.Kind) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *FeeRuleUpsertBulk) UpdateKind() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetFixedAmount sets the "fixed_amount" field.
+func (u *FeeRuleUpsertBulk) SetFixedAmount(v decimal.Decimal) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetFixedAmount(v)
+	})
+}
+
+// AddFixedAmount adds v to the "fixed_amount" field.
+func (u *FeeRuleUpsertBulk) AddFixedAmount(v decimal.Decimal) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.AddFixedAmount(v)
+	})
+}
+
+// UpdateFixedAmount sets the "fixed_amount" field to the value that was provided on create.
+func (u *FeeRuleUpsertBulk) UpdateFixedAmount() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateFixedAmount()
+	})
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *FeeRuleUpsertBulk) SetPercentage(v decimal.Decimal) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetPercentage(v)
+	})
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *FeeRuleUpsertBulk) AddPercentage(v decimal.Decimal) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.AddPercentage(v)
+	})
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *FeeRuleUpsertBulk) UpdatePercentage() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdatePercentage()
+	})
+}
+
+// SetTiers sets the "tiers" field.
+func (u *FeeRuleUpsertBulk) SetTiers(v []fee.Tier) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetTiers(v)
+	})
+}
+
+// UpdateTiers sets the "tiers" field to the value that was provided on create.
+func (u *FeeRuleUpsertBulk) UpdateTiers() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateTiers()
+	})
+}
+
+// ClearTiers clears the value of the "tiers" field.
+func (u *FeeRuleUpsertBulk) ClearTiers() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.ClearTiers()
+	})
+}
+
+// SetMinFee sets the "min_fee" field.
+func (u *FeeRuleUpsertBulk) SetMinFee(v decimal.Decimal) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetMinFee(v)
+	})
+}
+
+// AddMinFee adds v to the "min_fee" field.
+func (u *FeeRuleUpsertBulk) AddMinFee(v decimal.Decimal) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.AddMinFee(v)
+	})
+}
+
+// UpdateMinFee sets the "min_fee" field to the value that was provided on create.
+func (u *FeeRuleUpsertBulk) UpdateMinFee() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateMinFee()
+	})
+}
+
+// ClearMinFee clears the value of the "min_fee" field.
+func (u *FeeRuleUpsertBulk) ClearMinFee() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.ClearMinFee()
+	})
+}
+
+// SetMaxFee sets the "max_fee" field.
+func (u *FeeRuleUpsertBulk) SetMaxFee(v decimal.Decimal) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetMaxFee(v)
+	})
+}
+
+// AddMaxFee adds v to the "max_fee" field.
+func (u *FeeRuleUpsertBulk) AddMaxFee(v decimal.Decimal) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.AddMaxFee(v)
+	})
+}
+
+// UpdateMaxFee sets the "max_fee" field to the value that was provided on create.
+func (u *FeeRuleUpsertBulk) UpdateMaxFee() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateMaxFee()
+	})
+}
+
+// ClearMaxFee clears the value of the "max_fee" field.
+func (u *FeeRuleUpsertBulk) ClearMaxFee() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.ClearMaxFee()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *FeeRuleUpsertBulk) SetPriority(v int) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *FeeRuleUpsertBulk) AddPriority(v int) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *FeeRuleUpsertBulk) UpdatePriority() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *FeeRuleUpsertBulk) SetEnabled(v bool) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *FeeRuleUpsertBulk) UpdateEnabled() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FeeRuleUpsertBulk) SetUpdatedAt(v time.Time) *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FeeRuleUpsertBulk) UpdateUpdatedAt() *FeeRuleUpsertBulk {
+	return u.Update(func(s *FeeRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *FeeRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FeeRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FeeRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FeeRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/feerule"
+	"accounting/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FeeRuleDelete is the builder for deleting a FeeRule entity.
+type FeeRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *FeeRuleMutation
+}
+
+// Where appends a list predicates to the FeeRuleDelete builder.
+func (frd *FeeRuleDelete) Where(ps ...predicate.FeeRule) *FeeRuleDelete {
+	frd.mutation.Where(ps...)
+	return frd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (frd *FeeRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, frd.sqlExec, frd.mutation, frd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frd *FeeRuleDelete) ExecX(ctx context.Context) int {
+	n, err := frd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (frd *FeeRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(feerule.Table, sqlgraph.NewFieldSpec(feerule.FieldID, field.TypeInt))
+	if ps := frd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, frd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	frd.mutation.done = true
+	return affected, err
+}
+
+// FeeRuleDeleteOne is the builder for deleting a single FeeRule entity.
+type FeeRuleDeleteOne struct {
+	frd *FeeRuleDelete
+}
+
+// Where appends a list predicates to the FeeRuleDelete builder.
+func (frdo *FeeRuleDeleteOne) Where(ps ...predicate.FeeRule) *FeeRuleDeleteOne {
+	frdo.frd.mutation.Where(ps...)
+	return frdo
+}
+
+// Exec executes the deletion query.
+func (frdo *FeeRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := frdo.frd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{feerule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frdo *FeeRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := frdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/feerule"
+	"accounting/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FeeRuleQuery is the builder for querying FeeRule entities.
+type FeeRuleQuery struct {
+	config
+	ctx        *QueryContext
+	order      []feerule.OrderOption
+	inters     []Interceptor
+	predicates []predicate.FeeRule
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FeeRuleQuery builder.
+func (frq *FeeRuleQuery) Where(ps ...predicate.FeeRule) *FeeRuleQuery {
+	frq.predicates = append(frq.predicates, ps...)
+	return frq
+}
+
+// Limit the number of records to be returned by this query.
+func (frq *FeeRuleQuery) Limit(limit int) *FeeRuleQuery {
+	frq.ctx.Limit = &limit
+	return frq
+}
+
+// Offset to start from.
+func (frq *FeeRuleQuery) Offset(offset int) *FeeRuleQuery {
+	frq.ctx.Offset = &offset
+	return frq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (frq *FeeRuleQuery) Unique(unique bool) *FeeRuleQuery {
+	frq.ctx.Unique = &unique
+	return frq
+}
+
+// Order specifies how the records should be ordered.
+func (frq *FeeRuleQuery) Order(o ...feerule.OrderOption) *FeeRuleQuery {
+	frq.order = append(frq.order, o...)
+	return frq
+}
+
+// First returns the first FeeRule entity from the query.
+// Returns a *NotFoundError when no FeeRule was found.
+func (frq *FeeRuleQuery) First(ctx context.Context) (*FeeRule, error) {
+	nodes, err := frq.Limit(1).All(setContextOp(ctx, frq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{feerule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (frq *FeeRuleQuery) FirstX(ctx context.Context) *FeeRule {
+	node, err := frq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FeeRule ID from the query.
+// Returns a *NotFoundError when no FeeRule ID was found.
+func (frq *FeeRuleQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = frq.Limit(1).IDs(setContextOp(ctx, frq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{feerule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (frq *FeeRuleQuery) FirstIDX(ctx context.Context) int {
+	id, err := frq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FeeRule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FeeRule entity is found.
+// Returns a *NotFoundError when no FeeRule entities are found.
+func (frq *FeeRuleQuery) Only(ctx context.Context) (*FeeRule, error) {
+	nodes, err := frq.Limit(2).All(setContextOp(ctx, frq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{feerule.Label}
+	default:
+		return nil, &NotSingularError{feerule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (frq *FeeRuleQuery) OnlyX(ctx context.Context) *FeeRule {
+	node, err := frq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FeeRule ID in the query.
+// Returns a *NotSingularError when more than one FeeRule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (frq *FeeRuleQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = frq.Limit(2).IDs(setContextOp(ctx, frq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{feerule.Label}
+	default:
+		err = &NotSingularError{feerule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (frq *FeeRuleQuery) OnlyIDX(ctx context.Context) int {
+	id, err := frq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FeeRules.
+func (frq *FeeRuleQuery) All(ctx context.Context) ([]*FeeRule, error) {
+	ctx = setContextOp(ctx, frq.ctx, ent.OpQueryAll)
+	if err := frq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FeeRule, *FeeRuleQuery]()
+	return withInterceptors[[]*FeeRule](ctx, frq, qr, frq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (frq *FeeRuleQuery) AllX(ctx context.Context) []*FeeRule {
+	nodes, err := frq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FeeRule IDs.
+func (frq *FeeRuleQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if frq.ctx.Unique == nil && frq.path != nil {
+		frq.Unique(true)
+	}
+	ctx = setContextOp(ctx, frq.ctx, ent.OpQueryIDs)
+	if err = frq.Select(feerule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (frq *FeeRuleQuery) IDsX(ctx context.Context) []int {
+	ids, err := frq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (frq *FeeRuleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, frq.ctx, ent.OpQueryCount)
+	if err := frq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, frq, querierCount[*FeeRuleQuery](), frq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (frq *FeeRuleQuery) CountX(ctx context.Context) int {
+	count, err := frq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (frq *FeeRuleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, frq.ctx, ent.OpQueryExist)
+	switch _, err := frq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (frq *FeeRuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := frq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FeeRuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (frq *FeeRuleQuery) Clone() *FeeRuleQuery {
+	if frq == nil {
+		return nil
+	}
+	return &FeeRuleQuery{
+		config:     frq.config,
+		ctx:        frq.ctx.Clone(),
+		order:      append([]feerule.OrderOption{}, frq.order...),
+		inters:     append([]Interceptor{}, frq.inters...),
+		predicates: append([]predicate.FeeRule{}, frq.predicates...),
+		// clone intermediate query.
+		sql:  frq.sql.Clone(),
+		path: frq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FeeRule.Query().
+//		GroupBy(feerule.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (frq *FeeRuleQuery) GroupBy(field string, fields ...string) *FeeRuleGroupBy {
+	frq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FeeRuleGroupBy{build: frq}
+	grbuild.flds = &frq.ctx.Fields
+	grbuild.label = feerule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.FeeRule.Query().
+//		Select(feerule.FieldName).
+//		Scan(ctx, &v)
+func (frq *FeeRuleQuery) Select(fields ...string) *FeeRuleSelect {
+	frq.ctx.Fields = append(frq.ctx.Fields, fields...)
+	sbuild := &FeeRuleSelect{FeeRuleQuery: frq}
+	sbuild.label = feerule.Label
+	sbuild.flds, sbuild.scan = &frq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FeeRuleSelect configured with the given aggregations.
+func (frq *FeeRuleQuery) Aggregate(fns ...AggregateFunc) *FeeRuleSelect {
+	return frq.Select().Aggregate(fns...)
+}
+
+func (frq *FeeRuleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range frq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, frq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range frq.ctx.Fields {
+		if !feerule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if frq.path != nil {
+		prev, err := frq.path(ctx)
+		if err != nil {
+			return err
+		}
+		frq.sql = prev
+	}
+	return nil
+}
+
+func (frq *FeeRuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FeeRule, error) {
+	var (
+		nodes = []*FeeRule{}
+		_spec = frq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FeeRule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FeeRule{config: frq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(frq.modifiers) > 0 {
+		_spec.Modifiers = frq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, frq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (frq *FeeRuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := frq.querySpec()
+	if len(frq.modifiers) > 0 {
+		_spec.Modifiers = frq.modifiers
+	}
+	_spec.Node.Columns = frq.ctx.Fields
+	if len(frq.ctx.Fields) > 0 {
+		_spec.Unique = frq.ctx.Unique != nil && *frq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, frq.driver, _spec)
+}
+
+func (frq *FeeRuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(feerule.Table, feerule.Columns, sqlgraph.NewFieldSpec(feerule.FieldID, field.TypeInt))
+	_spec.From = frq.sql
+	if unique := frq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if frq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := frq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, feerule.FieldID)
+		for i := range fields {
+			if fields[i] != feerule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := frq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := frq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := frq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := frq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (frq *FeeRuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(frq.driver.Dialect())
+	t1 := builder.Table(feerule.Table)
+	columns := frq.ctx.Fields
+	if len(columns) == 0 {
+		columns = feerule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if frq.sql != nil {
+		selector = frq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if frq.ctx.Unique != nil && *frq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range frq.modifiers {
+		m(selector)
+	}
+	for _, p := range frq.predicates {
+		p(selector)
+	}
+	for _, p := range frq.order {
+		p(selector)
+	}
+	if offset := frq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := frq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (frq *FeeRuleQuery) ForUpdate(opts ...sql.LockOption) *FeeRuleQuery {
+	if frq.driver.Dialect() == dialect.Postgres {
+		frq.Unique(false)
+	}
+	frq.modifiers = append(frq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return frq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (frq *FeeRuleQuery) ForShare(opts ...sql.LockOption) *FeeRuleQuery {
+	if frq.driver.Dialect() == dialect.Postgres {
+		frq.Unique(false)
+	}
+	frq.modifiers = append(frq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return frq
+}
+
+// FeeRuleGroupBy is the group-by builder for FeeRule entities.
+type FeeRuleGroupBy struct {
+	selector
+	build *FeeRuleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (frgb *FeeRuleGroupBy) Aggregate(fns ...AggregateFunc) *FeeRuleGroupBy {
+	frgb.fns = append(frgb.fns, fns...)
+	return frgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (frgb *FeeRuleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, frgb.build.ctx, ent.OpQueryGroupBy)
+	if err := frgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FeeRuleQuery, *FeeRuleGroupBy](ctx, frgb.build, frgb, frgb.build.inters, v)
+}
+
+func (frgb *FeeRuleGroupBy) sqlScan(ctx context.Context, root *FeeRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(frgb.fns))
+	for _, fn := range frgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*frgb.flds)+len(frgb.fns))
+		for _, f := range *frgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*frgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := frgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FeeRuleSelect is the builder for selecting fields of FeeRule entities.
+type FeeRuleSelect struct {
+	*FeeRuleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (frs *FeeRuleSelect) Aggregate(fns ...AggregateFunc) *FeeRuleSelect {
+	frs.fns = append(frs.fns, fns...)
+	return frs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (frs *FeeRuleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, frs.ctx, ent.OpQuerySelect)
+	if err := frs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FeeRuleQuery, *FeeRuleSelect](ctx, frs.FeeRuleQuery, frs, frs.inters, v)
+}
+
+func (frs *FeeRuleSelect) sqlScan(ctx context.Context, root *FeeRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(frs.fns))
+	for _, fn := range frs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*frs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := frs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,875 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/feerule"
+	"accounting/ent/predicate"
+	"accounting/fee"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// FeeRuleUpdate is the builder for updating FeeRule entities.
+type FeeRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FeeRuleMutation
+}
+
+// Where appends a list predicates to the FeeRuleUpdate builder.
+func (fru *FeeRuleUpdate) Where(ps ...predicate.FeeRule) *FeeRuleUpdate {
+	fru.mutation.Where(ps...)
+	return fru
+}
+
+// SetName sets the "name" field.
+func (fru *FeeRuleUpdate) SetName(s string) *FeeRuleUpdate {
+	fru.mutation.SetName(s)
+	return fru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (fru *FeeRuleUpdate) SetNillableName(s *string) *FeeRuleUpdate {
+	if s != nil {
+		fru.SetName(*s)
+	}
+	return fru
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (fru *FeeRuleUpdate) SetTransactionType(ft feerule.TransactionType) *FeeRuleUpdate {
+	fru.mutation.SetTransactionType(ft)
+	return fru
+}
+
+// SetNillableTransactionType sets the "transaction_type" field if the given value is not nil.
+func (fru *FeeRuleUpdate) SetNillableTransactionType(ft *feerule.TransactionType) *FeeRuleUpdate {
+	if ft != nil {
+		fru.SetTransactionType(*ft)
+	}
+	return fru
+}
+
+// SetCurrency sets the "currency" field.
+func (fru *FeeRuleUpdate) SetCurrency(s string) *FeeRuleUpdate {
+	fru.mutation.SetCurrency(s)
+	return fru
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (fru *FeeRuleUpdate) SetNillableCurrency(s *string) *FeeRuleUpdate {
+	if s != nil {
+		fru.SetCurrency(*s)
+	}
+	return fru
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (fru *FeeRuleUpdate) ClearCurrency() *FeeRuleUpdate {
+	fru.mutation.ClearCurrency()
+	return fru
+}
+
+// SetTier sets the "tier" field.
+func (fru *FeeRuleUpdate) SetTier(s string) *FeeRuleUpdate {
+	fru.mutation.SetTier(s)
+	return fru
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (fru *FeeRuleUpdate) SetNillableTier(s *string) *FeeRuleUpdate {
+	if s != nil {
+		fru.SetTier(*s)
+	}
+	return fru
+}
+
+// ClearTier clears the value of the "tier" field.
+func (fru *FeeRuleUpdate) ClearTier() *FeeRuleUpdate {
+	fru.mutation.ClearTier()
+	return fru
+}
+
+// SetKind sets the "kind" field.
+func (fru *FeeRuleUpdate) SetKind(f feerule.Kind) *FeeRuleUpdate {
+	fru.mutation.SetKind(f)
+	return fru
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (fru *FeeRuleUpdate) SetNillableKind(f *feerule.Kind) *FeeRuleUpdate {
+	if f != nil {
+		fru.SetKind(*f)
+	}
+	return fru
+}
+
+// SetFixedAmount sets the "fixed_amount" field.
+func (fru *FeeRuleUpdate) SetFixedAmount(d decimal.Decimal) *FeeRuleUpdate {
+	fru.mutation.ResetFixedAmount()
+	fru.mutation.SetFixedAmount(d)
+	return fru
+}
+
+// SetNillableFixedAmount sets the "fixed_amount" field if the given value is not nil.
+func (fru *FeeRuleUpdate) SetNillableFixedAmount(d *decimal.Decimal) *FeeRuleUpdate {
+	if d != nil {
+		fru.SetFixedAmount(*d)
+	}
+	return fru
+}
+
+// AddFixedAmount adds d to the "fixed_amount" field.
+func (fru *FeeRuleUpdate) AddFixedAmount(d decimal.Decimal) *FeeRuleUpdate {
+	fru.mutation.AddFixedAmount(d)
+	return fru
+}
+
+// SetPercentage sets the "percentage" field.
+func (fru *FeeRuleUpdate) SetPercentage(d decimal.Decimal) *FeeRuleUpdate {
+	fru.mutation.ResetPercentage()
+	fru.mutation.SetPercentage(d)
+	return fru
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (fru *FeeRuleUpdate) SetNillablePercentage(d *decimal.Decimal) *FeeRuleUpdate {
+	if d != nil {
+		fru.SetPercentage(*d)
+	}
+	return fru
+}
+
+// AddPercentage adds d to the "percentage" field.
+func (fru *FeeRuleUpdate) AddPercentage(d decimal.Decimal) *FeeRuleUpdate {
+	fru.mutation.AddPercentage(d)
+	return fru
+}
+
+// SetTiers sets the "tiers" field.
+func (fru *FeeRuleUpdate) SetTiers(f []fee.Tier) *FeeRuleUpdate {
+	fru.mutation.SetTiers(f)
+	return fru
+}
+
+// AppendTiers appends f to the "tiers" field.
+func (fru *FeeRuleUpdate) AppendTiers(f []fee.Tier) *FeeRuleUpdate {
+	fru.mutation.AppendTiers(f)
+	return fru
+}
+
+// ClearTiers clears the value of the "tiers" field.
+func (fru *FeeRuleUpdate) ClearTiers() *FeeRuleUpdate {
+	fru.mutation.ClearTiers()
+	return fru
+}
+
+// SetMinFee sets the "min_fee" field.
+func (fru *FeeRuleUpdate) SetMinFee(d decimal.Decimal) *FeeRuleUpdate {
+	fru.mutation.ResetMinFee()
+	fru.mutation.SetMinFee(d)
+	return fru
+}
+
+// SetNillableMinFee sets the "min_fee" field if the given value is not nil.
+func (fru *FeeRuleUpdate) SetNillableMinFee(d *decimal.Decimal) *FeeRuleUpdate {
+	if d != nil {
+		fru.SetMinFee(*d)
+	}
+	return fru
+}
+
+// AddMinFee adds d to the "min_fee" field.
+func (fru *FeeRuleUpdate) AddMinFee(d decimal.Decimal) *FeeRuleUpdate {
+	fru.mutation.AddMinFee(d)
+	return fru
+}
+
+// ClearMinFee clears the value of the "min_fee" field.
+func (fru *FeeRuleUpdate) ClearMinFee() *FeeRuleUpdate {
+	fru.mutation.ClearMinFee()
+	return fru
+}
+
+// SetMaxFee sets the "max_fee" field.
+func (fru *FeeRuleUpdate) SetMaxFee(d decimal.Decimal) *FeeRuleUpdate {
+	fru.mutation.ResetMaxFee()
+	fru.mutation.SetMaxFee(d)
+	return fru
+}
+
+// SetNillableMaxFee sets the "max_fee" field if the given value is not nil.
+func (fru *FeeRuleUpdate) SetNillableMaxFee(d *decimal.Decimal) *FeeRuleUpdate {
+	if d != nil {
+		fru.SetMaxFee(*d)
+	}
+	return fru
+}
+
+// AddMaxFee adds d to the "max_fee" field.
+func (fru *FeeRuleUpdate) AddMaxFee(d decimal.Decimal) *FeeRuleUpdate {
+	fru.mutation.AddMaxFee(d)
+	return fru
+}
+
+// ClearMaxFee clears the value of the "max_fee" field.
+func (fru *FeeRuleUpdate) ClearMaxFee() *FeeRuleUpdate {
+	fru.mutation.ClearMaxFee()
+	return fru
+}
+
+// SetPriority sets the "priority" field.
+func (fru *FeeRuleUpdate) SetPriority(i int) *FeeRuleUpdate {
+	fru.mutation.ResetPriority()
+	fru.mutation.SetPriority(i)
+	return fru
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (fru *FeeRuleUpdate) SetNillablePriority(i *int) *FeeRuleUpdate {
+	if i != nil {
+		fru.SetPriority(*i)
+	}
+	return fru
+}
+
+// AddPriority adds i to the "priority" field.
+func (fru *FeeRuleUpdate) AddPriority(i int) *FeeRuleUpdate {
+	fru.mutation.AddPriority(i)
+	return fru
+}
+
+// SetEnabled sets the "enabled" field.
+func (fru *FeeRuleUpdate) SetEnabled(b bool) *FeeRuleUpdate {
+	fru.mutation.SetEnabled(b)
+	return fru
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (fru *FeeRuleUpdate) SetNillableEnabled(b *bool) *FeeRuleUpdate {
+	if b != nil {
+		fru.SetEnabled(*b)
+	}
+	return fru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fru *FeeRuleUpdate) SetUpdatedAt(t time.Time) *FeeRuleUpdate {
+	fru.mutation.SetUpdatedAt(t)
+	return fru
+}
+
+// Mutation returns the FeeRuleMutation object of the builder.
+func (fru *FeeRuleUpdate) Mutation() *FeeRuleMutation {
+	return fru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fru *FeeRuleUpdate) Save(ctx context.Context) (int, error) {
+	fru.defaults()
+	return withHooks(ctx, fru.sqlSave, fru.mutation, fru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fru *FeeRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := fru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fru *FeeRuleUpdate) Exec(ctx context.Context) error {
+	_, err := fru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fru *FeeRuleUpdate) ExecX(ctx context.Context) {
+	if err := fru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fru *FeeRuleUpdate) defaults() {
+	if _, ok := fru.mutation.UpdatedAt(); !ok {
+		v := feerule.UpdateDefaultUpdatedAt()
+		fru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fru *FeeRuleUpdate) check() error {
+	if v, ok := fru.mutation.Name(); ok {
+		if err := feerule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FeeRule.name": %w`, err)}
+		}
+	}
+	if v, ok := fru.mutation.TransactionType(); ok {
+		if err := feerule.TransactionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_type", err: fmt.Errorf(`ent: validator failed for field "FeeRule.transaction_type": %w`, err)}
+		}
+	}
+	if v, ok := fru.mutation.Currency(); ok {
+		if err := feerule.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "FeeRule.currency": %w`, err)}
+		}
+	}
+	if v, ok := fru.mutation.Kind(); ok {
+		if err := feerule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "FeeRule.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (fru *FeeRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(feerule.Table, feerule.Columns, sqlgraph.NewFieldSpec(feerule.FieldID, field.TypeInt))
+	if ps := fru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fru.mutation.Name(); ok {
+		_spec.SetField(feerule.FieldName, field.TypeString, value)
+	}
+	if value, ok := fru.mutation.TransactionType(); ok {
+		_spec.SetField(feerule.FieldTransactionType, field.TypeEnum, value)
+	}
+	if value, ok := fru.mutation.Currency(); ok {
+		_spec.SetField(feerule.FieldCurrency, field.TypeString, value)
+	}
+	if fru.mutation.CurrencyCleared() {
+		_spec.ClearField(feerule.FieldCurrency, field.TypeString)
+	}
+	if value, ok := fru.mutation.Tier(); ok {
+		_spec.SetField(feerule.FieldTier, field.TypeString, value)
+	}
+	if fru.mutation.TierCleared() {
+		_spec.ClearField(feerule.FieldTier, field.TypeString)
+	}
+	if value, ok := fru.mutation.Kind(); ok {
+		_spec.SetField(feerule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := fru.mutation.FixedAmount(); ok {
+		_spec.SetField(feerule.FieldFixedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fru.mutation.AddedFixedAmount(); ok {
+		_spec.AddField(feerule.FieldFixedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fru.mutation.Percentage(); ok {
+		_spec.SetField(feerule.FieldPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := fru.mutation.AddedPercentage(); ok {
+		_spec.AddField(feerule.FieldPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := fru.mutation.Tiers(); ok {
+		_spec.SetField(feerule.FieldTiers, field.TypeJSON, value)
+	}
+	if value, ok := fru.mutation.AppendedTiers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, feerule.FieldTiers, value)
+		})
+	}
+	if fru.mutation.TiersCleared() {
+		_spec.ClearField(feerule.FieldTiers, field.TypeJSON)
+	}
+	if value, ok := fru.mutation.MinFee(); ok {
+		_spec.SetField(feerule.FieldMinFee, field.TypeFloat64, value)
+	}
+	if value, ok := fru.mutation.AddedMinFee(); ok {
+		_spec.AddField(feerule.FieldMinFee, field.TypeFloat64, value)
+	}
+	if fru.mutation.MinFeeCleared() {
+		_spec.ClearField(feerule.FieldMinFee, field.TypeFloat64)
+	}
+	if value, ok := fru.mutation.MaxFee(); ok {
+		_spec.SetField(feerule.FieldMaxFee, field.TypeFloat64, value)
+	}
+	if value, ok := fru.mutation.AddedMaxFee(); ok {
+		_spec.AddField(feerule.FieldMaxFee, field.TypeFloat64, value)
+	}
+	if fru.mutation.MaxFeeCleared() {
+		_spec.ClearField(feerule.FieldMaxFee, field.TypeFloat64)
+	}
+	if value, ok := fru.mutation.Priority(); ok {
+		_spec.SetField(feerule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := fru.mutation.AddedPriority(); ok {
+		_spec.AddField(feerule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := fru.mutation.Enabled(); ok {
+		_spec.SetField(feerule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := fru.mutation.UpdatedAt(); ok {
+		_spec.SetField(feerule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{feerule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fru.mutation.done = true
+	return n, nil
+}
+
+// FeeRuleUpdateOne is the builder for updating a single FeeRule entity.
+type FeeRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FeeRuleMutation
+}
+
+// SetName sets the "name" field.
+func (fruo *FeeRuleUpdateOne) SetName(s string) *FeeRuleUpdateOne {
+	fruo.mutation.SetName(s)
+	return fruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (fruo *FeeRuleUpdateOne) SetNillableName(s *string) *FeeRuleUpdateOne {
+	if s != nil {
+		fruo.SetName(*s)
+	}
+	return fruo
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (fruo *FeeRuleUpdateOne) SetTransactionType(ft feerule.TransactionType) *FeeRuleUpdateOne {
+	fruo.mutation.SetTransactionType(ft)
+	return fruo
+}
+
+// SetNillableTransactionType sets the "transaction_type" field if the given value is not nil.
+func (fruo *FeeRuleUpdateOne) SetNillableTransactionType(ft *feerule.TransactionType) *FeeRuleUpdateOne {
+	if ft != nil {
+		fruo.SetTransactionType(*ft)
+	}
+	return fruo
+}
+
+// SetCurrency sets the "currency" field.
+func (fruo *FeeRuleUpdateOne) SetCurrency(s string) *FeeRuleUpdateOne {
+	fruo.mutation.SetCurrency(s)
+	return fruo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (fruo *FeeRuleUpdateOne) SetNillableCurrency(s *string) *FeeRuleUpdateOne {
+	if s != nil {
+		fruo.SetCurrency(*s)
+	}
+	return fruo
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (fruo *FeeRuleUpdateOne) ClearCurrency() *FeeRuleUpdateOne {
+	fruo.mutation.ClearCurrency()
+	return fruo
+}
+
+// SetTier sets the "tier" field.
+func (fruo *FeeRuleUpdateOne) SetTier(s string) *FeeRuleUpdateOne {
+	fruo.mutation.SetTier(s)
+	return fruo
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (fruo *FeeRuleUpdateOne) SetNillableTier(s *string) *FeeRuleUpdateOne {
+	if s != nil {
+		fruo.SetTier(*s)
+	}
+	return fruo
+}
+
+// ClearTier clears the value of the "tier" field.
+func (fruo *FeeRuleUpdateOne) ClearTier() *FeeRuleUpdateOne {
+	fruo.mutation.ClearTier()
+	return fruo
+}
+
+// SetKind sets the "kind" field.
+func (fruo *FeeRuleUpdateOne) SetKind(f feerule.Kind) *FeeRuleUpdateOne {
+	fruo.mutation.SetKind(f)
+	return fruo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (fruo *FeeRuleUpdateOne) SetNillableKind(f *feerule.Kind) *FeeRuleUpdateOne {
+	if f != nil {
+		fruo.SetKind(*f)
+	}
+	return fruo
+}
+
+// SetFixedAmount sets the "fixed_amount" field.
+func (fruo *FeeRuleUpdateOne) SetFixedAmount(d decimal.Decimal) *FeeRuleUpdateOne {
+	fruo.mutation.ResetFixedAmount()
+	fruo.mutation.SetFixedAmount(d)
+	return fruo
+}
+
+// SetNillableFixedAmount sets the "fixed_amount" field if the given value is not nil.
+func (fruo *FeeRuleUpdateOne) SetNillableFixedAmount(d *decimal.Decimal) *FeeRuleUpdateOne {
+	if d != nil {
+		fruo.SetFixedAmount(*d)
+	}
+	return fruo
+}
+
+// AddFixedAmount adds d to the "fixed_amount" field.
+func (fruo *FeeRuleUpdateOne) AddFixedAmount(d decimal.Decimal) *FeeRuleUpdateOne {
+	fruo.mutation.AddFixedAmount(d)
+	return fruo
+}
+
+// SetPercentage sets the "percentage" field.
+func (fruo *FeeRuleUpdateOne) SetPercentage(d decimal.Decimal) *FeeRuleUpdateOne {
+	fruo.mutation.ResetPercentage()
+	fruo.mutation.SetPercentage(d)
+	return fruo
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (fruo *FeeRuleUpdateOne) SetNillablePercentage(d *decimal.Decimal) *FeeRuleUpdateOne {
+	if d != nil {
+		fruo.SetPercentage(*d)
+	}
+	return fruo
+}
+
+// AddPercentage adds d to the "percentage" field.
+func (fruo *FeeRuleUpdateOne) AddPercentage(d decimal.Decimal) *FeeRuleUpdateOne {
+	fruo.mutation.AddPercentage(d)
+	return fruo
+}
+
+// SetTiers sets the "tiers" field.
+func (fruo *FeeRuleUpdateOne) SetTiers(f []fee.Tier) *FeeRuleUpdateOne {
+	fruo.mutation.SetTiers(f)
+	return fruo
+}
+
+// AppendTiers appends f to the "tiers" field.
+func (fruo *FeeRuleUpdateOne) AppendTiers(f []fee.Tier) *FeeRuleUpdateOne {
+	fruo.mutation.AppendTiers(f)
+	return fruo
+}
+
+// ClearTiers clears the value of the "tiers" field.
+func (fruo *FeeRuleUpdateOne) ClearTiers() *FeeRuleUpdateOne {
+	fruo.mutation.ClearTiers()
+	return fruo
+}
+
+// SetMinFee sets the "min_fee" field.
+func (fruo *FeeRuleUpdateOne) SetMinFee(d decimal.Decimal) *FeeRuleUpdateOne {
+	fruo.mutation.ResetMinFee()
+	fruo.mutation.SetMinFee(d)
+	return fruo
+}
+
+// SetNillableMinFee sets the "min_fee" field if the given value is not nil.
+func (fruo *FeeRuleUpdateOne) SetNillableMinFee(d *decimal.Decimal) *FeeRuleUpdateOne {
+	if d != nil {
+		fruo.SetMinFee(*d)
+	}
+	return fruo
+}
+
+// AddMinFee adds d to the "min_fee" field.
+func (fruo *FeeRuleUpdateOne) AddMinFee(d decimal.Decimal) *FeeRuleUpdateOne {
+	fruo.mutation.AddMinFee(d)
+	return fruo
+}
+
+// ClearMinFee clears the value of the "min_fee" field.
+func (fruo *FeeRuleUpdateOne) ClearMinFee() *FeeRuleUpdateOne {
+	fruo.mutation.ClearMinFee()
+	return fruo
+}
+
+// SetMaxFee sets the "max_fee" field.
+func (fruo *FeeRuleUpdateOne) SetMaxFee(d decimal.Decimal) *FeeRuleUpdateOne {
+	fruo.mutation.ResetMaxFee()
+	fruo.mutation.SetMaxFee(d)
+	return fruo
+}
+
+// SetNillableMaxFee sets the "max_fee" field if the given value is not nil.
+func (fruo *FeeRuleUpdateOne) SetNillableMaxFee(d *decimal.Decimal) *FeeRuleUpdateOne {
+	if d != nil {
+		fruo.SetMaxFee(*d)
+	}
+	return fruo
+}
+
+// AddMaxFee adds d to the "max_fee" field.
+func (fruo *FeeRuleUpdateOne) AddMaxFee(d decimal.Decimal) *FeeRuleUpdateOne {
+	fruo.mutation.AddMaxFee(d)
+	return fruo
+}
+
+// ClearMaxFee clears the value of the "max_fee" field.
+func (fruo *FeeRuleUpdateOne) ClearMaxFee() *FeeRuleUpdateOne {
+	fruo.mutation.ClearMaxFee()
+	return fruo
+}
+
+// SetPriority sets the "priority" field.
+func (fruo *FeeRuleUpdateOne) SetPriority(i int) *FeeRuleUpdateOne {
+	fruo.mutation.ResetPriority()
+	fruo.mutation.SetPriority(i)
+	return fruo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (fruo *FeeRuleUpdateOne) SetNillablePriority(i *int) *FeeRuleUpdateOne {
+	if i != nil {
+		fruo.SetPriority(*i)
+	}
+	return fruo
+}
+
+// AddPriority adds i to the "priority" field.
+func (fruo *FeeRuleUpdateOne) AddPriority(i int) *FeeRuleUpdateOne {
+	fruo.mutation.AddPriority(i)
+	return fruo
+}
+
+// SetEnabled sets the "enabled" field.
+func (fruo *FeeRuleUpdateOne) SetEnabled(b bool) *FeeRuleUpdateOne {
+	fruo.mutation.SetEnabled(b)
+	return fruo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (fruo *FeeRuleUpdateOne) SetNillableEnabled(b *bool) *FeeRuleUpdateOne {
+	if b != nil {
+		fruo.SetEnabled(*b)
+	}
+	return fruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fruo *FeeRuleUpdateOne) SetUpdatedAt(t time.Time) *FeeRuleUpdateOne {
+	fruo.mutation.SetUpdatedAt(t)
+	return fruo
+}
+
+// Mutation returns the FeeRuleMutation object of the builder.
+func (fruo *FeeRuleUpdateOne) Mutation() *FeeRuleMutation {
+	return fruo.mutation
+}
+
+// Where appends a list predicates to the FeeRuleUpdate builder.
+func (fruo *FeeRuleUpdateOne) Where(ps ...predicate.FeeRule) *FeeRuleUpdateOne {
+	fruo.mutation.Where(ps...)
+	return fruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fruo *FeeRuleUpdateOne) Select(field string, fields ...string) *FeeRuleUpdateOne {
+	fruo.fields = append([]string{field}, fields...)
+	return fruo
+}
+
+// Save executes the query and returns the updated FeeRule entity.
+func (fruo *FeeRuleUpdateOne) Save(ctx context.Context) (*FeeRule, error) {
+	fruo.defaults()
+	return withHooks(ctx, fruo.sqlSave, fruo.mutation, fruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fruo *FeeRuleUpdateOne) SaveX(ctx context.Context) *FeeRule {
+	node, err := fruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fruo *FeeRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := fruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fruo *FeeRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := fruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fruo *FeeRuleUpdateOne) defaults() {
+	if _, ok := fruo.mutation.UpdatedAt(); !ok {
+		v := feerule.UpdateDefaultUpdatedAt()
+		fruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fruo *FeeRuleUpdateOne) check() error {
+	if v, ok := fruo.mutation.Name(); ok {
+		if err := feerule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FeeRule.name": %w`, err)}
+		}
+	}
+	if v, ok := fruo.mutation.TransactionType(); ok {
+		if err := feerule.TransactionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_type", err: fmt.Errorf(`ent: validator failed for field "FeeRule.transaction_type": %w`, err)}
+		}
+	}
+	if v, ok := fruo.mutation.Currency(); ok {
+		if err := feerule.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "FeeRule.currency": %w`, err)}
+		}
+	}
+	if v, ok := fruo.mutation.Kind(); ok {
+		if err := feerule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "FeeRule.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (fruo *FeeRuleUpdateOne) sqlSave(ctx context.Context) (_node *FeeRule, err error) {
+	if err := fruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(feerule.Table, feerule.Columns, sqlgraph.NewFieldSpec(feerule.FieldID, field.TypeInt))
+	id, ok := fruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FeeRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, feerule.FieldID)
+		for _, f := range fields {
+			if !feerule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != feerule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fruo.mutation.Name(); ok {
+		_spec.SetField(feerule.FieldName, field.TypeString, value)
+	}
+	if value, ok := fruo.mutation.TransactionType(); ok {
+		_spec.SetField(feerule.FieldTransactionType, field.TypeEnum, value)
+	}
+	if value, ok := fruo.mutation.Currency(); ok {
+		_spec.SetField(feerule.FieldCurrency, field.TypeString, value)
+	}
+	if fruo.mutation.CurrencyCleared() {
+		_spec.ClearField(feerule.FieldCurrency, field.TypeString)
+	}
+	if value, ok := fruo.mutation.Tier(); ok {
+		_spec.SetField(feerule.FieldTier, field.TypeString, value)
+	}
+	if fruo.mutation.TierCleared() {
+		_spec.ClearField(feerule.FieldTier, field.TypeString)
+	}
+	if value, ok := fruo.mutation.Kind(); ok {
+		_spec.SetField(feerule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := fruo.mutation.FixedAmount(); ok {
+		_spec.SetField(feerule.FieldFixedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fruo.mutation.AddedFixedAmount(); ok {
+		_spec.AddField(feerule.FieldFixedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := fruo.mutation.Percentage(); ok {
+		_spec.SetField(feerule.FieldPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := fruo.mutation.AddedPercentage(); ok {
+		_spec.AddField(feerule.FieldPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := fruo.mutation.Tiers(); ok {
+		_spec.SetField(feerule.FieldTiers, field.TypeJSON, value)
+	}
+	if value, ok := fruo.mutation.AppendedTiers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, feerule.FieldTiers, value)
+		})
+	}
+	if fruo.mutation.TiersCleared() {
+		_spec.ClearField(feerule.FieldTiers, field.TypeJSON)
+	}
+	if value, ok := fruo.mutation.MinFee(); ok {
+		_spec.SetField(feerule.FieldMinFee, field.TypeFloat64, value)
+	}
+	if value, ok := fruo.mutation.AddedMinFee(); ok {
+		_spec.AddField(feerule.FieldMinFee, field.TypeFloat64, value)
+	}
+	if fruo.mutation.MinFeeCleared() {
+		_spec.ClearField(feerule.FieldMinFee, field.TypeFloat64)
+	}
+	if value, ok := fruo.mutation.MaxFee(); ok {
+		_spec.SetField(feerule.FieldMaxFee, field.TypeFloat64, value)
+	}
+	if value, ok := fruo.mutation.AddedMaxFee(); ok {
+		_spec.AddField(feerule.FieldMaxFee, field.TypeFloat64, value)
+	}
+	if fruo.mutation.MaxFeeCleared() {
+		_spec.ClearField(feerule.FieldMaxFee, field.TypeFloat64)
+	}
+	if value, ok := fruo.mutation.Priority(); ok {
+		_spec.SetField(feerule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := fruo.mutation.AddedPriority(); ok {
+		_spec.AddField(feerule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := fruo.mutation.Enabled(); ok {
+		_spec.SetField(feerule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := fruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(feerule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &FeeRule{config: fruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{feerule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fruo.mutation.done = true
+	return _node, nil
+}
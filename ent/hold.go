@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/hold"
+	"accounting/ent/transaction"
+	"accounting/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// Hold is the model entity for the Hold schema.
+type Hold struct {
+	config `json:"-"`
+	// ID of the ent.
+	// ID of the hold (string primary key)
+	ID string `json:"id,omitempty"`
+	// ID of the user, whose funds are reserved
+	UserID int `json:"user_id,omitempty"`
+	// Currency of the reserved funds
+	Currency string `json:"currency,omitempty"`
+	// Reserved amount
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Amount captured into a withdrawal, at most the reserved amount
+	CapturedAmount decimal.Decimal `json:"captured_amount,omitempty"`
+	// Status of the hold: active, captured, voided, expired
+	Status hold.Status `json:"status,omitempty"`
+	// ID of the withdrawal transaction created by the capture
+	TransactionID *string `json:"transaction_id,omitempty"`
+	// Time after which an active hold is released automatically
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Time of the hold creation
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Time of the last hold update
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HoldQuery when eager-loading is set.
+	Edges        HoldEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HoldEdges holds the relations/edges for other nodes in the graph.
+type HoldEdges struct {
+	// User, whose funds are reserved
+	User *User `json:"user,omitempty"`
+	// Withdrawal transaction created by the capture
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HoldEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HoldEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Hold) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case hold.FieldAmount, hold.FieldCapturedAmount:
+			values[i] = new(decimal.Decimal)
+		case hold.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case hold.FieldID, hold.FieldCurrency, hold.FieldStatus, hold.FieldTransactionID:
+			values[i] = new(sql.NullString)
+		case hold.FieldExpiresAt, hold.FieldCreatedAt, hold.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Hold fields.
+func (h *Hold) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case hold.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				h.ID = value.String
+			}
+		case hold.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				h.UserID = int(value.Int64)
+			}
+		case hold.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				h.Currency = value.String
+			}
+		case hold.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				h.Amount = *value
+			}
+		case hold.FieldCapturedAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field captured_amount", values[i])
+			} else if value != nil {
+				h.CapturedAmount = *value
+			}
+		case hold.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				h.Status = hold.Status(value.String)
+			}
+		case hold.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				h.TransactionID = new(string)
+				*h.TransactionID = value.String
+			}
+		case hold.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				h.ExpiresAt = value.Time
+			}
+		case hold.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				h.CreatedAt = value.Time
+			}
+		case hold.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				h.UpdatedAt = value.Time
+			}
+		default:
+			h.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Hold.
+// This includes values selected through modifiers, order, etc.
+func (h *Hold) Value(name string) (ent.Value, error) {
+	return h.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Hold entity.
+func (h *Hold) QueryUser() *UserQuery {
+	return NewHoldClient(h.config).QueryUser(h)
+}
+
+// QueryTransaction queries the "transaction" edge of the Hold entity.
+func (h *Hold) QueryTransaction() *TransactionQuery {
+	return NewHoldClient(h.config).QueryTransaction(h)
+}
+
+// Update returns a builder for updating this Hold.
+// Note that you need to call Hold.Unwrap() before calling this method if this Hold
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (h *Hold) Update() *HoldUpdateOne {
+	return NewHoldClient(h.config).UpdateOne(h)
+}
+
+// Unwrap unwraps the Hold entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (h *Hold) Unwrap() *Hold {
+	_tx, ok := h.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Hold is not a transactional entity")
+	}
+	h.config.driver = _tx.drv
+	return h
+}
+
+// String implements the fmt.Stringer.
+func (h *Hold) String() string {
+	var builder strings.Builder
+	builder.WriteString("Hold(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", h.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", h.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(h.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", h.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("captured_amount=")
+	builder.WriteString(fmt.Sprintf("%v", h.CapturedAmount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", h.Status))
+	builder.WriteString(", ")
+	if v := h.TransactionID; v != nil {
+		builder.WriteString("transaction_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(h.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(h.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(h.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Holds is a parsable slice of Hold.
+type Holds []*Hold
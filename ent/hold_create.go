@@ -0,0 +1,900 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/hold"
+	"accounting/ent/transaction"
+	"accounting/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// HoldCreate is the builder for creating a Hold entity.
+type HoldCreate struct {
+	config
+	mutation *HoldMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (hc *HoldCreate) SetUserID(i int) *HoldCreate {
+	hc.mutation.SetUserID(i)
+	return hc
+}
+
+// SetCurrency sets the "currency" field.
+func (hc *HoldCreate) SetCurrency(s string) *HoldCreate {
+	hc.mutation.SetCurrency(s)
+	return hc
+}
+
+// SetAmount sets the "amount" field.
+func (hc *HoldCreate) SetAmount(d decimal.Decimal) *HoldCreate {
+	hc.mutation.SetAmount(d)
+	return hc
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (hc *HoldCreate) SetCapturedAmount(d decimal.Decimal) *HoldCreate {
+	hc.mutation.SetCapturedAmount(d)
+	return hc
+}
+
+// SetNillableCapturedAmount sets the "captured_amount" field if the given value is not nil.
+func (hc *HoldCreate) SetNillableCapturedAmount(d *decimal.Decimal) *HoldCreate {
+	if d != nil {
+		hc.SetCapturedAmount(*d)
+	}
+	return hc
+}
+
+// SetStatus sets the "status" field.
+func (hc *HoldCreate) SetStatus(h hold.Status) *HoldCreate {
+	hc.mutation.SetStatus(h)
+	return hc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (hc *HoldCreate) SetNillableStatus(h *hold.Status) *HoldCreate {
+	if h != nil {
+		hc.SetStatus(*h)
+	}
+	return hc
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (hc *HoldCreate) SetTransactionID(s string) *HoldCreate {
+	hc.mutation.SetTransactionID(s)
+	return hc
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (hc *HoldCreate) SetNillableTransactionID(s *string) *HoldCreate {
+	if s != nil {
+		hc.SetTransactionID(*s)
+	}
+	return hc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (hc *HoldCreate) SetExpiresAt(t time.Time) *HoldCreate {
+	hc.mutation.SetExpiresAt(t)
+	return hc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (hc *HoldCreate) SetCreatedAt(t time.Time) *HoldCreate {
+	hc.mutation.SetCreatedAt(t)
+	return hc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (hc *HoldCreate) SetNillableCreatedAt(t *time.Time) *HoldCreate {
+	if t != nil {
+		hc.SetCreatedAt(*t)
+	}
+	return hc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (hc *HoldCreate) SetUpdatedAt(t time.Time) *HoldCreate {
+	hc.mutation.SetUpdatedAt(t)
+	return hc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (hc *HoldCreate) SetNillableUpdatedAt(t *time.Time) *HoldCreate {
+	if t != nil {
+		hc.SetUpdatedAt(*t)
+	}
+	return hc
+}
+
+// SetID sets the "id" field.
+func (hc *HoldCreate) SetID(s string) *HoldCreate {
+	hc.mutation.SetID(s)
+	return hc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (hc *HoldCreate) SetUser(u *User) *HoldCreate {
+	return hc.SetUserID(u.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (hc *HoldCreate) SetTransaction(t *Transaction) *HoldCreate {
+	return hc.SetTransactionID(t.ID)
+}
+
+// Mutation returns the HoldMutation object of the builder.
+func (hc *HoldCreate) Mutation() *HoldMutation {
+	return hc.mutation
+}
+
+// Save creates the Hold in the database.
+func (hc *HoldCreate) Save(ctx context.Context) (*Hold, error) {
+	hc.defaults()
+	return withHooks(ctx, hc.sqlSave, hc.mutation, hc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (hc *HoldCreate) SaveX(ctx context.Context) *Hold {
+	v, err := hc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hc *HoldCreate) Exec(ctx context.Context) error {
+	_, err := hc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hc *HoldCreate) ExecX(ctx context.Context) {
+	if err := hc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hc *HoldCreate) defaults() {
+	if _, ok := hc.mutation.CapturedAmount(); !ok {
+		v := hold.DefaultCapturedAmount()
+		hc.mutation.SetCapturedAmount(v)
+	}
+	if _, ok := hc.mutation.Status(); !ok {
+		v := hold.DefaultStatus
+		hc.mutation.SetStatus(v)
+	}
+	if _, ok := hc.mutation.CreatedAt(); !ok {
+		v := hold.DefaultCreatedAt()
+		hc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := hc.mutation.UpdatedAt(); !ok {
+		v := hold.DefaultUpdatedAt()
+		hc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hc *HoldCreate) check() error {
+	if _, ok := hc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Hold.user_id"`)}
+	}
+	if _, ok := hc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Hold.currency"`)}
+	}
+	if v, ok := hc.mutation.Currency(); ok {
+		if err := hold.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Hold.currency": %w`, err)}
+		}
+	}
+	if _, ok := hc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Hold.amount"`)}
+	}
+	if _, ok := hc.mutation.CapturedAmount(); !ok {
+		return &ValidationError{Name: "captured_amount", err: errors.New(`ent: missing required field "Hold.captured_amount"`)}
+	}
+	if _, ok := hc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Hold.status"`)}
+	}
+	if v, ok := hc.mutation.Status(); ok {
+		if err := hold.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Hold.status": %w`, err)}
+		}
+	}
+	if _, ok := hc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Hold.expires_at"`)}
+	}
+	if _, ok := hc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Hold.created_at"`)}
+	}
+	if _, ok := hc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Hold.updated_at"`)}
+	}
+	if v, ok := hc.mutation.ID(); ok {
+		if err := hold.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Hold.id": %w`, err)}
+		}
+	}
+	if len(hc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Hold.user"`)}
+	}
+	return nil
+}
+
+func (hc *HoldCreate) sqlSave(ctx context.Context) (*Hold, error) {
+	if err := hc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := hc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, hc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Hold.ID type: %T", _spec.ID.Value)
+		}
+	}
+	hc.mutation.id = &_node.ID
+	hc.mutation.done = true
+	return _node, nil
+}
+
+func (hc *HoldCreate) createSpec() (*Hold, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Hold{config: hc.config}
+		_spec = sqlgraph.NewCreateSpec(hold.Table, sqlgraph.NewFieldSpec(hold.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = hc.conflict
+	if id, ok := hc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := hc.mutation.Currency(); ok {
+		_spec.SetField(hold.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := hc.mutation.Amount(); ok {
+		_spec.SetField(hold.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := hc.mutation.CapturedAmount(); ok {
+		_spec.SetField(hold.FieldCapturedAmount, field.TypeFloat64, value)
+		_node.CapturedAmount = value
+	}
+	if value, ok := hc.mutation.Status(); ok {
+		_spec.SetField(hold.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := hc.mutation.ExpiresAt(); ok {
+		_spec.SetField(hold.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := hc.mutation.CreatedAt(); ok {
+		_spec.SetField(hold.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := hc.mutation.UpdatedAt(); ok {
+		_spec.SetField(hold.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := hc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   hold.UserTable,
+			Columns: []string{hold.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := hc.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hold.TransactionTable,
+			Columns: []string{hold.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransactionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Hold.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HoldUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (hc *HoldCreate) OnConflict(opts ...sql.ConflictOption) *HoldUpsertOne {
+	hc.conflict = opts
+	return &HoldUpsertOne{
+		create: hc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Hold.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hc *HoldCreate) OnConflictColumns(columns ...string) *HoldUpsertOne {
+	hc.conflict = append(hc.conflict, sql.ConflictColumns(columns...))
+	return &HoldUpsertOne{
+		create: hc,
+	}
+}
+
+type (
+	// HoldUpsertOne is the builder for "upsert"-ing
+	//  one Hold node.
+	HoldUpsertOne struct {
+		create *HoldCreate
+	}
+
+	// HoldUpsert is the "OnConflict" setter.
+	HoldUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (u *HoldUpsert) SetCapturedAmount(v decimal.Decimal) *HoldUpsert {
+	u.Set(hold.FieldCapturedAmount, v)
+	return u
+}
+
+// UpdateCapturedAmount sets the "captured_amount" field to the value that was provided on create.
+func (u *HoldUpsert) UpdateCapturedAmount() *HoldUpsert {
+	u.SetExcluded(hold.FieldCapturedAmount)
+	return u
+}
+
+// AddCapturedAmount adds v to the "captured_amount" field.
+func (u *HoldUpsert) AddCapturedAmount(v decimal.Decimal) *HoldUpsert {
+	u.Add(hold.FieldCapturedAmount, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *HoldUpsert) SetStatus(v hold.Status) *HoldUpsert {
+	u.Set(hold.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *HoldUpsert) UpdateStatus() *HoldUpsert {
+	u.SetExcluded(hold.FieldStatus)
+	return u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *HoldUpsert) SetTransactionID(v string) *HoldUpsert {
+	u.Set(hold.FieldTransactionID, v)
+	return u
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *HoldUpsert) UpdateTransactionID() *HoldUpsert {
+	u.SetExcluded(hold.FieldTransactionID)
+	return u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *HoldUpsert) ClearTransactionID() *HoldUpsert {
+	u.SetNull(hold.FieldTransactionID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *HoldUpsert) SetUpdatedAt(v time.Time) *HoldUpsert {
+	u.Set(hold.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *HoldUpsert) UpdateUpdatedAt() *HoldUpsert {
+	u.SetExcluded(hold.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Hold.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hold.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HoldUpsertOne) UpdateNewValues() *HoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(hold.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(hold.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(hold.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(hold.FieldAmount)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(hold.FieldExpiresAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(hold.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Hold.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HoldUpsertOne) Ignore() *HoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HoldUpsertOne) DoNothing() *HoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HoldCreate.OnConflict
+// documentation for more info.
+func (u *HoldUpsertOne) Update(set func(*HoldUpsert)) *HoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HoldUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (u *HoldUpsertOne) SetCapturedAmount(v decimal.Decimal) *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetCapturedAmount(v)
+	})
+}
+
+// AddCapturedAmount adds v to the "captured_amount" field.
+func (u *HoldUpsertOne) AddCapturedAmount(v decimal.Decimal) *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.AddCapturedAmount(v)
+	})
+}
+
+// UpdateCapturedAmount sets the "captured_amount" field to the value that was provided on create.
+func (u *HoldUpsertOne) UpdateCapturedAmount() *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateCapturedAmount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *HoldUpsertOne) SetStatus(v hold.Status) *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *HoldUpsertOne) UpdateStatus() *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *HoldUpsertOne) SetTransactionID(v string) *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *HoldUpsertOne) UpdateTransactionID() *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *HoldUpsertOne) ClearTransactionID() *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *HoldUpsertOne) SetUpdatedAt(v time.Time) *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *HoldUpsertOne) UpdateUpdatedAt() *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *HoldUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HoldCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HoldUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HoldUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: HoldUpsertOne.ID is not supported by MySQL driver. Use HoldUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HoldUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// HoldCreateBulk is the builder for creating many Hold entities in bulk.
+type HoldCreateBulk struct {
+	config
+	err      error
+	builders []*HoldCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Hold entities in the database.
+func (hcb *HoldCreateBulk) Save(ctx context.Context) ([]*Hold, error) {
+	if hcb.err != nil {
+		return nil, hcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(hcb.builders))
+	nodes := make([]*Hold, len(hcb.builders))
+	mutators := make([]Mutator, len(hcb.builders))
+	for i := range hcb.builders {
+		func(i int, root context.Context) {
+			builder := hcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HoldMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, hcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = hcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, hcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, hcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hcb *HoldCreateBulk) SaveX(ctx context.Context) []*Hold {
+	v, err := hcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hcb *HoldCreateBulk) Exec(ctx context.Context) error {
+	_, err := hcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hcb *HoldCreateBulk) ExecX(ctx context.Context) {
+	if err := hcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Hold.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HoldUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (hcb *HoldCreateBulk) OnConflict(opts ...sql.ConflictOption) *HoldUpsertBulk {
+	hcb.conflict = opts
+	return &HoldUpsertBulk{
+		create: hcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Hold.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hcb *HoldCreateBulk) OnConflictColumns(columns ...string) *HoldUpsertBulk {
+	hcb.conflict = append(hcb.conflict, sql.ConflictColumns(columns...))
+	return &HoldUpsertBulk{
+		create: hcb,
+	}
+}
+
+// HoldUpsertBulk is the builder for "upsert"-ing
+// a bulk of Hold nodes.
+type HoldUpsertBulk struct {
+	create *HoldCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Hold.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hold.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HoldUpsertBulk) UpdateNewValues() *HoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(hold.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(hold.FieldUserID)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(hold.FieldCurrency)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(hold.FieldAmount)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(hold.FieldExpiresAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(hold.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Hold.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HoldUpsertBulk) Ignore() *HoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HoldUpsertBulk) DoNothing() *HoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HoldCreateBulk.OnConflict
+// documentation for more info.
+func (u *HoldUpsertBulk) Update(set func(*HoldUpsert)) *HoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HoldUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (u *HoldUpsertBulk) SetCapturedAmount(v decimal.Decimal) *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetCapturedAmount(v)
+	})
+}
+
+// AddCapturedAmount adds v to the "captured_amount" field.
+func (u *HoldUpsertBulk) AddCapturedAmount(v decimal.Decimal) *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.AddCapturedAmount(v)
+	})
+}
+
+// UpdateCapturedAmount sets the "captured_amount" field to the value that was provided on create.
+func (u *HoldUpsertBulk) UpdateCapturedAmount() *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateCapturedAmount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *HoldUpsertBulk) SetStatus(v hold.Status) *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *HoldUpsertBulk) UpdateStatus() *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *HoldUpsertBulk) SetTransactionID(v string) *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *HoldUpsertBulk) UpdateTransactionID() *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *HoldUpsertBulk) ClearTransactionID() *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *HoldUpsertBulk) SetUpdatedAt(v time.Time) *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *HoldUpsertBulk) UpdateUpdatedAt() *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *HoldUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HoldCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HoldCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HoldUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
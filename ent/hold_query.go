@@ -0,0 +1,721 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/hold"
+	"accounting/ent/predicate"
+	"accounting/ent/transaction"
+	"accounting/ent/user"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HoldQuery is the builder for querying Hold entities.
+type HoldQuery struct {
+	config
+	ctx             *QueryContext
+	order           []hold.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Hold
+	withUser        *UserQuery
+	withTransaction *TransactionQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the HoldQuery builder.
+func (hq *HoldQuery) Where(ps ...predicate.Hold) *HoldQuery {
+	hq.predicates = append(hq.predicates, ps...)
+	return hq
+}
+
+// Limit the number of records to be returned by this query.
+func (hq *HoldQuery) Limit(limit int) *HoldQuery {
+	hq.ctx.Limit = &limit
+	return hq
+}
+
+// Offset to start from.
+func (hq *HoldQuery) Offset(offset int) *HoldQuery {
+	hq.ctx.Offset = &offset
+	return hq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (hq *HoldQuery) Unique(unique bool) *HoldQuery {
+	hq.ctx.Unique = &unique
+	return hq
+}
+
+// Order specifies how the records should be ordered.
+func (hq *HoldQuery) Order(o ...hold.OrderOption) *HoldQuery {
+	hq.order = append(hq.order, o...)
+	return hq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (hq *HoldQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: hq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hold.Table, hold.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, hold.UserTable, hold.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransaction chains the current query on the "transaction" edge.
+func (hq *HoldQuery) QueryTransaction() *TransactionQuery {
+	query := (&TransactionClient{config: hq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hold.Table, hold.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hold.TransactionTable, hold.TransactionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Hold entity from the query.
+// Returns a *NotFoundError when no Hold was found.
+func (hq *HoldQuery) First(ctx context.Context) (*Hold, error) {
+	nodes, err := hq.Limit(1).All(setContextOp(ctx, hq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{hold.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (hq *HoldQuery) FirstX(ctx context.Context) *Hold {
+	node, err := hq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Hold ID from the query.
+// Returns a *NotFoundError when no Hold ID was found.
+func (hq *HoldQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = hq.Limit(1).IDs(setContextOp(ctx, hq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{hold.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (hq *HoldQuery) FirstIDX(ctx context.Context) string {
+	id, err := hq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Hold entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Hold entity is found.
+// Returns a *NotFoundError when no Hold entities are found.
+func (hq *HoldQuery) Only(ctx context.Context) (*Hold, error) {
+	nodes, err := hq.Limit(2).All(setContextOp(ctx, hq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{hold.Label}
+	default:
+		return nil, &NotSingularError{hold.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (hq *HoldQuery) OnlyX(ctx context.Context) *Hold {
+	node, err := hq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Hold ID in the query.
+// Returns a *NotSingularError when more than one Hold ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (hq *HoldQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = hq.Limit(2).IDs(setContextOp(ctx, hq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{hold.Label}
+	default:
+		err = &NotSingularError{hold.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (hq *HoldQuery) OnlyIDX(ctx context.Context) string {
+	id, err := hq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Holds.
+func (hq *HoldQuery) All(ctx context.Context) ([]*Hold, error) {
+	ctx = setContextOp(ctx, hq.ctx, ent.OpQueryAll)
+	if err := hq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Hold, *HoldQuery]()
+	return withInterceptors[[]*Hold](ctx, hq, qr, hq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (hq *HoldQuery) AllX(ctx context.Context) []*Hold {
+	nodes, err := hq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Hold IDs.
+func (hq *HoldQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if hq.ctx.Unique == nil && hq.path != nil {
+		hq.Unique(true)
+	}
+	ctx = setContextOp(ctx, hq.ctx, ent.OpQueryIDs)
+	if err = hq.Select(hold.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (hq *HoldQuery) IDsX(ctx context.Context) []string {
+	ids, err := hq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (hq *HoldQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, hq.ctx, ent.OpQueryCount)
+	if err := hq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, hq, querierCount[*HoldQuery](), hq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (hq *HoldQuery) CountX(ctx context.Context) int {
+	count, err := hq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (hq *HoldQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, hq.ctx, ent.OpQueryExist)
+	switch _, err := hq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (hq *HoldQuery) ExistX(ctx context.Context) bool {
+	exist, err := hq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the HoldQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (hq *HoldQuery) Clone() *HoldQuery {
+	if hq == nil {
+		return nil
+	}
+	return &HoldQuery{
+		config:          hq.config,
+		ctx:             hq.ctx.Clone(),
+		order:           append([]hold.OrderOption{}, hq.order...),
+		inters:          append([]Interceptor{}, hq.inters...),
+		predicates:      append([]predicate.Hold{}, hq.predicates...),
+		withUser:        hq.withUser.Clone(),
+		withTransaction: hq.withTransaction.Clone(),
+		// clone intermediate query.
+		sql:  hq.sql.Clone(),
+		path: hq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (hq *HoldQuery) WithUser(opts ...func(*UserQuery)) *HoldQuery {
+	query := (&UserClient{config: hq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hq.withUser = query
+	return hq
+}
+
+// WithTransaction tells the query-builder to eager-load the nodes that are connected to
+// the "transaction" edge. The optional arguments are used to configure the query builder of the edge.
+func (hq *HoldQuery) WithTransaction(opts ...func(*TransactionQuery)) *HoldQuery {
+	query := (&TransactionClient{config: hq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hq.withTransaction = query
+	return hq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Hold.Query().
+//		GroupBy(hold.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (hq *HoldQuery) GroupBy(field string, fields ...string) *HoldGroupBy {
+	hq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &HoldGroupBy{build: hq}
+	grbuild.flds = &hq.ctx.Fields
+	grbuild.label = hold.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//	}
+//
+//	client.Hold.Query().
+//		Select(hold.FieldUserID).
+//		Scan(ctx, &v)
+func (hq *HoldQuery) Select(fields ...string) *HoldSelect {
+	hq.ctx.Fields = append(hq.ctx.Fields, fields...)
+	sbuild := &HoldSelect{HoldQuery: hq}
+	sbuild.label = hold.Label
+	sbuild.flds, sbuild.scan = &hq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a HoldSelect configured with the given aggregations.
+func (hq *HoldQuery) Aggregate(fns ...AggregateFunc) *HoldSelect {
+	return hq.Select().Aggregate(fns...)
+}
+
+func (hq *HoldQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range hq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, hq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range hq.ctx.Fields {
+		if !hold.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if hq.path != nil {
+		prev, err := hq.path(ctx)
+		if err != nil {
+			return err
+		}
+		hq.sql = prev
+	}
+	return nil
+}
+
+func (hq *HoldQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Hold, error) {
+	var (
+		nodes       = []*Hold{}
+		_spec       = hq.querySpec()
+		loadedTypes = [2]bool{
+			hq.withUser != nil,
+			hq.withTransaction != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Hold).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Hold{config: hq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(hq.modifiers) > 0 {
+		_spec.Modifiers = hq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, hq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := hq.withUser; query != nil {
+		if err := hq.loadUser(ctx, query, nodes, nil,
+			func(n *Hold, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := hq.withTransaction; query != nil {
+		if err := hq.loadTransaction(ctx, query, nodes, nil,
+			func(n *Hold, e *Transaction) { n.Edges.Transaction = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (hq *HoldQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Hold, init func(*Hold), assign func(*Hold, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Hold)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (hq *HoldQuery) loadTransaction(ctx context.Context, query *TransactionQuery, nodes []*Hold, init func(*Hold), assign func(*Hold, *Transaction)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Hold)
+	for i := range nodes {
+		if nodes[i].TransactionID == nil {
+			continue
+		}
+		fk := *nodes[i].TransactionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transaction.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "transaction_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (hq *HoldQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := hq.querySpec()
+	if len(hq.modifiers) > 0 {
+		_spec.Modifiers = hq.modifiers
+	}
+	_spec.Node.Columns = hq.ctx.Fields
+	if len(hq.ctx.Fields) > 0 {
+		_spec.Unique = hq.ctx.Unique != nil && *hq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, hq.driver, _spec)
+}
+
+func (hq *HoldQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(hold.Table, hold.Columns, sqlgraph.NewFieldSpec(hold.FieldID, field.TypeString))
+	_spec.From = hq.sql
+	if unique := hq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if hq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := hq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hold.FieldID)
+		for i := range fields {
+			if fields[i] != hold.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if hq.withUser != nil {
+			_spec.Node.AddColumnOnce(hold.FieldUserID)
+		}
+		if hq.withTransaction != nil {
+			_spec.Node.AddColumnOnce(hold.FieldTransactionID)
+		}
+	}
+	if ps := hq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := hq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := hq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := hq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (hq *HoldQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(hq.driver.Dialect())
+	t1 := builder.Table(hold.Table)
+	columns := hq.ctx.Fields
+	if len(columns) == 0 {
+		columns = hold.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if hq.sql != nil {
+		selector = hq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if hq.ctx.Unique != nil && *hq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range hq.modifiers {
+		m(selector)
+	}
+	for _, p := range hq.predicates {
+		p(selector)
+	}
+	for _, p := range hq.order {
+		p(selector)
+	}
+	if offset := hq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := hq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (hq *HoldQuery) ForUpdate(opts ...sql.LockOption) *HoldQuery {
+	if hq.driver.Dialect() == dialect.Postgres {
+		hq.Unique(false)
+	}
+	hq.modifiers = append(hq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return hq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (hq *HoldQuery) ForShare(opts ...sql.LockOption) *HoldQuery {
+	if hq.driver.Dialect() == dialect.Postgres {
+		hq.Unique(false)
+	}
+	hq.modifiers = append(hq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return hq
+}
+
+// HoldGroupBy is the group-by builder for Hold entities.
+type HoldGroupBy struct {
+	selector
+	build *HoldQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (hgb *HoldGroupBy) Aggregate(fns ...AggregateFunc) *HoldGroupBy {
+	hgb.fns = append(hgb.fns, fns...)
+	return hgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hgb *HoldGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hgb.build.ctx, ent.OpQueryGroupBy)
+	if err := hgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HoldQuery, *HoldGroupBy](ctx, hgb.build, hgb, hgb.build.inters, v)
+}
+
+func (hgb *HoldGroupBy) sqlScan(ctx context.Context, root *HoldQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(hgb.fns))
+	for _, fn := range hgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*hgb.flds)+len(hgb.fns))
+		for _, f := range *hgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*hgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// HoldSelect is the builder for selecting fields of Hold entities.
+type HoldSelect struct {
+	*HoldQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (hs *HoldSelect) Aggregate(fns ...AggregateFunc) *HoldSelect {
+	hs.fns = append(hs.fns, fns...)
+	return hs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hs *HoldSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hs.ctx, ent.OpQuerySelect)
+	if err := hs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HoldQuery, *HoldSelect](ctx, hs.HoldQuery, hs, hs.inters, v)
+}
+
+func (hs *HoldSelect) sqlScan(ctx context.Context, root *HoldQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(hs.fns))
+	for _, fn := range hs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*hs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/hold"
+	"accounting/ent/predicate"
+	"accounting/ent/transaction"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// HoldUpdate is the builder for updating Hold entities.
+type HoldUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HoldMutation
+}
+
+// Where appends a list predicates to the HoldUpdate builder.
+func (hu *HoldUpdate) Where(ps ...predicate.Hold) *HoldUpdate {
+	hu.mutation.Where(ps...)
+	return hu
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (hu *HoldUpdate) SetCapturedAmount(d decimal.Decimal) *HoldUpdate {
+	hu.mutation.ResetCapturedAmount()
+	hu.mutation.SetCapturedAmount(d)
+	return hu
+}
+
+// SetNillableCapturedAmount sets the "captured_amount" field if the given value is not nil.
+func (hu *HoldUpdate) SetNillableCapturedAmount(d *decimal.Decimal) *HoldUpdate {
+	if d != nil {
+		hu.SetCapturedAmount(*d)
+	}
+	return hu
+}
+
+// AddCapturedAmount adds d to the "captured_amount" field.
+func (hu *HoldUpdate) AddCapturedAmount(d decimal.Decimal) *HoldUpdate {
+	hu.mutation.AddCapturedAmount(d)
+	return hu
+}
+
+// SetStatus sets the "status" field.
+func (hu *HoldUpdate) SetStatus(h hold.Status) *HoldUpdate {
+	hu.mutation.SetStatus(h)
+	return hu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (hu *HoldUpdate) SetNillableStatus(h *hold.Status) *HoldUpdate {
+	if h != nil {
+		hu.SetStatus(*h)
+	}
+	return hu
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (hu *HoldUpdate) SetTransactionID(s string) *HoldUpdate {
+	hu.mutation.SetTransactionID(s)
+	return hu
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (hu *HoldUpdate) SetNillableTransactionID(s *string) *HoldUpdate {
+	if s != nil {
+		hu.SetTransactionID(*s)
+	}
+	return hu
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (hu *HoldUpdate) ClearTransactionID() *HoldUpdate {
+	hu.mutation.ClearTransactionID()
+	return hu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (hu *HoldUpdate) SetUpdatedAt(t time.Time) *HoldUpdate {
+	hu.mutation.SetUpdatedAt(t)
+	return hu
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (hu *HoldUpdate) SetTransaction(t *Transaction) *HoldUpdate {
+	return hu.SetTransactionID(t.ID)
+}
+
+// Mutation returns the HoldMutation object of the builder.
+func (hu *HoldUpdate) Mutation() *HoldMutation {
+	return hu.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (hu *HoldUpdate) ClearTransaction() *HoldUpdate {
+	hu.mutation.ClearTransaction()
+	return hu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (hu *HoldUpdate) Save(ctx context.Context) (int, error) {
+	hu.defaults()
+	return withHooks(ctx, hu.sqlSave, hu.mutation, hu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hu *HoldUpdate) SaveX(ctx context.Context) int {
+	affected, err := hu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (hu *HoldUpdate) Exec(ctx context.Context) error {
+	_, err := hu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hu *HoldUpdate) ExecX(ctx context.Context) {
+	if err := hu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hu *HoldUpdate) defaults() {
+	if _, ok := hu.mutation.UpdatedAt(); !ok {
+		v := hold.UpdateDefaultUpdatedAt()
+		hu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hu *HoldUpdate) check() error {
+	if v, ok := hu.mutation.Status(); ok {
+		if err := hold.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Hold.status": %w`, err)}
+		}
+	}
+	if hu.mutation.UserCleared() && len(hu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Hold.user"`)
+	}
+	return nil
+}
+
+func (hu *HoldUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := hu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hold.Table, hold.Columns, sqlgraph.NewFieldSpec(hold.FieldID, field.TypeString))
+	if ps := hu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hu.mutation.CapturedAmount(); ok {
+		_spec.SetField(hold.FieldCapturedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := hu.mutation.AddedCapturedAmount(); ok {
+		_spec.AddField(hold.FieldCapturedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := hu.mutation.Status(); ok {
+		_spec.SetField(hold.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := hu.mutation.UpdatedAt(); ok {
+		_spec.SetField(hold.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if hu.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hold.TransactionTable,
+			Columns: []string{hold.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hu.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hold.TransactionTable,
+			Columns: []string{hold.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, hu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hold.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	hu.mutation.done = true
+	return n, nil
+}
+
+// HoldUpdateOne is the builder for updating a single Hold entity.
+type HoldUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HoldMutation
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (huo *HoldUpdateOne) SetCapturedAmount(d decimal.Decimal) *HoldUpdateOne {
+	huo.mutation.ResetCapturedAmount()
+	huo.mutation.SetCapturedAmount(d)
+	return huo
+}
+
+// SetNillableCapturedAmount sets the "captured_amount" field if the given value is not nil.
+func (huo *HoldUpdateOne) SetNillableCapturedAmount(d *decimal.Decimal) *HoldUpdateOne {
+	if d != nil {
+		huo.SetCapturedAmount(*d)
+	}
+	return huo
+}
+
+// AddCapturedAmount adds d to the "captured_amount" field.
+func (huo *HoldUpdateOne) AddCapturedAmount(d decimal.Decimal) *HoldUpdateOne {
+	huo.mutation.AddCapturedAmount(d)
+	return huo
+}
+
+// SetStatus sets the "status" field.
+func (huo *HoldUpdateOne) SetStatus(h hold.Status) *HoldUpdateOne {
+	huo.mutation.SetStatus(h)
+	return huo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (huo *HoldUpdateOne) SetNillableStatus(h *hold.Status) *HoldUpdateOne {
+	if h != nil {
+		huo.SetStatus(*h)
+	}
+	return huo
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (huo *HoldUpdateOne) SetTransactionID(s string) *HoldUpdateOne {
+	huo.mutation.SetTransactionID(s)
+	return huo
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (huo *HoldUpdateOne) SetNillableTransactionID(s *string) *HoldUpdateOne {
+	if s != nil {
+		huo.SetTransactionID(*s)
+	}
+	return huo
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (huo *HoldUpdateOne) ClearTransactionID() *HoldUpdateOne {
+	huo.mutation.ClearTransactionID()
+	return huo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (huo *HoldUpdateOne) SetUpdatedAt(t time.Time) *HoldUpdateOne {
+	huo.mutation.SetUpdatedAt(t)
+	return huo
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (huo *HoldUpdateOne) SetTransaction(t *Transaction) *HoldUpdateOne {
+	return huo.SetTransactionID(t.ID)
+}
+
+// Mutation returns the HoldMutation object of the builder.
+func (huo *HoldUpdateOne) Mutation() *HoldMutation {
+	return huo.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (huo *HoldUpdateOne) ClearTransaction() *HoldUpdateOne {
+	huo.mutation.ClearTransaction()
+	return huo
+}
+
+// Where appends a list predicates to the HoldUpdate builder.
+func (huo *HoldUpdateOne) Where(ps ...predicate.Hold) *HoldUpdateOne {
+	huo.mutation.Where(ps...)
+	return huo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (huo *HoldUpdateOne) Select(field string, fields ...string) *HoldUpdateOne {
+	huo.fields = append([]string{field}, fields...)
+	return huo
+}
+
+// Save executes the query and returns the updated Hold entity.
+func (huo *HoldUpdateOne) Save(ctx context.Context) (*Hold, error) {
+	huo.defaults()
+	return withHooks(ctx, huo.sqlSave, huo.mutation, huo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (huo *HoldUpdateOne) SaveX(ctx context.Context) *Hold {
+	node, err := huo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (huo *HoldUpdateOne) Exec(ctx context.Context) error {
+	_, err := huo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (huo *HoldUpdateOne) ExecX(ctx context.Context) {
+	if err := huo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (huo *HoldUpdateOne) defaults() {
+	if _, ok := huo.mutation.UpdatedAt(); !ok {
+		v := hold.UpdateDefaultUpdatedAt()
+		huo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (huo *HoldUpdateOne) check() error {
+	if v, ok := huo.mutation.Status(); ok {
+		if err := hold.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Hold.status": %w`, err)}
+		}
+	}
+	if huo.mutation.UserCleared() && len(huo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Hold.user"`)
+	}
+	return nil
+}
+
+func (huo *HoldUpdateOne) sqlSave(ctx context.Context) (_node *Hold, err error) {
+	if err := huo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hold.Table, hold.Columns, sqlgraph.NewFieldSpec(hold.FieldID, field.TypeString))
+	id, ok := huo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Hold.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := huo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hold.FieldID)
+		for _, f := range fields {
+			if !hold.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != hold.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := huo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := huo.mutation.CapturedAmount(); ok {
+		_spec.SetField(hold.FieldCapturedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := huo.mutation.AddedCapturedAmount(); ok {
+		_spec.AddField(hold.FieldCapturedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := huo.mutation.Status(); ok {
+		_spec.SetField(hold.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := huo.mutation.UpdatedAt(); ok {
+		_spec.SetField(hold.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if huo.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hold.TransactionTable,
+			Columns: []string{hold.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := huo.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hold.TransactionTable,
+			Columns: []string{hold.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Hold{config: huo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, huo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hold.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	huo.mutation.done = true
+	return _node, nil
+}
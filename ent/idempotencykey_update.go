@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/idempotencykey"
+	"accounting/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IdempotencyKeyUpdate is the builder for updating IdempotencyKey entities.
+type IdempotencyKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// Where appends a list predicates to the IdempotencyKeyUpdate builder.
+func (iku *IdempotencyKeyUpdate) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdate {
+	iku.mutation.Where(ps...)
+	return iku
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (iku *IdempotencyKeyUpdate) SetResponseStatus(i int) *IdempotencyKeyUpdate {
+	iku.mutation.ResetResponseStatus()
+	iku.mutation.SetResponseStatus(i)
+	return iku
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableResponseStatus(i *int) *IdempotencyKeyUpdate {
+	if i != nil {
+		iku.SetResponseStatus(*i)
+	}
+	return iku
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (iku *IdempotencyKeyUpdate) AddResponseStatus(i int) *IdempotencyKeyUpdate {
+	iku.mutation.AddResponseStatus(i)
+	return iku
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (iku *IdempotencyKeyUpdate) ClearResponseStatus() *IdempotencyKeyUpdate {
+	iku.mutation.ClearResponseStatus()
+	return iku
+}
+
+// SetResponseBody sets the "response_body" field.
+func (iku *IdempotencyKeyUpdate) SetResponseBody(b []byte) *IdempotencyKeyUpdate {
+	iku.mutation.SetResponseBody(b)
+	return iku
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (iku *IdempotencyKeyUpdate) ClearResponseBody() *IdempotencyKeyUpdate {
+	iku.mutation.ClearResponseBody()
+	return iku
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (iku *IdempotencyKeyUpdate) SetLockedUntil(t time.Time) *IdempotencyKeyUpdate {
+	iku.mutation.SetLockedUntil(t)
+	return iku
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableLockedUntil(t *time.Time) *IdempotencyKeyUpdate {
+	if t != nil {
+		iku.SetLockedUntil(*t)
+	}
+	return iku
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (iku *IdempotencyKeyUpdate) ClearLockedUntil() *IdempotencyKeyUpdate {
+	iku.mutation.ClearLockedUntil()
+	return iku
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (iku *IdempotencyKeyUpdate) SetCompletedAt(t time.Time) *IdempotencyKeyUpdate {
+	iku.mutation.SetCompletedAt(t)
+	return iku
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableCompletedAt(t *time.Time) *IdempotencyKeyUpdate {
+	if t != nil {
+		iku.SetCompletedAt(*t)
+	}
+	return iku
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (iku *IdempotencyKeyUpdate) ClearCompletedAt() *IdempotencyKeyUpdate {
+	iku.mutation.ClearCompletedAt()
+	return iku
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (iku *IdempotencyKeyUpdate) Mutation() *IdempotencyKeyMutation {
+	return iku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iku *IdempotencyKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iku.sqlSave, iku.mutation, iku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := iku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iku *IdempotencyKeyUpdate) Exec(ctx context.Context) error {
+	_, err := iku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) ExecX(ctx context.Context) {
+	if err := iku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iku *IdempotencyKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(idempotencykey.Table, idempotencykey.Columns, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeString))
+	if ps := iku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iku.mutation.ResponseStatus(); ok {
+		_spec.SetField(idempotencykey.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := iku.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(idempotencykey.FieldResponseStatus, field.TypeInt, value)
+	}
+	if iku.mutation.ResponseStatusCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseStatus, field.TypeInt)
+	}
+	if value, ok := iku.mutation.ResponseBody(); ok {
+		_spec.SetField(idempotencykey.FieldResponseBody, field.TypeBytes, value)
+	}
+	if iku.mutation.ResponseBodyCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseBody, field.TypeBytes)
+	}
+	if value, ok := iku.mutation.LockedUntil(); ok {
+		_spec.SetField(idempotencykey.FieldLockedUntil, field.TypeTime, value)
+	}
+	if iku.mutation.LockedUntilCleared() {
+		_spec.ClearField(idempotencykey.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := iku.mutation.CompletedAt(); ok {
+		_spec.SetField(idempotencykey.FieldCompletedAt, field.TypeTime, value)
+	}
+	if iku.mutation.CompletedAtCleared() {
+		_spec.ClearField(idempotencykey.FieldCompletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iku.mutation.done = true
+	return n, nil
+}
+
+// IdempotencyKeyUpdateOne is the builder for updating a single IdempotencyKey entity.
+type IdempotencyKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetResponseStatus(i int) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ResetResponseStatus()
+	ikuo.mutation.SetResponseStatus(i)
+	return ikuo
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableResponseStatus(i *int) *IdempotencyKeyUpdateOne {
+	if i != nil {
+		ikuo.SetResponseStatus(*i)
+	}
+	return ikuo
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (ikuo *IdempotencyKeyUpdateOne) AddResponseStatus(i int) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.AddResponseStatus(i)
+	return ikuo
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearResponseStatus() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearResponseStatus()
+	return ikuo
+}
+
+// SetResponseBody sets the "response_body" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetResponseBody(b []byte) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetResponseBody(b)
+	return ikuo
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearResponseBody() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearResponseBody()
+	return ikuo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetLockedUntil(t time.Time) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetLockedUntil(t)
+	return ikuo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableLockedUntil(t *time.Time) *IdempotencyKeyUpdateOne {
+	if t != nil {
+		ikuo.SetLockedUntil(*t)
+	}
+	return ikuo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearLockedUntil() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearLockedUntil()
+	return ikuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetCompletedAt(t time.Time) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetCompletedAt(t)
+	return ikuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableCompletedAt(t *time.Time) *IdempotencyKeyUpdateOne {
+	if t != nil {
+		ikuo.SetCompletedAt(*t)
+	}
+	return ikuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearCompletedAt() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearCompletedAt()
+	return ikuo
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (ikuo *IdempotencyKeyUpdateOne) Mutation() *IdempotencyKeyMutation {
+	return ikuo.mutation
+}
+
+// Where appends a list predicates to the IdempotencyKeyUpdate builder.
+func (ikuo *IdempotencyKeyUpdateOne) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.Where(ps...)
+	return ikuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ikuo *IdempotencyKeyUpdateOne) Select(field string, fields ...string) *IdempotencyKeyUpdateOne {
+	ikuo.fields = append([]string{field}, fields...)
+	return ikuo
+}
+
+// Save executes the query and returns the updated IdempotencyKey entity.
+func (ikuo *IdempotencyKeyUpdateOne) Save(ctx context.Context) (*IdempotencyKey, error) {
+	return withHooks(ctx, ikuo.sqlSave, ikuo.mutation, ikuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) SaveX(ctx context.Context) *IdempotencyKey {
+	node, err := ikuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ikuo *IdempotencyKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := ikuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := ikuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ikuo *IdempotencyKeyUpdateOne) sqlSave(ctx context.Context) (_node *IdempotencyKey, err error) {
+	_spec := sqlgraph.NewUpdateSpec(idempotencykey.Table, idempotencykey.Columns, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeString))
+	id, ok := ikuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IdempotencyKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ikuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, idempotencykey.FieldID)
+		for _, f := range fields {
+			if !idempotencykey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != idempotencykey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ikuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ikuo.mutation.ResponseStatus(); ok {
+		_spec.SetField(idempotencykey.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := ikuo.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(idempotencykey.FieldResponseStatus, field.TypeInt, value)
+	}
+	if ikuo.mutation.ResponseStatusCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseStatus, field.TypeInt)
+	}
+	if value, ok := ikuo.mutation.ResponseBody(); ok {
+		_spec.SetField(idempotencykey.FieldResponseBody, field.TypeBytes, value)
+	}
+	if ikuo.mutation.ResponseBodyCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseBody, field.TypeBytes)
+	}
+	if value, ok := ikuo.mutation.LockedUntil(); ok {
+		_spec.SetField(idempotencykey.FieldLockedUntil, field.TypeTime, value)
+	}
+	if ikuo.mutation.LockedUntilCleared() {
+		_spec.ClearField(idempotencykey.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := ikuo.mutation.CompletedAt(); ok {
+		_spec.SetField(idempotencykey.FieldCompletedAt, field.TypeTime, value)
+	}
+	if ikuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(idempotencykey.FieldCompletedAt, field.TypeTime)
+	}
+	_node = &IdempotencyKey{config: ikuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ikuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ikuo.mutation.done = true
+	return _node, nil
+}
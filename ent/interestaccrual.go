@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/balance"
+	"accounting/ent/interestaccrual"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// InterestAccrual is the model entity for the InterestAccrual schema.
+type InterestAccrual struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID of the balance earning the interest
+	BalanceID int `json:"balance_id,omitempty"`
+	// Day the interest was earned on
+	Date time.Time `json:"date,omitempty"`
+	// Balance amount at the end of the day
+	Principal decimal.Decimal `json:"principal,omitempty"`
+	// Annual rate of the plan on the day, in percent
+	AnnualPercentage decimal.Decimal `json:"annual_percentage,omitempty"`
+	// Day-count convention of the plan on the day
+	DayCount interestaccrual.DayCount `json:"day_count,omitempty"`
+	// Interest earned on the day, unrounded
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// ID of the interest transaction that paid the accrual out
+	TransactionID *string `json:"transaction_id,omitempty"`
+	// Time of the accrual
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InterestAccrualQuery when eager-loading is set.
+	Edges        InterestAccrualEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// InterestAccrualEdges holds the relations/edges for other nodes in the graph.
+type InterestAccrualEdges struct {
+	// Balance earning the interest
+	Balance *Balance `json:"balance,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BalanceOrErr returns the Balance value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InterestAccrualEdges) BalanceOrErr() (*Balance, error) {
+	if e.Balance != nil {
+		return e.Balance, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: balance.Label}
+	}
+	return nil, &NotLoadedError{edge: "balance"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InterestAccrual) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case interestaccrual.FieldPrincipal, interestaccrual.FieldAnnualPercentage, interestaccrual.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case interestaccrual.FieldID, interestaccrual.FieldBalanceID:
+			values[i] = new(sql.NullInt64)
+		case interestaccrual.FieldDayCount, interestaccrual.FieldTransactionID:
+			values[i] = new(sql.NullString)
+		case interestaccrual.FieldDate, interestaccrual.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InterestAccrual fields.
+func (ia *InterestAccrual) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case interestaccrual.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ia.ID = int(value.Int64)
+		case interestaccrual.FieldBalanceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_id", values[i])
+			} else if value.Valid {
+				ia.BalanceID = int(value.Int64)
+			}
+		case interestaccrual.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				ia.Date = value.Time
+			}
+		case interestaccrual.FieldPrincipal:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field principal", values[i])
+			} else if value != nil {
+				ia.Principal = *value
+			}
+		case interestaccrual.FieldAnnualPercentage:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field annual_percentage", values[i])
+			} else if value != nil {
+				ia.AnnualPercentage = *value
+			}
+		case interestaccrual.FieldDayCount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field day_count", values[i])
+			} else if value.Valid {
+				ia.DayCount = interestaccrual.DayCount(value.String)
+			}
+		case interestaccrual.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				ia.Amount = *value
+			}
+		case interestaccrual.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				ia.TransactionID = new(string)
+				*ia.TransactionID = value.String
+			}
+		case interestaccrual.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ia.CreatedAt = value.Time
+			}
+		default:
+			ia.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InterestAccrual.
+// This includes values selected through modifiers, order, etc.
+func (ia *InterestAccrual) Value(name string) (ent.Value, error) {
+	return ia.selectValues.Get(name)
+}
+
+// QueryBalance queries the "balance" edge of the InterestAccrual entity.
+func (ia *InterestAccrual) QueryBalance() *BalanceQuery {
+	return NewInterestAccrualClient(ia.config).QueryBalance(ia)
+}
+
+// Update returns a builder for updating this InterestAccrual.
+// Note that you need to call InterestAccrual.Unwrap() before calling this method if this InterestAccrual
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ia *InterestAccrual) Update() *InterestAccrualUpdateOne {
+	return NewInterestAccrualClient(ia.config).UpdateOne(ia)
+}
+
+// Unwrap unwraps the InterestAccrual entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ia *InterestAccrual) Unwrap() *InterestAccrual {
+	_tx, ok := ia.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InterestAccrual is not a transactional entity")
+	}
+	ia.config.driver = _tx.drv
+	return ia
+}
+
+// String implements the fmt.Stringer.
+func (ia *InterestAccrual) String() string {
+	var builder strings.Builder
+	builder.WriteString("InterestAccrual(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ia.ID))
+	builder.WriteString("balance_id=")
+	builder.WriteString(fmt.Sprintf("%v", ia.BalanceID))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(ia.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("principal=")
+	builder.WriteString(fmt.Sprintf("%v", ia.Principal))
+	builder.WriteString(", ")
+	builder.WriteString("annual_percentage=")
+	builder.WriteString(fmt.Sprintf("%v", ia.AnnualPercentage))
+	builder.WriteString(", ")
+	builder.WriteString("day_count=")
+	builder.WriteString(fmt.Sprintf("%v", ia.DayCount))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", ia.Amount))
+	builder.WriteString(", ")
+	if v := ia.TransactionID; v != nil {
+		builder.WriteString("transaction_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ia.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InterestAccruals is a parsable slice of InterestAccrual.
+type InterestAccruals []*InterestAccrual
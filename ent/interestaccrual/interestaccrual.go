@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package interestaccrual
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the interestaccrual type in the database.
+	Label = "interest_accrual"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBalanceID holds the string denoting the balance_id field in the database.
+	FieldBalanceID = "balance_id"
+	// FieldDate holds the string denoting the date field in the database.
+	FieldDate = "date"
+	// FieldPrincipal holds the string denoting the principal field in the database.
+	FieldPrincipal = "principal"
+	// FieldAnnualPercentage holds the string denoting the annual_percentage field in the database.
+	FieldAnnualPercentage = "annual_percentage"
+	// FieldDayCount holds the string denoting the day_count field in the database.
+	FieldDayCount = "day_count"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldTransactionID holds the string denoting the transaction_id field in the database.
+	FieldTransactionID = "transaction_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeBalance holds the string denoting the balance edge name in mutations.
+	EdgeBalance = "balance"
+	// Table holds the table name of the interestaccrual in the database.
+	Table = "interest_accruals"
+	// BalanceTable is the table that holds the balance relation/edge.
+	BalanceTable = "interest_accruals"
+	// BalanceInverseTable is the table name for the Balance entity.
+	// It exists in this package in order to avoid circular dependency with the "balance" package.
+	BalanceInverseTable = "balances"
+	// BalanceColumn is the table column denoting the balance relation/edge.
+	BalanceColumn = "balance_id"
+)
+
+// Columns holds all SQL columns for interestaccrual fields.
+var Columns = []string{
+	FieldID,
+	FieldBalanceID,
+	FieldDate,
+	FieldPrincipal,
+	FieldAnnualPercentage,
+	FieldDayCount,
+	FieldAmount,
+	FieldTransactionID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// DayCount defines the type for the "day_count" enum field.
+type DayCount string
+
+// DayCount values.
+const (
+	DayCountAct365    DayCount = "act_365"
+	DayCountThirty360 DayCount = "thirty_360"
+)
+
+func (dc DayCount) String() string {
+	return string(dc)
+}
+
+// DayCountValidator is a validator for the "day_count" field enum values. It is called by the builders before save.
+func DayCountValidator(dc DayCount) error {
+	switch dc {
+	case DayCountAct365, DayCountThirty360:
+		return nil
+	default:
+		return fmt.Errorf("interestaccrual: invalid enum value for day_count field: %q", dc)
+	}
+}
+
+// OrderOption defines the ordering options for the InterestAccrual queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBalanceID orders the results by the balance_id field.
+func ByBalanceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalanceID, opts...).ToFunc()
+}
+
+// ByDate orders the results by the date field.
+func ByDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDate, opts...).ToFunc()
+}
+
+// ByPrincipal orders the results by the principal field.
+func ByPrincipal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrincipal, opts...).ToFunc()
+}
+
+// ByAnnualPercentage orders the results by the annual_percentage field.
+func ByAnnualPercentage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnnualPercentage, opts...).ToFunc()
+}
+
+// ByDayCount orders the results by the day_count field.
+func ByDayCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDayCount, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByTransactionID orders the results by the transaction_id field.
+func ByTransactionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByBalanceField orders the results by balance field.
+func ByBalanceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBalanceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBalanceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BalanceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BalanceTable, BalanceColumn),
+	)
+}
@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package interestaccrual
+
+import (
+	"accounting/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLTE(FieldID, id))
+}
+
+// BalanceID applies equality check predicate on the "balance_id" field. It's identical to BalanceIDEQ.
+func BalanceID(v int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldBalanceID, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldDate, v))
+}
+
+// Principal applies equality check predicate on the "principal" field. It's identical to PrincipalEQ.
+func Principal(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldPrincipal, v))
+}
+
+// AnnualPercentage applies equality check predicate on the "annual_percentage" field. It's identical to AnnualPercentageEQ.
+func AnnualPercentage(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldAnnualPercentage, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldAmount, v))
+}
+
+// TransactionID applies equality check predicate on the "transaction_id" field. It's identical to TransactionIDEQ.
+func TransactionID(v string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BalanceIDEQ applies the EQ predicate on the "balance_id" field.
+func BalanceIDEQ(v int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldBalanceID, v))
+}
+
+// BalanceIDNEQ applies the NEQ predicate on the "balance_id" field.
+func BalanceIDNEQ(v int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNEQ(FieldBalanceID, v))
+}
+
+// BalanceIDIn applies the In predicate on the "balance_id" field.
+func BalanceIDIn(vs ...int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldIn(FieldBalanceID, vs...))
+}
+
+// BalanceIDNotIn applies the NotIn predicate on the "balance_id" field.
+func BalanceIDNotIn(vs ...int) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNotIn(FieldBalanceID, vs...))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLTE(FieldDate, v))
+}
+
+// PrincipalEQ applies the EQ predicate on the "principal" field.
+func PrincipalEQ(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldPrincipal, v))
+}
+
+// PrincipalNEQ applies the NEQ predicate on the "principal" field.
+func PrincipalNEQ(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNEQ(FieldPrincipal, v))
+}
+
+// PrincipalIn applies the In predicate on the "principal" field.
+func PrincipalIn(vs ...decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldIn(FieldPrincipal, vs...))
+}
+
+// PrincipalNotIn applies the NotIn predicate on the "principal" field.
+func PrincipalNotIn(vs ...decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNotIn(FieldPrincipal, vs...))
+}
+
+// PrincipalGT applies the GT predicate on the "principal" field.
+func PrincipalGT(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGT(FieldPrincipal, v))
+}
+
+// PrincipalGTE applies the GTE predicate on the "principal" field.
+func PrincipalGTE(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGTE(FieldPrincipal, v))
+}
+
+// PrincipalLT applies the LT predicate on the "principal" field.
+func PrincipalLT(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLT(FieldPrincipal, v))
+}
+
+// PrincipalLTE applies the LTE predicate on the "principal" field.
+func PrincipalLTE(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLTE(FieldPrincipal, v))
+}
+
+// AnnualPercentageEQ applies the EQ predicate on the "annual_percentage" field.
+func AnnualPercentageEQ(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldAnnualPercentage, v))
+}
+
+// AnnualPercentageNEQ applies the NEQ predicate on the "annual_percentage" field.
+func AnnualPercentageNEQ(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNEQ(FieldAnnualPercentage, v))
+}
+
+// AnnualPercentageIn applies the In predicate on the "annual_percentage" field.
+func AnnualPercentageIn(vs ...decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldIn(FieldAnnualPercentage, vs...))
+}
+
+// AnnualPercentageNotIn applies the NotIn predicate on the "annual_percentage" field.
+func AnnualPercentageNotIn(vs ...decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNotIn(FieldAnnualPercentage, vs...))
+}
+
+// AnnualPercentageGT applies the GT predicate on the "annual_percentage" field.
+func AnnualPercentageGT(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGT(FieldAnnualPercentage, v))
+}
+
+// AnnualPercentageGTE applies the GTE predicate on the "annual_percentage" field.
+func AnnualPercentageGTE(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGTE(FieldAnnualPercentage, v))
+}
+
+// AnnualPercentageLT applies the LT predicate on the "annual_percentage" field.
+func AnnualPercentageLT(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLT(FieldAnnualPercentage, v))
+}
+
+// AnnualPercentageLTE applies the LTE predicate on the "annual_percentage" field.
+func AnnualPercentageLTE(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLTE(FieldAnnualPercentage, v))
+}
+
+// DayCountEQ applies the EQ predicate on the "day_count" field.
+func DayCountEQ(v DayCount) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldDayCount, v))
+}
+
+// DayCountNEQ applies the NEQ predicate on the "day_count" field.
+func DayCountNEQ(v DayCount) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNEQ(FieldDayCount, v))
+}
+
+// DayCountIn applies the In predicate on the "day_count" field.
+func DayCountIn(vs ...DayCount) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldIn(FieldDayCount, vs...))
+}
+
+// DayCountNotIn applies the NotIn predicate on the "day_count" field.
+func DayCountNotIn(vs ...DayCount) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNotIn(FieldDayCount, vs...))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLTE(FieldAmount, v))
+}
+
+// TransactionIDEQ applies the EQ predicate on the "transaction_id" field.
+func TransactionIDEQ(v string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TransactionIDNEQ applies the NEQ predicate on the "transaction_id" field.
+func TransactionIDNEQ(v string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNEQ(FieldTransactionID, v))
+}
+
+// TransactionIDIn applies the In predicate on the "transaction_id" field.
+func TransactionIDIn(vs ...string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDNotIn applies the NotIn predicate on the "transaction_id" field.
+func TransactionIDNotIn(vs ...string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNotIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDGT applies the GT predicate on the "transaction_id" field.
+func TransactionIDGT(v string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGT(FieldTransactionID, v))
+}
+
+// TransactionIDGTE applies the GTE predicate on the "transaction_id" field.
+func TransactionIDGTE(v string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGTE(FieldTransactionID, v))
+}
+
+// TransactionIDLT applies the LT predicate on the "transaction_id" field.
+func TransactionIDLT(v string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLT(FieldTransactionID, v))
+}
+
+// TransactionIDLTE applies the LTE predicate on the "transaction_id" field.
+func TransactionIDLTE(v string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLTE(FieldTransactionID, v))
+}
+
+// TransactionIDContains applies the Contains predicate on the "transaction_id" field.
+func TransactionIDContains(v string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldContains(FieldTransactionID, v))
+}
+
+// TransactionIDHasPrefix applies the HasPrefix predicate on the "transaction_id" field.
+func TransactionIDHasPrefix(v string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldHasPrefix(FieldTransactionID, v))
+}
+
+// TransactionIDHasSuffix applies the HasSuffix predicate on the "transaction_id" field.
+func TransactionIDHasSuffix(v string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldHasSuffix(FieldTransactionID, v))
+}
+
+// TransactionIDIsNil applies the IsNil predicate on the "transaction_id" field.
+func TransactionIDIsNil() predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldIsNull(FieldTransactionID))
+}
+
+// TransactionIDNotNil applies the NotNil predicate on the "transaction_id" field.
+func TransactionIDNotNil() predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNotNull(FieldTransactionID))
+}
+
+// TransactionIDEqualFold applies the EqualFold predicate on the "transaction_id" field.
+func TransactionIDEqualFold(v string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEqualFold(FieldTransactionID, v))
+}
+
+// TransactionIDContainsFold applies the ContainsFold predicate on the "transaction_id" field.
+func TransactionIDContainsFold(v string) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldContainsFold(FieldTransactionID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasBalance applies the HasEdge predicate on the "balance" edge.
+func HasBalance() predicate.InterestAccrual {
+	return predicate.InterestAccrual(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BalanceTable, BalanceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBalanceWith applies the HasEdge predicate on the "balance" edge with a given conditions (other predicates).
+func HasBalanceWith(preds ...predicate.Balance) predicate.InterestAccrual {
+	return predicate.InterestAccrual(func(s *sql.Selector) {
+		step := newBalanceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InterestAccrual) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InterestAccrual) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InterestAccrual) predicate.InterestAccrual {
+	return predicate.InterestAccrual(sql.NotPredicates(p))
+}
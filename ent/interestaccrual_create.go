@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/balance"
+	"accounting/ent/interestaccrual"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// InterestAccrualCreate is the builder for creating a InterestAccrual entity.
+type InterestAccrualCreate struct {
+	config
+	mutation *InterestAccrualMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetBalanceID sets the "balance_id" field.
+func (iac *InterestAccrualCreate) SetBalanceID(i int) *InterestAccrualCreate {
+	iac.mutation.SetBalanceID(i)
+	return iac
+}
+
+// SetDate sets the "date" field.
+func (iac *InterestAccrualCreate) SetDate(t time.Time) *InterestAccrualCreate {
+	iac.mutation.SetDate(t)
+	return iac
+}
+
+// SetPrincipal sets the "principal" field.
+func (iac *InterestAccrualCreate) SetPrincipal(d decimal.Decimal) *InterestAccrualCreate {
+	iac.mutation.SetPrincipal(d)
+	return iac
+}
+
+// SetAnnualPercentage sets the "annual_percentage" field.
+func (iac *InterestAccrualCreate) SetAnnualPercentage(d decimal.Decimal) *InterestAccrualCreate {
+	iac.mutation.SetAnnualPercentage(d)
+	return iac
+}
+
+// SetDayCount sets the "day_count" field.
+func (iac *InterestAccrualCreate) SetDayCount(ic interestaccrual.DayCount) *InterestAccrualCreate {
+	iac.mutation.SetDayCount(ic)
+	return iac
+}
+
+// SetAmount sets the "amount" field.
+func (iac *InterestAccrualCreate) SetAmount(d decimal.Decimal) *InterestAccrualCreate {
+	iac.mutation.SetAmount(d)
+	return iac
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (iac *InterestAccrualCreate) SetTransactionID(s string) *InterestAccrualCreate {
+	iac.mutation.SetTransactionID(s)
+	return iac
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (iac *InterestAccrualCreate) SetNillableTransactionID(s *string) *InterestAccrualCreate {
+	if s != nil {
+		iac.SetTransactionID(*s)
+	}
+	return iac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iac *InterestAccrualCreate) SetCreatedAt(t time.Time) *InterestAccrualCreate {
+	iac.mutation.SetCreatedAt(t)
+	return iac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iac *InterestAccrualCreate) SetNillableCreatedAt(t *time.Time) *InterestAccrualCreate {
+	if t != nil {
+		iac.SetCreatedAt(*t)
+	}
+	return iac
+}
+
+// SetBalance sets the "balance" edge to the Balance entity.
+func (iac *InterestAccrualCreate) SetBalance(b *Balance) *InterestAccrualCreate {
+	return iac.SetBalanceID(b.ID)
+}
+
+// Mutation returns the InterestAccrualMutation object of the builder.
+func (iac *InterestAccrualCreate) Mutation() *InterestAccrualMutation {
+	return iac.mutation
+}
+
+// Save creates the InterestAccrual in the database.
+func (iac *InterestAccrualCreate) Save(ctx context.Context) (*InterestAccrual, error) {
+	iac.defaults()
+	return withHooks(ctx, iac.sqlSave, iac.mutation, iac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iac *InterestAccrualCreate) SaveX(ctx context.Context) *InterestAccrual {
+	v, err := iac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iac *InterestAccrualCreate) Exec(ctx context.Context) error {
+	_, err := iac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iac *InterestAccrualCreate) ExecX(ctx context.Context) {
+	if err := iac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iac *InterestAccrualCreate) defaults() {
+	if _, ok := iac.mutation.CreatedAt(); !ok {
+		v := interestaccrual.DefaultCreatedAt()
+		iac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iac *InterestAccrualCreate) check() error {
+	if _, ok := iac.mutation.BalanceID(); !ok {
+		return &ValidationError{Name: "balance_id", err: errors.New(`ent: missing required field "InterestAccrual.balance_id"`)}
+	}
+	if _, ok := iac.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "InterestAccrual.date"`)}
+	}
+	if _, ok := iac.mutation.Principal(); !ok {
+		return &ValidationError{Name: "principal", err: errors.New(`ent: missing required field "InterestAccrual.principal"`)}
+	}
+	if _, ok := iac.mutation.AnnualPercentage(); !ok {
+		return &ValidationError{Name: "annual_percentage", err: errors.New(`ent: missing required field "InterestAccrual.annual_percentage"`)}
+	}
+	if _, ok := iac.mutation.DayCount(); !ok {
+		return &ValidationError{Name: "day_count", err: errors.New(`ent: missing required field "InterestAccrual.day_count"`)}
+	}
+	if v, ok := iac.mutation.DayCount(); ok {
+		if err := interestaccrual.DayCountValidator(v); err != nil {
+			return &ValidationError{Name: "day_count", err: fmt.Errorf(`ent: validator failed for field "InterestAccrual.day_count": %w`, err)}
+		}
+	}
+	if _, ok := iac.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "InterestAccrual.amount"`)}
+	}
+	if _, ok := iac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InterestAccrual.created_at"`)}
+	}
+	if len(iac.mutation.BalanceIDs()) == 0 {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required edge "InterestAccrual.balance"`)}
+	}
+	return nil
+}
+
+func (iac *InterestAccrualCreate) sqlSave(ctx context.Context) (*InterestAccrual, error) {
+	if err := iac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	iac.mutation.id = &_node.ID
+	iac.mutation.done = true
+	return _node, nil
+}
+
+func (iac *InterestAccrualCreate) createSpec() (*InterestAccrual, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InterestAccrual{config: iac.config}
+		_spec = sqlgraph.NewCreateSpec(interestaccrual.Table, sqlgraph.NewFieldSpec(interestaccrual.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = iac.conflict
+	if value, ok := iac.mutation.Date(); ok {
+		_spec.SetField(interestaccrual.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := iac.mutation.Principal(); ok {
+		_spec.SetField(interestaccrual.FieldPrincipal, field.TypeFloat64, value)
+		_node.Principal = value
+	}
+	if value, ok := iac.mutation.AnnualPercentage(); ok {
+		_spec.SetField(interestaccrual.FieldAnnualPercentage, field.TypeFloat64, value)
+		_node.AnnualPercentage = value
+	}
+	if value, ok := iac.mutation.DayCount(); ok {
+		_spec.SetField(interestaccrual.FieldDayCount, field.TypeEnum, value)
+		_node.DayCount = value
+	}
+	if value, ok := iac.mutation.Amount(); ok {
+		_spec.SetField(interestaccrual.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := iac.mutation.TransactionID(); ok {
+		_spec.SetField(interestaccrual.FieldTransactionID, field.TypeString, value)
+		_node.TransactionID = &value
+	}
+	if value, ok := iac.mutation.CreatedAt(); ok {
+		_spec.SetField(interestaccrual.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := iac.mutation.BalanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   interestaccrual.BalanceTable,
+			Columns: []string{interestaccrual.BalanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BalanceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InterestAccrual.Create().
+//		SetBalanceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InterestAccrualUpsert) {
+//			SetBalanceID(v+v).
+//		}).
+//		Exec(ctx)
+func (iac *InterestAccrualCreate) OnConflict(opts ...sql.ConflictOption) *InterestAccrualUpsertOne {
+	iac.conflict = opts
+	return &InterestAccrualUpsertOne{
+		create: iac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InterestAccrual.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (iac *InterestAccrualCreate) OnConflictColumns(columns ...string) *InterestAccrualUpsertOne {
+	iac.conflict = append(iac.conflict, sql.ConflictColumns(columns...))
+	return &InterestAccrualUpsertOne{
+		create: iac,
+	}
+}
+
+type (
+	// InterestAccrualUpsertOne is the builder for "upsert"-ing
+	//  one InterestAccrual node.
+	InterestAccrualUpsertOne struct {
+		create *InterestAccrualCreate
+	}
+
+	// InterestAccrualUpsert is the "OnConflict" setter.
+	InterestAccrualUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *InterestAccrualUpsert) SetTransactionID(v string) *InterestAccrualUpsert {
+	u.Set(interestaccrual.FieldTransactionID, v)
+	return u
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *InterestAccrualUpsert) UpdateTransactionID() *InterestAccrualUpsert {
+	u.SetExcluded(interestaccrual.FieldTransactionID)
+	return u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *InterestAccrualUpsert) ClearTransactionID() *InterestAccrualUpsert {
+	u.SetNull(interestaccrual.FieldTransactionID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.InterestAccrual.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InterestAccrualUpsertOne) UpdateNewValues() *InterestAccrualUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.BalanceID(); exists {
+			s.SetIgnore(interestaccrual.FieldBalanceID)
+		}
+		if _, exists := u.create.mutation.Date(); exists {
+			s.SetIgnore(interestaccrual.FieldDate)
+		}
+		if _, exists := u.create.mutation.Principal(); exists {
+			s.SetIgnore(interestaccrual.FieldPrincipal)
+		}
+		if _, exists := u.create.mutation.AnnualPercentage(); exists {
+			s.SetIgnore(interestaccrual.FieldAnnualPercentage)
+		}
+		if _, exists := u.create.mutation.DayCount(); exists {
+			s.SetIgnore(interestaccrual.FieldDayCount)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(interestaccrual.FieldAmount)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(interestaccrual.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InterestAccrual.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InterestAccrualUpsertOne) Ignore() *InterestAccrualUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InterestAccrualUpsertOne) DoNothing() *InterestAccrualUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InterestAccrualCreate.OnConflict
+// documentation for more info.
+func (u *InterestAccrualUpsertOne) Update(set func(*InterestAccrualUpsert)) *InterestAccrualUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InterestAccrualUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *InterestAccrualUpsertOne) SetTransactionID(v string) *InterestAccrualUpsertOne {
+	return u.Update(func(s *InterestAccrualUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *InterestAccrualUpsertOne) UpdateTransactionID() *InterestAccrualUpsertOne {
+	return u.Update(func(s *InterestAccrualUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *InterestAccrualUpsertOne) ClearTransactionID() *InterestAccrualUpsertOne {
+	return u.Update(func(s *InterestAccrualUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// Exec executes the query.
+func (u *InterestAccrualUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InterestAccrualCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InterestAccrualUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InterestAccrualUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InterestAccrualUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InterestAccrualCreateBulk is the builder for creating many InterestAccrual entities in bulk.
+type InterestAccrualCreateBulk struct {
+	config
+	err      error
+	builders []*InterestAccrualCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InterestAccrual entities in the database.
+func (iacb *InterestAccrualCreateBulk) Save(ctx context.Context) ([]*InterestAccrual, error) {
+	if iacb.err != nil {
+		return nil, iacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iacb.builders))
+	nodes := make([]*InterestAccrual, len(iacb.builders))
+	mutators := make([]Mutator, len(iacb.builders))
+	for i := range iacb.builders {
+		func(i int, root context.Context) {
+			builder := iacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InterestAccrualMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = iacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iacb *InterestAccrualCreateBulk) SaveX(ctx context.Context) []*InterestAccrual {
+	v, err := iacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iacb *InterestAccrualCreateBulk) Exec(ctx context.Context) error {
+	_, err := iacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iacb *InterestAccrualCreateBulk) ExecX(ctx context.Context) {
+	if err := iacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InterestAccrual.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InterestAccrualUpsert) {
+//			SetBalanceID(v+v).
+//		}).
+//		Exec(ctx)
+func (iacb *InterestAccrualCreateBulk) OnConflict(opts ...sql.ConflictOption) *InterestAccrualUpsertBulk {
+	iacb.conflict = opts
+	return &InterestAccrualUpsertBulk{
+		create: iacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InterestAccrual.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (iacb *InterestAccrualCreateBulk) OnConflictColumns(columns ...string) *InterestAccrualUpsertBulk {
+	iacb.conflict = append(iacb.conflict, sql.ConflictColumns(columns...))
+	return &InterestAccrualUpsertBulk{
+		create: iacb,
+	}
+}
+
+// InterestAccrualUpsertBulk is the builder for "upsert"-ing
+// a bulk of InterestAccrual nodes.
+type InterestAccrualUpsertBulk struct {
+	create *InterestAccrualCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InterestAccrual.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InterestAccrualUpsertBulk) UpdateNewValues() *InterestAccrualUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.BalanceID(); exists {
+				s.SetIgnore(interestaccrual.FieldBalanceID)
+			}
+			if _, exists := b.mutation.Date(); exists {
+				s.SetIgnore(interestaccrual.FieldDate)
+			}
+			if _, exists := b.mutation.Principal(); exists {
+				s.SetIgnore(interestaccrual.FieldPrincipal)
+			}
+			if _, exists := b.mutation.AnnualPercentage(); exists {
+				s.SetIgnore(interestaccrual.FieldAnnualPercentage)
+			}
+			if _, exists := b.mutation.DayCount(); exists {
+				s.SetIgnore(interestaccrual.FieldDayCount)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(interestaccrual.FieldAmount)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(interestaccrual.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InterestAccrual.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InterestAccrualUpsertBulk) Ignore() *InterestAccrualUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InterestAccrualUpsertBulk) DoNothing() *InterestAccrualUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InterestAccrualCreateBulk.OnConflict
+// documentation for more info.
+func (u *InterestAccrualUpsertBulk) Update(set func(*InterestAccrualUpsert)) *InterestAccrualUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InterestAccrualUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *InterestAccrualUpsertBulk) SetTransactionID(v string) *InterestAccrualUpsertBulk {
+	return u.Update(func(s *InterestAccrualUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *InterestAccrualUpsertBulk) UpdateTransactionID() *InterestAccrualUpsertBulk {
+	return u.Update(func(s *InterestAccrualUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *InterestAccrualUpsertBulk) ClearTransactionID() *InterestAccrualUpsertBulk {
+	return u.Update(func(s *InterestAccrualUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// Exec executes the query.
+func (u *InterestAccrualUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InterestAccrualCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InterestAccrualCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InterestAccrualUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
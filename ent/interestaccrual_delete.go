@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/interestaccrual"
+	"accounting/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InterestAccrualDelete is the builder for deleting a InterestAccrual entity.
+type InterestAccrualDelete struct {
+	config
+	hooks    []Hook
+	mutation *InterestAccrualMutation
+}
+
+// Where appends a list predicates to the InterestAccrualDelete builder.
+func (iad *InterestAccrualDelete) Where(ps ...predicate.InterestAccrual) *InterestAccrualDelete {
+	iad.mutation.Where(ps...)
+	return iad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (iad *InterestAccrualDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, iad.sqlExec, iad.mutation, iad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iad *InterestAccrualDelete) ExecX(ctx context.Context) int {
+	n, err := iad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (iad *InterestAccrualDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(interestaccrual.Table, sqlgraph.NewFieldSpec(interestaccrual.FieldID, field.TypeInt))
+	if ps := iad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, iad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	iad.mutation.done = true
+	return affected, err
+}
+
+// InterestAccrualDeleteOne is the builder for deleting a single InterestAccrual entity.
+type InterestAccrualDeleteOne struct {
+	iad *InterestAccrualDelete
+}
+
+// Where appends a list predicates to the InterestAccrualDelete builder.
+func (iado *InterestAccrualDeleteOne) Where(ps ...predicate.InterestAccrual) *InterestAccrualDeleteOne {
+	iado.iad.mutation.Where(ps...)
+	return iado
+}
+
+// Exec executes the deletion query.
+func (iado *InterestAccrualDeleteOne) Exec(ctx context.Context) error {
+	n, err := iado.iad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{interestaccrual.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iado *InterestAccrualDeleteOne) ExecX(ctx context.Context) {
+	if err := iado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/balance"
+	"accounting/ent/interestaccrual"
+	"accounting/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InterestAccrualQuery is the builder for querying InterestAccrual entities.
+type InterestAccrualQuery struct {
+	config
+	ctx         *QueryContext
+	order       []interestaccrual.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.InterestAccrual
+	withBalance *BalanceQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InterestAccrualQuery builder.
+func (iaq *InterestAccrualQuery) Where(ps ...predicate.InterestAccrual) *InterestAccrualQuery {
+	iaq.predicates = append(iaq.predicates, ps...)
+	return iaq
+}
+
+// Limit the number of records to be returned by this query.
+func (iaq *InterestAccrualQuery) Limit(limit int) *InterestAccrualQuery {
+	iaq.ctx.Limit = &limit
+	return iaq
+}
+
+// Offset to start from.
+func (iaq *InterestAccrualQuery) Offset(offset int) *InterestAccrualQuery {
+	iaq.ctx.Offset = &offset
+	return iaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iaq *InterestAccrualQuery) Unique(unique bool) *InterestAccrualQuery {
+	iaq.ctx.Unique = &unique
+	return iaq
+}
+
+// Order specifies how the records should be ordered.
+func (iaq *InterestAccrualQuery) Order(o ...interestaccrual.OrderOption) *InterestAccrualQuery {
+	iaq.order = append(iaq.order, o...)
+	return iaq
+}
+
+// QueryBalance chains the current query on the "balance" edge.
+func (iaq *InterestAccrualQuery) QueryBalance() *BalanceQuery {
+	query := (&BalanceClient{config: iaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(interestaccrual.Table, interestaccrual.FieldID, selector),
+			sqlgraph.To(balance.Table, balance.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, interestaccrual.BalanceTable, interestaccrual.BalanceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first InterestAccrual entity from the query.
+// Returns a *NotFoundError when no InterestAccrual was found.
+func (iaq *InterestAccrualQuery) First(ctx context.Context) (*InterestAccrual, error) {
+	nodes, err := iaq.Limit(1).All(setContextOp(ctx, iaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{interestaccrual.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iaq *InterestAccrualQuery) FirstX(ctx context.Context) *InterestAccrual {
+	node, err := iaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InterestAccrual ID from the query.
+// Returns a *NotFoundError when no InterestAccrual ID was found.
+func (iaq *InterestAccrualQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iaq.Limit(1).IDs(setContextOp(ctx, iaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{interestaccrual.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iaq *InterestAccrualQuery) FirstIDX(ctx context.Context) int {
+	id, err := iaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InterestAccrual entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InterestAccrual entity is found.
+// Returns a *NotFoundError when no InterestAccrual entities are found.
+func (iaq *InterestAccrualQuery) Only(ctx context.Context) (*InterestAccrual, error) {
+	nodes, err := iaq.Limit(2).All(setContextOp(ctx, iaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{interestaccrual.Label}
+	default:
+		return nil, &NotSingularError{interestaccrual.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iaq *InterestAccrualQuery) OnlyX(ctx context.Context) *InterestAccrual {
+	node, err := iaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InterestAccrual ID in the query.
+// Returns a *NotSingularError when more than one InterestAccrual ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iaq *InterestAccrualQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iaq.Limit(2).IDs(setContextOp(ctx, iaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{interestaccrual.Label}
+	default:
+		err = &NotSingularError{interestaccrual.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iaq *InterestAccrualQuery) OnlyIDX(ctx context.Context) int {
+	id, err := iaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InterestAccruals.
+func (iaq *InterestAccrualQuery) All(ctx context.Context) ([]*InterestAccrual, error) {
+	ctx = setContextOp(ctx, iaq.ctx, ent.OpQueryAll)
+	if err := iaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*InterestAccrual, *InterestAccrualQuery]()
+	return withInterceptors[[]*InterestAccrual](ctx, iaq, qr, iaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iaq *InterestAccrualQuery) AllX(ctx context.Context) []*InterestAccrual {
+	nodes, err := iaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InterestAccrual IDs.
+func (iaq *InterestAccrualQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if iaq.ctx.Unique == nil && iaq.path != nil {
+		iaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, iaq.ctx, ent.OpQueryIDs)
+	if err = iaq.Select(interestaccrual.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iaq *InterestAccrualQuery) IDsX(ctx context.Context) []int {
+	ids, err := iaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iaq *InterestAccrualQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, iaq.ctx, ent.OpQueryCount)
+	if err := iaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, iaq, querierCount[*InterestAccrualQuery](), iaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iaq *InterestAccrualQuery) CountX(ctx context.Context) int {
+	count, err := iaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iaq *InterestAccrualQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, iaq.ctx, ent.OpQueryExist)
+	switch _, err := iaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iaq *InterestAccrualQuery) ExistX(ctx context.Context) bool {
+	exist, err := iaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InterestAccrualQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iaq *InterestAccrualQuery) Clone() *InterestAccrualQuery {
+	if iaq == nil {
+		return nil
+	}
+	return &InterestAccrualQuery{
+		config:      iaq.config,
+		ctx:         iaq.ctx.Clone(),
+		order:       append([]interestaccrual.OrderOption{}, iaq.order...),
+		inters:      append([]Interceptor{}, iaq.inters...),
+		predicates:  append([]predicate.InterestAccrual{}, iaq.predicates...),
+		withBalance: iaq.withBalance.Clone(),
+		// clone intermediate query.
+		sql:  iaq.sql.Clone(),
+		path: iaq.path,
+	}
+}
+
+// WithBalance tells the query-builder to eager-load the nodes that are connected to
+// the "balance" edge. The optional arguments are used to configure the query builder of the edge.
+func (iaq *InterestAccrualQuery) WithBalance(opts ...func(*BalanceQuery)) *InterestAccrualQuery {
+	query := (&BalanceClient{config: iaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iaq.withBalance = query
+	return iaq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BalanceID int `json:"balance_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InterestAccrual.Query().
+//		GroupBy(interestaccrual.FieldBalanceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (iaq *InterestAccrualQuery) GroupBy(field string, fields ...string) *InterestAccrualGroupBy {
+	iaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &InterestAccrualGroupBy{build: iaq}
+	grbuild.flds = &iaq.ctx.Fields
+	grbuild.label = interestaccrual.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BalanceID int `json:"balance_id,omitempty"`
+//	}
+//
+//	client.InterestAccrual.Query().
+//		Select(interestaccrual.FieldBalanceID).
+//		Scan(ctx, &v)
+func (iaq *InterestAccrualQuery) Select(fields ...string) *InterestAccrualSelect {
+	iaq.ctx.Fields = append(iaq.ctx.Fields, fields...)
+	sbuild := &InterestAccrualSelect{InterestAccrualQuery: iaq}
+	sbuild.label = interestaccrual.Label
+	sbuild.flds, sbuild.scan = &iaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a InterestAccrualSelect configured with the given aggregations.
+func (iaq *InterestAccrualQuery) Aggregate(fns ...AggregateFunc) *InterestAccrualSelect {
+	return iaq.Select().Aggregate(fns...)
+}
+
+func (iaq *InterestAccrualQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range iaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range iaq.ctx.Fields {
+		if !interestaccrual.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if iaq.path != nil {
+		prev, err := iaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iaq.sql = prev
+	}
+	return nil
+}
+
+func (iaq *InterestAccrualQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InterestAccrual, error) {
+	var (
+		nodes       = []*InterestAccrual{}
+		_spec       = iaq.querySpec()
+		loadedTypes = [1]bool{
+			iaq.withBalance != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*InterestAccrual).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &InterestAccrual{config: iaq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(iaq.modifiers) > 0 {
+		_spec.Modifiers = iaq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := iaq.withBalance; query != nil {
+		if err := iaq.loadBalance(ctx, query, nodes, nil,
+			func(n *InterestAccrual, e *Balance) { n.Edges.Balance = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (iaq *InterestAccrualQuery) loadBalance(ctx context.Context, query *BalanceQuery, nodes []*InterestAccrual, init func(*InterestAccrual), assign func(*InterestAccrual, *Balance)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*InterestAccrual)
+	for i := range nodes {
+		fk := nodes[i].BalanceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(balance.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "balance_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (iaq *InterestAccrualQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iaq.querySpec()
+	if len(iaq.modifiers) > 0 {
+		_spec.Modifiers = iaq.modifiers
+	}
+	_spec.Node.Columns = iaq.ctx.Fields
+	if len(iaq.ctx.Fields) > 0 {
+		_spec.Unique = iaq.ctx.Unique != nil && *iaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, iaq.driver, _spec)
+}
+
+func (iaq *InterestAccrualQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(interestaccrual.Table, interestaccrual.Columns, sqlgraph.NewFieldSpec(interestaccrual.FieldID, field.TypeInt))
+	_spec.From = iaq.sql
+	if unique := iaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if iaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := iaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, interestaccrual.FieldID)
+		for i := range fields {
+			if fields[i] != interestaccrual.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if iaq.withBalance != nil {
+			_spec.Node.AddColumnOnce(interestaccrual.FieldBalanceID)
+		}
+	}
+	if ps := iaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iaq *InterestAccrualQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iaq.driver.Dialect())
+	t1 := builder.Table(interestaccrual.Table)
+	columns := iaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = interestaccrual.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iaq.sql != nil {
+		selector = iaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iaq.ctx.Unique != nil && *iaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range iaq.modifiers {
+		m(selector)
+	}
+	for _, p := range iaq.predicates {
+		p(selector)
+	}
+	for _, p := range iaq.order {
+		p(selector)
+	}
+	if offset := iaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (iaq *InterestAccrualQuery) ForUpdate(opts ...sql.LockOption) *InterestAccrualQuery {
+	if iaq.driver.Dialect() == dialect.Postgres {
+		iaq.Unique(false)
+	}
+	iaq.modifiers = append(iaq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return iaq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (iaq *InterestAccrualQuery) ForShare(opts ...sql.LockOption) *InterestAccrualQuery {
+	if iaq.driver.Dialect() == dialect.Postgres {
+		iaq.Unique(false)
+	}
+	iaq.modifiers = append(iaq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return iaq
+}
+
+// InterestAccrualGroupBy is the group-by builder for InterestAccrual entities.
+type InterestAccrualGroupBy struct {
+	selector
+	build *InterestAccrualQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iagb *InterestAccrualGroupBy) Aggregate(fns ...AggregateFunc) *InterestAccrualGroupBy {
+	iagb.fns = append(iagb.fns, fns...)
+	return iagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iagb *InterestAccrualGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iagb.build.ctx, ent.OpQueryGroupBy)
+	if err := iagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InterestAccrualQuery, *InterestAccrualGroupBy](ctx, iagb.build, iagb, iagb.build.inters, v)
+}
+
+func (iagb *InterestAccrualGroupBy) sqlScan(ctx context.Context, root *InterestAccrualQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(iagb.fns))
+	for _, fn := range iagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*iagb.flds)+len(iagb.fns))
+		for _, f := range *iagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*iagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// InterestAccrualSelect is the builder for selecting fields of InterestAccrual entities.
+type InterestAccrualSelect struct {
+	*InterestAccrualQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ias *InterestAccrualSelect) Aggregate(fns ...AggregateFunc) *InterestAccrualSelect {
+	ias.fns = append(ias.fns, fns...)
+	return ias
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ias *InterestAccrualSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ias.ctx, ent.OpQuerySelect)
+	if err := ias.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InterestAccrualQuery, *InterestAccrualSelect](ctx, ias.InterestAccrualQuery, ias, ias.inters, v)
+}
+
+func (ias *InterestAccrualSelect) sqlScan(ctx context.Context, root *InterestAccrualQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ias.fns))
+	for _, fn := range ias.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ias.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ias.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/interestaccrual"
+	"accounting/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InterestAccrualUpdate is the builder for updating InterestAccrual entities.
+type InterestAccrualUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InterestAccrualMutation
+}
+
+// Where appends a list predicates to the InterestAccrualUpdate builder.
+func (iau *InterestAccrualUpdate) Where(ps ...predicate.InterestAccrual) *InterestAccrualUpdate {
+	iau.mutation.Where(ps...)
+	return iau
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (iau *InterestAccrualUpdate) SetTransactionID(s string) *InterestAccrualUpdate {
+	iau.mutation.SetTransactionID(s)
+	return iau
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (iau *InterestAccrualUpdate) SetNillableTransactionID(s *string) *InterestAccrualUpdate {
+	if s != nil {
+		iau.SetTransactionID(*s)
+	}
+	return iau
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (iau *InterestAccrualUpdate) ClearTransactionID() *InterestAccrualUpdate {
+	iau.mutation.ClearTransactionID()
+	return iau
+}
+
+// Mutation returns the InterestAccrualMutation object of the builder.
+func (iau *InterestAccrualUpdate) Mutation() *InterestAccrualMutation {
+	return iau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iau *InterestAccrualUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iau.sqlSave, iau.mutation, iau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iau *InterestAccrualUpdate) SaveX(ctx context.Context) int {
+	affected, err := iau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iau *InterestAccrualUpdate) Exec(ctx context.Context) error {
+	_, err := iau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iau *InterestAccrualUpdate) ExecX(ctx context.Context) {
+	if err := iau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iau *InterestAccrualUpdate) check() error {
+	if iau.mutation.BalanceCleared() && len(iau.mutation.BalanceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InterestAccrual.balance"`)
+	}
+	return nil
+}
+
+func (iau *InterestAccrualUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(interestaccrual.Table, interestaccrual.Columns, sqlgraph.NewFieldSpec(interestaccrual.FieldID, field.TypeInt))
+	if ps := iau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iau.mutation.TransactionID(); ok {
+		_spec.SetField(interestaccrual.FieldTransactionID, field.TypeString, value)
+	}
+	if iau.mutation.TransactionIDCleared() {
+		_spec.ClearField(interestaccrual.FieldTransactionID, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{interestaccrual.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iau.mutation.done = true
+	return n, nil
+}
+
+// InterestAccrualUpdateOne is the builder for updating a single InterestAccrual entity.
+type InterestAccrualUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InterestAccrualMutation
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (iauo *InterestAccrualUpdateOne) SetTransactionID(s string) *InterestAccrualUpdateOne {
+	iauo.mutation.SetTransactionID(s)
+	return iauo
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (iauo *InterestAccrualUpdateOne) SetNillableTransactionID(s *string) *InterestAccrualUpdateOne {
+	if s != nil {
+		iauo.SetTransactionID(*s)
+	}
+	return iauo
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (iauo *InterestAccrualUpdateOne) ClearTransactionID() *InterestAccrualUpdateOne {
+	iauo.mutation.ClearTransactionID()
+	return iauo
+}
+
+// Mutation returns the InterestAccrualMutation object of the builder.
+func (iauo *InterestAccrualUpdateOne) Mutation() *InterestAccrualMutation {
+	return iauo.mutation
+}
+
+// Where appends a list predicates to the InterestAccrualUpdate builder.
+func (iauo *InterestAccrualUpdateOne) Where(ps ...predicate.InterestAccrual) *InterestAccrualUpdateOne {
+	iauo.mutation.Where(ps...)
+	return iauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iauo *InterestAccrualUpdateOne) Select(field string, fields ...string) *InterestAccrualUpdateOne {
+	iauo.fields = append([]string{field}, fields...)
+	return iauo
+}
+
+// Save executes the query and returns the updated InterestAccrual entity.
+func (iauo *InterestAccrualUpdateOne) Save(ctx context.Context) (*InterestAccrual, error) {
+	return withHooks(ctx, iauo.sqlSave, iauo.mutation, iauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iauo *InterestAccrualUpdateOne) SaveX(ctx context.Context) *InterestAccrual {
+	node, err := iauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iauo *InterestAccrualUpdateOne) Exec(ctx context.Context) error {
+	_, err := iauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iauo *InterestAccrualUpdateOne) ExecX(ctx context.Context) {
+	if err := iauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iauo *InterestAccrualUpdateOne) check() error {
+	if iauo.mutation.BalanceCleared() && len(iauo.mutation.BalanceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InterestAccrual.balance"`)
+	}
+	return nil
+}
+
+func (iauo *InterestAccrualUpdateOne) sqlSave(ctx context.Context) (_node *InterestAccrual, err error) {
+	if err := iauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(interestaccrual.Table, interestaccrual.Columns, sqlgraph.NewFieldSpec(interestaccrual.FieldID, field.TypeInt))
+	id, ok := iauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InterestAccrual.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, interestaccrual.FieldID)
+		for _, f := range fields {
+			if !interestaccrual.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != interestaccrual.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iauo.mutation.TransactionID(); ok {
+		_spec.SetField(interestaccrual.FieldTransactionID, field.TypeString, value)
+	}
+	if iauo.mutation.TransactionIDCleared() {
+		_spec.ClearField(interestaccrual.FieldTransactionID, field.TypeString)
+	}
+	_node = &InterestAccrual{config: iauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{interestaccrual.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/interestplan"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// InterestPlan is the model entity for the InterestPlan schema.
+type InterestPlan struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Human-readable name of the plan
+	Name string `json:"name,omitempty"`
+	// Nominal annual interest rate in percent, e.g. 3.5 for 3.5%
+	AnnualPercentage decimal.Decimal `json:"annual_percentage,omitempty"`
+	// Day-count convention: act_365 (ACT/365 Fixed), thirty_360 (30/360)
+	DayCount interestplan.DayCount `json:"day_count,omitempty"`
+	// Time of the plan creation
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Time of the last plan update
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InterestPlanQuery when eager-loading is set.
+	Edges        InterestPlanEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// InterestPlanEdges holds the relations/edges for other nodes in the graph.
+type InterestPlanEdges struct {
+	// Balances earning interest under the plan
+	Balances []*Balance `json:"balances,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BalancesOrErr returns the Balances value or an error if the edge
+// was not loaded in eager-loading.
+func (e InterestPlanEdges) BalancesOrErr() ([]*Balance, error) {
+	if e.loadedTypes[0] {
+		return e.Balances, nil
+	}
+	return nil, &NotLoadedError{edge: "balances"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InterestPlan) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case interestplan.FieldAnnualPercentage:
+			values[i] = new(decimal.Decimal)
+		case interestplan.FieldID:
+			values[i] = new(sql.NullInt64)
+		case interestplan.FieldName, interestplan.FieldDayCount:
+			values[i] = new(sql.NullString)
+		case interestplan.FieldCreatedAt, interestplan.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InterestPlan fields.
+func (ip *InterestPlan) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case interestplan.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ip.ID = int(value.Int64)
+		case interestplan.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ip.Name = value.String
+			}
+		case interestplan.FieldAnnualPercentage:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field annual_percentage", values[i])
+			} else if value != nil {
+				ip.AnnualPercentage = *value
+			}
+		case interestplan.FieldDayCount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field day_count", values[i])
+			} else if value.Valid {
+				ip.DayCount = interestplan.DayCount(value.String)
+			}
+		case interestplan.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ip.CreatedAt = value.Time
+			}
+		case interestplan.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ip.UpdatedAt = value.Time
+			}
+		default:
+			ip.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InterestPlan.
+// This includes values selected through modifiers, order, etc.
+func (ip *InterestPlan) Value(name string) (ent.Value, error) {
+	return ip.selectValues.Get(name)
+}
+
+// QueryBalances queries the "balances" edge of the InterestPlan entity.
+func (ip *InterestPlan) QueryBalances() *BalanceQuery {
+	return NewInterestPlanClient(ip.config).QueryBalances(ip)
+}
+
+// Update returns a builder for updating this InterestPlan.
+// Note that you need to call InterestPlan.Unwrap() before calling this method if this InterestPlan
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ip *InterestPlan) Update() *InterestPlanUpdateOne {
+	return NewInterestPlanClient(ip.config).UpdateOne(ip)
+}
+
+// Unwrap unwraps the InterestPlan entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ip *InterestPlan) Unwrap() *InterestPlan {
+	_tx, ok := ip.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InterestPlan is not a transactional entity")
+	}
+	ip.config.driver = _tx.drv
+	return ip
+}
+
+// String implements the fmt.Stringer.
+func (ip *InterestPlan) String() string {
+	var builder strings.Builder
+	builder.WriteString("InterestPlan(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ip.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ip.Name)
+	builder.WriteString(", ")
+	builder.WriteString("annual_percentage=")
+	builder.WriteString(fmt.Sprintf("%v", ip.AnnualPercentage))
+	builder.WriteString(", ")
+	builder.WriteString("day_count=")
+	builder.WriteString(fmt.Sprintf("%v", ip.DayCount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ip.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ip.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InterestPlans is a parsable slice of InterestPlan.
+type InterestPlans []*InterestPlan
@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package interestplan
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the interestplan type in the database.
+	Label = "interest_plan"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldAnnualPercentage holds the string denoting the annual_percentage field in the database.
+	FieldAnnualPercentage = "annual_percentage"
+	// FieldDayCount holds the string denoting the day_count field in the database.
+	FieldDayCount = "day_count"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeBalances holds the string denoting the balances edge name in mutations.
+	EdgeBalances = "balances"
+	// Table holds the table name of the interestplan in the database.
+	Table = "interest_plans"
+	// BalancesTable is the table that holds the balances relation/edge.
+	BalancesTable = "balances"
+	// BalancesInverseTable is the table name for the Balance entity.
+	// It exists in this package in order to avoid circular dependency with the "balance" package.
+	BalancesInverseTable = "balances"
+	// BalancesColumn is the table column denoting the balances relation/edge.
+	BalancesColumn = "interest_plan_id"
+)
+
+// Columns holds all SQL columns for interestplan fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldAnnualPercentage,
+	FieldDayCount,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// DayCount defines the type for the "day_count" enum field.
+type DayCount string
+
+// DayCount values.
+const (
+	DayCountAct365    DayCount = "act_365"
+	DayCountThirty360 DayCount = "thirty_360"
+)
+
+func (dc DayCount) String() string {
+	return string(dc)
+}
+
+// DayCountValidator is a validator for the "day_count" field enum values. It is called by the builders before save.
+func DayCountValidator(dc DayCount) error {
+	switch dc {
+	case DayCountAct365, DayCountThirty360:
+		return nil
+	default:
+		return fmt.Errorf("interestplan: invalid enum value for day_count field: %q", dc)
+	}
+}
+
+// OrderOption defines the ordering options for the InterestPlan queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByAnnualPercentage orders the results by the annual_percentage field.
+func ByAnnualPercentage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnnualPercentage, opts...).ToFunc()
+}
+
+// ByDayCount orders the results by the day_count field.
+func ByDayCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDayCount, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByBalancesCount orders the results by balances count.
+func ByBalancesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBalancesStep(), opts...)
+	}
+}
+
+// ByBalances orders the results by balances terms.
+func ByBalances(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBalancesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newBalancesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BalancesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BalancesTable, BalancesColumn),
+	)
+}
@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package interestplan
+
+import (
+	"accounting/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldEQ(FieldName, v))
+}
+
+// AnnualPercentage applies equality check predicate on the "annual_percentage" field. It's identical to AnnualPercentageEQ.
+func AnnualPercentage(v decimal.Decimal) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldEQ(FieldAnnualPercentage, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldContainsFold(FieldName, v))
+}
+
+// AnnualPercentageEQ applies the EQ predicate on the "annual_percentage" field.
+func AnnualPercentageEQ(v decimal.Decimal) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldEQ(FieldAnnualPercentage, v))
+}
+
+// AnnualPercentageNEQ applies the NEQ predicate on the "annual_percentage" field.
+func AnnualPercentageNEQ(v decimal.Decimal) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldNEQ(FieldAnnualPercentage, v))
+}
+
+// AnnualPercentageIn applies the In predicate on the "annual_percentage" field.
+func AnnualPercentageIn(vs ...decimal.Decimal) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldIn(FieldAnnualPercentage, vs...))
+}
+
+// AnnualPercentageNotIn applies the NotIn predicate on the "annual_percentage" field.
+func AnnualPercentageNotIn(vs ...decimal.Decimal) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldNotIn(FieldAnnualPercentage, vs...))
+}
+
+// AnnualPercentageGT applies the GT predicate on the "annual_percentage" field.
+func AnnualPercentageGT(v decimal.Decimal) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldGT(FieldAnnualPercentage, v))
+}
+
+// AnnualPercentageGTE applies the GTE predicate on the "annual_percentage" field.
+func AnnualPercentageGTE(v decimal.Decimal) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldGTE(FieldAnnualPercentage, v))
+}
+
+// AnnualPercentageLT applies the LT predicate on the "annual_percentage" field.
+func AnnualPercentageLT(v decimal.Decimal) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldLT(FieldAnnualPercentage, v))
+}
+
+// AnnualPercentageLTE applies the LTE predicate on the "annual_percentage" field.
+func AnnualPercentageLTE(v decimal.Decimal) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldLTE(FieldAnnualPercentage, v))
+}
+
+// DayCountEQ applies the EQ predicate on the "day_count" field.
+func DayCountEQ(v DayCount) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldEQ(FieldDayCount, v))
+}
+
+// DayCountNEQ applies the NEQ predicate on the "day_count" field.
+func DayCountNEQ(v DayCount) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldNEQ(FieldDayCount, v))
+}
+
+// DayCountIn applies the In predicate on the "day_count" field.
+func DayCountIn(vs ...DayCount) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldIn(FieldDayCount, vs...))
+}
+
+// DayCountNotIn applies the NotIn predicate on the "day_count" field.
+func DayCountNotIn(vs ...DayCount) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldNotIn(FieldDayCount, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasBalances applies the HasEdge predicate on the "balances" edge.
+func HasBalances() predicate.InterestPlan {
+	return predicate.InterestPlan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BalancesTable, BalancesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBalancesWith applies the HasEdge predicate on the "balances" edge with a given conditions (other predicates).
+func HasBalancesWith(preds ...predicate.Balance) predicate.InterestPlan {
+	return predicate.InterestPlan(func(s *sql.Selector) {
+		step := newBalancesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InterestPlan) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InterestPlan) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InterestPlan) predicate.InterestPlan {
+	return predicate.InterestPlan(sql.NotPredicates(p))
+}
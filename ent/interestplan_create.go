@@ -0,0 +1,732 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/balance"
+	"accounting/ent/interestplan"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// InterestPlanCreate is the builder for creating a InterestPlan entity.
+type InterestPlanCreate struct {
+	config
+	mutation *InterestPlanMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (ipc *InterestPlanCreate) SetName(s string) *InterestPlanCreate {
+	ipc.mutation.SetName(s)
+	return ipc
+}
+
+// SetAnnualPercentage sets the "annual_percentage" field.
+func (ipc *InterestPlanCreate) SetAnnualPercentage(d decimal.Decimal) *InterestPlanCreate {
+	ipc.mutation.SetAnnualPercentage(d)
+	return ipc
+}
+
+// SetDayCount sets the "day_count" field.
+func (ipc *InterestPlanCreate) SetDayCount(ic interestplan.DayCount) *InterestPlanCreate {
+	ipc.mutation.SetDayCount(ic)
+	return ipc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ipc *InterestPlanCreate) SetCreatedAt(t time.Time) *InterestPlanCreate {
+	ipc.mutation.SetCreatedAt(t)
+	return ipc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ipc *InterestPlanCreate) SetNillableCreatedAt(t *time.Time) *InterestPlanCreate {
+	if t != nil {
+		ipc.SetCreatedAt(*t)
+	}
+	return ipc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ipc *InterestPlanCreate) SetUpdatedAt(t time.Time) *InterestPlanCreate {
+	ipc.mutation.SetUpdatedAt(t)
+	return ipc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ipc *InterestPlanCreate) SetNillableUpdatedAt(t *time.Time) *InterestPlanCreate {
+	if t != nil {
+		ipc.SetUpdatedAt(*t)
+	}
+	return ipc
+}
+
+// AddBalanceIDs adds the "balances" edge to the Balance entity by IDs.
+func (ipc *InterestPlanCreate) AddBalanceIDs(ids ...int) *InterestPlanCreate {
+	ipc.mutation.AddBalanceIDs(ids...)
+	return ipc
+}
+
+// AddBalances adds the "balances" edges to the Balance entity.
+func (ipc *InterestPlanCreate) AddBalances(b ...*Balance) *InterestPlanCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return ipc.AddBalanceIDs(ids...)
+}
+
+// Mutation returns the InterestPlanMutation object of the builder.
+func (ipc *InterestPlanCreate) Mutation() *InterestPlanMutation {
+	return ipc.mutation
+}
+
+// Save creates the InterestPlan in the database.
+func (ipc *InterestPlanCreate) Save(ctx context.Context) (*InterestPlan, error) {
+	ipc.defaults()
+	return withHooks(ctx, ipc.sqlSave, ipc.mutation, ipc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ipc *InterestPlanCreate) SaveX(ctx context.Context) *InterestPlan {
+	v, err := ipc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ipc *InterestPlanCreate) Exec(ctx context.Context) error {
+	_, err := ipc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipc *InterestPlanCreate) ExecX(ctx context.Context) {
+	if err := ipc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ipc *InterestPlanCreate) defaults() {
+	if _, ok := ipc.mutation.CreatedAt(); !ok {
+		v := interestplan.DefaultCreatedAt()
+		ipc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ipc.mutation.UpdatedAt(); !ok {
+		v := interestplan.DefaultUpdatedAt()
+		ipc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ipc *InterestPlanCreate) check() error {
+	if _, ok := ipc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "InterestPlan.name"`)}
+	}
+	if v, ok := ipc.mutation.Name(); ok {
+		if err := interestplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "InterestPlan.name": %w`, err)}
+		}
+	}
+	if _, ok := ipc.mutation.AnnualPercentage(); !ok {
+		return &ValidationError{Name: "annual_percentage", err: errors.New(`ent: missing required field "InterestPlan.annual_percentage"`)}
+	}
+	if _, ok := ipc.mutation.DayCount(); !ok {
+		return &ValidationError{Name: "day_count", err: errors.New(`ent: missing required field "InterestPlan.day_count"`)}
+	}
+	if v, ok := ipc.mutation.DayCount(); ok {
+		if err := interestplan.DayCountValidator(v); err != nil {
+			return &ValidationError{Name: "day_count", err: fmt.Errorf(`ent: validator failed for field "InterestPlan.day_count": %w`, err)}
+		}
+	}
+	if _, ok := ipc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InterestPlan.created_at"`)}
+	}
+	if _, ok := ipc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InterestPlan.updated_at"`)}
+	}
+	return nil
+}
+
+func (ipc *InterestPlanCreate) sqlSave(ctx context.Context) (*InterestPlan, error) {
+	if err := ipc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ipc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ipc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ipc.mutation.id = &_node.ID
+	ipc.mutation.done = true
+	return _node, nil
+}
+
+func (ipc *InterestPlanCreate) createSpec() (*InterestPlan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InterestPlan{config: ipc.config}
+		_spec = sqlgraph.NewCreateSpec(interestplan.Table, sqlgraph.NewFieldSpec(interestplan.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ipc.conflict
+	if value, ok := ipc.mutation.Name(); ok {
+		_spec.SetField(interestplan.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ipc.mutation.AnnualPercentage(); ok {
+		_spec.SetField(interestplan.FieldAnnualPercentage, field.TypeFloat64, value)
+		_node.AnnualPercentage = value
+	}
+	if value, ok := ipc.mutation.DayCount(); ok {
+		_spec.SetField(interestplan.FieldDayCount, field.TypeEnum, value)
+		_node.DayCount = value
+	}
+	if value, ok := ipc.mutation.CreatedAt(); ok {
+		_spec.SetField(interestplan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ipc.mutation.UpdatedAt(); ok {
+		_spec.SetField(interestplan.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := ipc.mutation.BalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   interestplan.BalancesTable,
+			Columns: []string{interestplan.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InterestPlan.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InterestPlanUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (ipc *InterestPlanCreate) OnConflict(opts ...sql.ConflictOption) *InterestPlanUpsertOne {
+	ipc.conflict = opts
+	return &InterestPlanUpsertOne{
+		create: ipc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InterestPlan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ipc *InterestPlanCreate) OnConflictColumns(columns ...string) *InterestPlanUpsertOne {
+	ipc.conflict = append(ipc.conflict, sql.ConflictColumns(columns...))
+	return &InterestPlanUpsertOne{
+		create: ipc,
+	}
+}
+
+type (
+	// InterestPlanUpsertOne is the builder for "upsert"-ing
+	//  one InterestPlan node.
+	InterestPlanUpsertOne struct {
+		create *InterestPlanCreate
+	}
+
+	// InterestPlanUpsert is the "OnConflict" setter.
+	InterestPlanUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *InterestPlanUpsert) SetName(v string) *InterestPlanUpsert {
+	u.Set(interestplan.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *InterestPlanUpsert) UpdateName() *InterestPlanUpsert {
+	u.SetExcluded(interestplan.FieldName)
+	return u
+}
+
+// SetAnnualPercentage sets the "annual_percentage" field.
+func (u *InterestPlanUpsert) SetAnnualPercentage(v decimal.Decimal) *InterestPlanUpsert {
+	u.Set(interestplan.FieldAnnualPercentage, v)
+	return u
+}
+
+// UpdateAnnualPercentage sets the "annual_percentage" field to the value that was provided on create.
+func (u *InterestPlanUpsert) UpdateAnnualPercentage() *InterestPlanUpsert {
+	u.SetExcluded(interestplan.FieldAnnualPercentage)
+	return u
+}
+
+// AddAnnualPercentage adds v to the "annual_percentage" field.
+func (u *InterestPlanUpsert) AddAnnualPercentage(v decimal.Decimal) *InterestPlanUpsert {
+	u.Add(interestplan.FieldAnnualPercentage, v)
+	return u
+}
+
+// SetDayCount sets the "day_count" field.
+func (u *InterestPlanUpsert) SetDayCount(v interestplan.DayCount) *InterestPlanUpsert {
+	u.Set(interestplan.FieldDayCount, v)
+	return u
+}
+
+// UpdateDayCount sets the "day_count" field to the value that was provided on create.
+func (u *InterestPlanUpsert) UpdateDayCount() *InterestPlanUpsert {
+	u.SetExcluded(interestplan.FieldDayCount)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InterestPlanUpsert) SetUpdatedAt(v time.Time) *InterestPlanUpsert {
+	u.Set(interestplan.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InterestPlanUpsert) UpdateUpdatedAt() *InterestPlanUpsert {
+	u.SetExcluded(interestplan.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.InterestPlan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InterestPlanUpsertOne) UpdateNewValues() *InterestPlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(interestplan.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InterestPlan.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InterestPlanUpsertOne) Ignore() *InterestPlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InterestPlanUpsertOne) DoNothing() *InterestPlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InterestPlanCreate.OnConflict
+// documentation for more info.
+func (u *InterestPlanUpsertOne) Update(set func(*InterestPlanUpsert)) *InterestPlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InterestPlanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *InterestPlanUpsertOne) SetName(v string) *InterestPlanUpsertOne {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *InterestPlanUpsertOne) UpdateName() *InterestPlanUpsertOne {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAnnualPercentage sets the "annual_percentage" field.
+func (u *InterestPlanUpsertOne) SetAnnualPercentage(v decimal.Decimal) *InterestPlanUpsertOne {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.SetAnnualPercentage(v)
+	})
+}
+
+// AddAnnualPercentage adds v to the "annual_percentage" field.
+func (u *InterestPlanUpsertOne) AddAnnualPercentage(v decimal.Decimal) *InterestPlanUpsertOne {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.AddAnnualPercentage(v)
+	})
+}
+
+// UpdateAnnualPercentage sets the "annual_percentage" field to the value that was provided on create.
+func (u *InterestPlanUpsertOne) UpdateAnnualPercentage() *InterestPlanUpsertOne {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.UpdateAnnualPercentage()
+	})
+}
+
+// SetDayCount sets the "day_count" field.
+func (u *InterestPlanUpsertOne) SetDayCount(v interestplan.DayCount) *InterestPlanUpsertOne {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.SetDayCount(v)
+	})
+}
+
+// UpdateDayCount sets the "day_count" field to the value that was provided on create.
+func (u *InterestPlanUpsertOne) UpdateDayCount() *InterestPlanUpsertOne {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.UpdateDayCount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InterestPlanUpsertOne) SetUpdatedAt(v time.Time) *InterestPlanUpsertOne {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InterestPlanUpsertOne) UpdateUpdatedAt() *InterestPlanUpsertOne {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InterestPlanUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InterestPlanCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InterestPlanUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InterestPlanUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InterestPlanUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InterestPlanCreateBulk is the builder for creating many InterestPlan entities in bulk.
+type InterestPlanCreateBulk struct {
+	config
+	err      error
+	builders []*InterestPlanCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InterestPlan entities in the database.
+func (ipcb *InterestPlanCreateBulk) Save(ctx context.Context) ([]*InterestPlan, error) {
+	if ipcb.err != nil {
+		return nil, ipcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ipcb.builders))
+	nodes := make([]*InterestPlan, len(ipcb.builders))
+	mutators := make([]Mutator, len(ipcb.builders))
+	for i := range ipcb.builders {
+		func(i int, root context.Context) {
+			builder := ipcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InterestPlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ipcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ipcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ipcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ipcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ipcb *InterestPlanCreateBulk) SaveX(ctx context.Context) []*InterestPlan {
+	v, err := ipcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ipcb *InterestPlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := ipcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipcb *InterestPlanCreateBulk) ExecX(ctx context.Context) {
+	if err := ipcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InterestPlan.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InterestPlanUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (ipcb *InterestPlanCreateBulk) OnConflict(opts ...sql.ConflictOption) *InterestPlanUpsertBulk {
+	ipcb.conflict = opts
+	return &InterestPlanUpsertBulk{
+		create: ipcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InterestPlan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ipcb *InterestPlanCreateBulk) OnConflictColumns(columns ...string) *InterestPlanUpsertBulk {
+	ipcb.conflict = append(ipcb.conflict, sql.ConflictColumns(columns...))
+	return &InterestPlanUpsertBulk{
+		create: ipcb,
+	}
+}
+
+// InterestPlanUpsertBulk is the builder for "upsert"-ing
+// a bulk of InterestPlan nodes.
+type InterestPlanUpsertBulk struct {
+	create *InterestPlanCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InterestPlan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InterestPlanUpsertBulk) UpdateNewValues() *InterestPlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(interestplan.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InterestPlan.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InterestPlanUpsertBulk) Ignore() *InterestPlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InterestPlanUpsertBulk) DoNothing() *InterestPlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InterestPlanCreateBulk.OnConflict
+// documentation for more info.
+func (u *InterestPlanUpsertBulk) Update(set func(*InterestPlanUpsert)) *InterestPlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InterestPlanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *InterestPlanUpsertBulk) SetName(v string) *InterestPlanUpsertBulk {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *InterestPlanUpsertBulk) UpdateName() *InterestPlanUpsertBulk {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAnnualPercentage sets the "annual_percentage" field.
+func (u *InterestPlanUpsertBulk) SetAnnualPercentage(v decimal.Decimal) *InterestPlanUpsertBulk {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.SetAnnualPercentage(v)
+	})
+}
+
+// AddAnnualPercentage adds v to the "annual_percentage" field.
+func (u *InterestPlanUpsertBulk) AddAnnualPercentage(v decimal.Decimal) *InterestPlanUpsertBulk {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.AddAnnualPercentage(v)
+	})
+}
+
+// UpdateAnnualPercentage sets the "annual_percentage" field to the value that was provided on create.
+func (u *InterestPlanUpsertBulk) UpdateAnnualPercentage() *InterestPlanUpsertBulk {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.UpdateAnnualPercentage()
+	})
+}
+
+// SetDayCount sets the "day_count" field.
+func (u *InterestPlanUpsertBulk) SetDayCount(v interestplan.DayCount) *InterestPlanUpsertBulk {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.SetDayCount(v)
+	})
+}
+
+// UpdateDayCount sets the "day_count" field to the value that was provided on create.
+func (u *InterestPlanUpsertBulk) UpdateDayCount() *InterestPlanUpsertBulk {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.UpdateDayCount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InterestPlanUpsertBulk) SetUpdatedAt(v time.Time) *InterestPlanUpsertBulk {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InterestPlanUpsertBulk) UpdateUpdatedAt() *InterestPlanUpsertBulk {
+	return u.Update(func(s *InterestPlanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InterestPlanUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InterestPlanCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InterestPlanCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InterestPlanUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/interestplan"
+	"accounting/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InterestPlanDelete is the builder for deleting a InterestPlan entity.
+type InterestPlanDelete struct {
+	config
+	hooks    []Hook
+	mutation *InterestPlanMutation
+}
+
+// Where appends a list predicates to the InterestPlanDelete builder.
+func (ipd *InterestPlanDelete) Where(ps ...predicate.InterestPlan) *InterestPlanDelete {
+	ipd.mutation.Where(ps...)
+	return ipd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ipd *InterestPlanDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ipd.sqlExec, ipd.mutation, ipd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipd *InterestPlanDelete) ExecX(ctx context.Context) int {
+	n, err := ipd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ipd *InterestPlanDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(interestplan.Table, sqlgraph.NewFieldSpec(interestplan.FieldID, field.TypeInt))
+	if ps := ipd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ipd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ipd.mutation.done = true
+	return affected, err
+}
+
+// InterestPlanDeleteOne is the builder for deleting a single InterestPlan entity.
+type InterestPlanDeleteOne struct {
+	ipd *InterestPlanDelete
+}
+
+// Where appends a list predicates to the InterestPlanDelete builder.
+func (ipdo *InterestPlanDeleteOne) Where(ps ...predicate.InterestPlan) *InterestPlanDeleteOne {
+	ipdo.ipd.mutation.Where(ps...)
+	return ipdo
+}
+
+// Exec executes the deletion query.
+func (ipdo *InterestPlanDeleteOne) Exec(ctx context.Context) error {
+	n, err := ipdo.ipd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{interestplan.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipdo *InterestPlanDeleteOne) ExecX(ctx context.Context) {
+	if err := ipdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,646 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/balance"
+	"accounting/ent/interestplan"
+	"accounting/ent/predicate"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InterestPlanQuery is the builder for querying InterestPlan entities.
+type InterestPlanQuery struct {
+	config
+	ctx          *QueryContext
+	order        []interestplan.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.InterestPlan
+	withBalances *BalanceQuery
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InterestPlanQuery builder.
+func (ipq *InterestPlanQuery) Where(ps ...predicate.InterestPlan) *InterestPlanQuery {
+	ipq.predicates = append(ipq.predicates, ps...)
+	return ipq
+}
+
+// Limit the number of records to be returned by this query.
+func (ipq *InterestPlanQuery) Limit(limit int) *InterestPlanQuery {
+	ipq.ctx.Limit = &limit
+	return ipq
+}
+
+// Offset to start from.
+func (ipq *InterestPlanQuery) Offset(offset int) *InterestPlanQuery {
+	ipq.ctx.Offset = &offset
+	return ipq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ipq *InterestPlanQuery) Unique(unique bool) *InterestPlanQuery {
+	ipq.ctx.Unique = &unique
+	return ipq
+}
+
+// Order specifies how the records should be ordered.
+func (ipq *InterestPlanQuery) Order(o ...interestplan.OrderOption) *InterestPlanQuery {
+	ipq.order = append(ipq.order, o...)
+	return ipq
+}
+
+// QueryBalances chains the current query on the "balances" edge.
+func (ipq *InterestPlanQuery) QueryBalances() *BalanceQuery {
+	query := (&BalanceClient{config: ipq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ipq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ipq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(interestplan.Table, interestplan.FieldID, selector),
+			sqlgraph.To(balance.Table, balance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, interestplan.BalancesTable, interestplan.BalancesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ipq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first InterestPlan entity from the query.
+// Returns a *NotFoundError when no InterestPlan was found.
+func (ipq *InterestPlanQuery) First(ctx context.Context) (*InterestPlan, error) {
+	nodes, err := ipq.Limit(1).All(setContextOp(ctx, ipq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{interestplan.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ipq *InterestPlanQuery) FirstX(ctx context.Context) *InterestPlan {
+	node, err := ipq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InterestPlan ID from the query.
+// Returns a *NotFoundError when no InterestPlan ID was found.
+func (ipq *InterestPlanQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ipq.Limit(1).IDs(setContextOp(ctx, ipq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{interestplan.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ipq *InterestPlanQuery) FirstIDX(ctx context.Context) int {
+	id, err := ipq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InterestPlan entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InterestPlan entity is found.
+// Returns a *NotFoundError when no InterestPlan entities are found.
+func (ipq *InterestPlanQuery) Only(ctx context.Context) (*InterestPlan, error) {
+	nodes, err := ipq.Limit(2).All(setContextOp(ctx, ipq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{interestplan.Label}
+	default:
+		return nil, &NotSingularError{interestplan.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ipq *InterestPlanQuery) OnlyX(ctx context.Context) *InterestPlan {
+	node, err := ipq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InterestPlan ID in the query.
+// Returns a *NotSingularError when more than one InterestPlan ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ipq *InterestPlanQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ipq.Limit(2).IDs(setContextOp(ctx, ipq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{interestplan.Label}
+	default:
+		err = &NotSingularError{interestplan.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ipq *InterestPlanQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ipq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InterestPlans.
+func (ipq *InterestPlanQuery) All(ctx context.Context) ([]*InterestPlan, error) {
+	ctx = setContextOp(ctx, ipq.ctx, ent.OpQueryAll)
+	if err := ipq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*InterestPlan, *InterestPlanQuery]()
+	return withInterceptors[[]*InterestPlan](ctx, ipq, qr, ipq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ipq *InterestPlanQuery) AllX(ctx context.Context) []*InterestPlan {
+	nodes, err := ipq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InterestPlan IDs.
+func (ipq *InterestPlanQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ipq.ctx.Unique == nil && ipq.path != nil {
+		ipq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ipq.ctx, ent.OpQueryIDs)
+	if err = ipq.Select(interestplan.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ipq *InterestPlanQuery) IDsX(ctx context.Context) []int {
+	ids, err := ipq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ipq *InterestPlanQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ipq.ctx, ent.OpQueryCount)
+	if err := ipq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ipq, querierCount[*InterestPlanQuery](), ipq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ipq *InterestPlanQuery) CountX(ctx context.Context) int {
+	count, err := ipq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ipq *InterestPlanQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ipq.ctx, ent.OpQueryExist)
+	switch _, err := ipq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ipq *InterestPlanQuery) ExistX(ctx context.Context) bool {
+	exist, err := ipq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InterestPlanQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ipq *InterestPlanQuery) Clone() *InterestPlanQuery {
+	if ipq == nil {
+		return nil
+	}
+	return &InterestPlanQuery{
+		config:       ipq.config,
+		ctx:          ipq.ctx.Clone(),
+		order:        append([]interestplan.OrderOption{}, ipq.order...),
+		inters:       append([]Interceptor{}, ipq.inters...),
+		predicates:   append([]predicate.InterestPlan{}, ipq.predicates...),
+		withBalances: ipq.withBalances.Clone(),
+		// clone intermediate query.
+		sql:  ipq.sql.Clone(),
+		path: ipq.path,
+	}
+}
+
+// WithBalances tells the query-builder to eager-load the nodes that are connected to
+// the "balances" edge. The optional arguments are used to configure the query builder of the edge.
+func (ipq *InterestPlanQuery) WithBalances(opts ...func(*BalanceQuery)) *InterestPlanQuery {
+	query := (&BalanceClient{config: ipq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ipq.withBalances = query
+	return ipq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InterestPlan.Query().
+//		GroupBy(interestplan.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ipq *InterestPlanQuery) GroupBy(field string, fields ...string) *InterestPlanGroupBy {
+	ipq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &InterestPlanGroupBy{build: ipq}
+	grbuild.flds = &ipq.ctx.Fields
+	grbuild.label = interestplan.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.InterestPlan.Query().
+//		Select(interestplan.FieldName).
+//		Scan(ctx, &v)
+func (ipq *InterestPlanQuery) Select(fields ...string) *InterestPlanSelect {
+	ipq.ctx.Fields = append(ipq.ctx.Fields, fields...)
+	sbuild := &InterestPlanSelect{InterestPlanQuery: ipq}
+	sbuild.label = interestplan.Label
+	sbuild.flds, sbuild.scan = &ipq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a InterestPlanSelect configured with the given aggregations.
+func (ipq *InterestPlanQuery) Aggregate(fns ...AggregateFunc) *InterestPlanSelect {
+	return ipq.Select().Aggregate(fns...)
+}
+
+func (ipq *InterestPlanQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ipq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ipq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ipq.ctx.Fields {
+		if !interestplan.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ipq.path != nil {
+		prev, err := ipq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ipq.sql = prev
+	}
+	return nil
+}
+
+func (ipq *InterestPlanQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InterestPlan, error) {
+	var (
+		nodes       = []*InterestPlan{}
+		_spec       = ipq.querySpec()
+		loadedTypes = [1]bool{
+			ipq.withBalances != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*InterestPlan).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &InterestPlan{config: ipq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ipq.modifiers) > 0 {
+		_spec.Modifiers = ipq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ipq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ipq.withBalances; query != nil {
+		if err := ipq.loadBalances(ctx, query, nodes,
+			func(n *InterestPlan) { n.Edges.Balances = []*Balance{} },
+			func(n *InterestPlan, e *Balance) { n.Edges.Balances = append(n.Edges.Balances, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ipq *InterestPlanQuery) loadBalances(ctx context.Context, query *BalanceQuery, nodes []*InterestPlan, init func(*InterestPlan), assign func(*InterestPlan, *Balance)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*InterestPlan)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(balance.FieldInterestPlanID)
+	}
+	query.Where(predicate.Balance(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(interestplan.BalancesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.InterestPlanID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "interest_plan_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "interest_plan_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (ipq *InterestPlanQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ipq.querySpec()
+	if len(ipq.modifiers) > 0 {
+		_spec.Modifiers = ipq.modifiers
+	}
+	_spec.Node.Columns = ipq.ctx.Fields
+	if len(ipq.ctx.Fields) > 0 {
+		_spec.Unique = ipq.ctx.Unique != nil && *ipq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ipq.driver, _spec)
+}
+
+func (ipq *InterestPlanQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(interestplan.Table, interestplan.Columns, sqlgraph.NewFieldSpec(interestplan.FieldID, field.TypeInt))
+	_spec.From = ipq.sql
+	if unique := ipq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ipq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ipq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, interestplan.FieldID)
+		for i := range fields {
+			if fields[i] != interestplan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ipq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ipq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ipq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ipq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ipq *InterestPlanQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ipq.driver.Dialect())
+	t1 := builder.Table(interestplan.Table)
+	columns := ipq.ctx.Fields
+	if len(columns) == 0 {
+		columns = interestplan.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ipq.sql != nil {
+		selector = ipq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ipq.ctx.Unique != nil && *ipq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ipq.modifiers {
+		m(selector)
+	}
+	for _, p := range ipq.predicates {
+		p(selector)
+	}
+	for _, p := range ipq.order {
+		p(selector)
+	}
+	if offset := ipq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ipq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ipq *InterestPlanQuery) ForUpdate(opts ...sql.LockOption) *InterestPlanQuery {
+	if ipq.driver.Dialect() == dialect.Postgres {
+		ipq.Unique(false)
+	}
+	ipq.modifiers = append(ipq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ipq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ipq *InterestPlanQuery) ForShare(opts ...sql.LockOption) *InterestPlanQuery {
+	if ipq.driver.Dialect() == dialect.Postgres {
+		ipq.Unique(false)
+	}
+	ipq.modifiers = append(ipq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ipq
+}
+
+// InterestPlanGroupBy is the group-by builder for InterestPlan entities.
+type InterestPlanGroupBy struct {
+	selector
+	build *InterestPlanQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ipgb *InterestPlanGroupBy) Aggregate(fns ...AggregateFunc) *InterestPlanGroupBy {
+	ipgb.fns = append(ipgb.fns, fns...)
+	return ipgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ipgb *InterestPlanGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ipgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ipgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InterestPlanQuery, *InterestPlanGroupBy](ctx, ipgb.build, ipgb, ipgb.build.inters, v)
+}
+
+func (ipgb *InterestPlanGroupBy) sqlScan(ctx context.Context, root *InterestPlanQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ipgb.fns))
+	for _, fn := range ipgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ipgb.flds)+len(ipgb.fns))
+		for _, f := range *ipgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ipgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ipgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// InterestPlanSelect is the builder for selecting fields of InterestPlan entities.
+type InterestPlanSelect struct {
+	*InterestPlanQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ips *InterestPlanSelect) Aggregate(fns ...AggregateFunc) *InterestPlanSelect {
+	ips.fns = append(ips.fns, fns...)
+	return ips
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ips *InterestPlanSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ips.ctx, ent.OpQuerySelect)
+	if err := ips.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InterestPlanQuery, *InterestPlanSelect](ctx, ips.InterestPlanQuery, ips, ips.inters, v)
+}
+
+func (ips *InterestPlanSelect) sqlScan(ctx context.Context, root *InterestPlanQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ips.fns))
+	for _, fn := range ips.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ips.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ips.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
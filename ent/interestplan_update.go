@@ -0,0 +1,534 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/balance"
+	"accounting/ent/interestplan"
+	"accounting/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// InterestPlanUpdate is the builder for updating InterestPlan entities.
+type InterestPlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InterestPlanMutation
+}
+
+// Where appends a list predicates to the InterestPlanUpdate builder.
+func (ipu *InterestPlanUpdate) Where(ps ...predicate.InterestPlan) *InterestPlanUpdate {
+	ipu.mutation.Where(ps...)
+	return ipu
+}
+
+// SetName sets the "name" field.
+func (ipu *InterestPlanUpdate) SetName(s string) *InterestPlanUpdate {
+	ipu.mutation.SetName(s)
+	return ipu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ipu *InterestPlanUpdate) SetNillableName(s *string) *InterestPlanUpdate {
+	if s != nil {
+		ipu.SetName(*s)
+	}
+	return ipu
+}
+
+// SetAnnualPercentage sets the "annual_percentage" field.
+func (ipu *InterestPlanUpdate) SetAnnualPercentage(d decimal.Decimal) *InterestPlanUpdate {
+	ipu.mutation.ResetAnnualPercentage()
+	ipu.mutation.SetAnnualPercentage(d)
+	return ipu
+}
+
+// SetNillableAnnualPercentage sets the "annual_percentage" field if the given value is not nil.
+func (ipu *InterestPlanUpdate) SetNillableAnnualPercentage(d *decimal.Decimal) *InterestPlanUpdate {
+	if d != nil {
+		ipu.SetAnnualPercentage(*d)
+	}
+	return ipu
+}
+
+// AddAnnualPercentage adds d to the "annual_percentage" field.
+func (ipu *InterestPlanUpdate) AddAnnualPercentage(d decimal.Decimal) *InterestPlanUpdate {
+	ipu.mutation.AddAnnualPercentage(d)
+	return ipu
+}
+
+// SetDayCount sets the "day_count" field.
+func (ipu *InterestPlanUpdate) SetDayCount(ic interestplan.DayCount) *InterestPlanUpdate {
+	ipu.mutation.SetDayCount(ic)
+	return ipu
+}
+
+// SetNillableDayCount sets the "day_count" field if the given value is not nil.
+func (ipu *InterestPlanUpdate) SetNillableDayCount(ic *interestplan.DayCount) *InterestPlanUpdate {
+	if ic != nil {
+		ipu.SetDayCount(*ic)
+	}
+	return ipu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ipu *InterestPlanUpdate) SetUpdatedAt(t time.Time) *InterestPlanUpdate {
+	ipu.mutation.SetUpdatedAt(t)
+	return ipu
+}
+
+// AddBalanceIDs adds the "balances" edge to the Balance entity by IDs.
+func (ipu *InterestPlanUpdate) AddBalanceIDs(ids ...int) *InterestPlanUpdate {
+	ipu.mutation.AddBalanceIDs(ids...)
+	return ipu
+}
+
+// AddBalances adds the "balances" edges to the Balance entity.
+func (ipu *InterestPlanUpdate) AddBalances(b ...*Balance) *InterestPlanUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return ipu.AddBalanceIDs(ids...)
+}
+
+// Mutation returns the InterestPlanMutation object of the builder.
+func (ipu *InterestPlanUpdate) Mutation() *InterestPlanMutation {
+	return ipu.mutation
+}
+
+// ClearBalances clears all "balances" edges to the Balance entity.
+func (ipu *InterestPlanUpdate) ClearBalances() *InterestPlanUpdate {
+	ipu.mutation.ClearBalances()
+	return ipu
+}
+
+// RemoveBalanceIDs removes the "balances" edge to Balance entities by IDs.
+func (ipu *InterestPlanUpdate) RemoveBalanceIDs(ids ...int) *InterestPlanUpdate {
+	ipu.mutation.RemoveBalanceIDs(ids...)
+	return ipu
+}
+
+// RemoveBalances removes "balances" edges to Balance entities.
+func (ipu *InterestPlanUpdate) RemoveBalances(b ...*Balance) *InterestPlanUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return ipu.RemoveBalanceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ipu *InterestPlanUpdate) Save(ctx context.Context) (int, error) {
+	ipu.defaults()
+	return withHooks(ctx, ipu.sqlSave, ipu.mutation, ipu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ipu *InterestPlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := ipu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ipu *InterestPlanUpdate) Exec(ctx context.Context) error {
+	_, err := ipu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipu *InterestPlanUpdate) ExecX(ctx context.Context) {
+	if err := ipu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ipu *InterestPlanUpdate) defaults() {
+	if _, ok := ipu.mutation.UpdatedAt(); !ok {
+		v := interestplan.UpdateDefaultUpdatedAt()
+		ipu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ipu *InterestPlanUpdate) check() error {
+	if v, ok := ipu.mutation.Name(); ok {
+		if err := interestplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "InterestPlan.name": %w`, err)}
+		}
+	}
+	if v, ok := ipu.mutation.DayCount(); ok {
+		if err := interestplan.DayCountValidator(v); err != nil {
+			return &ValidationError{Name: "day_count", err: fmt.Errorf(`ent: validator failed for field "InterestPlan.day_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ipu *InterestPlanUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ipu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(interestplan.Table, interestplan.Columns, sqlgraph.NewFieldSpec(interestplan.FieldID, field.TypeInt))
+	if ps := ipu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ipu.mutation.Name(); ok {
+		_spec.SetField(interestplan.FieldName, field.TypeString, value)
+	}
+	if value, ok := ipu.mutation.AnnualPercentage(); ok {
+		_spec.SetField(interestplan.FieldAnnualPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := ipu.mutation.AddedAnnualPercentage(); ok {
+		_spec.AddField(interestplan.FieldAnnualPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := ipu.mutation.DayCount(); ok {
+		_spec.SetField(interestplan.FieldDayCount, field.TypeEnum, value)
+	}
+	if value, ok := ipu.mutation.UpdatedAt(); ok {
+		_spec.SetField(interestplan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ipu.mutation.BalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   interestplan.BalancesTable,
+			Columns: []string{interestplan.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balance.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ipu.mutation.RemovedBalancesIDs(); len(nodes) > 0 && !ipu.mutation.BalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   interestplan.BalancesTable,
+			Columns: []string{interestplan.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ipu.mutation.BalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   interestplan.BalancesTable,
+			Columns: []string{interestplan.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ipu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{interestplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ipu.mutation.done = true
+	return n, nil
+}
+
+// InterestPlanUpdateOne is the builder for updating a single InterestPlan entity.
+type InterestPlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InterestPlanMutation
+}
+
+// SetName sets the "name" field.
+func (ipuo *InterestPlanUpdateOne) SetName(s string) *InterestPlanUpdateOne {
+	ipuo.mutation.SetName(s)
+	return ipuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ipuo *InterestPlanUpdateOne) SetNillableName(s *string) *InterestPlanUpdateOne {
+	if s != nil {
+		ipuo.SetName(*s)
+	}
+	return ipuo
+}
+
+// SetAnnualPercentage sets the "annual_percentage" field.
+func (ipuo *InterestPlanUpdateOne) SetAnnualPercentage(d decimal.Decimal) *InterestPlanUpdateOne {
+	ipuo.mutation.ResetAnnualPercentage()
+	ipuo.mutation.SetAnnualPercentage(d)
+	return ipuo
+}
+
+// SetNillableAnnualPercentage sets the "annual_percentage" field if the given value is not nil.
+func (ipuo *InterestPlanUpdateOne) SetNillableAnnualPercentage(d *decimal.Decimal) *InterestPlanUpdateOne {
+	if d != nil {
+		ipuo.SetAnnualPercentage(*d)
+	}
+	return ipuo
+}
+
+// AddAnnualPercentage adds d to the "annual_percentage" field.
+func (ipuo *InterestPlanUpdateOne) AddAnnualPercentage(d decimal.Decimal) *InterestPlanUpdateOne {
+	ipuo.mutation.AddAnnualPercentage(d)
+	return ipuo
+}
+
+// SetDayCount sets the "day_count" field.
+func (ipuo *InterestPlanUpdateOne) SetDayCount(ic interestplan.DayCount) *InterestPlanUpdateOne {
+	ipuo.mutation.SetDayCount(ic)
+	return ipuo
+}
+
+// SetNillableDayCount sets the "day_count" field if the given value is not nil.
+func (ipuo *InterestPlanUpdateOne) SetNillableDayCount(ic *interestplan.DayCount) *InterestPlanUpdateOne {
+	if ic != nil {
+		ipuo.SetDayCount(*ic)
+	}
+	return ipuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ipuo *InterestPlanUpdateOne) SetUpdatedAt(t time.Time) *InterestPlanUpdateOne {
+	ipuo.mutation.SetUpdatedAt(t)
+	return ipuo
+}
+
+// AddBalanceIDs adds the "balances" edge to the Balance entity by IDs.
+func (ipuo *InterestPlanUpdateOne) AddBalanceIDs(ids ...int) *InterestPlanUpdateOne {
+	ipuo.mutation.AddBalanceIDs(ids...)
+	return ipuo
+}
+
+// AddBalances adds the "balances" edges to the Balance entity.
+func (ipuo *InterestPlanUpdateOne) AddBalances(b ...*Balance) *InterestPlanUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return ipuo.AddBalanceIDs(ids...)
+}
+
+// Mutation returns the InterestPlanMutation object of the builder.
+func (ipuo *InterestPlanUpdateOne) Mutation() *InterestPlanMutation {
+	return ipuo.mutation
+}
+
+// ClearBalances clears all "balances" edges to the Balance entity.
+func (ipuo *InterestPlanUpdateOne) ClearBalances() *InterestPlanUpdateOne {
+	ipuo.mutation.ClearBalances()
+	return ipuo
+}
+
+// RemoveBalanceIDs removes the "balances" edge to Balance entities by IDs.
+func (ipuo *InterestPlanUpdateOne) RemoveBalanceIDs(ids ...int) *InterestPlanUpdateOne {
+	ipuo.mutation.RemoveBalanceIDs(ids...)
+	return ipuo
+}
+
+// RemoveBalances removes "balances" edges to Balance entities.
+func (ipuo *InterestPlanUpdateOne) RemoveBalances(b ...*Balance) *InterestPlanUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return ipuo.RemoveBalanceIDs(ids...)
+}
+
+// Where appends a list predicates to the InterestPlanUpdate builder.
+func (ipuo *InterestPlanUpdateOne) Where(ps ...predicate.InterestPlan) *InterestPlanUpdateOne {
+	ipuo.mutation.Where(ps...)
+	return ipuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ipuo *InterestPlanUpdateOne) Select(field string, fields ...string) *InterestPlanUpdateOne {
+	ipuo.fields = append([]string{field}, fields...)
+	return ipuo
+}
+
+// Save executes the query and returns the updated InterestPlan entity.
+func (ipuo *InterestPlanUpdateOne) Save(ctx context.Context) (*InterestPlan, error) {
+	ipuo.defaults()
+	return withHooks(ctx, ipuo.sqlSave, ipuo.mutation, ipuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ipuo *InterestPlanUpdateOne) SaveX(ctx context.Context) *InterestPlan {
+	node, err := ipuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ipuo *InterestPlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := ipuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipuo *InterestPlanUpdateOne) ExecX(ctx context.Context) {
+	if err := ipuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ipuo *InterestPlanUpdateOne) defaults() {
+	if _, ok := ipuo.mutation.UpdatedAt(); !ok {
+		v := interestplan.UpdateDefaultUpdatedAt()
+		ipuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ipuo *InterestPlanUpdateOne) check() error {
+	if v, ok := ipuo.mutation.Name(); ok {
+		if err := interestplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "InterestPlan.name": %w`, err)}
+		}
+	}
+	if v, ok := ipuo.mutation.DayCount(); ok {
+		if err := interestplan.DayCountValidator(v); err != nil {
+			return &ValidationError{Name: "day_count", err: fmt.Errorf(`ent: validator failed for field "InterestPlan.day_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ipuo *InterestPlanUpdateOne) sqlSave(ctx context.Context) (_node *InterestPlan, err error) {
+	if err := ipuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(interestplan.Table, interestplan.Columns, sqlgraph.NewFieldSpec(interestplan.FieldID, field.TypeInt))
+	id, ok := ipuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InterestPlan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ipuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, interestplan.FieldID)
+		for _, f := range fields {
+			if !interestplan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != interestplan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ipuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ipuo.mutation.Name(); ok {
+		_spec.SetField(interestplan.FieldName, field.TypeString, value)
+	}
+	if value, ok := ipuo.mutation.AnnualPercentage(); ok {
+		_spec.SetField(interestplan.FieldAnnualPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := ipuo.mutation.AddedAnnualPercentage(); ok {
+		_spec.AddField(interestplan.FieldAnnualPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := ipuo.mutation.DayCount(); ok {
+		_spec.SetField(interestplan.FieldDayCount, field.TypeEnum, value)
+	}
+	if value, ok := ipuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(interestplan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ipuo.mutation.BalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   interestplan.BalancesTable,
+			Columns: []string{interestplan.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balance.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ipuo.mutation.RemovedBalancesIDs(); len(nodes) > 0 && !ipuo.mutation.BalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   interestplan.BalancesTable,
+			Columns: []string{interestplan.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ipuo.mutation.BalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   interestplan.BalancesTable,
+			Columns: []string{interestplan.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &InterestPlan{config: ipuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ipuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{interestplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ipuo.mutation.done = true
+	return _node, nil
+}
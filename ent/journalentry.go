@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/journalentry"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// JournalEntry is the model entity for the JournalEntry schema.
+type JournalEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	// ID of the journal entry
+	ID int `json:"id,omitempty"`
+	// Human readable description of the entry
+	Description string `json:"description,omitempty"`
+	// Time the entry takes effect in the books; earlier than created_at when back-dated
+	EffectiveAt time.Time `json:"effective_at,omitempty"`
+	// Time of the journal entry creation
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the JournalEntryQuery when eager-loading is set.
+	Edges        JournalEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// JournalEntryEdges holds the relations/edges for other nodes in the graph.
+type JournalEntryEdges struct {
+	// Postings of the entry
+	Postings []*Posting `json:"postings,omitempty"`
+	// Transactions recorded by the entry
+	Transactions []*Transaction `json:"transactions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PostingsOrErr returns the Postings value or an error if the edge
+// was not loaded in eager-loading.
+func (e JournalEntryEdges) PostingsOrErr() ([]*Posting, error) {
+	if e.loadedTypes[0] {
+		return e.Postings, nil
+	}
+	return nil, &NotLoadedError{edge: "postings"}
+}
+
+// TransactionsOrErr returns the Transactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e JournalEntryEdges) TransactionsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[1] {
+		return e.Transactions, nil
+	}
+	return nil, &NotLoadedError{edge: "transactions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JournalEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case journalentry.FieldID:
+			values[i] = new(sql.NullInt64)
+		case journalentry.FieldDescription:
+			values[i] = new(sql.NullString)
+		case journalentry.FieldEffectiveAt, journalentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JournalEntry fields.
+func (je *JournalEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case journalentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			je.ID = int(value.Int64)
+		case journalentry.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				je.Description = value.String
+			}
+		case journalentry.FieldEffectiveAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_at", values[i])
+			} else if value.Valid {
+				je.EffectiveAt = value.Time
+			}
+		case journalentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				je.CreatedAt = value.Time
+			}
+		default:
+			je.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JournalEntry.
+// This includes values selected through modifiers, order, etc.
+func (je *JournalEntry) Value(name string) (ent.Value, error) {
+	return je.selectValues.Get(name)
+}
+
+// QueryPostings queries the "postings" edge of the JournalEntry entity.
+func (je *JournalEntry) QueryPostings() *PostingQuery {
+	return NewJournalEntryClient(je.config).QueryPostings(je)
+}
+
+// QueryTransactions queries the "transactions" edge of the JournalEntry entity.
+func (je *JournalEntry) QueryTransactions() *TransactionQuery {
+	return NewJournalEntryClient(je.config).QueryTransactions(je)
+}
+
+// Update returns a builder for updating this JournalEntry.
+// Note that you need to call JournalEntry.Unwrap() before calling this method if this JournalEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (je *JournalEntry) Update() *JournalEntryUpdateOne {
+	return NewJournalEntryClient(je.config).UpdateOne(je)
+}
+
+// Unwrap unwraps the JournalEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (je *JournalEntry) Unwrap() *JournalEntry {
+	_tx, ok := je.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JournalEntry is not a transactional entity")
+	}
+	je.config.driver = _tx.drv
+	return je
+}
+
+// String implements the fmt.Stringer.
+func (je *JournalEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("JournalEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", je.ID))
+	builder.WriteString("description=")
+	builder.WriteString(je.Description)
+	builder.WriteString(", ")
+	builder.WriteString("effective_at=")
+	builder.WriteString(je.EffectiveAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(je.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JournalEntries is a parsable slice of JournalEntry.
+type JournalEntries []*JournalEntry
@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/journalentry"
+	"accounting/ent/posting"
+	"accounting/ent/transaction"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JournalEntryCreate is the builder for creating a JournalEntry entity.
+type JournalEntryCreate struct {
+	config
+	mutation *JournalEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDescription sets the "description" field.
+func (jec *JournalEntryCreate) SetDescription(s string) *JournalEntryCreate {
+	jec.mutation.SetDescription(s)
+	return jec
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (jec *JournalEntryCreate) SetNillableDescription(s *string) *JournalEntryCreate {
+	if s != nil {
+		jec.SetDescription(*s)
+	}
+	return jec
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (jec *JournalEntryCreate) SetEffectiveAt(t time.Time) *JournalEntryCreate {
+	jec.mutation.SetEffectiveAt(t)
+	return jec
+}
+
+// SetNillableEffectiveAt sets the "effective_at" field if the given value is not nil.
+func (jec *JournalEntryCreate) SetNillableEffectiveAt(t *time.Time) *JournalEntryCreate {
+	if t != nil {
+		jec.SetEffectiveAt(*t)
+	}
+	return jec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jec *JournalEntryCreate) SetCreatedAt(t time.Time) *JournalEntryCreate {
+	jec.mutation.SetCreatedAt(t)
+	return jec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (jec *JournalEntryCreate) SetNillableCreatedAt(t *time.Time) *JournalEntryCreate {
+	if t != nil {
+		jec.SetCreatedAt(*t)
+	}
+	return jec
+}
+
+// SetID sets the "id" field.
+func (jec *JournalEntryCreate) SetID(i int) *JournalEntryCreate {
+	jec.mutation.SetID(i)
+	return jec
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by IDs.
+func (jec *JournalEntryCreate) AddPostingIDs(ids ...int) *JournalEntryCreate {
+	jec.mutation.AddPostingIDs(ids...)
+	return jec
+}
+
+// AddPostings adds the "postings" edges to the Posting entity.
+func (jec *JournalEntryCreate) AddPostings(p ...*Posting) *JournalEntryCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return jec.AddPostingIDs(ids...)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (jec *JournalEntryCreate) AddTransactionIDs(ids ...string) *JournalEntryCreate {
+	jec.mutation.AddTransactionIDs(ids...)
+	return jec
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (jec *JournalEntryCreate) AddTransactions(t ...*Transaction) *JournalEntryCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return jec.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the JournalEntryMutation object of the builder.
+func (jec *JournalEntryCreate) Mutation() *JournalEntryMutation {
+	return jec.mutation
+}
+
+// Save creates the JournalEntry in the database.
+func (jec *JournalEntryCreate) Save(ctx context.Context) (*JournalEntry, error) {
+	jec.defaults()
+	return withHooks(ctx, jec.sqlSave, jec.mutation, jec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jec *JournalEntryCreate) SaveX(ctx context.Context) *JournalEntry {
+	v, err := jec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jec *JournalEntryCreate) Exec(ctx context.Context) error {
+	_, err := jec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jec *JournalEntryCreate) ExecX(ctx context.Context) {
+	if err := jec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jec *JournalEntryCreate) defaults() {
+	if _, ok := jec.mutation.Description(); !ok {
+		v := journalentry.DefaultDescription
+		jec.mutation.SetDescription(v)
+	}
+	if _, ok := jec.mutation.EffectiveAt(); !ok {
+		v := journalentry.DefaultEffectiveAt()
+		jec.mutation.SetEffectiveAt(v)
+	}
+	if _, ok := jec.mutation.CreatedAt(); !ok {
+		v := journalentry.DefaultCreatedAt()
+		jec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jec *JournalEntryCreate) check() error {
+	if _, ok := jec.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "JournalEntry.description"`)}
+	}
+	if _, ok := jec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "JournalEntry.created_at"`)}
+	}
+	return nil
+}
+
+func (jec *JournalEntryCreate) sqlSave(ctx context.Context) (*JournalEntry, error) {
+	if err := jec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	jec.mutation.id = &_node.ID
+	jec.mutation.done = true
+	return _node, nil
+}
+
+func (jec *JournalEntryCreate) createSpec() (*JournalEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JournalEntry{config: jec.config}
+		_spec = sqlgraph.NewCreateSpec(journalentry.Table, sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = jec.conflict
+	if id, ok := jec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := jec.mutation.Description(); ok {
+		_spec.SetField(journalentry.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := jec.mutation.EffectiveAt(); ok {
+		_spec.SetField(journalentry.FieldEffectiveAt, field.TypeTime, value)
+		_node.EffectiveAt = value
+	}
+	if value, ok := jec.mutation.CreatedAt(); ok {
+		_spec.SetField(journalentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := jec.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := jec.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.TransactionsTable,
+			Columns: []string{journalentry.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JournalEntry.Create().
+//		SetDescription(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JournalEntryUpsert) {
+//			SetDescription(v+v).
+//		}).
+//		Exec(ctx)
+func (jec *JournalEntryCreate) OnConflict(opts ...sql.ConflictOption) *JournalEntryUpsertOne {
+	jec.conflict = opts
+	return &JournalEntryUpsertOne{
+		create: jec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JournalEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jec *JournalEntryCreate) OnConflictColumns(columns ...string) *JournalEntryUpsertOne {
+	jec.conflict = append(jec.conflict, sql.ConflictColumns(columns...))
+	return &JournalEntryUpsertOne{
+		create: jec,
+	}
+}
+
+type (
+	// JournalEntryUpsertOne is the builder for "upsert"-ing
+	//  one JournalEntry node.
+	JournalEntryUpsertOne struct {
+		create *JournalEntryCreate
+	}
+
+	// JournalEntryUpsert is the "OnConflict" setter.
+	JournalEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDescription sets the "description" field.
+func (u *JournalEntryUpsert) SetDescription(v string) *JournalEntryUpsert {
+	u.Set(journalentry.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *JournalEntryUpsert) UpdateDescription() *JournalEntryUpsert {
+	u.SetExcluded(journalentry.FieldDescription)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.JournalEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(journalentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JournalEntryUpsertOne) UpdateNewValues() *JournalEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(journalentry.FieldID)
+		}
+		if _, exists := u.create.mutation.EffectiveAt(); exists {
+			s.SetIgnore(journalentry.FieldEffectiveAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(journalentry.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JournalEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JournalEntryUpsertOne) Ignore() *JournalEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JournalEntryUpsertOne) DoNothing() *JournalEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JournalEntryCreate.OnConflict
+// documentation for more info.
+func (u *JournalEntryUpsertOne) Update(set func(*JournalEntryUpsert)) *JournalEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JournalEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *JournalEntryUpsertOne) SetDescription(v string) *JournalEntryUpsertOne {
+	return u.Update(func(s *JournalEntryUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *JournalEntryUpsertOne) UpdateDescription() *JournalEntryUpsertOne {
+	return u.Update(func(s *JournalEntryUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *JournalEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JournalEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JournalEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JournalEntryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JournalEntryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JournalEntryCreateBulk is the builder for creating many JournalEntry entities in bulk.
+type JournalEntryCreateBulk struct {
+	config
+	err      error
+	builders []*JournalEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the JournalEntry entities in the database.
+func (jecb *JournalEntryCreateBulk) Save(ctx context.Context) ([]*JournalEntry, error) {
+	if jecb.err != nil {
+		return nil, jecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jecb.builders))
+	nodes := make([]*JournalEntry, len(jecb.builders))
+	mutators := make([]Mutator, len(jecb.builders))
+	for i := range jecb.builders {
+		func(i int, root context.Context) {
+			builder := jecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JournalEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = jecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jecb *JournalEntryCreateBulk) SaveX(ctx context.Context) []*JournalEntry {
+	v, err := jecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jecb *JournalEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := jecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jecb *JournalEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := jecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JournalEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JournalEntryUpsert) {
+//			SetDescription(v+v).
+//		}).
+//		Exec(ctx)
+func (jecb *JournalEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *JournalEntryUpsertBulk {
+	jecb.conflict = opts
+	return &JournalEntryUpsertBulk{
+		create: jecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JournalEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jecb *JournalEntryCreateBulk) OnConflictColumns(columns ...string) *JournalEntryUpsertBulk {
+	jecb.conflict = append(jecb.conflict, sql.ConflictColumns(columns...))
+	return &JournalEntryUpsertBulk{
+		create: jecb,
+	}
+}
+
+// JournalEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of JournalEntry nodes.
+type JournalEntryUpsertBulk struct {
+	create *JournalEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.JournalEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(journalentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JournalEntryUpsertBulk) UpdateNewValues() *JournalEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(journalentry.FieldID)
+			}
+			if _, exists := b.mutation.EffectiveAt(); exists {
+				s.SetIgnore(journalentry.FieldEffectiveAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(journalentry.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JournalEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JournalEntryUpsertBulk) Ignore() *JournalEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JournalEntryUpsertBulk) DoNothing() *JournalEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JournalEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *JournalEntryUpsertBulk) Update(set func(*JournalEntryUpsert)) *JournalEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JournalEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *JournalEntryUpsertBulk) SetDescription(v string) *JournalEntryUpsertBulk {
+	return u.Update(func(s *JournalEntryUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *JournalEntryUpsertBulk) UpdateDescription() *JournalEntryUpsertBulk {
+	return u.Update(func(s *JournalEntryUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *JournalEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JournalEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JournalEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JournalEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/journalentry"
+	"accounting/ent/posting"
+	"accounting/ent/predicate"
+	"accounting/ent/transaction"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JournalEntryUpdate is the builder for updating JournalEntry entities.
+type JournalEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JournalEntryMutation
+}
+
+// Where appends a list predicates to the JournalEntryUpdate builder.
+func (jeu *JournalEntryUpdate) Where(ps ...predicate.JournalEntry) *JournalEntryUpdate {
+	jeu.mutation.Where(ps...)
+	return jeu
+}
+
+// SetDescription sets the "description" field.
+func (jeu *JournalEntryUpdate) SetDescription(s string) *JournalEntryUpdate {
+	jeu.mutation.SetDescription(s)
+	return jeu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (jeu *JournalEntryUpdate) SetNillableDescription(s *string) *JournalEntryUpdate {
+	if s != nil {
+		jeu.SetDescription(*s)
+	}
+	return jeu
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by IDs.
+func (jeu *JournalEntryUpdate) AddPostingIDs(ids ...int) *JournalEntryUpdate {
+	jeu.mutation.AddPostingIDs(ids...)
+	return jeu
+}
+
+// AddPostings adds the "postings" edges to the Posting entity.
+func (jeu *JournalEntryUpdate) AddPostings(p ...*Posting) *JournalEntryUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return jeu.AddPostingIDs(ids...)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (jeu *JournalEntryUpdate) AddTransactionIDs(ids ...string) *JournalEntryUpdate {
+	jeu.mutation.AddTransactionIDs(ids...)
+	return jeu
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (jeu *JournalEntryUpdate) AddTransactions(t ...*Transaction) *JournalEntryUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return jeu.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the JournalEntryMutation object of the builder.
+func (jeu *JournalEntryUpdate) Mutation() *JournalEntryMutation {
+	return jeu.mutation
+}
+
+// ClearPostings clears all "postings" edges to the Posting entity.
+func (jeu *JournalEntryUpdate) ClearPostings() *JournalEntryUpdate {
+	jeu.mutation.ClearPostings()
+	return jeu
+}
+
+// RemovePostingIDs removes the "postings" edge to Posting entities by IDs.
+func (jeu *JournalEntryUpdate) RemovePostingIDs(ids ...int) *JournalEntryUpdate {
+	jeu.mutation.RemovePostingIDs(ids...)
+	return jeu
+}
+
+// RemovePostings removes "postings" edges to Posting entities.
+func (jeu *JournalEntryUpdate) RemovePostings(p ...*Posting) *JournalEntryUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return jeu.RemovePostingIDs(ids...)
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (jeu *JournalEntryUpdate) ClearTransactions() *JournalEntryUpdate {
+	jeu.mutation.ClearTransactions()
+	return jeu
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (jeu *JournalEntryUpdate) RemoveTransactionIDs(ids ...string) *JournalEntryUpdate {
+	jeu.mutation.RemoveTransactionIDs(ids...)
+	return jeu
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (jeu *JournalEntryUpdate) RemoveTransactions(t ...*Transaction) *JournalEntryUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return jeu.RemoveTransactionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jeu *JournalEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, jeu.sqlSave, jeu.mutation, jeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jeu *JournalEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := jeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jeu *JournalEntryUpdate) Exec(ctx context.Context) error {
+	_, err := jeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jeu *JournalEntryUpdate) ExecX(ctx context.Context) {
+	if err := jeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (jeu *JournalEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(journalentry.Table, journalentry.Columns, sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt))
+	if ps := jeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jeu.mutation.Description(); ok {
+		_spec.SetField(journalentry.FieldDescription, field.TypeString, value)
+	}
+	if jeu.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jeu.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !jeu.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jeu.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if jeu.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.TransactionsTable,
+			Columns: []string{journalentry.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jeu.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !jeu.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.TransactionsTable,
+			Columns: []string{journalentry.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jeu.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.TransactionsTable,
+			Columns: []string{journalentry.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, jeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{journalentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	jeu.mutation.done = true
+	return n, nil
+}
+
+// JournalEntryUpdateOne is the builder for updating a single JournalEntry entity.
+type JournalEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JournalEntryMutation
+}
+
+// SetDescription sets the "description" field.
+func (jeuo *JournalEntryUpdateOne) SetDescription(s string) *JournalEntryUpdateOne {
+	jeuo.mutation.SetDescription(s)
+	return jeuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (jeuo *JournalEntryUpdateOne) SetNillableDescription(s *string) *JournalEntryUpdateOne {
+	if s != nil {
+		jeuo.SetDescription(*s)
+	}
+	return jeuo
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by IDs.
+func (jeuo *JournalEntryUpdateOne) AddPostingIDs(ids ...int) *JournalEntryUpdateOne {
+	jeuo.mutation.AddPostingIDs(ids...)
+	return jeuo
+}
+
+// AddPostings adds the "postings" edges to the Posting entity.
+func (jeuo *JournalEntryUpdateOne) AddPostings(p ...*Posting) *JournalEntryUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return jeuo.AddPostingIDs(ids...)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (jeuo *JournalEntryUpdateOne) AddTransactionIDs(ids ...string) *JournalEntryUpdateOne {
+	jeuo.mutation.AddTransactionIDs(ids...)
+	return jeuo
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (jeuo *JournalEntryUpdateOne) AddTransactions(t ...*Transaction) *JournalEntryUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return jeuo.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the JournalEntryMutation object of the builder.
+func (jeuo *JournalEntryUpdateOne) Mutation() *JournalEntryMutation {
+	return jeuo.mutation
+}
+
+// ClearPostings clears all "postings" edges to the Posting entity.
+func (jeuo *JournalEntryUpdateOne) ClearPostings() *JournalEntryUpdateOne {
+	jeuo.mutation.ClearPostings()
+	return jeuo
+}
+
+// RemovePostingIDs removes the "postings" edge to Posting entities by IDs.
+func (jeuo *JournalEntryUpdateOne) RemovePostingIDs(ids ...int) *JournalEntryUpdateOne {
+	jeuo.mutation.RemovePostingIDs(ids...)
+	return jeuo
+}
+
+// RemovePostings removes "postings" edges to Posting entities.
+func (jeuo *JournalEntryUpdateOne) RemovePostings(p ...*Posting) *JournalEntryUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return jeuo.RemovePostingIDs(ids...)
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (jeuo *JournalEntryUpdateOne) ClearTransactions() *JournalEntryUpdateOne {
+	jeuo.mutation.ClearTransactions()
+	return jeuo
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (jeuo *JournalEntryUpdateOne) RemoveTransactionIDs(ids ...string) *JournalEntryUpdateOne {
+	jeuo.mutation.RemoveTransactionIDs(ids...)
+	return jeuo
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (jeuo *JournalEntryUpdateOne) RemoveTransactions(t ...*Transaction) *JournalEntryUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return jeuo.RemoveTransactionIDs(ids...)
+}
+
+// Where appends a list predicates to the JournalEntryUpdate builder.
+func (jeuo *JournalEntryUpdateOne) Where(ps ...predicate.JournalEntry) *JournalEntryUpdateOne {
+	jeuo.mutation.Where(ps...)
+	return jeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jeuo *JournalEntryUpdateOne) Select(field string, fields ...string) *JournalEntryUpdateOne {
+	jeuo.fields = append([]string{field}, fields...)
+	return jeuo
+}
+
+// Save executes the query and returns the updated JournalEntry entity.
+func (jeuo *JournalEntryUpdateOne) Save(ctx context.Context) (*JournalEntry, error) {
+	return withHooks(ctx, jeuo.sqlSave, jeuo.mutation, jeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jeuo *JournalEntryUpdateOne) SaveX(ctx context.Context) *JournalEntry {
+	node, err := jeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jeuo *JournalEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := jeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jeuo *JournalEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := jeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (jeuo *JournalEntryUpdateOne) sqlSave(ctx context.Context) (_node *JournalEntry, err error) {
+	_spec := sqlgraph.NewUpdateSpec(journalentry.Table, journalentry.Columns, sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt))
+	id, ok := jeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JournalEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, journalentry.FieldID)
+		for _, f := range fields {
+			if !journalentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != journalentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jeuo.mutation.Description(); ok {
+		_spec.SetField(journalentry.FieldDescription, field.TypeString, value)
+	}
+	if jeuo.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jeuo.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !jeuo.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jeuo.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if jeuo.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.TransactionsTable,
+			Columns: []string{journalentry.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jeuo.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !jeuo.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.TransactionsTable,
+			Columns: []string{journalentry.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jeuo.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.TransactionsTable,
+			Columns: []string{journalentry.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &JournalEntry{config: jeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{journalentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	jeuo.mutation.done = true
+	return _node, nil
+}
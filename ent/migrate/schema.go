@@ -9,13 +9,71 @@ import (
 )
 
 var (
+	// AccountsColumns holds the columns for the "accounts" table.
+	AccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"user", "system"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// AccountsTable holds the schema information for the "accounts" table.
+	AccountsTable = &schema.Table{
+		Name:       "accounts",
+		Columns:    AccountsColumns,
+		PrimaryKey: []*schema.Column{AccountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "accounts_users_accounts",
+				Columns:    []*schema.Column{AccountsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "account_user_id_currency",
+				Unique:  false,
+				Columns: []*schema.Column{AccountsColumns[6], AccountsColumns[4]},
+			},
+		},
+	}
+	// AccountingPeriodsColumns holds the columns for the "accounting_periods" table.
+	AccountingPeriodsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "closing", "closed"}, Default: "open"},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AccountingPeriodsTable holds the schema information for the "accounting_periods" table.
+	AccountingPeriodsTable = &schema.Table{
+		Name:       "accounting_periods",
+		Columns:    AccountingPeriodsColumns,
+		PrimaryKey: []*schema.Column{AccountingPeriodsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accountingperiod_starts_at_ends_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccountingPeriodsColumns[2], AccountingPeriodsColumns[3]},
+			},
+		},
+	}
 	// BalancesColumns holds the columns for the "balances" table.
 	BalancesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "currency", Type: field.TypeString},
-		{Name: "amount", Type: field.TypeFloat64, Default: 0},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "available", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "overdraft_limit", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "sequence", Type: field.TypeInt64, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "interest_plan_id", Type: field.TypeInt, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt},
 	}
 	// BalancesTable holds the schema information for the "balances" table.
@@ -24,9 +82,15 @@ var (
 		Columns:    BalancesColumns,
 		PrimaryKey: []*schema.Column{BalancesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "balances_interest_plans_balances",
+				Columns:    []*schema.Column{BalancesColumns[8]},
+				RefColumns: []*schema.Column{InterestPlansColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "balances_users_balances",
-				Columns:    []*schema.Column{BalancesColumns[5]},
+				Columns:    []*schema.Column{BalancesColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -40,22 +104,412 @@ var (
 			{
 				Name:    "balance_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{BalancesColumns[5]},
+				Columns: []*schema.Column{BalancesColumns[9]},
 			},
 			{
 				Name:    "balance_user_id_currency",
 				Unique:  true,
-				Columns: []*schema.Column{BalancesColumns[5], BalancesColumns[1]},
+				Columns: []*schema.Column{BalancesColumns[9], BalancesColumns[1]},
+			},
+		},
+	}
+	// BalanceSnapshotsColumns holds the columns for the "balance_snapshots" table.
+	BalanceSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "taken_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeInt},
+	}
+	// BalanceSnapshotsTable holds the schema information for the "balance_snapshots" table.
+	BalanceSnapshotsTable = &schema.Table{
+		Name:       "balance_snapshots",
+		Columns:    BalanceSnapshotsColumns,
+		PrimaryKey: []*schema.Column{BalanceSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "balance_snapshots_accounts_snapshots",
+				Columns:    []*schema.Column{BalanceSnapshotsColumns[4]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "balancesnapshot_account_id_taken_at",
+				Unique:  true,
+				Columns: []*schema.Column{BalanceSnapshotsColumns[4], BalanceSnapshotsColumns[2]},
+			},
+		},
+	}
+	// CurrenciesColumns holds the columns for the "currencies" table.
+	CurrenciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "numeric_code", Type: field.TypeString, Default: ""},
+		{Name: "name", Type: field.TypeString},
+		{Name: "exponent", Type: field.TypeInt},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CurrenciesTable holds the schema information for the "currencies" table.
+	CurrenciesTable = &schema.Table{
+		Name:       "currencies",
+		Columns:    CurrenciesColumns,
+		PrimaryKey: []*schema.Column{CurrenciesColumns[0]},
+	}
+	// ExchangeRatesColumns holds the columns for the "exchange_rates" table.
+	ExchangeRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "base_currency", Type: field.TypeString},
+		{Name: "quote_currency", Type: field.TypeString},
+		{Name: "rate", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(24,12)"}},
+		{Name: "spread", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(24,12)"}},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ExchangeRatesTable holds the schema information for the "exchange_rates" table.
+	ExchangeRatesTable = &schema.Table{
+		Name:       "exchange_rates",
+		Columns:    ExchangeRatesColumns,
+		PrimaryKey: []*schema.Column{ExchangeRatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exchangerate_base_currency_quote_currency_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{ExchangeRatesColumns[1], ExchangeRatesColumns[2], ExchangeRatesColumns[5]},
+			},
+		},
+	}
+	// FeeRulesColumns holds the columns for the "fee_rules" table.
+	FeeRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "transaction_type", Type: field.TypeEnum, Enums: []string{"deposit", "withdrawal", "transfer_out", "exchange_out"}},
+		{Name: "currency", Type: field.TypeString, Nullable: true},
+		{Name: "tier", Type: field.TypeString, Nullable: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"fixed", "percentage", "tiered"}},
+		{Name: "fixed_amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "percentage", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(24,12)"}},
+		{Name: "tiers", Type: field.TypeJSON, Nullable: true},
+		{Name: "min_fee", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "max_fee", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// FeeRulesTable holds the schema information for the "fee_rules" table.
+	FeeRulesTable = &schema.Table{
+		Name:       "fee_rules",
+		Columns:    FeeRulesColumns,
+		PrimaryKey: []*schema.Column{FeeRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "feerule_transaction_type_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{FeeRulesColumns[2], FeeRulesColumns[12]},
+			},
+		},
+	}
+	// HoldsColumns holds the columns for the "holds" table.
+	HoldsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "captured_amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "captured", "voided", "expired"}, Default: "active"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "transaction_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// HoldsTable holds the schema information for the "holds" table.
+	HoldsTable = &schema.Table{
+		Name:       "holds",
+		Columns:    HoldsColumns,
+		PrimaryKey: []*schema.Column{HoldsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "holds_transactions_transaction",
+				Columns:    []*schema.Column{HoldsColumns[8]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "holds_users_holds",
+				Columns:    []*schema.Column{HoldsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "hold_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{HoldsColumns[9]},
+			},
+			{
+				Name:    "hold_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{HoldsColumns[4], HoldsColumns[5]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 512},
+		{Name: "request_hash", Type: field.TypeString},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "response_body", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[4]},
+			},
+		},
+	}
+	// InterestAccrualsColumns holds the columns for the "interest_accruals" table.
+	InterestAccrualsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "principal", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "annual_percentage", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(24,12)"}},
+		{Name: "day_count", Type: field.TypeEnum, Enums: []string{"act_365", "thirty_360"}},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,12)"}},
+		{Name: "transaction_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "balance_id", Type: field.TypeInt},
+	}
+	// InterestAccrualsTable holds the schema information for the "interest_accruals" table.
+	InterestAccrualsTable = &schema.Table{
+		Name:       "interest_accruals",
+		Columns:    InterestAccrualsColumns,
+		PrimaryKey: []*schema.Column{InterestAccrualsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "interest_accruals_balances_interest_accruals",
+				Columns:    []*schema.Column{InterestAccrualsColumns[8]},
+				RefColumns: []*schema.Column{BalancesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "interestaccrual_balance_id_date",
+				Unique:  true,
+				Columns: []*schema.Column{InterestAccrualsColumns[8], InterestAccrualsColumns[1]},
+			},
+		},
+	}
+	// InterestPlansColumns holds the columns for the "interest_plans" table.
+	InterestPlansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "annual_percentage", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(24,12)"}},
+		{Name: "day_count", Type: field.TypeEnum, Enums: []string{"act_365", "thirty_360"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// InterestPlansTable holds the schema information for the "interest_plans" table.
+	InterestPlansTable = &schema.Table{
+		Name:       "interest_plans",
+		Columns:    InterestPlansColumns,
+		PrimaryKey: []*schema.Column{InterestPlansColumns[0]},
+	}
+	// JournalEntriesColumns holds the columns for the "journal_entries" table.
+	JournalEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "effective_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// JournalEntriesTable holds the schema information for the "journal_entries" table.
+	JournalEntriesTable = &schema.Table{
+		Name:       "journal_entries",
+		Columns:    JournalEntriesColumns,
+		PrimaryKey: []*schema.Column{JournalEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "journalentry_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{JournalEntriesColumns[3]},
+			},
+			{
+				Name:    "journalentry_effective_at",
+				Unique:  false,
+				Columns: []*schema.Column{JournalEntriesColumns[2]},
+			},
+		},
+	}
+	// PostingsColumns holds the columns for the "postings" table.
+	PostingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "effective_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeInt},
+		{Name: "journal_entry_id", Type: field.TypeInt},
+	}
+	// PostingsTable holds the schema information for the "postings" table.
+	PostingsTable = &schema.Table{
+		Name:       "postings",
+		Columns:    PostingsColumns,
+		PrimaryKey: []*schema.Column{PostingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "postings_accounts_postings",
+				Columns:    []*schema.Column{PostingsColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "postings_journal_entries_postings",
+				Columns:    []*schema.Column{PostingsColumns[6]},
+				RefColumns: []*schema.Column{JournalEntriesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "posting_journal_entry_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostingsColumns[6]},
+			},
+			{
+				Name:    "posting_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostingsColumns[5]},
+			},
+			{
+				Name:    "posting_account_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PostingsColumns[5], PostingsColumns[4]},
+			},
+			{
+				Name:    "posting_effective_at_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostingsColumns[3], PostingsColumns[5]},
+			},
+		},
+	}
+	// SchedulesColumns holds the columns for the "schedules" table.
+	SchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"deposit", "withdrawal"}},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "frequency", Type: field.TypeEnum, Enums: []string{"once", "daily", "weekly", "monthly", "yearly"}},
+		{Name: "interval", Type: field.TypeInt, Default: 1},
+		{Name: "count", Type: field.TypeInt, Nullable: true},
+		{Name: "start_at", Type: field.TypeTime},
+		{Name: "end_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "completed", "cancelled"}, Default: "active"},
+		{Name: "next_occurrence", Type: field.TypeInt, Default: 0},
+		{Name: "next_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SchedulesTable holds the schema information for the "schedules" table.
+	SchedulesTable = &schema.Table{
+		Name:       "schedules",
+		Columns:    SchedulesColumns,
+		PrimaryKey: []*schema.Column{SchedulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "schedules_users_schedules",
+				Columns:    []*schema.Column{SchedulesColumns[16]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "schedule_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SchedulesColumns[16]},
+			},
+			{
+				Name:    "schedule_status_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{SchedulesColumns[9], SchedulesColumns[11]},
+			},
+		},
+	}
+	// ScheduleRunsColumns holds the columns for the "schedule_runs" table.
+	ScheduleRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "occurrence", Type: field.TypeInt},
+		{Name: "scheduled_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"succeeded", "failed"}},
+		{Name: "transaction_id", Type: field.TypeString, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "schedule_id", Type: field.TypeInt},
+	}
+	// ScheduleRunsTable holds the schema information for the "schedule_runs" table.
+	ScheduleRunsTable = &schema.Table{
+		Name:       "schedule_runs",
+		Columns:    ScheduleRunsColumns,
+		PrimaryKey: []*schema.Column{ScheduleRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "schedule_runs_schedules_runs",
+				Columns:    []*schema.Column{ScheduleRunsColumns[7]},
+				RefColumns: []*schema.Column{SchedulesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "schedulerun_schedule_id_occurrence",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduleRunsColumns[7], ScheduleRunsColumns[1]},
 			},
 		},
 	}
 	// TransactionsColumns holds the columns for the "transactions" table.
 	TransactionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
-		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
 		{Name: "currency", Type: field.TypeString, Default: "USD"},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"deposit", "withdrawal"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"deposit", "withdrawal", "transfer_in", "transfer_out", "exchange_in", "exchange_out", "adjustment_in", "adjustment_out", "fee", "fee_refund", "interest"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "posted", "failed", "partially_reversed", "reversed"}, Default: "posted"},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true},
+		{Name: "posted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reversed_amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "transfer_id", Type: field.TypeString, Nullable: true},
+		{Name: "exchange_id", Type: field.TypeString, Nullable: true},
+		{Name: "applied_rate", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(24,12)"}},
+		{Name: "balance_after", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "balance_sequence", Type: field.TypeInt64, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "category", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "source", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "external_ref", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "effective_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "exchange_rate_id", Type: field.TypeInt, Nullable: true},
+		{Name: "journal_entry_id", Type: field.TypeInt, Nullable: true},
+		{Name: "reversal_of_id", Type: field.TypeString, Nullable: true},
+		{Name: "fee_of_id", Type: field.TypeString, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt},
 	}
 	// TransactionsTable holds the schema information for the "transactions" table.
@@ -64,9 +518,33 @@ var (
 		Columns:    TransactionsColumns,
 		PrimaryKey: []*schema.Column{TransactionsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transactions_exchange_rates_transactions",
+				Columns:    []*schema.Column{TransactionsColumns[20]},
+				RefColumns: []*schema.Column{ExchangeRatesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "transactions_journal_entries_transactions",
+				Columns:    []*schema.Column{TransactionsColumns[21]},
+				RefColumns: []*schema.Column{JournalEntriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "transactions_transactions_reversals",
+				Columns:    []*schema.Column{TransactionsColumns[22]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "transactions_transactions_fees",
+				Columns:    []*schema.Column{TransactionsColumns[23]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "transactions_users_transactions",
-				Columns:    []*schema.Column{TransactionsColumns[5]},
+				Columns:    []*schema.Column{TransactionsColumns[24]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -75,12 +553,99 @@ var (
 			{
 				Name:    "transaction_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[5]},
+				Columns: []*schema.Column{TransactionsColumns[24]},
 			},
 			{
 				Name:    "transaction_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[4]},
+				Columns: []*schema.Column{TransactionsColumns[19]},
+			},
+			{
+				Name:    "transaction_effective_at",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[18]},
+			},
+			{
+				Name:    "transaction_transfer_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[8]},
+			},
+			{
+				Name:    "transaction_exchange_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[9]},
+			},
+			{
+				Name:    "transaction_reversal_of_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[22]},
+			},
+			{
+				Name:    "transaction_fee_of_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[23]},
+			},
+			{
+				Name:    "transaction_user_id_currency_balance_sequence",
+				Unique:  true,
+				Columns: []*schema.Column{TransactionsColumns[24], TransactionsColumns[2], TransactionsColumns[12]},
+			},
+			{
+				Name:    "transaction_source_external_ref",
+				Unique:  true,
+				Columns: []*schema.Column{TransactionsColumns[15], TransactionsColumns[16]},
+			},
+			{
+				Name:    "transaction_category",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[14]},
+			},
+			{
+				Name:    "transaction_user_id_currency_effective_at",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[24], TransactionsColumns[2], TransactionsColumns[18]},
+			},
+			{
+				Name:    "transaction_user_id_currency_type_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[24], TransactionsColumns[2], TransactionsColumns[3], TransactionsColumns[19]},
+			},
+		},
+	}
+	// TrialBalanceLinesColumns holds the columns for the "trial_balance_lines" table.
+	TrialBalanceLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "movement", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "balance", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeInt},
+		{Name: "period_id", Type: field.TypeInt},
+	}
+	// TrialBalanceLinesTable holds the schema information for the "trial_balance_lines" table.
+	TrialBalanceLinesTable = &schema.Table{
+		Name:       "trial_balance_lines",
+		Columns:    TrialBalanceLinesColumns,
+		PrimaryKey: []*schema.Column{TrialBalanceLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "trial_balance_lines_accounts_trial_balance_lines",
+				Columns:    []*schema.Column{TrialBalanceLinesColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "trial_balance_lines_accounting_periods_trial_balance_lines",
+				Columns:    []*schema.Column{TrialBalanceLinesColumns[6]},
+				RefColumns: []*schema.Column{AccountingPeriodsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "trialbalanceline_period_id_account_id",
+				Unique:  true,
+				Columns: []*schema.Column{TrialBalanceLinesColumns[6], TrialBalanceLinesColumns[5]},
 			},
 		},
 	}
@@ -89,8 +654,14 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Unique: true},
-		{Name: "age", Type: field.TypeInt},
+		{Name: "age", Type: field.TypeInt, Nullable: true},
+		{Name: "tier", Type: field.TypeString, Default: "standard"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "frozen", "closed"}, Default: "active"},
+		{Name: "status_reason", Type: field.TypeString, Nullable: true},
+		{Name: "status_changed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "erased_at", Type: field.TypeTime, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -98,19 +669,80 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// VelocityLimitsColumns holds the columns for the "velocity_limits" table.
+	VelocityLimitsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "transaction_type", Type: field.TypeEnum, Enums: []string{"deposit", "withdrawal"}},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "tier", Type: field.TypeString, Nullable: true},
+		{Name: "window_seconds", Type: field.TypeInt64},
+		{Name: "max_count", Type: field.TypeInt, Nullable: true},
+		{Name: "max_amount", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(30,8)"}},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// VelocityLimitsTable holds the schema information for the "velocity_limits" table.
+	VelocityLimitsTable = &schema.Table{
+		Name:       "velocity_limits",
+		Columns:    VelocityLimitsColumns,
+		PrimaryKey: []*schema.Column{VelocityLimitsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "velocitylimit_transaction_type_currency_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{VelocityLimitsColumns[2], VelocityLimitsColumns[3], VelocityLimitsColumns[8]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccountsTable,
+		AccountingPeriodsTable,
 		BalancesTable,
+		BalanceSnapshotsTable,
+		CurrenciesTable,
+		ExchangeRatesTable,
+		FeeRulesTable,
+		HoldsTable,
+		IdempotencyKeysTable,
+		InterestAccrualsTable,
+		InterestPlansTable,
+		JournalEntriesTable,
+		PostingsTable,
+		SchedulesTable,
+		ScheduleRunsTable,
 		TransactionsTable,
+		TrialBalanceLinesTable,
 		UsersTable,
+		VelocityLimitsTable,
 	}
 )
 
 func init() {
-	BalancesTable.ForeignKeys[0].RefTable = UsersTable
+	AccountsTable.ForeignKeys[0].RefTable = UsersTable
+	BalancesTable.ForeignKeys[0].RefTable = InterestPlansTable
+	BalancesTable.ForeignKeys[1].RefTable = UsersTable
 	BalancesTable.Annotation = &entsql.Annotation{}
 	BalancesTable.Annotation.Checks = map[string]string{
-		"balance_amount_non_negative": "amount >= 0",
+		"balance_amount_within_overdraft":      "amount >= -overdraft_limit",
+		"balance_available_within_overdraft":   "available >= -overdraft_limit",
+		"balance_overdraft_limit_non_negative": "overdraft_limit >= 0",
 	}
-	TransactionsTable.ForeignKeys[0].RefTable = UsersTable
+	BalanceSnapshotsTable.ForeignKeys[0].RefTable = AccountsTable
+	HoldsTable.ForeignKeys[0].RefTable = TransactionsTable
+	HoldsTable.ForeignKeys[1].RefTable = UsersTable
+	InterestAccrualsTable.ForeignKeys[0].RefTable = BalancesTable
+	PostingsTable.ForeignKeys[0].RefTable = AccountsTable
+	PostingsTable.ForeignKeys[1].RefTable = JournalEntriesTable
+	SchedulesTable.ForeignKeys[0].RefTable = UsersTable
+	ScheduleRunsTable.ForeignKeys[0].RefTable = SchedulesTable
+	TransactionsTable.ForeignKeys[0].RefTable = ExchangeRatesTable
+	TransactionsTable.ForeignKeys[1].RefTable = JournalEntriesTable
+	TransactionsTable.ForeignKeys[2].RefTable = TransactionsTable
+	TransactionsTable.ForeignKeys[3].RefTable = TransactionsTable
+	TransactionsTable.ForeignKeys[4].RefTable = UsersTable
+	TrialBalanceLinesTable.ForeignKeys[0].RefTable = AccountsTable
+	TrialBalanceLinesTable.ForeignKeys[1].RefTable = AccountingPeriodsTable
 }
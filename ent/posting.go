@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/account"
+	"accounting/ent/journalentry"
+	"accounting/ent/posting"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// Posting is the model entity for the Posting schema.
+type Posting struct {
+	config `json:"-"`
+	// ID of the ent.
+	// ID of the posting
+	ID int `json:"id,omitempty"`
+	// ID of the journal entry, to which the posting belongs
+	JournalEntryID int `json:"journal_entry_id,omitempty"`
+	// ID of the account affected by the posting
+	AccountID int `json:"account_id,omitempty"`
+	// Signed amount: positive increases the account balance, negative decreases it
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Currency of the posting
+	Currency string `json:"currency,omitempty"`
+	// Time the posting takes effect in the books, copied from its journal entry
+	EffectiveAt time.Time `json:"effective_at,omitempty"`
+	// Time of the posting creation
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PostingQuery when eager-loading is set.
+	Edges        PostingEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PostingEdges holds the relations/edges for other nodes in the graph.
+type PostingEdges struct {
+	// Journal entry, to which the posting belongs
+	JournalEntry *JournalEntry `json:"journal_entry,omitempty"`
+	// Account affected by the posting
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// JournalEntryOrErr returns the JournalEntry value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostingEdges) JournalEntryOrErr() (*JournalEntry, error) {
+	if e.JournalEntry != nil {
+		return e.JournalEntry, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: journalentry.Label}
+	}
+	return nil, &NotLoadedError{edge: "journal_entry"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostingEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Posting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case posting.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case posting.FieldID, posting.FieldJournalEntryID, posting.FieldAccountID:
+			values[i] = new(sql.NullInt64)
+		case posting.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case posting.FieldEffectiveAt, posting.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Posting fields.
+func (po *Posting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case posting.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			po.ID = int(value.Int64)
+		case posting.FieldJournalEntryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value.Valid {
+				po.JournalEntryID = int(value.Int64)
+			}
+		case posting.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				po.AccountID = int(value.Int64)
+			}
+		case posting.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				po.Amount = *value
+			}
+		case posting.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				po.Currency = value.String
+			}
+		case posting.FieldEffectiveAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_at", values[i])
+			} else if value.Valid {
+				po.EffectiveAt = value.Time
+			}
+		case posting.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				po.CreatedAt = value.Time
+			}
+		default:
+			po.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Posting.
+// This includes values selected through modifiers, order, etc.
+func (po *Posting) Value(name string) (ent.Value, error) {
+	return po.selectValues.Get(name)
+}
+
+// QueryJournalEntry queries the "journal_entry" edge of the Posting entity.
+func (po *Posting) QueryJournalEntry() *JournalEntryQuery {
+	return NewPostingClient(po.config).QueryJournalEntry(po)
+}
+
+// QueryAccount queries the "account" edge of the Posting entity.
+func (po *Posting) QueryAccount() *AccountQuery {
+	return NewPostingClient(po.config).QueryAccount(po)
+}
+
+// Update returns a builder for updating this Posting.
+// Note that you need to call Posting.Unwrap() before calling this method if this Posting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (po *Posting) Update() *PostingUpdateOne {
+	return NewPostingClient(po.config).UpdateOne(po)
+}
+
+// Unwrap unwraps the Posting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (po *Posting) Unwrap() *Posting {
+	_tx, ok := po.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Posting is not a transactional entity")
+	}
+	po.config.driver = _tx.drv
+	return po
+}
+
+// String implements the fmt.Stringer.
+func (po *Posting) String() string {
+	var builder strings.Builder
+	builder.WriteString("Posting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", po.ID))
+	builder.WriteString("journal_entry_id=")
+	builder.WriteString(fmt.Sprintf("%v", po.JournalEntryID))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", po.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", po.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(po.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("effective_at=")
+	builder.WriteString(po.EffectiveAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(po.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Postings is a parsable slice of Posting.
+type Postings []*Posting
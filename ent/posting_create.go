@@ -0,0 +1,623 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/account"
+	"accounting/ent/journalentry"
+	"accounting/ent/posting"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// PostingCreate is the builder for creating a Posting entity.
+type PostingCreate struct {
+	config
+	mutation *PostingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (pc *PostingCreate) SetJournalEntryID(i int) *PostingCreate {
+	pc.mutation.SetJournalEntryID(i)
+	return pc
+}
+
+// SetAccountID sets the "account_id" field.
+func (pc *PostingCreate) SetAccountID(i int) *PostingCreate {
+	pc.mutation.SetAccountID(i)
+	return pc
+}
+
+// SetAmount sets the "amount" field.
+func (pc *PostingCreate) SetAmount(d decimal.Decimal) *PostingCreate {
+	pc.mutation.SetAmount(d)
+	return pc
+}
+
+// SetCurrency sets the "currency" field.
+func (pc *PostingCreate) SetCurrency(s string) *PostingCreate {
+	pc.mutation.SetCurrency(s)
+	return pc
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (pc *PostingCreate) SetEffectiveAt(t time.Time) *PostingCreate {
+	pc.mutation.SetEffectiveAt(t)
+	return pc
+}
+
+// SetNillableEffectiveAt sets the "effective_at" field if the given value is not nil.
+func (pc *PostingCreate) SetNillableEffectiveAt(t *time.Time) *PostingCreate {
+	if t != nil {
+		pc.SetEffectiveAt(*t)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PostingCreate) SetCreatedAt(t time.Time) *PostingCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PostingCreate) SetNillableCreatedAt(t *time.Time) *PostingCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *PostingCreate) SetID(i int) *PostingCreate {
+	pc.mutation.SetID(i)
+	return pc
+}
+
+// SetJournalEntry sets the "journal_entry" edge to the JournalEntry entity.
+func (pc *PostingCreate) SetJournalEntry(j *JournalEntry) *PostingCreate {
+	return pc.SetJournalEntryID(j.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (pc *PostingCreate) SetAccount(a *Account) *PostingCreate {
+	return pc.SetAccountID(a.ID)
+}
+
+// Mutation returns the PostingMutation object of the builder.
+func (pc *PostingCreate) Mutation() *PostingMutation {
+	return pc.mutation
+}
+
+// Save creates the Posting in the database.
+func (pc *PostingCreate) Save(ctx context.Context) (*Posting, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PostingCreate) SaveX(ctx context.Context) *Posting {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PostingCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PostingCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PostingCreate) defaults() {
+	if _, ok := pc.mutation.EffectiveAt(); !ok {
+		v := posting.DefaultEffectiveAt()
+		pc.mutation.SetEffectiveAt(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := posting.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PostingCreate) check() error {
+	if _, ok := pc.mutation.JournalEntryID(); !ok {
+		return &ValidationError{Name: "journal_entry_id", err: errors.New(`ent: missing required field "Posting.journal_entry_id"`)}
+	}
+	if _, ok := pc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "Posting.account_id"`)}
+	}
+	if _, ok := pc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Posting.amount"`)}
+	}
+	if _, ok := pc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Posting.currency"`)}
+	}
+	if v, ok := pc.mutation.Currency(); ok {
+		if err := posting.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Posting.currency": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Posting.created_at"`)}
+	}
+	if len(pc.mutation.JournalEntryIDs()) == 0 {
+		return &ValidationError{Name: "journal_entry", err: errors.New(`ent: missing required edge "Posting.journal_entry"`)}
+	}
+	if len(pc.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Posting.account"`)}
+	}
+	return nil
+}
+
+func (pc *PostingCreate) sqlSave(ctx context.Context) (*Posting, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PostingCreate) createSpec() (*Posting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Posting{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(posting.Table, sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = pc.conflict
+	if id, ok := pc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pc.mutation.Amount(); ok {
+		_spec.SetField(posting.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := pc.mutation.Currency(); ok {
+		_spec.SetField(posting.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := pc.mutation.EffectiveAt(); ok {
+		_spec.SetField(posting.FieldEffectiveAt, field.TypeTime, value)
+		_node.EffectiveAt = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(posting.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pc.mutation.JournalEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posting.JournalEntryTable,
+			Columns: []string{posting.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.JournalEntryID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posting.AccountTable,
+			Columns: []string{posting.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Posting.Create().
+//		SetJournalEntryID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostingUpsert) {
+//			SetJournalEntryID(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *PostingCreate) OnConflict(opts ...sql.ConflictOption) *PostingUpsertOne {
+	pc.conflict = opts
+	return &PostingUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Posting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *PostingCreate) OnConflictColumns(columns ...string) *PostingUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PostingUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PostingUpsertOne is the builder for "upsert"-ing
+	//  one Posting node.
+	PostingUpsertOne struct {
+		create *PostingCreate
+	}
+
+	// PostingUpsert is the "OnConflict" setter.
+	PostingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Posting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(posting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostingUpsertOne) UpdateNewValues() *PostingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(posting.FieldID)
+		}
+		if _, exists := u.create.mutation.JournalEntryID(); exists {
+			s.SetIgnore(posting.FieldJournalEntryID)
+		}
+		if _, exists := u.create.mutation.AccountID(); exists {
+			s.SetIgnore(posting.FieldAccountID)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(posting.FieldAmount)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(posting.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.EffectiveAt(); exists {
+			s.SetIgnore(posting.FieldEffectiveAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(posting.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Posting.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostingUpsertOne) Ignore() *PostingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostingUpsertOne) DoNothing() *PostingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostingCreate.OnConflict
+// documentation for more info.
+func (u *PostingUpsertOne) Update(set func(*PostingUpsert)) *PostingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PostingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PostingUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PostingUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PostingCreateBulk is the builder for creating many Posting entities in bulk.
+type PostingCreateBulk struct {
+	config
+	err      error
+	builders []*PostingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Posting entities in the database.
+func (pcb *PostingCreateBulk) Save(ctx context.Context) ([]*Posting, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Posting, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PostingCreateBulk) SaveX(ctx context.Context) []*Posting {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PostingCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PostingCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Posting.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostingUpsert) {
+//			SetJournalEntryID(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *PostingCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostingUpsertBulk {
+	pcb.conflict = opts
+	return &PostingUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Posting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *PostingCreateBulk) OnConflictColumns(columns ...string) *PostingUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PostingUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PostingUpsertBulk is the builder for "upsert"-ing
+// a bulk of Posting nodes.
+type PostingUpsertBulk struct {
+	create *PostingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Posting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(posting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostingUpsertBulk) UpdateNewValues() *PostingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(posting.FieldID)
+			}
+			if _, exists := b.mutation.JournalEntryID(); exists {
+				s.SetIgnore(posting.FieldJournalEntryID)
+			}
+			if _, exists := b.mutation.AccountID(); exists {
+				s.SetIgnore(posting.FieldAccountID)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(posting.FieldAmount)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(posting.FieldCurrency)
+			}
+			if _, exists := b.mutation.EffectiveAt(); exists {
+				s.SetIgnore(posting.FieldEffectiveAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(posting.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Posting.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostingUpsertBulk) Ignore() *PostingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostingUpsertBulk) DoNothing() *PostingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostingCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostingUpsertBulk) Update(set func(*PostingUpsert)) *PostingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PostingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PostingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
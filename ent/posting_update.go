@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/posting"
+	"accounting/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PostingUpdate is the builder for updating Posting entities.
+type PostingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostingMutation
+}
+
+// Where appends a list predicates to the PostingUpdate builder.
+func (pu *PostingUpdate) Where(ps ...predicate.Posting) *PostingUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// Mutation returns the PostingMutation object of the builder.
+func (pu *PostingUpdate) Mutation() *PostingMutation {
+	return pu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PostingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PostingUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PostingUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PostingUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PostingUpdate) check() error {
+	if pu.mutation.JournalEntryCleared() && len(pu.mutation.JournalEntryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Posting.journal_entry"`)
+	}
+	if pu.mutation.AccountCleared() && len(pu.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Posting.account"`)
+	}
+	return nil
+}
+
+func (pu *PostingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(posting.Table, posting.Columns, sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{posting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PostingUpdateOne is the builder for updating a single Posting entity.
+type PostingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostingMutation
+}
+
+// Mutation returns the PostingMutation object of the builder.
+func (puo *PostingUpdateOne) Mutation() *PostingMutation {
+	return puo.mutation
+}
+
+// Where appends a list predicates to the PostingUpdate builder.
+func (puo *PostingUpdateOne) Where(ps ...predicate.Posting) *PostingUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PostingUpdateOne) Select(field string, fields ...string) *PostingUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Posting entity.
+func (puo *PostingUpdateOne) Save(ctx context.Context) (*Posting, error) {
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PostingUpdateOne) SaveX(ctx context.Context) *Posting {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PostingUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PostingUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PostingUpdateOne) check() error {
+	if puo.mutation.JournalEntryCleared() && len(puo.mutation.JournalEntryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Posting.journal_entry"`)
+	}
+	if puo.mutation.AccountCleared() && len(puo.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Posting.account"`)
+	}
+	return nil
+}
+
+func (puo *PostingUpdateOne) sqlSave(ctx context.Context) (_node *Posting, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(posting.Table, posting.Columns, sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Posting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, posting.FieldID)
+		for _, f := range fields {
+			if !posting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != posting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &Posting{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{posting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
@@ -6,11 +6,59 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Account is the predicate function for account builders.
+type Account func(*sql.Selector)
+
+// AccountingPeriod is the predicate function for accountingperiod builders.
+type AccountingPeriod func(*sql.Selector)
+
 // Balance is the predicate function for balance builders.
 type Balance func(*sql.Selector)
 
+// BalanceSnapshot is the predicate function for balancesnapshot builders.
+type BalanceSnapshot func(*sql.Selector)
+
+// Currency is the predicate function for currency builders.
+type Currency func(*sql.Selector)
+
+// ExchangeRate is the predicate function for exchangerate builders.
+type ExchangeRate func(*sql.Selector)
+
+// FeeRule is the predicate function for feerule builders.
+type FeeRule func(*sql.Selector)
+
+// Hold is the predicate function for hold builders.
+type Hold func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// InterestAccrual is the predicate function for interestaccrual builders.
+type InterestAccrual func(*sql.Selector)
+
+// InterestPlan is the predicate function for interestplan builders.
+type InterestPlan func(*sql.Selector)
+
+// JournalEntry is the predicate function for journalentry builders.
+type JournalEntry func(*sql.Selector)
+
+// Posting is the predicate function for posting builders.
+type Posting func(*sql.Selector)
+
+// Schedule is the predicate function for schedule builders.
+type Schedule func(*sql.Selector)
+
+// ScheduleRun is the predicate function for schedulerun builders.
+type ScheduleRun func(*sql.Selector)
+
 // Transaction is the predicate function for transaction builders.
 type Transaction func(*sql.Selector)
 
+// TrialBalanceLine is the predicate function for trialbalanceline builders.
+type TrialBalanceLine func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// VelocityLimit is the predicate function for velocitylimit builders.
+type VelocityLimit func(*sql.Selector)
@@ -2,7 +2,455 @@
 
 package runtime
 
-// The schema-stitching logic is generated in accounting/ent/runtime.go
+import (
+	"accounting/ent/account"
+	"accounting/ent/accountingperiod"
+	"accounting/ent/balance"
+	"accounting/ent/balancesnapshot"
+	"accounting/ent/currency"
+	"accounting/ent/exchangerate"
+	"accounting/ent/feerule"
+	"accounting/ent/hold"
+	"accounting/ent/idempotencykey"
+	"accounting/ent/interestaccrual"
+	"accounting/ent/interestplan"
+	"accounting/ent/journalentry"
+	"accounting/ent/posting"
+	"accounting/ent/schedule"
+	"accounting/ent/schedulerun"
+	"accounting/ent/schema"
+	"accounting/ent/transaction"
+	"accounting/ent/trialbalanceline"
+	"accounting/ent/user"
+	"accounting/ent/velocitylimit"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	accountFields := schema.Account{}.Fields()
+	_ = accountFields
+	// accountDescCode is the schema descriptor for code field.
+	accountDescCode := accountFields[1].Descriptor()
+	// account.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	account.CodeValidator = accountDescCode.Validators[0].(func(string) error)
+	// accountDescName is the schema descriptor for name field.
+	accountDescName := accountFields[3].Descriptor()
+	// account.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	account.NameValidator = accountDescName.Validators[0].(func(string) error)
+	// accountDescCurrency is the schema descriptor for currency field.
+	accountDescCurrency := accountFields[5].Descriptor()
+	// account.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	account.CurrencyValidator = accountDescCurrency.Validators[0].(func(string) error)
+	// accountDescCreatedAt is the schema descriptor for created_at field.
+	accountDescCreatedAt := accountFields[6].Descriptor()
+	// account.DefaultCreatedAt holds the default value on creation for the created_at field.
+	account.DefaultCreatedAt = accountDescCreatedAt.Default.(func() time.Time)
+	accountingperiodFields := schema.AccountingPeriod{}.Fields()
+	_ = accountingperiodFields
+	// accountingperiodDescName is the schema descriptor for name field.
+	accountingperiodDescName := accountingperiodFields[0].Descriptor()
+	// accountingperiod.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	accountingperiod.NameValidator = accountingperiodDescName.Validators[0].(func(string) error)
+	// accountingperiodDescCreatedAt is the schema descriptor for created_at field.
+	accountingperiodDescCreatedAt := accountingperiodFields[5].Descriptor()
+	// accountingperiod.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accountingperiod.DefaultCreatedAt = accountingperiodDescCreatedAt.Default.(func() time.Time)
+	balanceFields := schema.Balance{}.Fields()
+	_ = balanceFields
+	// balanceDescCurrency is the schema descriptor for currency field.
+	balanceDescCurrency := balanceFields[2].Descriptor()
+	// balance.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	balance.CurrencyValidator = balanceDescCurrency.Validators[0].(func(string) error)
+	// balanceDescAmount is the schema descriptor for amount field.
+	balanceDescAmount := balanceFields[3].Descriptor()
+	// balance.DefaultAmount holds the default value on creation for the amount field.
+	balance.DefaultAmount = balanceDescAmount.Default.(func() decimal.Decimal)
+	// balanceDescAvailable is the schema descriptor for available field.
+	balanceDescAvailable := balanceFields[4].Descriptor()
+	// balance.DefaultAvailable holds the default value on creation for the available field.
+	balance.DefaultAvailable = balanceDescAvailable.Default.(func() decimal.Decimal)
+	// balanceDescOverdraftLimit is the schema descriptor for overdraft_limit field.
+	balanceDescOverdraftLimit := balanceFields[5].Descriptor()
+	// balance.DefaultOverdraftLimit holds the default value on creation for the overdraft_limit field.
+	balance.DefaultOverdraftLimit = balanceDescOverdraftLimit.Default.(func() decimal.Decimal)
+	// balanceDescSequence is the schema descriptor for sequence field.
+	balanceDescSequence := balanceFields[7].Descriptor()
+	// balance.DefaultSequence holds the default value on creation for the sequence field.
+	balance.DefaultSequence = balanceDescSequence.Default.(int64)
+	// balance.SequenceValidator is a validator for the "sequence" field. It is called by the builders before save.
+	balance.SequenceValidator = balanceDescSequence.Validators[0].(func(int64) error)
+	// balanceDescCreatedAt is the schema descriptor for created_at field.
+	balanceDescCreatedAt := balanceFields[8].Descriptor()
+	// balance.DefaultCreatedAt holds the default value on creation for the created_at field.
+	balance.DefaultCreatedAt = balanceDescCreatedAt.Default.(func() time.Time)
+	// balanceDescUpdatedAt is the schema descriptor for updated_at field.
+	balanceDescUpdatedAt := balanceFields[9].Descriptor()
+	// balance.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	balance.DefaultUpdatedAt = balanceDescUpdatedAt.Default.(func() time.Time)
+	// balance.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	balance.UpdateDefaultUpdatedAt = balanceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	balancesnapshotFields := schema.BalanceSnapshot{}.Fields()
+	_ = balancesnapshotFields
+	// balancesnapshotDescCreatedAt is the schema descriptor for created_at field.
+	balancesnapshotDescCreatedAt := balancesnapshotFields[3].Descriptor()
+	// balancesnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	balancesnapshot.DefaultCreatedAt = balancesnapshotDescCreatedAt.Default.(func() time.Time)
+	currencyFields := schema.Currency{}.Fields()
+	_ = currencyFields
+	// currencyDescNumericCode is the schema descriptor for numeric_code field.
+	currencyDescNumericCode := currencyFields[1].Descriptor()
+	// currency.DefaultNumericCode holds the default value on creation for the numeric_code field.
+	currency.DefaultNumericCode = currencyDescNumericCode.Default.(string)
+	// currencyDescName is the schema descriptor for name field.
+	currencyDescName := currencyFields[2].Descriptor()
+	// currency.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	currency.NameValidator = currencyDescName.Validators[0].(func(string) error)
+	// currencyDescExponent is the schema descriptor for exponent field.
+	currencyDescExponent := currencyFields[3].Descriptor()
+	// currency.ExponentValidator is a validator for the "exponent" field. It is called by the builders before save.
+	currency.ExponentValidator = currencyDescExponent.Validators[0].(func(int) error)
+	// currencyDescEnabled is the schema descriptor for enabled field.
+	currencyDescEnabled := currencyFields[4].Descriptor()
+	// currency.DefaultEnabled holds the default value on creation for the enabled field.
+	currency.DefaultEnabled = currencyDescEnabled.Default.(bool)
+	// currencyDescCreatedAt is the schema descriptor for created_at field.
+	currencyDescCreatedAt := currencyFields[5].Descriptor()
+	// currency.DefaultCreatedAt holds the default value on creation for the created_at field.
+	currency.DefaultCreatedAt = currencyDescCreatedAt.Default.(func() time.Time)
+	// currencyDescUpdatedAt is the schema descriptor for updated_at field.
+	currencyDescUpdatedAt := currencyFields[6].Descriptor()
+	// currency.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	currency.DefaultUpdatedAt = currencyDescUpdatedAt.Default.(func() time.Time)
+	// currency.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	currency.UpdateDefaultUpdatedAt = currencyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// currencyDescID is the schema descriptor for id field.
+	currencyDescID := currencyFields[0].Descriptor()
+	// currency.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	currency.IDValidator = currencyDescID.Validators[0].(func(string) error)
+	exchangerateFields := schema.ExchangeRate{}.Fields()
+	_ = exchangerateFields
+	// exchangerateDescBaseCurrency is the schema descriptor for base_currency field.
+	exchangerateDescBaseCurrency := exchangerateFields[1].Descriptor()
+	// exchangerate.BaseCurrencyValidator is a validator for the "base_currency" field. It is called by the builders before save.
+	exchangerate.BaseCurrencyValidator = exchangerateDescBaseCurrency.Validators[0].(func(string) error)
+	// exchangerateDescQuoteCurrency is the schema descriptor for quote_currency field.
+	exchangerateDescQuoteCurrency := exchangerateFields[2].Descriptor()
+	// exchangerate.QuoteCurrencyValidator is a validator for the "quote_currency" field. It is called by the builders before save.
+	exchangerate.QuoteCurrencyValidator = exchangerateDescQuoteCurrency.Validators[0].(func(string) error)
+	// exchangerateDescSpread is the schema descriptor for spread field.
+	exchangerateDescSpread := exchangerateFields[4].Descriptor()
+	// exchangerate.DefaultSpread holds the default value on creation for the spread field.
+	exchangerate.DefaultSpread = exchangerateDescSpread.Default.(func() decimal.Decimal)
+	// exchangerateDescCreatedAt is the schema descriptor for created_at field.
+	exchangerateDescCreatedAt := exchangerateFields[7].Descriptor()
+	// exchangerate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	exchangerate.DefaultCreatedAt = exchangerateDescCreatedAt.Default.(func() time.Time)
+	feeruleFields := schema.FeeRule{}.Fields()
+	_ = feeruleFields
+	// feeruleDescName is the schema descriptor for name field.
+	feeruleDescName := feeruleFields[0].Descriptor()
+	// feerule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	feerule.NameValidator = feeruleDescName.Validators[0].(func(string) error)
+	// feeruleDescCurrency is the schema descriptor for currency field.
+	feeruleDescCurrency := feeruleFields[2].Descriptor()
+	// feerule.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	feerule.CurrencyValidator = feeruleDescCurrency.Validators[0].(func(string) error)
+	// feeruleDescFixedAmount is the schema descriptor for fixed_amount field.
+	feeruleDescFixedAmount := feeruleFields[5].Descriptor()
+	// feerule.DefaultFixedAmount holds the default value on creation for the fixed_amount field.
+	feerule.DefaultFixedAmount = feeruleDescFixedAmount.Default.(func() decimal.Decimal)
+	// feeruleDescPercentage is the schema descriptor for percentage field.
+	feeruleDescPercentage := feeruleFields[6].Descriptor()
+	// feerule.DefaultPercentage holds the default value on creation for the percentage field.
+	feerule.DefaultPercentage = feeruleDescPercentage.Default.(func() decimal.Decimal)
+	// feeruleDescPriority is the schema descriptor for priority field.
+	feeruleDescPriority := feeruleFields[10].Descriptor()
+	// feerule.DefaultPriority holds the default value on creation for the priority field.
+	feerule.DefaultPriority = feeruleDescPriority.Default.(int)
+	// feeruleDescEnabled is the schema descriptor for enabled field.
+	feeruleDescEnabled := feeruleFields[11].Descriptor()
+	// feerule.DefaultEnabled holds the default value on creation for the enabled field.
+	feerule.DefaultEnabled = feeruleDescEnabled.Default.(bool)
+	// feeruleDescCreatedAt is the schema descriptor for created_at field.
+	feeruleDescCreatedAt := feeruleFields[12].Descriptor()
+	// feerule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	feerule.DefaultCreatedAt = feeruleDescCreatedAt.Default.(func() time.Time)
+	// feeruleDescUpdatedAt is the schema descriptor for updated_at field.
+	feeruleDescUpdatedAt := feeruleFields[13].Descriptor()
+	// feerule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	feerule.DefaultUpdatedAt = feeruleDescUpdatedAt.Default.(func() time.Time)
+	// feerule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	feerule.UpdateDefaultUpdatedAt = feeruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	holdFields := schema.Hold{}.Fields()
+	_ = holdFields
+	// holdDescCurrency is the schema descriptor for currency field.
+	holdDescCurrency := holdFields[2].Descriptor()
+	// hold.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	hold.CurrencyValidator = holdDescCurrency.Validators[0].(func(string) error)
+	// holdDescCapturedAmount is the schema descriptor for captured_amount field.
+	holdDescCapturedAmount := holdFields[4].Descriptor()
+	// hold.DefaultCapturedAmount holds the default value on creation for the captured_amount field.
+	hold.DefaultCapturedAmount = holdDescCapturedAmount.Default.(func() decimal.Decimal)
+	// holdDescCreatedAt is the schema descriptor for created_at field.
+	holdDescCreatedAt := holdFields[8].Descriptor()
+	// hold.DefaultCreatedAt holds the default value on creation for the created_at field.
+	hold.DefaultCreatedAt = holdDescCreatedAt.Default.(func() time.Time)
+	// holdDescUpdatedAt is the schema descriptor for updated_at field.
+	holdDescUpdatedAt := holdFields[9].Descriptor()
+	// hold.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	hold.DefaultUpdatedAt = holdDescUpdatedAt.Default.(func() time.Time)
+	// hold.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	hold.UpdateDefaultUpdatedAt = holdDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// holdDescID is the schema descriptor for id field.
+	holdDescID := holdFields[0].Descriptor()
+	// hold.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	hold.IDValidator = holdDescID.Validators[0].(func(string) error)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescRequestHash is the schema descriptor for request_hash field.
+	idempotencykeyDescRequestHash := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.RequestHashValidator is a validator for the "request_hash" field. It is called by the builders before save.
+	idempotencykey.RequestHashValidator = idempotencykeyDescRequestHash.Validators[0].(func(string) error)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[4].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	idempotencykey.IDValidator = func() func(string) error {
+		validators := idempotencykeyDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	interestaccrualFields := schema.InterestAccrual{}.Fields()
+	_ = interestaccrualFields
+	// interestaccrualDescCreatedAt is the schema descriptor for created_at field.
+	interestaccrualDescCreatedAt := interestaccrualFields[7].Descriptor()
+	// interestaccrual.DefaultCreatedAt holds the default value on creation for the created_at field.
+	interestaccrual.DefaultCreatedAt = interestaccrualDescCreatedAt.Default.(func() time.Time)
+	interestplanFields := schema.InterestPlan{}.Fields()
+	_ = interestplanFields
+	// interestplanDescName is the schema descriptor for name field.
+	interestplanDescName := interestplanFields[0].Descriptor()
+	// interestplan.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	interestplan.NameValidator = interestplanDescName.Validators[0].(func(string) error)
+	// interestplanDescCreatedAt is the schema descriptor for created_at field.
+	interestplanDescCreatedAt := interestplanFields[3].Descriptor()
+	// interestplan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	interestplan.DefaultCreatedAt = interestplanDescCreatedAt.Default.(func() time.Time)
+	// interestplanDescUpdatedAt is the schema descriptor for updated_at field.
+	interestplanDescUpdatedAt := interestplanFields[4].Descriptor()
+	// interestplan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	interestplan.DefaultUpdatedAt = interestplanDescUpdatedAt.Default.(func() time.Time)
+	// interestplan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	interestplan.UpdateDefaultUpdatedAt = interestplanDescUpdatedAt.UpdateDefault.(func() time.Time)
+	journalentryFields := schema.JournalEntry{}.Fields()
+	_ = journalentryFields
+	// journalentryDescDescription is the schema descriptor for description field.
+	journalentryDescDescription := journalentryFields[1].Descriptor()
+	// journalentry.DefaultDescription holds the default value on creation for the description field.
+	journalentry.DefaultDescription = journalentryDescDescription.Default.(string)
+	// journalentryDescEffectiveAt is the schema descriptor for effective_at field.
+	journalentryDescEffectiveAt := journalentryFields[2].Descriptor()
+	// journalentry.DefaultEffectiveAt holds the default value on creation for the effective_at field.
+	journalentry.DefaultEffectiveAt = journalentryDescEffectiveAt.Default.(func() time.Time)
+	// journalentryDescCreatedAt is the schema descriptor for created_at field.
+	journalentryDescCreatedAt := journalentryFields[3].Descriptor()
+	// journalentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	journalentry.DefaultCreatedAt = journalentryDescCreatedAt.Default.(func() time.Time)
+	postingFields := schema.Posting{}.Fields()
+	_ = postingFields
+	// postingDescCurrency is the schema descriptor for currency field.
+	postingDescCurrency := postingFields[4].Descriptor()
+	// posting.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	posting.CurrencyValidator = postingDescCurrency.Validators[0].(func(string) error)
+	// postingDescEffectiveAt is the schema descriptor for effective_at field.
+	postingDescEffectiveAt := postingFields[5].Descriptor()
+	// posting.DefaultEffectiveAt holds the default value on creation for the effective_at field.
+	posting.DefaultEffectiveAt = postingDescEffectiveAt.Default.(func() time.Time)
+	// postingDescCreatedAt is the schema descriptor for created_at field.
+	postingDescCreatedAt := postingFields[6].Descriptor()
+	// posting.DefaultCreatedAt holds the default value on creation for the created_at field.
+	posting.DefaultCreatedAt = postingDescCreatedAt.Default.(func() time.Time)
+	scheduleFields := schema.Schedule{}.Fields()
+	_ = scheduleFields
+	// scheduleDescCurrency is the schema descriptor for currency field.
+	scheduleDescCurrency := scheduleFields[3].Descriptor()
+	// schedule.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	schedule.CurrencyValidator = scheduleDescCurrency.Validators[0].(func(string) error)
+	// scheduleDescInterval is the schema descriptor for interval field.
+	scheduleDescInterval := scheduleFields[5].Descriptor()
+	// schedule.DefaultInterval holds the default value on creation for the interval field.
+	schedule.DefaultInterval = scheduleDescInterval.Default.(int)
+	// schedule.IntervalValidator is a validator for the "interval" field. It is called by the builders before save.
+	schedule.IntervalValidator = scheduleDescInterval.Validators[0].(func(int) error)
+	// scheduleDescCount is the schema descriptor for count field.
+	scheduleDescCount := scheduleFields[6].Descriptor()
+	// schedule.CountValidator is a validator for the "count" field. It is called by the builders before save.
+	schedule.CountValidator = scheduleDescCount.Validators[0].(func(int) error)
+	// scheduleDescNextOccurrence is the schema descriptor for next_occurrence field.
+	scheduleDescNextOccurrence := scheduleFields[10].Descriptor()
+	// schedule.DefaultNextOccurrence holds the default value on creation for the next_occurrence field.
+	schedule.DefaultNextOccurrence = scheduleDescNextOccurrence.Default.(int)
+	// schedule.NextOccurrenceValidator is a validator for the "next_occurrence" field. It is called by the builders before save.
+	schedule.NextOccurrenceValidator = scheduleDescNextOccurrence.Validators[0].(func(int) error)
+	// scheduleDescAttempts is the schema descriptor for attempts field.
+	scheduleDescAttempts := scheduleFields[12].Descriptor()
+	// schedule.DefaultAttempts holds the default value on creation for the attempts field.
+	schedule.DefaultAttempts = scheduleDescAttempts.Default.(int)
+	// schedule.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	schedule.AttemptsValidator = scheduleDescAttempts.Validators[0].(func(int) error)
+	// scheduleDescCreatedAt is the schema descriptor for created_at field.
+	scheduleDescCreatedAt := scheduleFields[14].Descriptor()
+	// schedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	schedule.DefaultCreatedAt = scheduleDescCreatedAt.Default.(func() time.Time)
+	// scheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduleDescUpdatedAt := scheduleFields[15].Descriptor()
+	// schedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	schedule.DefaultUpdatedAt = scheduleDescUpdatedAt.Default.(func() time.Time)
+	// schedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	schedule.UpdateDefaultUpdatedAt = scheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	schedulerunFields := schema.ScheduleRun{}.Fields()
+	_ = schedulerunFields
+	// schedulerunDescOccurrence is the schema descriptor for occurrence field.
+	schedulerunDescOccurrence := schedulerunFields[1].Descriptor()
+	// schedulerun.OccurrenceValidator is a validator for the "occurrence" field. It is called by the builders before save.
+	schedulerun.OccurrenceValidator = schedulerunDescOccurrence.Validators[0].(func(int) error)
+	// schedulerunDescCreatedAt is the schema descriptor for created_at field.
+	schedulerunDescCreatedAt := schedulerunFields[6].Descriptor()
+	// schedulerun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	schedulerun.DefaultCreatedAt = schedulerunDescCreatedAt.Default.(func() time.Time)
+	transactionHooks := schema.Transaction{}.Hooks()
+	transaction.Hooks[0] = transactionHooks[0]
+	transaction.Hooks[1] = transactionHooks[1]
+	transaction.Hooks[2] = transactionHooks[2]
+	transactionFields := schema.Transaction{}.Fields()
+	_ = transactionFields
+	// transactionDescCurrency is the schema descriptor for currency field.
+	transactionDescCurrency := transactionFields[3].Descriptor()
+	// transaction.DefaultCurrency holds the default value on creation for the currency field.
+	transaction.DefaultCurrency = transactionDescCurrency.Default.(string)
+	// transactionDescReversedAmount is the schema descriptor for reversed_amount field.
+	transactionDescReversedAmount := transactionFields[8].Descriptor()
+	// transaction.DefaultReversedAmount holds the default value on creation for the reversed_amount field.
+	transaction.DefaultReversedAmount = transactionDescReversedAmount.Default.(func() decimal.Decimal)
+	// transactionDescDescription is the schema descriptor for description field.
+	transactionDescDescription := transactionFields[18].Descriptor()
+	// transaction.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	transaction.DescriptionValidator = transactionDescDescription.Validators[0].(func(string) error)
+	// transactionDescCategory is the schema descriptor for category field.
+	transactionDescCategory := transactionFields[19].Descriptor()
+	// transaction.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	transaction.CategoryValidator = transactionDescCategory.Validators[0].(func(string) error)
+	// transactionDescSource is the schema descriptor for source field.
+	transactionDescSource := transactionFields[20].Descriptor()
+	// transaction.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	transaction.SourceValidator = transactionDescSource.Validators[0].(func(string) error)
+	// transactionDescExternalRef is the schema descriptor for external_ref field.
+	transactionDescExternalRef := transactionFields[21].Descriptor()
+	// transaction.ExternalRefValidator is a validator for the "external_ref" field. It is called by the builders before save.
+	transaction.ExternalRefValidator = transactionDescExternalRef.Validators[0].(func(string) error)
+	// transactionDescEffectiveAt is the schema descriptor for effective_at field.
+	transactionDescEffectiveAt := transactionFields[23].Descriptor()
+	// transaction.DefaultEffectiveAt holds the default value on creation for the effective_at field.
+	transaction.DefaultEffectiveAt = transactionDescEffectiveAt.Default.(func() time.Time)
+	// transactionDescCreatedAt is the schema descriptor for created_at field.
+	transactionDescCreatedAt := transactionFields[24].Descriptor()
+	// transaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transaction.DefaultCreatedAt = transactionDescCreatedAt.Default.(func() time.Time)
+	// transactionDescID is the schema descriptor for id field.
+	transactionDescID := transactionFields[0].Descriptor()
+	// transaction.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	transaction.IDValidator = transactionDescID.Validators[0].(func(string) error)
+	trialbalancelineHooks := schema.TrialBalanceLine{}.Hooks()
+	trialbalanceline.Hooks[0] = trialbalancelineHooks[0]
+	trialbalancelineFields := schema.TrialBalanceLine{}.Fields()
+	_ = trialbalancelineFields
+	// trialbalancelineDescCurrency is the schema descriptor for currency field.
+	trialbalancelineDescCurrency := trialbalancelineFields[2].Descriptor()
+	// trialbalanceline.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	trialbalanceline.CurrencyValidator = trialbalancelineDescCurrency.Validators[0].(func(string) error)
+	// trialbalancelineDescCreatedAt is the schema descriptor for created_at field.
+	trialbalancelineDescCreatedAt := trialbalancelineFields[5].Descriptor()
+	// trialbalanceline.DefaultCreatedAt holds the default value on creation for the created_at field.
+	trialbalanceline.DefaultCreatedAt = trialbalancelineDescCreatedAt.Default.(func() time.Time)
+	userHooks := schema.User{}.Hooks()
+	user.Hooks[0] = userHooks[0]
+	userInters := schema.User{}.Interceptors()
+	user.Interceptors[0] = userInters[0]
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[1].Descriptor()
+	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	user.NameValidator = userDescName.Validators[0].(func(string) error)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[2].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescAge is the schema descriptor for age field.
+	userDescAge := userFields[3].Descriptor()
+	// user.AgeValidator is a validator for the "age" field. It is called by the builders before save.
+	user.AgeValidator = userDescAge.Validators[0].(func(int) error)
+	// userDescTier is the schema descriptor for tier field.
+	userDescTier := userFields[4].Descriptor()
+	// user.DefaultTier holds the default value on creation for the tier field.
+	user.DefaultTier = userDescTier.Default.(string)
+	// user.TierValidator is a validator for the "tier" field. It is called by the builders before save.
+	user.TierValidator = userDescTier.Validators[0].(func(string) error)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[8].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	velocitylimitFields := schema.VelocityLimit{}.Fields()
+	_ = velocitylimitFields
+	// velocitylimitDescName is the schema descriptor for name field.
+	velocitylimitDescName := velocitylimitFields[0].Descriptor()
+	// velocitylimit.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	velocitylimit.NameValidator = velocitylimitDescName.Validators[0].(func(string) error)
+	// velocitylimitDescCurrency is the schema descriptor for currency field.
+	velocitylimitDescCurrency := velocitylimitFields[2].Descriptor()
+	// velocitylimit.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	velocitylimit.CurrencyValidator = velocitylimitDescCurrency.Validators[0].(func(string) error)
+	// velocitylimitDescWindowSeconds is the schema descriptor for window_seconds field.
+	velocitylimitDescWindowSeconds := velocitylimitFields[4].Descriptor()
+	// velocitylimit.WindowSecondsValidator is a validator for the "window_seconds" field. It is called by the builders before save.
+	velocitylimit.WindowSecondsValidator = velocitylimitDescWindowSeconds.Validators[0].(func(int64) error)
+	// velocitylimitDescMaxCount is the schema descriptor for max_count field.
+	velocitylimitDescMaxCount := velocitylimitFields[5].Descriptor()
+	// velocitylimit.MaxCountValidator is a validator for the "max_count" field. It is called by the builders before save.
+	velocitylimit.MaxCountValidator = velocitylimitDescMaxCount.Validators[0].(func(int) error)
+	// velocitylimitDescEnabled is the schema descriptor for enabled field.
+	velocitylimitDescEnabled := velocitylimitFields[7].Descriptor()
+	// velocitylimit.DefaultEnabled holds the default value on creation for the enabled field.
+	velocitylimit.DefaultEnabled = velocitylimitDescEnabled.Default.(bool)
+	// velocitylimitDescCreatedAt is the schema descriptor for created_at field.
+	velocitylimitDescCreatedAt := velocitylimitFields[8].Descriptor()
+	// velocitylimit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	velocitylimit.DefaultCreatedAt = velocitylimitDescCreatedAt.Default.(func() time.Time)
+	// velocitylimitDescUpdatedAt is the schema descriptor for updated_at field.
+	velocitylimitDescUpdatedAt := velocitylimitFields[9].Descriptor()
+	// velocitylimit.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	velocitylimit.DefaultUpdatedAt = velocitylimitDescUpdatedAt.Default.(func() time.Time)
+	// velocitylimit.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	velocitylimit.UpdateDefaultUpdatedAt = velocitylimitDescUpdatedAt.UpdateDefault.(func() time.Time)
+}
 
 const (
 	Version = "v0.14.4"                                         // Version of ent codegen.
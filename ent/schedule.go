@@ -0,0 +1,331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/schedule"
+	"accounting/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// Schedule is the model entity for the Schedule schema.
+type Schedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID of the user, whose balance the transactions change
+	UserID int `json:"user_id,omitempty"`
+	// Type of the transaction created on every occurrence: deposit, withdrawal
+	Type schedule.Type `json:"type,omitempty"`
+	// Amount of every transaction
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Currency of every transaction
+	Currency string `json:"currency,omitempty"`
+	// Recurrence frequency, as RRULE FREQ: once, daily, weekly, monthly, yearly
+	Frequency schedule.Frequency `json:"frequency,omitempty"`
+	// Number of frequency units between occurrences, as RRULE INTERVAL
+	Interval int `json:"interval,omitempty"`
+	// Maximum number of occurrences, as RRULE COUNT
+	Count *int `json:"count,omitempty"`
+	// Time of the first occurrence; later occurrences keep its time of day
+	StartAt time.Time `json:"start_at,omitempty"`
+	// No occurrence happens after this time, as RRULE UNTIL
+	EndAt *time.Time `json:"end_at,omitempty"`
+	// Status of the schedule: active, completed, cancelled
+	Status schedule.Status `json:"status,omitempty"`
+	// Zero-based number of the next occurrence to execute
+	NextOccurrence int `json:"next_occurrence,omitempty"`
+	// Time the next occurrence is due or retried; empty once the schedule ends
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	// Failed attempts to execute the next occurrence
+	Attempts int `json:"attempts,omitempty"`
+	// Error of the last failed attempt
+	LastError *string `json:"last_error,omitempty"`
+	// Time of the schedule creation
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Time of the last schedule update
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScheduleQuery when eager-loading is set.
+	Edges        ScheduleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScheduleEdges holds the relations/edges for other nodes in the graph.
+type ScheduleEdges struct {
+	// User, whose balance the transactions change
+	User *User `json:"user,omitempty"`
+	// Attempts to execute the occurrences of the schedule
+	Runs []*ScheduleRun `json:"runs,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduleEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// RunsOrErr returns the Runs value or an error if the edge
+// was not loaded in eager-loading.
+func (e ScheduleEdges) RunsOrErr() ([]*ScheduleRun, error) {
+	if e.loadedTypes[1] {
+		return e.Runs, nil
+	}
+	return nil, &NotLoadedError{edge: "runs"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Schedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schedule.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case schedule.FieldID, schedule.FieldUserID, schedule.FieldInterval, schedule.FieldCount, schedule.FieldNextOccurrence, schedule.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case schedule.FieldType, schedule.FieldCurrency, schedule.FieldFrequency, schedule.FieldStatus, schedule.FieldLastError:
+			values[i] = new(sql.NullString)
+		case schedule.FieldStartAt, schedule.FieldEndAt, schedule.FieldNextRunAt, schedule.FieldCreatedAt, schedule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Schedule fields.
+func (s *Schedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schedule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case schedule.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				s.UserID = int(value.Int64)
+			}
+		case schedule.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				s.Type = schedule.Type(value.String)
+			}
+		case schedule.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				s.Amount = *value
+			}
+		case schedule.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				s.Currency = value.String
+			}
+		case schedule.FieldFrequency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field frequency", values[i])
+			} else if value.Valid {
+				s.Frequency = schedule.Frequency(value.String)
+			}
+		case schedule.FieldInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interval", values[i])
+			} else if value.Valid {
+				s.Interval = int(value.Int64)
+			}
+		case schedule.FieldCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count", values[i])
+			} else if value.Valid {
+				s.Count = new(int)
+				*s.Count = int(value.Int64)
+			}
+		case schedule.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				s.StartAt = value.Time
+			}
+		case schedule.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				s.EndAt = new(time.Time)
+				*s.EndAt = value.Time
+			}
+		case schedule.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				s.Status = schedule.Status(value.String)
+			}
+		case schedule.FieldNextOccurrence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field next_occurrence", values[i])
+			} else if value.Valid {
+				s.NextOccurrence = int(value.Int64)
+			}
+		case schedule.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				s.NextRunAt = new(time.Time)
+				*s.NextRunAt = value.Time
+			}
+		case schedule.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				s.Attempts = int(value.Int64)
+			}
+		case schedule.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				s.LastError = new(string)
+				*s.LastError = value.String
+			}
+		case schedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case schedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Schedule.
+// This includes values selected through modifiers, order, etc.
+func (s *Schedule) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Schedule entity.
+func (s *Schedule) QueryUser() *UserQuery {
+	return NewScheduleClient(s.config).QueryUser(s)
+}
+
+// QueryRuns queries the "runs" edge of the Schedule entity.
+func (s *Schedule) QueryRuns() *ScheduleRunQuery {
+	return NewScheduleClient(s.config).QueryRuns(s)
+}
+
+// Update returns a builder for updating this Schedule.
+// Note that you need to call Schedule.Unwrap() before calling this method if this Schedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Schedule) Update() *ScheduleUpdateOne {
+	return NewScheduleClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Schedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Schedule) Unwrap() *Schedule {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Schedule is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Schedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("Schedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", s.Type))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", s.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(s.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("frequency=")
+	builder.WriteString(fmt.Sprintf("%v", s.Frequency))
+	builder.WriteString(", ")
+	builder.WriteString("interval=")
+	builder.WriteString(fmt.Sprintf("%v", s.Interval))
+	builder.WriteString(", ")
+	if v := s.Count; v != nil {
+		builder.WriteString("count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(s.StartAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := s.EndAt; v != nil {
+		builder.WriteString("end_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", s.Status))
+	builder.WriteString(", ")
+	builder.WriteString("next_occurrence=")
+	builder.WriteString(fmt.Sprintf("%v", s.NextOccurrence))
+	builder.WriteString(", ")
+	if v := s.NextRunAt; v != nil {
+		builder.WriteString("next_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", s.Attempts))
+	builder.WriteString(", ")
+	if v := s.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Schedules is a parsable slice of Schedule.
+type Schedules []*Schedule
@@ -0,0 +1,1189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/schedule"
+	"accounting/ent/schedulerun"
+	"accounting/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// ScheduleCreate is the builder for creating a Schedule entity.
+type ScheduleCreate struct {
+	config
+	mutation *ScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (sc *ScheduleCreate) SetUserID(i int) *ScheduleCreate {
+	sc.mutation.SetUserID(i)
+	return sc
+}
+
+// SetType sets the "type" field.
+func (sc *ScheduleCreate) SetType(s schedule.Type) *ScheduleCreate {
+	sc.mutation.SetType(s)
+	return sc
+}
+
+// SetAmount sets the "amount" field.
+func (sc *ScheduleCreate) SetAmount(d decimal.Decimal) *ScheduleCreate {
+	sc.mutation.SetAmount(d)
+	return sc
+}
+
+// SetCurrency sets the "currency" field.
+func (sc *ScheduleCreate) SetCurrency(s string) *ScheduleCreate {
+	sc.mutation.SetCurrency(s)
+	return sc
+}
+
+// SetFrequency sets the "frequency" field.
+func (sc *ScheduleCreate) SetFrequency(s schedule.Frequency) *ScheduleCreate {
+	sc.mutation.SetFrequency(s)
+	return sc
+}
+
+// SetInterval sets the "interval" field.
+func (sc *ScheduleCreate) SetInterval(i int) *ScheduleCreate {
+	sc.mutation.SetInterval(i)
+	return sc
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableInterval(i *int) *ScheduleCreate {
+	if i != nil {
+		sc.SetInterval(*i)
+	}
+	return sc
+}
+
+// SetCount sets the "count" field.
+func (sc *ScheduleCreate) SetCount(i int) *ScheduleCreate {
+	sc.mutation.SetCount(i)
+	return sc
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableCount(i *int) *ScheduleCreate {
+	if i != nil {
+		sc.SetCount(*i)
+	}
+	return sc
+}
+
+// SetStartAt sets the "start_at" field.
+func (sc *ScheduleCreate) SetStartAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetStartAt(t)
+	return sc
+}
+
+// SetEndAt sets the "end_at" field.
+func (sc *ScheduleCreate) SetEndAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetEndAt(t)
+	return sc
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableEndAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetEndAt(*t)
+	}
+	return sc
+}
+
+// SetStatus sets the "status" field.
+func (sc *ScheduleCreate) SetStatus(s schedule.Status) *ScheduleCreate {
+	sc.mutation.SetStatus(s)
+	return sc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableStatus(s *schedule.Status) *ScheduleCreate {
+	if s != nil {
+		sc.SetStatus(*s)
+	}
+	return sc
+}
+
+// SetNextOccurrence sets the "next_occurrence" field.
+func (sc *ScheduleCreate) SetNextOccurrence(i int) *ScheduleCreate {
+	sc.mutation.SetNextOccurrence(i)
+	return sc
+}
+
+// SetNillableNextOccurrence sets the "next_occurrence" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableNextOccurrence(i *int) *ScheduleCreate {
+	if i != nil {
+		sc.SetNextOccurrence(*i)
+	}
+	return sc
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (sc *ScheduleCreate) SetNextRunAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetNextRunAt(t)
+	return sc
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableNextRunAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetNextRunAt(*t)
+	}
+	return sc
+}
+
+// SetAttempts sets the "attempts" field.
+func (sc *ScheduleCreate) SetAttempts(i int) *ScheduleCreate {
+	sc.mutation.SetAttempts(i)
+	return sc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableAttempts(i *int) *ScheduleCreate {
+	if i != nil {
+		sc.SetAttempts(*i)
+	}
+	return sc
+}
+
+// SetLastError sets the "last_error" field.
+func (sc *ScheduleCreate) SetLastError(s string) *ScheduleCreate {
+	sc.mutation.SetLastError(s)
+	return sc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableLastError(s *string) *ScheduleCreate {
+	if s != nil {
+		sc.SetLastError(*s)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *ScheduleCreate) SetCreatedAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableCreatedAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *ScheduleCreate) SetUpdatedAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableUpdatedAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sc *ScheduleCreate) SetUser(u *User) *ScheduleCreate {
+	return sc.SetUserID(u.ID)
+}
+
+// AddRunIDs adds the "runs" edge to the ScheduleRun entity by IDs.
+func (sc *ScheduleCreate) AddRunIDs(ids ...int) *ScheduleCreate {
+	sc.mutation.AddRunIDs(ids...)
+	return sc
+}
+
+// AddRuns adds the "runs" edges to the ScheduleRun entity.
+func (sc *ScheduleCreate) AddRuns(s ...*ScheduleRun) *ScheduleCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddRunIDs(ids...)
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (sc *ScheduleCreate) Mutation() *ScheduleMutation {
+	return sc.mutation
+}
+
+// Save creates the Schedule in the database.
+func (sc *ScheduleCreate) Save(ctx context.Context) (*Schedule, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ScheduleCreate) SaveX(ctx context.Context) *Schedule {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *ScheduleCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *ScheduleCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *ScheduleCreate) defaults() {
+	if _, ok := sc.mutation.Interval(); !ok {
+		v := schedule.DefaultInterval
+		sc.mutation.SetInterval(v)
+	}
+	if _, ok := sc.mutation.Status(); !ok {
+		v := schedule.DefaultStatus
+		sc.mutation.SetStatus(v)
+	}
+	if _, ok := sc.mutation.NextOccurrence(); !ok {
+		v := schedule.DefaultNextOccurrence
+		sc.mutation.SetNextOccurrence(v)
+	}
+	if _, ok := sc.mutation.Attempts(); !ok {
+		v := schedule.DefaultAttempts
+		sc.mutation.SetAttempts(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := schedule.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := schedule.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *ScheduleCreate) check() error {
+	if _, ok := sc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Schedule.user_id"`)}
+	}
+	if _, ok := sc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Schedule.type"`)}
+	}
+	if v, ok := sc.mutation.GetType(); ok {
+		if err := schedule.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Schedule.type": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Schedule.amount"`)}
+	}
+	if _, ok := sc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Schedule.currency"`)}
+	}
+	if v, ok := sc.mutation.Currency(); ok {
+		if err := schedule.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Schedule.currency": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Frequency(); !ok {
+		return &ValidationError{Name: "frequency", err: errors.New(`ent: missing required field "Schedule.frequency"`)}
+	}
+	if v, ok := sc.mutation.Frequency(); ok {
+		if err := schedule.FrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "Schedule.frequency": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Interval(); !ok {
+		return &ValidationError{Name: "interval", err: errors.New(`ent: missing required field "Schedule.interval"`)}
+	}
+	if v, ok := sc.mutation.Interval(); ok {
+		if err := schedule.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "Schedule.interval": %w`, err)}
+		}
+	}
+	if v, ok := sc.mutation.Count(); ok {
+		if err := schedule.CountValidator(v); err != nil {
+			return &ValidationError{Name: "count", err: fmt.Errorf(`ent: validator failed for field "Schedule.count": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.StartAt(); !ok {
+		return &ValidationError{Name: "start_at", err: errors.New(`ent: missing required field "Schedule.start_at"`)}
+	}
+	if _, ok := sc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Schedule.status"`)}
+	}
+	if v, ok := sc.mutation.Status(); ok {
+		if err := schedule.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Schedule.status": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.NextOccurrence(); !ok {
+		return &ValidationError{Name: "next_occurrence", err: errors.New(`ent: missing required field "Schedule.next_occurrence"`)}
+	}
+	if v, ok := sc.mutation.NextOccurrence(); ok {
+		if err := schedule.NextOccurrenceValidator(v); err != nil {
+			return &ValidationError{Name: "next_occurrence", err: fmt.Errorf(`ent: validator failed for field "Schedule.next_occurrence": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Schedule.attempts"`)}
+	}
+	if v, ok := sc.mutation.Attempts(); ok {
+		if err := schedule.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "Schedule.attempts": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Schedule.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Schedule.updated_at"`)}
+	}
+	if len(sc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Schedule.user"`)}
+	}
+	return nil
+}
+
+func (sc *ScheduleCreate) sqlSave(ctx context.Context) (*Schedule, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *ScheduleCreate) createSpec() (*Schedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Schedule{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(schedule.Table, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = sc.conflict
+	if value, ok := sc.mutation.GetType(); ok {
+		_spec.SetField(schedule.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := sc.mutation.Amount(); ok {
+		_spec.SetField(schedule.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := sc.mutation.Currency(); ok {
+		_spec.SetField(schedule.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := sc.mutation.Frequency(); ok {
+		_spec.SetField(schedule.FieldFrequency, field.TypeEnum, value)
+		_node.Frequency = value
+	}
+	if value, ok := sc.mutation.Interval(); ok {
+		_spec.SetField(schedule.FieldInterval, field.TypeInt, value)
+		_node.Interval = value
+	}
+	if value, ok := sc.mutation.Count(); ok {
+		_spec.SetField(schedule.FieldCount, field.TypeInt, value)
+		_node.Count = &value
+	}
+	if value, ok := sc.mutation.StartAt(); ok {
+		_spec.SetField(schedule.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = value
+	}
+	if value, ok := sc.mutation.EndAt(); ok {
+		_spec.SetField(schedule.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = &value
+	}
+	if value, ok := sc.mutation.Status(); ok {
+		_spec.SetField(schedule.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := sc.mutation.NextOccurrence(); ok {
+		_spec.SetField(schedule.FieldNextOccurrence, field.TypeInt, value)
+		_node.NextOccurrence = value
+	}
+	if value, ok := sc.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = &value
+	}
+	if value, ok := sc.mutation.Attempts(); ok {
+		_spec.SetField(schedule.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := sc.mutation.LastError(); ok {
+		_spec.SetField(schedule.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(schedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schedule.UserTable,
+			Columns: []string{schedule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Schedule.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *ScheduleCreate) OnConflict(opts ...sql.ConflictOption) *ScheduleUpsertOne {
+	sc.conflict = opts
+	return &ScheduleUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *ScheduleCreate) OnConflictColumns(columns ...string) *ScheduleUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// ScheduleUpsertOne is the builder for "upsert"-ing
+	//  one Schedule node.
+	ScheduleUpsertOne struct {
+		create *ScheduleCreate
+	}
+
+	// ScheduleUpsert is the "OnConflict" setter.
+	ScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *ScheduleUpsert) SetStatus(v schedule.Status) *ScheduleUpsert {
+	u.Set(schedule.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateStatus() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldStatus)
+	return u
+}
+
+// SetNextOccurrence sets the "next_occurrence" field.
+func (u *ScheduleUpsert) SetNextOccurrence(v int) *ScheduleUpsert {
+	u.Set(schedule.FieldNextOccurrence, v)
+	return u
+}
+
+// UpdateNextOccurrence sets the "next_occurrence" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateNextOccurrence() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldNextOccurrence)
+	return u
+}
+
+// AddNextOccurrence adds v to the "next_occurrence" field.
+func (u *ScheduleUpsert) AddNextOccurrence(v int) *ScheduleUpsert {
+	u.Add(schedule.FieldNextOccurrence, v)
+	return u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduleUpsert) SetNextRunAt(v time.Time) *ScheduleUpsert {
+	u.Set(schedule.FieldNextRunAt, v)
+	return u
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateNextRunAt() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldNextRunAt)
+	return u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduleUpsert) ClearNextRunAt() *ScheduleUpsert {
+	u.SetNull(schedule.FieldNextRunAt)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *ScheduleUpsert) SetAttempts(v int) *ScheduleUpsert {
+	u.Set(schedule.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateAttempts() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *ScheduleUpsert) AddAttempts(v int) *ScheduleUpsert {
+	u.Add(schedule.FieldAttempts, v)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ScheduleUpsert) SetLastError(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateLastError() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ScheduleUpsert) ClearLastError() *ScheduleUpsert {
+	u.SetNull(schedule.FieldLastError)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduleUpsert) SetUpdatedAt(v time.Time) *ScheduleUpsert {
+	u.Set(schedule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateUpdatedAt() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ScheduleUpsertOne) UpdateNewValues() *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(schedule.FieldUserID)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(schedule.FieldType)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(schedule.FieldAmount)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(schedule.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.Frequency(); exists {
+			s.SetIgnore(schedule.FieldFrequency)
+		}
+		if _, exists := u.create.mutation.Interval(); exists {
+			s.SetIgnore(schedule.FieldInterval)
+		}
+		if _, exists := u.create.mutation.Count(); exists {
+			s.SetIgnore(schedule.FieldCount)
+		}
+		if _, exists := u.create.mutation.StartAt(); exists {
+			s.SetIgnore(schedule.FieldStartAt)
+		}
+		if _, exists := u.create.mutation.EndAt(); exists {
+			s.SetIgnore(schedule.FieldEndAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(schedule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScheduleUpsertOne) Ignore() *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleUpsertOne) DoNothing() *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleCreate.OnConflict
+// documentation for more info.
+func (u *ScheduleUpsertOne) Update(set func(*ScheduleUpsert)) *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ScheduleUpsertOne) SetStatus(v schedule.Status) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateStatus() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetNextOccurrence sets the "next_occurrence" field.
+func (u *ScheduleUpsertOne) SetNextOccurrence(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetNextOccurrence(v)
+	})
+}
+
+// AddNextOccurrence adds v to the "next_occurrence" field.
+func (u *ScheduleUpsertOne) AddNextOccurrence(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddNextOccurrence(v)
+	})
+}
+
+// UpdateNextOccurrence sets the "next_occurrence" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateNextOccurrence() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateNextOccurrence()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduleUpsertOne) SetNextRunAt(v time.Time) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateNextRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduleUpsertOne) ClearNextRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *ScheduleUpsertOne) SetAttempts(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *ScheduleUpsertOne) AddAttempts(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateAttempts() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ScheduleUpsertOne) SetLastError(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateLastError() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ScheduleUpsertOne) ClearLastError() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduleUpsertOne) SetUpdatedAt(v time.Time) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateUpdatedAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScheduleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScheduleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScheduleCreateBulk is the builder for creating many Schedule entities in bulk.
+type ScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Schedule entities in the database.
+func (scb *ScheduleCreateBulk) Save(ctx context.Context) ([]*Schedule, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Schedule, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *ScheduleCreateBulk) SaveX(ctx context.Context) []*Schedule {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *ScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *ScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Schedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *ScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScheduleUpsertBulk {
+	scb.conflict = opts
+	return &ScheduleUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *ScheduleCreateBulk) OnConflictColumns(columns ...string) *ScheduleUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleUpsertBulk{
+		create: scb,
+	}
+}
+
+// ScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of Schedule nodes.
+type ScheduleUpsertBulk struct {
+	create *ScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ScheduleUpsertBulk) UpdateNewValues() *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(schedule.FieldUserID)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(schedule.FieldType)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(schedule.FieldAmount)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(schedule.FieldCurrency)
+			}
+			if _, exists := b.mutation.Frequency(); exists {
+				s.SetIgnore(schedule.FieldFrequency)
+			}
+			if _, exists := b.mutation.Interval(); exists {
+				s.SetIgnore(schedule.FieldInterval)
+			}
+			if _, exists := b.mutation.Count(); exists {
+				s.SetIgnore(schedule.FieldCount)
+			}
+			if _, exists := b.mutation.StartAt(); exists {
+				s.SetIgnore(schedule.FieldStartAt)
+			}
+			if _, exists := b.mutation.EndAt(); exists {
+				s.SetIgnore(schedule.FieldEndAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(schedule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScheduleUpsertBulk) Ignore() *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleUpsertBulk) DoNothing() *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScheduleUpsertBulk) Update(set func(*ScheduleUpsert)) *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ScheduleUpsertBulk) SetStatus(v schedule.Status) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateStatus() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetNextOccurrence sets the "next_occurrence" field.
+func (u *ScheduleUpsertBulk) SetNextOccurrence(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetNextOccurrence(v)
+	})
+}
+
+// AddNextOccurrence adds v to the "next_occurrence" field.
+func (u *ScheduleUpsertBulk) AddNextOccurrence(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddNextOccurrence(v)
+	})
+}
+
+// UpdateNextOccurrence sets the "next_occurrence" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateNextOccurrence() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateNextOccurrence()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduleUpsertBulk) SetNextRunAt(v time.Time) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateNextRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduleUpsertBulk) ClearNextRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *ScheduleUpsertBulk) SetAttempts(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *ScheduleUpsertBulk) AddAttempts(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateAttempts() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *ScheduleUpsertBulk) SetLastError(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateLastError() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *ScheduleUpsertBulk) ClearLastError() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduleUpsertBulk) SetUpdatedAt(v time.Time) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateUpdatedAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
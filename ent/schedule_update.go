@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/predicate"
+	"accounting/ent/schedule"
+	"accounting/ent/schedulerun"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleUpdate is the builder for updating Schedule entities.
+type ScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduleMutation
+}
+
+// Where appends a list predicates to the ScheduleUpdate builder.
+func (su *ScheduleUpdate) Where(ps ...predicate.Schedule) *ScheduleUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetStatus sets the "status" field.
+func (su *ScheduleUpdate) SetStatus(s schedule.Status) *ScheduleUpdate {
+	su.mutation.SetStatus(s)
+	return su
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableStatus(s *schedule.Status) *ScheduleUpdate {
+	if s != nil {
+		su.SetStatus(*s)
+	}
+	return su
+}
+
+// SetNextOccurrence sets the "next_occurrence" field.
+func (su *ScheduleUpdate) SetNextOccurrence(i int) *ScheduleUpdate {
+	su.mutation.ResetNextOccurrence()
+	su.mutation.SetNextOccurrence(i)
+	return su
+}
+
+// SetNillableNextOccurrence sets the "next_occurrence" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableNextOccurrence(i *int) *ScheduleUpdate {
+	if i != nil {
+		su.SetNextOccurrence(*i)
+	}
+	return su
+}
+
+// AddNextOccurrence adds i to the "next_occurrence" field.
+func (su *ScheduleUpdate) AddNextOccurrence(i int) *ScheduleUpdate {
+	su.mutation.AddNextOccurrence(i)
+	return su
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (su *ScheduleUpdate) SetNextRunAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetNextRunAt(t)
+	return su
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableNextRunAt(t *time.Time) *ScheduleUpdate {
+	if t != nil {
+		su.SetNextRunAt(*t)
+	}
+	return su
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (su *ScheduleUpdate) ClearNextRunAt() *ScheduleUpdate {
+	su.mutation.ClearNextRunAt()
+	return su
+}
+
+// SetAttempts sets the "attempts" field.
+func (su *ScheduleUpdate) SetAttempts(i int) *ScheduleUpdate {
+	su.mutation.ResetAttempts()
+	su.mutation.SetAttempts(i)
+	return su
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableAttempts(i *int) *ScheduleUpdate {
+	if i != nil {
+		su.SetAttempts(*i)
+	}
+	return su
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (su *ScheduleUpdate) AddAttempts(i int) *ScheduleUpdate {
+	su.mutation.AddAttempts(i)
+	return su
+}
+
+// SetLastError sets the "last_error" field.
+func (su *ScheduleUpdate) SetLastError(s string) *ScheduleUpdate {
+	su.mutation.SetLastError(s)
+	return su
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableLastError(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetLastError(*s)
+	}
+	return su
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (su *ScheduleUpdate) ClearLastError() *ScheduleUpdate {
+	su.mutation.ClearLastError()
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *ScheduleUpdate) SetUpdatedAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// AddRunIDs adds the "runs" edge to the ScheduleRun entity by IDs.
+func (su *ScheduleUpdate) AddRunIDs(ids ...int) *ScheduleUpdate {
+	su.mutation.AddRunIDs(ids...)
+	return su
+}
+
+// AddRuns adds the "runs" edges to the ScheduleRun entity.
+func (su *ScheduleUpdate) AddRuns(s ...*ScheduleRun) *ScheduleUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddRunIDs(ids...)
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (su *ScheduleUpdate) Mutation() *ScheduleMutation {
+	return su.mutation
+}
+
+// ClearRuns clears all "runs" edges to the ScheduleRun entity.
+func (su *ScheduleUpdate) ClearRuns() *ScheduleUpdate {
+	su.mutation.ClearRuns()
+	return su
+}
+
+// RemoveRunIDs removes the "runs" edge to ScheduleRun entities by IDs.
+func (su *ScheduleUpdate) RemoveRunIDs(ids ...int) *ScheduleUpdate {
+	su.mutation.RemoveRunIDs(ids...)
+	return su
+}
+
+// RemoveRuns removes "runs" edges to ScheduleRun entities.
+func (su *ScheduleUpdate) RemoveRuns(s ...*ScheduleRun) *ScheduleUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveRunIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ScheduleUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ScheduleUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *ScheduleUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := schedule.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *ScheduleUpdate) check() error {
+	if v, ok := su.mutation.Status(); ok {
+		if err := schedule.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Schedule.status": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.NextOccurrence(); ok {
+		if err := schedule.NextOccurrenceValidator(v); err != nil {
+			return &ValidationError{Name: "next_occurrence", err: fmt.Errorf(`ent: validator failed for field "Schedule.next_occurrence": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Attempts(); ok {
+		if err := schedule.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "Schedule.attempts": %w`, err)}
+		}
+	}
+	if su.mutation.UserCleared() && len(su.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Schedule.user"`)
+	}
+	return nil
+}
+
+func (su *ScheduleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schedule.Table, schedule.Columns, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if su.mutation.CountCleared() {
+		_spec.ClearField(schedule.FieldCount, field.TypeInt)
+	}
+	if su.mutation.EndAtCleared() {
+		_spec.ClearField(schedule.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.Status(); ok {
+		_spec.SetField(schedule.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.NextOccurrence(); ok {
+		_spec.SetField(schedule.FieldNextOccurrence, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedNextOccurrence(); ok {
+		_spec.AddField(schedule.FieldNextOccurrence, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if su.mutation.NextRunAtCleared() {
+		_spec.ClearField(schedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.Attempts(); ok {
+		_spec.SetField(schedule.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedAttempts(); ok {
+		_spec.AddField(schedule.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.LastError(); ok {
+		_spec.SetField(schedule.FieldLastError, field.TypeString, value)
+	}
+	if su.mutation.LastErrorCleared() {
+		_spec.ClearField(schedule.FieldLastError, field.TypeString)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if su.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedRunsIDs(); len(nodes) > 0 && !su.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// ScheduleUpdateOne is the builder for updating a single Schedule entity.
+type ScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduleMutation
+}
+
+// SetStatus sets the "status" field.
+func (suo *ScheduleUpdateOne) SetStatus(s schedule.Status) *ScheduleUpdateOne {
+	suo.mutation.SetStatus(s)
+	return suo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableStatus(s *schedule.Status) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetStatus(*s)
+	}
+	return suo
+}
+
+// SetNextOccurrence sets the "next_occurrence" field.
+func (suo *ScheduleUpdateOne) SetNextOccurrence(i int) *ScheduleUpdateOne {
+	suo.mutation.ResetNextOccurrence()
+	suo.mutation.SetNextOccurrence(i)
+	return suo
+}
+
+// SetNillableNextOccurrence sets the "next_occurrence" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableNextOccurrence(i *int) *ScheduleUpdateOne {
+	if i != nil {
+		suo.SetNextOccurrence(*i)
+	}
+	return suo
+}
+
+// AddNextOccurrence adds i to the "next_occurrence" field.
+func (suo *ScheduleUpdateOne) AddNextOccurrence(i int) *ScheduleUpdateOne {
+	suo.mutation.AddNextOccurrence(i)
+	return suo
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (suo *ScheduleUpdateOne) SetNextRunAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetNextRunAt(t)
+	return suo
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableNextRunAt(t *time.Time) *ScheduleUpdateOne {
+	if t != nil {
+		suo.SetNextRunAt(*t)
+	}
+	return suo
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (suo *ScheduleUpdateOne) ClearNextRunAt() *ScheduleUpdateOne {
+	suo.mutation.ClearNextRunAt()
+	return suo
+}
+
+// SetAttempts sets the "attempts" field.
+func (suo *ScheduleUpdateOne) SetAttempts(i int) *ScheduleUpdateOne {
+	suo.mutation.ResetAttempts()
+	suo.mutation.SetAttempts(i)
+	return suo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableAttempts(i *int) *ScheduleUpdateOne {
+	if i != nil {
+		suo.SetAttempts(*i)
+	}
+	return suo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (suo *ScheduleUpdateOne) AddAttempts(i int) *ScheduleUpdateOne {
+	suo.mutation.AddAttempts(i)
+	return suo
+}
+
+// SetLastError sets the "last_error" field.
+func (suo *ScheduleUpdateOne) SetLastError(s string) *ScheduleUpdateOne {
+	suo.mutation.SetLastError(s)
+	return suo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableLastError(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetLastError(*s)
+	}
+	return suo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (suo *ScheduleUpdateOne) ClearLastError() *ScheduleUpdateOne {
+	suo.mutation.ClearLastError()
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *ScheduleUpdateOne) SetUpdatedAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// AddRunIDs adds the "runs" edge to the ScheduleRun entity by IDs.
+func (suo *ScheduleUpdateOne) AddRunIDs(ids ...int) *ScheduleUpdateOne {
+	suo.mutation.AddRunIDs(ids...)
+	return suo
+}
+
+// AddRuns adds the "runs" edges to the ScheduleRun entity.
+func (suo *ScheduleUpdateOne) AddRuns(s ...*ScheduleRun) *ScheduleUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddRunIDs(ids...)
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (suo *ScheduleUpdateOne) Mutation() *ScheduleMutation {
+	return suo.mutation
+}
+
+// ClearRuns clears all "runs" edges to the ScheduleRun entity.
+func (suo *ScheduleUpdateOne) ClearRuns() *ScheduleUpdateOne {
+	suo.mutation.ClearRuns()
+	return suo
+}
+
+// RemoveRunIDs removes the "runs" edge to ScheduleRun entities by IDs.
+func (suo *ScheduleUpdateOne) RemoveRunIDs(ids ...int) *ScheduleUpdateOne {
+	suo.mutation.RemoveRunIDs(ids...)
+	return suo
+}
+
+// RemoveRuns removes "runs" edges to ScheduleRun entities.
+func (suo *ScheduleUpdateOne) RemoveRuns(s ...*ScheduleRun) *ScheduleUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveRunIDs(ids...)
+}
+
+// Where appends a list predicates to the ScheduleUpdate builder.
+func (suo *ScheduleUpdateOne) Where(ps ...predicate.Schedule) *ScheduleUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ScheduleUpdateOne) Select(field string, fields ...string) *ScheduleUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Schedule entity.
+func (suo *ScheduleUpdateOne) Save(ctx context.Context) (*Schedule, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ScheduleUpdateOne) SaveX(ctx context.Context) *Schedule {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *ScheduleUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := schedule.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *ScheduleUpdateOne) check() error {
+	if v, ok := suo.mutation.Status(); ok {
+		if err := schedule.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Schedule.status": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.NextOccurrence(); ok {
+		if err := schedule.NextOccurrenceValidator(v); err != nil {
+			return &ValidationError{Name: "next_occurrence", err: fmt.Errorf(`ent: validator failed for field "Schedule.next_occurrence": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Attempts(); ok {
+		if err := schedule.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "Schedule.attempts": %w`, err)}
+		}
+	}
+	if suo.mutation.UserCleared() && len(suo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Schedule.user"`)
+	}
+	return nil
+}
+
+func (suo *ScheduleUpdateOne) sqlSave(ctx context.Context) (_node *Schedule, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schedule.Table, schedule.Columns, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Schedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedule.FieldID)
+		for _, f := range fields {
+			if !schedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != schedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if suo.mutation.CountCleared() {
+		_spec.ClearField(schedule.FieldCount, field.TypeInt)
+	}
+	if suo.mutation.EndAtCleared() {
+		_spec.ClearField(schedule.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.Status(); ok {
+		_spec.SetField(schedule.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.NextOccurrence(); ok {
+		_spec.SetField(schedule.FieldNextOccurrence, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedNextOccurrence(); ok {
+		_spec.AddField(schedule.FieldNextOccurrence, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if suo.mutation.NextRunAtCleared() {
+		_spec.ClearField(schedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.Attempts(); ok {
+		_spec.SetField(schedule.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedAttempts(); ok {
+		_spec.AddField(schedule.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.LastError(); ok {
+		_spec.SetField(schedule.FieldLastError, field.TypeString, value)
+	}
+	if suo.mutation.LastErrorCleared() {
+		_spec.ClearField(schedule.FieldLastError, field.TypeString)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedRunsIDs(); len(nodes) > 0 && !suo.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Schedule{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
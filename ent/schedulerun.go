@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/schedule"
+	"accounting/ent/schedulerun"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ScheduleRun is the model entity for the ScheduleRun schema.
+type ScheduleRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID of the schedule
+	ScheduleID int `json:"schedule_id,omitempty"`
+	// Zero-based number of the executed occurrence
+	Occurrence int `json:"occurrence,omitempty"`
+	// Time the occurrence was due
+	ScheduledAt time.Time `json:"scheduled_at,omitempty"`
+	// Outcome of the attempt: succeeded, failed
+	Status schedulerun.Status `json:"status,omitempty"`
+	// ID of the transaction created for the occurrence
+	TransactionID *string `json:"transaction_id,omitempty"`
+	// Error of a failed attempt
+	Error *string `json:"error,omitempty"`
+	// Time of the attempt
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScheduleRunQuery when eager-loading is set.
+	Edges        ScheduleRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScheduleRunEdges holds the relations/edges for other nodes in the graph.
+type ScheduleRunEdges struct {
+	// Schedule, whose occurrence was executed
+	Schedule *Schedule `json:"schedule,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ScheduleOrErr returns the Schedule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduleRunEdges) ScheduleOrErr() (*Schedule, error) {
+	if e.Schedule != nil {
+		return e.Schedule, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: schedule.Label}
+	}
+	return nil, &NotLoadedError{edge: "schedule"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduleRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schedulerun.FieldID, schedulerun.FieldScheduleID, schedulerun.FieldOccurrence:
+			values[i] = new(sql.NullInt64)
+		case schedulerun.FieldStatus, schedulerun.FieldTransactionID, schedulerun.FieldError:
+			values[i] = new(sql.NullString)
+		case schedulerun.FieldScheduledAt, schedulerun.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduleRun fields.
+func (sr *ScheduleRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schedulerun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sr.ID = int(value.Int64)
+		case schedulerun.FieldScheduleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value.Valid {
+				sr.ScheduleID = int(value.Int64)
+			}
+		case schedulerun.FieldOccurrence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field occurrence", values[i])
+			} else if value.Valid {
+				sr.Occurrence = int(value.Int64)
+			}
+		case schedulerun.FieldScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
+			} else if value.Valid {
+				sr.ScheduledAt = value.Time
+			}
+		case schedulerun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sr.Status = schedulerun.Status(value.String)
+			}
+		case schedulerun.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				sr.TransactionID = new(string)
+				*sr.TransactionID = value.String
+			}
+		case schedulerun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				sr.Error = new(string)
+				*sr.Error = value.String
+			}
+		case schedulerun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sr.CreatedAt = value.Time
+			}
+		default:
+			sr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduleRun.
+// This includes values selected through modifiers, order, etc.
+func (sr *ScheduleRun) Value(name string) (ent.Value, error) {
+	return sr.selectValues.Get(name)
+}
+
+// QuerySchedule queries the "schedule" edge of the ScheduleRun entity.
+func (sr *ScheduleRun) QuerySchedule() *ScheduleQuery {
+	return NewScheduleRunClient(sr.config).QuerySchedule(sr)
+}
+
+// Update returns a builder for updating this ScheduleRun.
+// Note that you need to call ScheduleRun.Unwrap() before calling this method if this ScheduleRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sr *ScheduleRun) Update() *ScheduleRunUpdateOne {
+	return NewScheduleRunClient(sr.config).UpdateOne(sr)
+}
+
+// Unwrap unwraps the ScheduleRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sr *ScheduleRun) Unwrap() *ScheduleRun {
+	_tx, ok := sr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduleRun is not a transactional entity")
+	}
+	sr.config.driver = _tx.drv
+	return sr
+}
+
+// String implements the fmt.Stringer.
+func (sr *ScheduleRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduleRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sr.ID))
+	builder.WriteString("schedule_id=")
+	builder.WriteString(fmt.Sprintf("%v", sr.ScheduleID))
+	builder.WriteString(", ")
+	builder.WriteString("occurrence=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Occurrence))
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_at=")
+	builder.WriteString(sr.ScheduledAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Status))
+	builder.WriteString(", ")
+	if v := sr.TransactionID; v != nil {
+		builder.WriteString("transaction_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := sr.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduleRuns is a parsable slice of ScheduleRun.
+type ScheduleRuns []*ScheduleRun
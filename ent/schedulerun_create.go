@@ -0,0 +1,601 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/schedule"
+	"accounting/ent/schedulerun"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleRunCreate is the builder for creating a ScheduleRun entity.
+type ScheduleRunCreate struct {
+	config
+	mutation *ScheduleRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (src *ScheduleRunCreate) SetScheduleID(i int) *ScheduleRunCreate {
+	src.mutation.SetScheduleID(i)
+	return src
+}
+
+// SetOccurrence sets the "occurrence" field.
+func (src *ScheduleRunCreate) SetOccurrence(i int) *ScheduleRunCreate {
+	src.mutation.SetOccurrence(i)
+	return src
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (src *ScheduleRunCreate) SetScheduledAt(t time.Time) *ScheduleRunCreate {
+	src.mutation.SetScheduledAt(t)
+	return src
+}
+
+// SetStatus sets the "status" field.
+func (src *ScheduleRunCreate) SetStatus(s schedulerun.Status) *ScheduleRunCreate {
+	src.mutation.SetStatus(s)
+	return src
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (src *ScheduleRunCreate) SetTransactionID(s string) *ScheduleRunCreate {
+	src.mutation.SetTransactionID(s)
+	return src
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (src *ScheduleRunCreate) SetNillableTransactionID(s *string) *ScheduleRunCreate {
+	if s != nil {
+		src.SetTransactionID(*s)
+	}
+	return src
+}
+
+// SetError sets the "error" field.
+func (src *ScheduleRunCreate) SetError(s string) *ScheduleRunCreate {
+	src.mutation.SetError(s)
+	return src
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (src *ScheduleRunCreate) SetNillableError(s *string) *ScheduleRunCreate {
+	if s != nil {
+		src.SetError(*s)
+	}
+	return src
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (src *ScheduleRunCreate) SetCreatedAt(t time.Time) *ScheduleRunCreate {
+	src.mutation.SetCreatedAt(t)
+	return src
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (src *ScheduleRunCreate) SetNillableCreatedAt(t *time.Time) *ScheduleRunCreate {
+	if t != nil {
+		src.SetCreatedAt(*t)
+	}
+	return src
+}
+
+// SetSchedule sets the "schedule" edge to the Schedule entity.
+func (src *ScheduleRunCreate) SetSchedule(s *Schedule) *ScheduleRunCreate {
+	return src.SetScheduleID(s.ID)
+}
+
+// Mutation returns the ScheduleRunMutation object of the builder.
+func (src *ScheduleRunCreate) Mutation() *ScheduleRunMutation {
+	return src.mutation
+}
+
+// Save creates the ScheduleRun in the database.
+func (src *ScheduleRunCreate) Save(ctx context.Context) (*ScheduleRun, error) {
+	src.defaults()
+	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (src *ScheduleRunCreate) SaveX(ctx context.Context) *ScheduleRun {
+	v, err := src.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (src *ScheduleRunCreate) Exec(ctx context.Context) error {
+	_, err := src.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (src *ScheduleRunCreate) ExecX(ctx context.Context) {
+	if err := src.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (src *ScheduleRunCreate) defaults() {
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		v := schedulerun.DefaultCreatedAt()
+		src.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (src *ScheduleRunCreate) check() error {
+	if _, ok := src.mutation.ScheduleID(); !ok {
+		return &ValidationError{Name: "schedule_id", err: errors.New(`ent: missing required field "ScheduleRun.schedule_id"`)}
+	}
+	if _, ok := src.mutation.Occurrence(); !ok {
+		return &ValidationError{Name: "occurrence", err: errors.New(`ent: missing required field "ScheduleRun.occurrence"`)}
+	}
+	if v, ok := src.mutation.Occurrence(); ok {
+		if err := schedulerun.OccurrenceValidator(v); err != nil {
+			return &ValidationError{Name: "occurrence", err: fmt.Errorf(`ent: validator failed for field "ScheduleRun.occurrence": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.ScheduledAt(); !ok {
+		return &ValidationError{Name: "scheduled_at", err: errors.New(`ent: missing required field "ScheduleRun.scheduled_at"`)}
+	}
+	if _, ok := src.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScheduleRun.status"`)}
+	}
+	if v, ok := src.mutation.Status(); ok {
+		if err := schedulerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduleRun.status": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduleRun.created_at"`)}
+	}
+	if len(src.mutation.ScheduleIDs()) == 0 {
+		return &ValidationError{Name: "schedule", err: errors.New(`ent: missing required edge "ScheduleRun.schedule"`)}
+	}
+	return nil
+}
+
+func (src *ScheduleRunCreate) sqlSave(ctx context.Context) (*ScheduleRun, error) {
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := src.createSpec()
+	if err := sqlgraph.CreateNode(ctx, src.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	src.mutation.id = &_node.ID
+	src.mutation.done = true
+	return _node, nil
+}
+
+func (src *ScheduleRunCreate) createSpec() (*ScheduleRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduleRun{config: src.config}
+		_spec = sqlgraph.NewCreateSpec(schedulerun.Table, sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = src.conflict
+	if value, ok := src.mutation.Occurrence(); ok {
+		_spec.SetField(schedulerun.FieldOccurrence, field.TypeInt, value)
+		_node.Occurrence = value
+	}
+	if value, ok := src.mutation.ScheduledAt(); ok {
+		_spec.SetField(schedulerun.FieldScheduledAt, field.TypeTime, value)
+		_node.ScheduledAt = value
+	}
+	if value, ok := src.mutation.Status(); ok {
+		_spec.SetField(schedulerun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := src.mutation.TransactionID(); ok {
+		_spec.SetField(schedulerun.FieldTransactionID, field.TypeString, value)
+		_node.TransactionID = &value
+	}
+	if value, ok := src.mutation.Error(); ok {
+		_spec.SetField(schedulerun.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := src.mutation.CreatedAt(); ok {
+		_spec.SetField(schedulerun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := src.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schedulerun.ScheduleTable,
+			Columns: []string{schedulerun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ScheduleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduleRun.Create().
+//		SetScheduleID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleRunUpsert) {
+//			SetScheduleID(v+v).
+//		}).
+//		Exec(ctx)
+func (src *ScheduleRunCreate) OnConflict(opts ...sql.ConflictOption) *ScheduleRunUpsertOne {
+	src.conflict = opts
+	return &ScheduleRunUpsertOne{
+		create: src,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduleRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (src *ScheduleRunCreate) OnConflictColumns(columns ...string) *ScheduleRunUpsertOne {
+	src.conflict = append(src.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleRunUpsertOne{
+		create: src,
+	}
+}
+
+type (
+	// ScheduleRunUpsertOne is the builder for "upsert"-ing
+	//  one ScheduleRun node.
+	ScheduleRunUpsertOne struct {
+		create *ScheduleRunCreate
+	}
+
+	// ScheduleRunUpsert is the "OnConflict" setter.
+	ScheduleRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ScheduleRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ScheduleRunUpsertOne) UpdateNewValues() *ScheduleRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ScheduleID(); exists {
+			s.SetIgnore(schedulerun.FieldScheduleID)
+		}
+		if _, exists := u.create.mutation.Occurrence(); exists {
+			s.SetIgnore(schedulerun.FieldOccurrence)
+		}
+		if _, exists := u.create.mutation.ScheduledAt(); exists {
+			s.SetIgnore(schedulerun.FieldScheduledAt)
+		}
+		if _, exists := u.create.mutation.Status(); exists {
+			s.SetIgnore(schedulerun.FieldStatus)
+		}
+		if _, exists := u.create.mutation.TransactionID(); exists {
+			s.SetIgnore(schedulerun.FieldTransactionID)
+		}
+		if _, exists := u.create.mutation.Error(); exists {
+			s.SetIgnore(schedulerun.FieldError)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(schedulerun.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduleRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScheduleRunUpsertOne) Ignore() *ScheduleRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleRunUpsertOne) DoNothing() *ScheduleRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleRunCreate.OnConflict
+// documentation for more info.
+func (u *ScheduleRunUpsertOne) Update(set func(*ScheduleRunUpsert)) *ScheduleRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ScheduleRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScheduleRunUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScheduleRunUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScheduleRunCreateBulk is the builder for creating many ScheduleRun entities in bulk.
+type ScheduleRunCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduleRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScheduleRun entities in the database.
+func (srcb *ScheduleRunCreateBulk) Save(ctx context.Context) ([]*ScheduleRun, error) {
+	if srcb.err != nil {
+		return nil, srcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srcb.builders))
+	nodes := make([]*ScheduleRun, len(srcb.builders))
+	mutators := make([]Mutator, len(srcb.builders))
+	for i := range srcb.builders {
+		func(i int, root context.Context) {
+			builder := srcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduleRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = srcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcb *ScheduleRunCreateBulk) SaveX(ctx context.Context) []*ScheduleRun {
+	v, err := srcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcb *ScheduleRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := srcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcb *ScheduleRunCreateBulk) ExecX(ctx context.Context) {
+	if err := srcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduleRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleRunUpsert) {
+//			SetScheduleID(v+v).
+//		}).
+//		Exec(ctx)
+func (srcb *ScheduleRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScheduleRunUpsertBulk {
+	srcb.conflict = opts
+	return &ScheduleRunUpsertBulk{
+		create: srcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduleRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (srcb *ScheduleRunCreateBulk) OnConflictColumns(columns ...string) *ScheduleRunUpsertBulk {
+	srcb.conflict = append(srcb.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleRunUpsertBulk{
+		create: srcb,
+	}
+}
+
+// ScheduleRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScheduleRun nodes.
+type ScheduleRunUpsertBulk struct {
+	create *ScheduleRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScheduleRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ScheduleRunUpsertBulk) UpdateNewValues() *ScheduleRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ScheduleID(); exists {
+				s.SetIgnore(schedulerun.FieldScheduleID)
+			}
+			if _, exists := b.mutation.Occurrence(); exists {
+				s.SetIgnore(schedulerun.FieldOccurrence)
+			}
+			if _, exists := b.mutation.ScheduledAt(); exists {
+				s.SetIgnore(schedulerun.FieldScheduledAt)
+			}
+			if _, exists := b.mutation.Status(); exists {
+				s.SetIgnore(schedulerun.FieldStatus)
+			}
+			if _, exists := b.mutation.TransactionID(); exists {
+				s.SetIgnore(schedulerun.FieldTransactionID)
+			}
+			if _, exists := b.mutation.Error(); exists {
+				s.SetIgnore(schedulerun.FieldError)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(schedulerun.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduleRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScheduleRunUpsertBulk) Ignore() *ScheduleRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleRunUpsertBulk) DoNothing() *ScheduleRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScheduleRunUpsertBulk) Update(set func(*ScheduleRunUpsert)) *ScheduleRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ScheduleRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScheduleRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/predicate"
+	"accounting/ent/schedule"
+	"accounting/ent/schedulerun"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleRunQuery is the builder for querying ScheduleRun entities.
+type ScheduleRunQuery struct {
+	config
+	ctx          *QueryContext
+	order        []schedulerun.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ScheduleRun
+	withSchedule *ScheduleQuery
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ScheduleRunQuery builder.
+func (srq *ScheduleRunQuery) Where(ps ...predicate.ScheduleRun) *ScheduleRunQuery {
+	srq.predicates = append(srq.predicates, ps...)
+	return srq
+}
+
+// Limit the number of records to be returned by this query.
+func (srq *ScheduleRunQuery) Limit(limit int) *ScheduleRunQuery {
+	srq.ctx.Limit = &limit
+	return srq
+}
+
+// Offset to start from.
+func (srq *ScheduleRunQuery) Offset(offset int) *ScheduleRunQuery {
+	srq.ctx.Offset = &offset
+	return srq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (srq *ScheduleRunQuery) Unique(unique bool) *ScheduleRunQuery {
+	srq.ctx.Unique = &unique
+	return srq
+}
+
+// Order specifies how the records should be ordered.
+func (srq *ScheduleRunQuery) Order(o ...schedulerun.OrderOption) *ScheduleRunQuery {
+	srq.order = append(srq.order, o...)
+	return srq
+}
+
+// QuerySchedule chains the current query on the "schedule" edge.
+func (srq *ScheduleRunQuery) QuerySchedule() *ScheduleQuery {
+	query := (&ScheduleClient{config: srq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := srq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := srq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(schedulerun.Table, schedulerun.FieldID, selector),
+			sqlgraph.To(schedule.Table, schedule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, schedulerun.ScheduleTable, schedulerun.ScheduleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(srq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ScheduleRun entity from the query.
+// Returns a *NotFoundError when no ScheduleRun was found.
+func (srq *ScheduleRunQuery) First(ctx context.Context) (*ScheduleRun, error) {
+	nodes, err := srq.Limit(1).All(setContextOp(ctx, srq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{schedulerun.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (srq *ScheduleRunQuery) FirstX(ctx context.Context) *ScheduleRun {
+	node, err := srq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ScheduleRun ID from the query.
+// Returns a *NotFoundError when no ScheduleRun ID was found.
+func (srq *ScheduleRunQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = srq.Limit(1).IDs(setContextOp(ctx, srq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{schedulerun.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (srq *ScheduleRunQuery) FirstIDX(ctx context.Context) int {
+	id, err := srq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ScheduleRun entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ScheduleRun entity is found.
+// Returns a *NotFoundError when no ScheduleRun entities are found.
+func (srq *ScheduleRunQuery) Only(ctx context.Context) (*ScheduleRun, error) {
+	nodes, err := srq.Limit(2).All(setContextOp(ctx, srq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{schedulerun.Label}
+	default:
+		return nil, &NotSingularError{schedulerun.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (srq *ScheduleRunQuery) OnlyX(ctx context.Context) *ScheduleRun {
+	node, err := srq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ScheduleRun ID in the query.
+// Returns a *NotSingularError when more than one ScheduleRun ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (srq *ScheduleRunQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = srq.Limit(2).IDs(setContextOp(ctx, srq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{schedulerun.Label}
+	default:
+		err = &NotSingularError{schedulerun.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (srq *ScheduleRunQuery) OnlyIDX(ctx context.Context) int {
+	id, err := srq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ScheduleRuns.
+func (srq *ScheduleRunQuery) All(ctx context.Context) ([]*ScheduleRun, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryAll)
+	if err := srq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ScheduleRun, *ScheduleRunQuery]()
+	return withInterceptors[[]*ScheduleRun](ctx, srq, qr, srq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (srq *ScheduleRunQuery) AllX(ctx context.Context) []*ScheduleRun {
+	nodes, err := srq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ScheduleRun IDs.
+func (srq *ScheduleRunQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if srq.ctx.Unique == nil && srq.path != nil {
+		srq.Unique(true)
+	}
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryIDs)
+	if err = srq.Select(schedulerun.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (srq *ScheduleRunQuery) IDsX(ctx context.Context) []int {
+	ids, err := srq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (srq *ScheduleRunQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryCount)
+	if err := srq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, srq, querierCount[*ScheduleRunQuery](), srq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (srq *ScheduleRunQuery) CountX(ctx context.Context) int {
+	count, err := srq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (srq *ScheduleRunQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryExist)
+	switch _, err := srq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (srq *ScheduleRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := srq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ScheduleRunQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (srq *ScheduleRunQuery) Clone() *ScheduleRunQuery {
+	if srq == nil {
+		return nil
+	}
+	return &ScheduleRunQuery{
+		config:       srq.config,
+		ctx:          srq.ctx.Clone(),
+		order:        append([]schedulerun.OrderOption{}, srq.order...),
+		inters:       append([]Interceptor{}, srq.inters...),
+		predicates:   append([]predicate.ScheduleRun{}, srq.predicates...),
+		withSchedule: srq.withSchedule.Clone(),
+		// clone intermediate query.
+		sql:  srq.sql.Clone(),
+		path: srq.path,
+	}
+}
+
+// WithSchedule tells the query-builder to eager-load the nodes that are connected to
+// the "schedule" edge. The optional arguments are used to configure the query builder of the edge.
+func (srq *ScheduleRunQuery) WithSchedule(opts ...func(*ScheduleQuery)) *ScheduleRunQuery {
+	query := (&ScheduleClient{config: srq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	srq.withSchedule = query
+	return srq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ScheduleID int `json:"schedule_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ScheduleRun.Query().
+//		GroupBy(schedulerun.FieldScheduleID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (srq *ScheduleRunQuery) GroupBy(field string, fields ...string) *ScheduleRunGroupBy {
+	srq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ScheduleRunGroupBy{build: srq}
+	grbuild.flds = &srq.ctx.Fields
+	grbuild.label = schedulerun.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ScheduleID int `json:"schedule_id,omitempty"`
+//	}
+//
+//	client.ScheduleRun.Query().
+//		Select(schedulerun.FieldScheduleID).
+//		Scan(ctx, &v)
+func (srq *ScheduleRunQuery) Select(fields ...string) *ScheduleRunSelect {
+	srq.ctx.Fields = append(srq.ctx.Fields, fields...)
+	sbuild := &ScheduleRunSelect{ScheduleRunQuery: srq}
+	sbuild.label = schedulerun.Label
+	sbuild.flds, sbuild.scan = &srq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ScheduleRunSelect configured with the given aggregations.
+func (srq *ScheduleRunQuery) Aggregate(fns ...AggregateFunc) *ScheduleRunSelect {
+	return srq.Select().Aggregate(fns...)
+}
+
+func (srq *ScheduleRunQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range srq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, srq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range srq.ctx.Fields {
+		if !schedulerun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if srq.path != nil {
+		prev, err := srq.path(ctx)
+		if err != nil {
+			return err
+		}
+		srq.sql = prev
+	}
+	return nil
+}
+
+func (srq *ScheduleRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ScheduleRun, error) {
+	var (
+		nodes       = []*ScheduleRun{}
+		_spec       = srq.querySpec()
+		loadedTypes = [1]bool{
+			srq.withSchedule != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ScheduleRun).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ScheduleRun{config: srq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(srq.modifiers) > 0 {
+		_spec.Modifiers = srq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, srq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := srq.withSchedule; query != nil {
+		if err := srq.loadSchedule(ctx, query, nodes, nil,
+			func(n *ScheduleRun, e *Schedule) { n.Edges.Schedule = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (srq *ScheduleRunQuery) loadSchedule(ctx context.Context, query *ScheduleQuery, nodes []*ScheduleRun, init func(*ScheduleRun), assign func(*ScheduleRun, *Schedule)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ScheduleRun)
+	for i := range nodes {
+		fk := nodes[i].ScheduleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(schedule.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "schedule_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (srq *ScheduleRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := srq.querySpec()
+	if len(srq.modifiers) > 0 {
+		_spec.Modifiers = srq.modifiers
+	}
+	_spec.Node.Columns = srq.ctx.Fields
+	if len(srq.ctx.Fields) > 0 {
+		_spec.Unique = srq.ctx.Unique != nil && *srq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, srq.driver, _spec)
+}
+
+func (srq *ScheduleRunQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(schedulerun.Table, schedulerun.Columns, sqlgraph.NewFieldSpec(schedulerun.FieldID, field.TypeInt))
+	_spec.From = srq.sql
+	if unique := srq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if srq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := srq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedulerun.FieldID)
+		for i := range fields {
+			if fields[i] != schedulerun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if srq.withSchedule != nil {
+			_spec.Node.AddColumnOnce(schedulerun.FieldScheduleID)
+		}
+	}
+	if ps := srq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := srq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := srq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := srq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (srq *ScheduleRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(srq.driver.Dialect())
+	t1 := builder.Table(schedulerun.Table)
+	columns := srq.ctx.Fields
+	if len(columns) == 0 {
+		columns = schedulerun.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if srq.sql != nil {
+		selector = srq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if srq.ctx.Unique != nil && *srq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range srq.modifiers {
+		m(selector)
+	}
+	for _, p := range srq.predicates {
+		p(selector)
+	}
+	for _, p := range srq.order {
+		p(selector)
+	}
+	if offset := srq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := srq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (srq *ScheduleRunQuery) ForUpdate(opts ...sql.LockOption) *ScheduleRunQuery {
+	if srq.driver.Dialect() == dialect.Postgres {
+		srq.Unique(false)
+	}
+	srq.modifiers = append(srq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return srq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (srq *ScheduleRunQuery) ForShare(opts ...sql.LockOption) *ScheduleRunQuery {
+	if srq.driver.Dialect() == dialect.Postgres {
+		srq.Unique(false)
+	}
+	srq.modifiers = append(srq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return srq
+}
+
+// ScheduleRunGroupBy is the group-by builder for ScheduleRun entities.
+type ScheduleRunGroupBy struct {
+	selector
+	build *ScheduleRunQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (srgb *ScheduleRunGroupBy) Aggregate(fns ...AggregateFunc) *ScheduleRunGroupBy {
+	srgb.fns = append(srgb.fns, fns...)
+	return srgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srgb *ScheduleRunGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srgb.build.ctx, ent.OpQueryGroupBy)
+	if err := srgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScheduleRunQuery, *ScheduleRunGroupBy](ctx, srgb.build, srgb, srgb.build.inters, v)
+}
+
+func (srgb *ScheduleRunGroupBy) sqlScan(ctx context.Context, root *ScheduleRunQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(srgb.fns))
+	for _, fn := range srgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*srgb.flds)+len(srgb.fns))
+		for _, f := range *srgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*srgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ScheduleRunSelect is the builder for selecting fields of ScheduleRun entities.
+type ScheduleRunSelect struct {
+	*ScheduleRunQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (srs *ScheduleRunSelect) Aggregate(fns ...AggregateFunc) *ScheduleRunSelect {
+	srs.fns = append(srs.fns, fns...)
+	return srs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srs *ScheduleRunSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srs.ctx, ent.OpQuerySelect)
+	if err := srs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScheduleRunQuery, *ScheduleRunSelect](ctx, srs.ScheduleRunQuery, srs, srs.inters, v)
+}
+
+func (srs *ScheduleRunSelect) sqlScan(ctx context.Context, root *ScheduleRunQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(srs.fns))
+	for _, fn := range srs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*srs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Account holds the schema definition for the Account entity.
+type Account struct {
+	ent.Schema
+}
+
+// Fields of the Account.
+func (Account) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Comment("ID of the account").
+			StructTag(`json:"id,omitempty"`),
+
+		field.String("code").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("Unique account code (e.g. user:42:USD, system:external_cash:USD)"),
+
+		field.Enum("type").
+			Values("user", "system").
+			Immutable().
+			Comment("Type of the account: user wallet or system account"),
+
+		field.String("name").
+			NotEmpty().
+			Immutable().
+			Comment("Name of the account (e.g. wallet, external_cash)"),
+
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the user owning the account, empty for system accounts"),
+
+		field.String("currency").
+			NotEmpty().
+			Immutable().
+			Comment("Currency of the account"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the account creation"),
+	}
+}
+
+// Edges of the Account.
+func (Account) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("accounts").
+			Unique().
+			Immutable().
+			Field("user_id").
+			Comment("User owning the account"),
+		edge.To("postings", Posting.Type).
+			Comment("Postings made against the account"),
+		edge.To("snapshots", BalanceSnapshot.Type).
+			Comment("Periodic snapshots of the account balance"),
+		edge.To("trial_balance_lines", TrialBalanceLine.Type).
+			Comment("Balances of the account in the trial balances of closed accounting periods"),
+	}
+}
+
+// Indexes of the Account.
+func (Account) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "currency"),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccountingPeriod holds the schema definition for the AccountingPeriod entity.
+// An accounting period is a span of time the books are closed for; once it is
+// closed, no transaction can take effect inside it anymore.
+type AccountingPeriod struct {
+	ent.Schema
+}
+
+// Fields of the AccountingPeriod.
+func (AccountingPeriod) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("Unique name of the period (e.g. 2025-01)"),
+
+		field.Time("starts_at").
+			Immutable().
+			Comment("First instant of the period"),
+
+		field.Time("ends_at").
+			Immutable().
+			Comment("Instant the period ends at, exclusive"),
+
+		field.Enum("status").
+			Values("open", "closing", "closed").
+			Default("open").
+			Comment("Status of the period: open, closing (locked for review) or closed"),
+
+		field.Time("closed_at").
+			Optional().
+			Nillable().
+			Comment("Time the period was closed"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the period creation"),
+	}
+}
+
+// Edges of the AccountingPeriod.
+func (AccountingPeriod) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("trial_balance_lines", TrialBalanceLine.Type).
+			Comment("Trial balance captured when the period was closed"),
+	}
+}
+
+// Indexes of the AccountingPeriod.
+func (AccountingPeriod) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for finding the period an instant falls into
+		index.Fields("starts_at", "ends_at"),
+	}
+}
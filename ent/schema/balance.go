@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
 )
 
 // Balance holds the schema definition for the Balance entity.
@@ -31,9 +32,33 @@ func (Balance) Fields() []ent.Field {
 			Comment("Currency code (e.g. USD, EUR, RUB)"),
 
 		field.Float("amount").
-			Default(0).
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }).
 			Comment("Amount of the balance in the specified currency"),
 
+		field.Float("available").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }).
+			Comment("Amount minus the funds reserved by active holds"),
+
+		field.Float("overdraft_limit").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }).
+			Comment("How far below zero the available funds may go; zero allows no overdraft"),
+
+		field.Int("interest_plan_id").
+			Optional().
+			Nillable().
+			Comment("ID of the interest plan the balance earns interest under"),
+
+		field.Int64("sequence").
+			Default(0).
+			NonNegative().
+			Comment("Number of changes applied to the amount; the last transaction has this sequence"),
+
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -55,6 +80,13 @@ func (Balance) Edges() []ent.Edge {
 			Required().
 			Field("user_id").
 			Comment("User, to which the balance belongs"),
+		edge.From("interest_plan", InterestPlan.Type).
+			Ref("balances").
+			Unique().
+			Field("interest_plan_id").
+			Comment("Interest plan the balance earns interest under"),
+		edge.To("interest_accruals", InterestAccrual.Type).
+			Comment("Daily interest accruals of the balance"),
 	}
 }
 
@@ -75,9 +107,11 @@ func (Balance) Indexes() []ent.Index {
 
 func (Balance) Annotations() []schema.Annotation {
 	return []schema.Annotation{
-		// Adds a named CHECK constraint
+		// Adds named CHECK constraints
 		entsql.Checks(map[string]string{
-			"balance_amount_non_negative": "amount >= 0",
+			"balance_amount_within_overdraft":      "amount >= -overdraft_limit",
+			"balance_available_within_overdraft":   "available >= -overdraft_limit",
+			"balance_overdraft_limit_non_negative": "overdraft_limit >= 0",
 		}),
 	}
 }
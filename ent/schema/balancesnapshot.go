@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// BalanceSnapshot holds the schema definition for the BalanceSnapshot entity.
+type BalanceSnapshot struct {
+	ent.Schema
+}
+
+// Fields of the BalanceSnapshot.
+func (BalanceSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("account_id").
+			Immutable().
+			Comment("ID of the account, whose balance is captured"),
+
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Comment("Sum of all postings to the account effective up to and including taken_at; back-dated postings are added later"),
+
+		field.Time("taken_at").
+			Immutable().
+			Comment("Instant the balance is captured at"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the snapshot creation"),
+	}
+}
+
+// Edges of the BalanceSnapshot.
+func (BalanceSnapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("snapshots").
+			Unique().
+			Required().
+			Immutable().
+			Field("account_id").
+			Comment("Account, whose balance is captured"),
+	}
+}
+
+// Indexes of the BalanceSnapshot.
+func (BalanceSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for finding the latest snapshot before an instant
+		index.Fields("account_id", "taken_at").
+			Unique(),
+	}
+}
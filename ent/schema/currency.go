@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Currency holds the schema definition for the Currency entity.
+type Currency struct {
+	ent.Schema
+}
+
+// Fields of the Currency.
+func (Currency) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Match(currencyCodePattern).
+			Immutable().
+			Comment("ISO 4217 alphabetic code of the currency (e.g. USD)").
+			StructTag(`json:"code,omitempty"`),
+
+		field.String("numeric_code").
+			Default("").
+			Comment("ISO 4217 numeric code of the currency (e.g. 840)"),
+
+		field.String("name").
+			NotEmpty().
+			Comment("Name of the currency"),
+
+		field.Int("exponent").
+			NonNegative().
+			Comment("Number of decimal places of the minor unit (e.g. 2 for USD, 0 for JPY)"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether new transactions in the currency are accepted"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the currency creation"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Time of the last currency update"),
+	}
+}
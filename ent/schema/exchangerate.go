@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRate holds the schema definition for the ExchangeRate entity.
+type ExchangeRate struct {
+	ent.Schema
+}
+
+// Fields of the ExchangeRate.
+func (ExchangeRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Comment("ID of the exchange rate").
+			StructTag(`json:"id,omitempty"`),
+
+		field.String("base_currency").
+			NotEmpty().
+			Immutable().
+			Comment("Currency being sold (e.g. USD)"),
+
+		field.String("quote_currency").
+			NotEmpty().
+			Immutable().
+			Comment("Currency being bought (e.g. EUR)"),
+
+		field.Float("rate").
+			GoType(decimal.Decimal{}).
+			SchemaType(rateSchemaType).
+			Immutable().
+			Comment("Mid rate: units of the quote currency for one unit of the base currency"),
+
+		field.Float("spread").
+			GoType(decimal.Decimal{}).
+			SchemaType(rateSchemaType).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }).
+			Immutable().
+			Comment("Fraction of the mid rate kept on conversion (e.g. 0.005 for 0.5%)"),
+
+		field.Time("valid_from").
+			Immutable().
+			Comment("Start of the validity period of the rate"),
+
+		field.Time("valid_to").
+			Optional().
+			Nillable().
+			Comment("End of the validity period of the rate (exclusive), empty if open-ended"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the exchange rate creation"),
+	}
+}
+
+// Edges of the ExchangeRate.
+func (ExchangeRate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("transactions", Transaction.Type).
+			Comment("Exchange transactions that applied the rate"),
+	}
+}
+
+// Indexes of the ExchangeRate.
+func (ExchangeRate) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for fast lookup of the rate valid at a given time
+		index.Fields("base_currency", "quote_currency", "valid_from"),
+	}
+}
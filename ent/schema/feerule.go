@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"time"
+
+	"accounting/fee"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// FeeRule holds the schema definition for the FeeRule entity.
+// A fee rule charges a fee on transactions of a type, optionally limited to a
+// currency and a user tier.
+type FeeRule struct {
+	ent.Schema
+}
+
+// Fields of the FeeRule.
+func (FeeRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("Human-readable name of the rule"),
+
+		field.Enum("transaction_type").
+			Values("deposit", "withdrawal", "transfer_out", "exchange_out").
+			Comment("Type of the transactions the fee is charged on"),
+
+		field.String("currency").
+			Optional().
+			Nillable().
+			Match(currencyCodePattern).
+			Comment("Currency the rule is limited to; empty matches every currency"),
+
+		field.String("tier").
+			Optional().
+			Nillable().
+			Comment("User tier the rule is limited to; empty matches every tier"),
+
+		field.Enum("kind").
+			Values("fixed", "percentage", "tiered").
+			Comment("How the fee is computed: fixed, percentage, tiered"),
+
+		field.Float("fixed_amount").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }).
+			Comment("Fixed fee; for percentage rules it is charged on top of the percentage"),
+
+		field.Float("percentage").
+			GoType(decimal.Decimal{}).
+			SchemaType(rateSchemaType).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }).
+			Comment("Percentage of the amount charged by percentage rules, e.g. 1.5 for 1.5%"),
+
+		field.JSON("tiers", []fee.Tier{}).
+			Optional().
+			Comment("Bands of tiered rules, ordered by their upper bound"),
+
+		field.Float("min_fee").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Optional().
+			Nillable().
+			Comment("Lower cap of the fee"),
+
+		field.Float("max_fee").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Optional().
+			Nillable().
+			Comment("Upper cap of the fee"),
+
+		field.Int("priority").
+			Default(0).
+			Comment("Rule applied when several match; the highest priority wins"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether the rule is applied"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the rule creation"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Time of the last rule update"),
+	}
+}
+
+// Indexes of the FeeRule.
+func (FeeRule) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for fast search of the rules applying to a transaction type
+		index.Fields("transaction_type", "enabled"),
+	}
+}
@@ -0,0 +1,99 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// Hold holds the schema definition for the Hold entity.
+// A hold reserves funds of a balance until it is captured, voided or expires.
+type Hold struct {
+	ent.Schema
+}
+
+// Fields of the Hold.
+func (Hold) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Comment("ID of the hold (string primary key)").
+			NotEmpty().
+			Immutable().
+			StructTag(`json:"id,omitempty"`),
+
+		field.Int("user_id").
+			Immutable().
+			Comment("ID of the user, whose funds are reserved"),
+
+		field.String("currency").
+			NotEmpty().
+			Immutable().
+			Comment("Currency of the reserved funds"),
+
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Immutable().
+			Comment("Reserved amount"),
+
+		field.Float("captured_amount").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }).
+			Comment("Amount captured into a withdrawal, at most the reserved amount"),
+
+		field.Enum("status").
+			Values("active", "captured", "voided", "expired").
+			Default("active").
+			Comment("Status of the hold: active, captured, voided, expired"),
+
+		field.String("transaction_id").
+			Optional().
+			Nillable().
+			Comment("ID of the withdrawal transaction created by the capture"),
+
+		field.Time("expires_at").
+			Immutable().
+			Comment("Time after which an active hold is released automatically"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the hold creation"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Time of the last hold update"),
+	}
+}
+
+// Edges of the Hold.
+func (Hold) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("holds").
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id").
+			Comment("User, whose funds are reserved"),
+		edge.To("transaction", Transaction.Type).
+			Unique().
+			Field("transaction_id").
+			Comment("Withdrawal transaction created by the capture"),
+	}
+}
+
+// Indexes of the Hold.
+func (Hold) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		// Index for fast search of active holds to expire
+		index.Fields("status", "expires_at"),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+// It stores the fingerprint of a request made with a client-supplied key
+// together with the response, so retries can be answered without re-executing.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Comment("Method and route of the request followed by the idempotency key supplied by the client").
+			NotEmpty().
+			MaxLen(512).
+			Immutable().
+			StructTag(`json:"id,omitempty"`),
+
+		field.String("request_hash").
+			NotEmpty().
+			Immutable().
+			Comment("SHA-256 fingerprint of the method, path and normalized body of the request"),
+
+		field.Int("response_status").
+			Optional().
+			Nillable().
+			Comment("HTTP status of the stored response, empty while the request is in progress"),
+
+		field.Bytes("response_body").
+			Optional().
+			Comment("Body of the stored response"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the first request with the key"),
+
+		field.Time("locked_until").
+			Optional().
+			Nillable().
+			Comment("End of the lease of the request in progress; a retry after it takes the key over"),
+
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("Time the response was stored"),
+	}
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
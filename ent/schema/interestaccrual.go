@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// InterestAccrual holds the schema definition for the InterestAccrual entity.
+// An accrual is the interest a balance earned on one day; accruals are paid out
+// by an interest transaction at the end of the month.
+type InterestAccrual struct {
+	ent.Schema
+}
+
+// Fields of the InterestAccrual.
+func (InterestAccrual) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("balance_id").
+			Immutable().
+			Comment("ID of the balance earning the interest"),
+
+		field.Time("date").
+			SchemaType(dateSchemaType).
+			Immutable().
+			Comment("Day the interest was earned on"),
+
+		field.Float("principal").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Immutable().
+			Comment("Balance amount at the end of the day"),
+
+		field.Float("annual_percentage").
+			GoType(decimal.Decimal{}).
+			SchemaType(rateSchemaType).
+			Immutable().
+			Comment("Annual rate of the plan on the day, in percent"),
+
+		field.Enum("day_count").
+			Values("act_365", "thirty_360").
+			Immutable().
+			Comment("Day-count convention of the plan on the day"),
+
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			SchemaType(accrualSchemaType).
+			Immutable().
+			Comment("Interest earned on the day, unrounded"),
+
+		field.String("transaction_id").
+			Optional().
+			Nillable().
+			Comment("ID of the interest transaction that paid the accrual out"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the accrual"),
+	}
+}
+
+// Edges of the InterestAccrual.
+func (InterestAccrual) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("balance", Balance.Type).
+			Ref("interest_accruals").
+			Unique().
+			Required().
+			Immutable().
+			Field("balance_id").
+			Comment("Balance earning the interest"),
+	}
+}
+
+// Indexes of the InterestAccrual.
+func (InterestAccrual) Indexes() []ent.Index {
+	return []ent.Index{
+		// A balance accrues interest once per day, so re-running a day is a no-op
+		index.Fields("balance_id", "date").
+			Unique(),
+	}
+}
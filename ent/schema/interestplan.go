@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// InterestPlan holds the schema definition for the InterestPlan entity.
+// An interest plan sets the rate and day-count convention of the balances it is assigned to.
+type InterestPlan struct {
+	ent.Schema
+}
+
+// Fields of the InterestPlan.
+func (InterestPlan) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("Human-readable name of the plan"),
+
+		field.Float("annual_percentage").
+			GoType(decimal.Decimal{}).
+			SchemaType(rateSchemaType).
+			Comment("Nominal annual interest rate in percent, e.g. 3.5 for 3.5%"),
+
+		field.Enum("day_count").
+			Values("act_365", "thirty_360").
+			Comment("Day-count convention: act_365 (ACT/365 Fixed), thirty_360 (30/360)"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the plan creation"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Time of the last plan update"),
+	}
+}
+
+// Edges of the InterestPlan.
+func (InterestPlan) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("balances", Balance.Type).
+			Comment("Balances earning interest under the plan"),
+	}
+}
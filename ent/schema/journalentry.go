@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// JournalEntry holds the schema definition for the JournalEntry entity.
+// A journal entry groups postings that sum to zero per currency.
+type JournalEntry struct {
+	ent.Schema
+}
+
+// Fields of the JournalEntry.
+func (JournalEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Comment("ID of the journal entry").
+			StructTag(`json:"id,omitempty"`),
+
+		field.String("description").
+			Default("").
+			Comment("Human readable description of the entry"),
+
+		field.Time("effective_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")).
+			Comment("Time the entry takes effect in the books; earlier than created_at when back-dated"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the journal entry creation"),
+	}
+}
+
+// Edges of the JournalEntry.
+func (JournalEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("postings", Posting.Type).
+			Comment("Postings of the entry"),
+		edge.To("transactions", Transaction.Type).
+			Comment("Transactions recorded by the entry"),
+	}
+}
+
+// Indexes of the JournalEntry.
+func (JournalEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("effective_at"),
+	}
+}
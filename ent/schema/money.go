@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"regexp"
+
+	"entgo.io/ent/dialect"
+)
+
+// currencyCodePattern matches ISO 4217 alphabetic currency codes.
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// moneySchemaType maps monetary fields to an exact NUMERIC column instead of
+// double precision, so sums never drift by fractions of a minor unit.
+var moneySchemaType = map[string]string{
+	dialect.Postgres: "numeric(30,8)",
+}
+
+// rateSchemaType maps exchange rates and other ratios to a NUMERIC column with
+// enough scale to keep conversions exact.
+var rateSchemaType = map[string]string{
+	dialect.Postgres: "numeric(24,12)",
+}
+
+// accrualSchemaType maps interest accruals to a NUMERIC column with a finer
+// scale than money, so daily amounts far below a minor unit add up exactly.
+var accrualSchemaType = map[string]string{
+	dialect.Postgres: "numeric(30,12)",
+}
+
+// dateSchemaType maps calendar days without a time of day to a DATE column.
+var dateSchemaType = map[string]string{
+	dialect.Postgres: "date",
+}
@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// Posting holds the schema definition for the Posting entity.
+type Posting struct {
+	ent.Schema
+}
+
+// Fields of the Posting.
+func (Posting) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Comment("ID of the posting").
+			StructTag(`json:"id,omitempty"`),
+
+		field.Int("journal_entry_id").
+			Immutable().
+			Comment("ID of the journal entry, to which the posting belongs"),
+
+		field.Int("account_id").
+			Immutable().
+			Comment("ID of the account affected by the posting"),
+
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Immutable().
+			Comment("Signed amount: positive increases the account balance, negative decreases it"),
+
+		field.String("currency").
+			NotEmpty().
+			Immutable().
+			Comment("Currency of the posting"),
+
+		field.Time("effective_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")).
+			Comment("Time the posting takes effect in the books, copied from its journal entry"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the posting creation"),
+	}
+}
+
+// Edges of the Posting.
+func (Posting) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("journal_entry", JournalEntry.Type).
+			Ref("postings").
+			Unique().
+			Required().
+			Immutable().
+			Field("journal_entry_id").
+			Comment("Journal entry, to which the posting belongs"),
+		edge.From("account", Account.Type).
+			Ref("postings").
+			Unique().
+			Required().
+			Immutable().
+			Field("account_id").
+			Comment("Account affected by the posting"),
+	}
+}
+
+// Indexes of the Posting.
+func (Posting) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("journal_entry_id"),
+		index.Fields("account_id"),
+
+		// Index for summing the postings of an account within a time range
+		index.Fields("account_id", "created_at"),
+
+		// Index for summing the postings of the accounts for a trial balance
+		index.Fields("effective_at", "account_id"),
+	}
+}
@@ -0,0 +1,130 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// Schedule holds the schema definition for the Schedule entity.
+// A schedule creates a deposit or withdrawal on every occurrence of an
+// RRULE-style recurrence (frequency, interval, count and end date).
+type Schedule struct {
+	ent.Schema
+}
+
+// Fields of the Schedule.
+func (Schedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Immutable().
+			Comment("ID of the user, whose balance the transactions change"),
+
+		field.Enum("type").
+			Values("deposit", "withdrawal").
+			Immutable().
+			Comment("Type of the transaction created on every occurrence: deposit, withdrawal"),
+
+		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Immutable().
+			Comment("Amount of every transaction"),
+
+		field.String("currency").
+			NotEmpty().
+			Immutable().
+			Comment("Currency of every transaction"),
+
+		field.Enum("frequency").
+			Values("once", "daily", "weekly", "monthly", "yearly").
+			Immutable().
+			Comment("Recurrence frequency, as RRULE FREQ: once, daily, weekly, monthly, yearly"),
+
+		field.Int("interval").
+			Default(1).
+			Positive().
+			Immutable().
+			Comment("Number of frequency units between occurrences, as RRULE INTERVAL"),
+
+		field.Int("count").
+			Optional().
+			Nillable().
+			Positive().
+			Immutable().
+			Comment("Maximum number of occurrences, as RRULE COUNT"),
+
+		field.Time("start_at").
+			Immutable().
+			Comment("Time of the first occurrence; later occurrences keep its time of day"),
+
+		field.Time("end_at").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("No occurrence happens after this time, as RRULE UNTIL"),
+
+		field.Enum("status").
+			Values("active", "completed", "cancelled").
+			Default("active").
+			Comment("Status of the schedule: active, completed, cancelled"),
+
+		field.Int("next_occurrence").
+			Default(0).
+			NonNegative().
+			Comment("Zero-based number of the next occurrence to execute"),
+
+		field.Time("next_run_at").
+			Optional().
+			Nillable().
+			Comment("Time the next occurrence is due or retried; empty once the schedule ends"),
+
+		field.Int("attempts").
+			Default(0).
+			NonNegative().
+			Comment("Failed attempts to execute the next occurrence"),
+
+		field.String("last_error").
+			Optional().
+			Nillable().
+			Comment("Error of the last failed attempt"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the schedule creation"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Time of the last schedule update"),
+	}
+}
+
+// Edges of the Schedule.
+func (Schedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("schedules").
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id").
+			Comment("User, whose balance the transactions change"),
+		edge.To("runs", ScheduleRun.Type).
+			Comment("Attempts to execute the occurrences of the schedule"),
+	}
+}
+
+// Indexes of the Schedule.
+func (Schedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		// Index for fast search of due schedules
+		index.Fields("status", "next_run_at"),
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ScheduleRun holds the schema definition for the ScheduleRun entity.
+// A run records one attempt to execute an occurrence of a schedule.
+type ScheduleRun struct {
+	ent.Schema
+}
+
+// Fields of the ScheduleRun.
+func (ScheduleRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("schedule_id").
+			Immutable().
+			Comment("ID of the schedule"),
+
+		field.Int("occurrence").
+			NonNegative().
+			Immutable().
+			Comment("Zero-based number of the executed occurrence"),
+
+		field.Time("scheduled_at").
+			Immutable().
+			Comment("Time the occurrence was due"),
+
+		field.Enum("status").
+			Values("succeeded", "failed").
+			Immutable().
+			Comment("Outcome of the attempt: succeeded, failed"),
+
+		field.String("transaction_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the transaction created for the occurrence"),
+
+		field.String("error").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Error of a failed attempt"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the attempt"),
+	}
+}
+
+// Edges of the ScheduleRun.
+func (ScheduleRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("schedule", Schedule.Type).
+			Ref("runs").
+			Unique().
+			Required().
+			Immutable().
+			Field("schedule_id").
+			Comment("Schedule, whose occurrence was executed"),
+	}
+}
+
+// Indexes of the ScheduleRun.
+func (ScheduleRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("schedule_id", "occurrence"),
+	}
+}
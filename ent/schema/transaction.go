@@ -1,12 +1,21 @@
 package schema
 
 import (
+	"context"
+	"fmt"
 	"time"
 
+	gen "accounting/ent"
+	"accounting/ent/hook"
+	"accounting/ent/transaction"
+	"accounting/errors"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
 )
 
 // Transaction holds the schema definition for the Transaction entity.
@@ -27,6 +36,8 @@ func (Transaction) Fields() []ent.Field {
 			Comment("ID of the user, to which the transaction belongs"),
 
 		field.Float("amount").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
 			Comment("Amount of the transaction"),
 
 		field.String("currency").
@@ -34,8 +45,121 @@ func (Transaction) Fields() []ent.Field {
 			Comment("Currency of the transaction"),
 
 		field.Enum("type").
-			Values("deposit", "withdrawal").
-			Comment("Type of the transaction: deposit, withdrawal"),
+			Values("deposit", "withdrawal", "transfer_in", "transfer_out", "exchange_in", "exchange_out",
+				"adjustment_in", "adjustment_out", "fee", "fee_refund", "interest").
+			Comment("Type of the transaction: deposit, withdrawal, transfer_in, transfer_out, exchange_in, exchange_out, " +
+				"adjustment_in, adjustment_out, fee, fee_refund, interest"),
+
+		field.Enum("status").
+			Values("pending", "posted", "failed", "partially_reversed", "reversed").
+			Default("posted").
+			Comment("Status of the transaction: pending, posted, failed, partially_reversed, reversed"),
+
+		field.String("failure_reason").
+			Optional().
+			Nillable().
+			Comment("Reason a pending transaction failed"),
+
+		field.Time("posted_at").
+			Optional().
+			Nillable().
+			Comment("Time the balance effects of the transaction were applied"),
+
+		field.Float("reversed_amount").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			DefaultFunc(func() decimal.Decimal { return decimal.Zero }).
+			Comment("Part of the amount compensated by reversals"),
+
+		field.String("reversal_of_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the transaction compensated by this reversal"),
+
+		field.String("fee_of_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the transaction the fee is charged on"),
+
+		field.String("transfer_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the transfer linking both legs of a user-to-user transfer"),
+
+		field.String("exchange_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the exchange linking both legs of a currency conversion"),
+
+		field.Int("exchange_rate_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the exchange rate applied to a currency conversion"),
+
+		field.Float("applied_rate").
+			GoType(decimal.Decimal{}).
+			SchemaType(rateSchemaType).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Rate applied to a currency conversion, after the spread"),
+
+		field.Int("journal_entry_id").
+			Optional().
+			Nillable().
+			Comment("ID of the journal entry holding the postings of the transaction"),
+
+		field.Float("balance_after").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Optional().
+			Nillable().
+			Comment("Balance amount in the currency right after the transaction was posted"),
+
+		field.Int64("balance_sequence").
+			Optional().
+			Nillable().
+			Comment("Position of the transaction in the sequence of changes of its balance"),
+
+		field.String("description").
+			Optional().
+			Nillable().
+			MaxLen(500).
+			Comment("Human-readable reason of the transaction"),
+
+		field.String("category").
+			Optional().
+			Nillable().
+			MaxLen(64).
+			Comment("Category of the transaction, e.g. payroll or refund"),
+
+		field.String("source").
+			Optional().
+			Nillable().
+			Immutable().
+			MaxLen(64).
+			Comment("System the transaction originates from"),
+
+		field.String("external_ref").
+			Optional().
+			Nillable().
+			Immutable().
+			MaxLen(255).
+			Comment("Reference of the transaction in its source system"),
+
+		field.JSON("metadata", map[string]any{}).
+			Optional().
+			Comment("Free-form key-value data attached by the client"),
+
+		field.Time("effective_at").
+			Default(time.Now).
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")).
+			Comment("Time the transaction takes effect in the books: when it was posted, or earlier when back-dated"),
 
 		field.Time("created_at").
 			Default(time.Now).
@@ -53,6 +177,29 @@ func (Transaction) Edges() []ent.Edge {
 			Required().
 			Field("user_id").
 			Comment("User, to which the transaction belongs"),
+		edge.From("journal_entry", JournalEntry.Type).
+			Ref("transactions").
+			Unique().
+			Field("journal_entry_id").
+			Comment("Journal entry holding the postings of the transaction"),
+		edge.To("reversals", Transaction.Type).
+			From("reversal_of").
+			Unique().
+			Field("reversal_of_id").
+			Immutable().
+			Comment("Reversals compensating the transaction, and the transaction compensated by a reversal"),
+		edge.To("fees", Transaction.Type).
+			From("fee_of").
+			Unique().
+			Field("fee_of_id").
+			Immutable().
+			Comment("Fees charged on the transaction, and the transaction a fee is charged on"),
+		edge.From("exchange_rate", ExchangeRate.Type).
+			Ref("transactions").
+			Unique().
+			Immutable().
+			Field("exchange_rate_id").
+			Comment("Exchange rate applied to a currency conversion"),
 	}
 }
 
@@ -61,5 +208,78 @@ func (Transaction) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id"),
 		index.Fields("created_at"),
+		index.Fields("effective_at"),
+		index.Fields("transfer_id"),
+		index.Fields("exchange_id"),
+		index.Fields("reversal_of_id"),
+		index.Fields("fee_of_id"),
+
+		// Every change of a balance has its own position, so gaps in the sequence are detectable
+		index.Fields("user_id", "currency", "balance_sequence").
+			Unique(),
+
+		// An external reference identifies at most one transaction of its source system
+		index.Fields("source", "external_ref").
+			Unique(),
+		index.Fields("category"),
+
+		// Index for listing the transactions of a balance within a period for statements
+		index.Fields("user_id", "currency", "effective_at"),
+
+		// Index for fast counting of a user's recent transactions for velocity limits
+		index.Fields("user_id", "currency", "type", "created_at"),
+	}
+}
+
+// transactionStatusTransitions lists the statuses a transaction may move to from each status.
+// Failed and fully reversed transactions are final.
+var transactionStatusTransitions = map[transaction.Status][]transaction.Status{
+	transaction.StatusPending:           {transaction.StatusPosted, transaction.StatusFailed},
+	transaction.StatusPosted:            {transaction.StatusPartiallyReversed, transaction.StatusReversed},
+	transaction.StatusPartiallyReversed: {transaction.StatusPartiallyReversed, transaction.StatusReversed},
+}
+
+// Hooks of the Transaction.
+func (Transaction) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// New transactions start either pending or posted
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.TransactionFunc(func(ctx context.Context, m *gen.TransactionMutation) (ent.Value, error) {
+					if status, ok := m.Status(); ok && status != transaction.StatusPending && status != transaction.StatusPosted {
+						return nil, errors.WithDetails(errors.ErrInvalidState, "transaction cannot be created as %s", status)
+					}
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpCreate,
+		),
+		// Status changes must follow the allowed transitions
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.TransactionFunc(func(ctx context.Context, m *gen.TransactionMutation) (ent.Value, error) {
+					to, ok := m.Status()
+					if !ok {
+						return next.Mutate(ctx, m)
+					}
+					from, err := m.OldStatus(ctx)
+					if err != nil {
+						return nil, fmt.Errorf("failed loading transaction status: %w", err)
+					}
+					for _, allowed := range transactionStatusTransitions[from] {
+						if allowed == to {
+							return next.Mutate(ctx, m)
+						}
+					}
+					return nil, errors.WithDetails(errors.ErrInvalidState, "transaction cannot move from %s to %s", from, to)
+				})
+			},
+			ent.OpUpdateOne,
+		),
+		// Bulk updates cannot check the current status of each row
+		hook.If(
+			hook.FixedError(errors.WithDetails(errors.ErrInvalidState, "transaction status can only be changed one by one")),
+			hook.And(hook.HasOp(ent.OpUpdate), hook.HasFields(transaction.FieldStatus)),
+		),
 	}
 }
@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"time"
+
+	"accounting/ent/hook"
+	"accounting/errors"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// TrialBalanceLine holds the schema definition for the TrialBalanceLine entity.
+// The lines of a closed accounting period hold the balance of every account at
+// the end of the period; together they form its frozen trial balance.
+type TrialBalanceLine struct {
+	ent.Schema
+}
+
+// Fields of the TrialBalanceLine.
+func (TrialBalanceLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("period_id").
+			Immutable().
+			Comment("ID of the accounting period, whose trial balance the line belongs to"),
+
+		field.Int("account_id").
+			Immutable().
+			Comment("ID of the account, whose balance is captured"),
+
+		field.String("currency").
+			NotEmpty().
+			Immutable().
+			Comment("Currency of the account"),
+
+		field.Float("movement").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Immutable().
+			Comment("Sum of the postings to the account effective within the period"),
+
+		field.Float("balance").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Immutable().
+			Comment("Sum of the postings to the account effective before the end of the period"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the line creation"),
+	}
+}
+
+// Edges of the TrialBalanceLine.
+func (TrialBalanceLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("period", AccountingPeriod.Type).
+			Ref("trial_balance_lines").
+			Unique().
+			Required().
+			Immutable().
+			Field("period_id").
+			Comment("Accounting period, whose trial balance the line belongs to"),
+		edge.From("account", Account.Type).
+			Ref("trial_balance_lines").
+			Unique().
+			Required().
+			Immutable().
+			Field("account_id").
+			Comment("Account, whose balance is captured"),
+	}
+}
+
+// Indexes of the TrialBalanceLine.
+func (TrialBalanceLine) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("period_id", "account_id").
+			Unique(),
+	}
+}
+
+// Hooks of the TrialBalanceLine.
+func (TrialBalanceLine) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// The trial balance of a closed period is frozen
+		hook.On(
+			hook.FixedError(errors.WithDetails(errors.ErrInvalidState, "trial balance lines cannot be changed")),
+			ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne,
+		),
+	}
+}
@@ -1,8 +1,14 @@
 package schema
 
 import (
+	"context"
 	"time"
 
+	gen "accounting/ent"
+	"accounting/ent/hook"
+	"accounting/ent/intercept"
+	"accounting/ent/user"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -25,9 +31,36 @@ func (User) Fields() []ent.Field {
 			NotEmpty().
 			Unique(),
 		field.Int("age").
-			Positive(),
+			Positive().
+			Optional().
+			Nillable().
+			Comment("Age of the user; cleared when the user is erased"),
+		field.String("tier").
+			NotEmpty().
+			Default("standard").
+			Comment("Pricing tier of the user, used to select fee rules"),
+		field.Enum("status").
+			Values("active", "frozen", "closed").
+			Default("active").
+			Comment("Account status: active, frozen (deposits only), closed (no operations)"),
+		field.String("status_reason").
+			Optional().
+			Nillable().
+			Comment("Reason of the last status change"),
+		field.Time("status_changed_at").
+			Optional().
+			Nillable().
+			Comment("Time of the last status change"),
 		field.Time("created_at").
 			Default(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Time the user was soft-deleted; deleted users are hidden from queries"),
+		field.Time("erased_at").
+			Optional().
+			Nillable().
+			Comment("Time the personal data of the user was pseudonymized"),
 	}
 }
 
@@ -38,5 +71,61 @@ func (User) Edges() []ent.Edge {
 			Comment("User's transactions"),
 		edge.To("balances", Balance.Type).
 			Comment("User's balances in different currencies"),
+		edge.To("accounts", Account.Type).
+			Comment("User's ledger accounts"),
+		edge.To("holds", Hold.Type).
+			Comment("User's authorization holds"),
+		edge.To("schedules", Schedule.Type).
+			Comment("User's scheduled and recurring transactions"),
+	}
+}
+
+// softDeleteKey is the context key disabling the soft-delete interceptor and hook
+type softDeleteKey struct{}
+
+// SkipSoftDelete returns a context whose queries also return soft-deleted users
+// and whose deletes remove users for real
+func SkipSoftDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, softDeleteKey{}, true)
+}
+
+// skipSoftDelete reports whether soft-deletion is disabled in ctx
+func skipSoftDelete(ctx context.Context) bool {
+	skip, _ := ctx.Value(softDeleteKey{}).(bool)
+	return skip
+}
+
+// Interceptors of the User.
+func (User) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		// Soft-deleted users are hidden from queries and edge traversals
+		intercept.TraverseUser(func(ctx context.Context, q *gen.UserQuery) error {
+			if !skipSoftDelete(ctx) {
+				q.Where(user.DeletedAtIsNil())
+			}
+			return nil
+		}),
+	}
+}
+
+// Hooks of the User.
+func (User) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// Deleting a user sets deleted_at instead, so the transactions and
+		// balances referencing the user are kept
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.UserFunc(func(ctx context.Context, m *gen.UserMutation) (ent.Value, error) {
+					if skipSoftDelete(ctx) {
+						return next.Mutate(ctx, m)
+					}
+					m.SetOp(ent.OpUpdate)
+					m.SetDeletedAt(time.Now())
+					m.Where(user.DeletedAtIsNil())
+					return m.Client().Mutate(ctx, m)
+				})
+			},
+			ent.OpDelete|ent.OpDeleteOne,
+		),
 	}
 }
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/shopspring/decimal"
+)
+
+// VelocityLimit holds the schema definition for the VelocityLimit entity.
+// A velocity limit caps the number and the total amount of deposits or
+// withdrawals a user makes in a currency within a rolling window, optionally
+// only for users of a tier.
+type VelocityLimit struct {
+	ent.Schema
+}
+
+// Fields of the VelocityLimit.
+func (VelocityLimit) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("Human-readable name of the limit"),
+
+		field.Enum("transaction_type").
+			Values("deposit", "withdrawal").
+			Comment("Type of the transactions the limit applies to"),
+
+		field.String("currency").
+			Match(currencyCodePattern).
+			Comment("Currency of the limited transactions and of max_amount"),
+
+		field.String("tier").
+			Optional().
+			Nillable().
+			Comment("User tier the limit is limited to; empty matches every tier"),
+
+		field.Int64("window_seconds").
+			Positive().
+			Comment("Length of the rolling window in seconds"),
+
+		field.Int("max_count").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Maximum number of transactions within the window"),
+
+		field.Float("max_amount").
+			GoType(decimal.Decimal{}).
+			SchemaType(moneySchemaType).
+			Optional().
+			Nillable().
+			Comment("Maximum total amount of the transactions within the window"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether the limit is enforced"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time of the limit creation"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Time of the last limit update"),
+	}
+}
+
+// Indexes of the VelocityLimit.
+func (VelocityLimit) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for fast search of the limits applying to a transaction
+		index.Fields("transaction_type", "currency", "enabled"),
+	}
+}
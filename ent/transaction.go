@@ -3,14 +3,18 @@
 package ent
 
 import (
+	"accounting/ent/exchangerate"
+	"accounting/ent/journalentry"
 	"accounting/ent/transaction"
 	"accounting/ent/user"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
 )
 
 // Transaction is the model entity for the Transaction schema.
@@ -22,11 +26,49 @@ type Transaction struct {
 	// ID of the user, to which the transaction belongs
 	UserID int `json:"user_id,omitempty"`
 	// Amount of the transaction
-	Amount float64 `json:"amount,omitempty"`
+	Amount decimal.Decimal `json:"amount,omitempty"`
 	// Currency of the transaction
 	Currency string `json:"currency,omitempty"`
-	// Type of the transaction: deposit, withdrawal
+	// Type of the transaction: deposit, withdrawal, transfer_in, transfer_out, exchange_in, exchange_out, adjustment_in, adjustment_out, fee, fee_refund, interest
 	Type transaction.Type `json:"type,omitempty"`
+	// Status of the transaction: pending, posted, failed, partially_reversed, reversed
+	Status transaction.Status `json:"status,omitempty"`
+	// Reason a pending transaction failed
+	FailureReason *string `json:"failure_reason,omitempty"`
+	// Time the balance effects of the transaction were applied
+	PostedAt *time.Time `json:"posted_at,omitempty"`
+	// Part of the amount compensated by reversals
+	ReversedAmount decimal.Decimal `json:"reversed_amount,omitempty"`
+	// ID of the transaction compensated by this reversal
+	ReversalOfID *string `json:"reversal_of_id,omitempty"`
+	// ID of the transaction the fee is charged on
+	FeeOfID *string `json:"fee_of_id,omitempty"`
+	// ID of the transfer linking both legs of a user-to-user transfer
+	TransferID *string `json:"transfer_id,omitempty"`
+	// ID of the exchange linking both legs of a currency conversion
+	ExchangeID *string `json:"exchange_id,omitempty"`
+	// ID of the exchange rate applied to a currency conversion
+	ExchangeRateID *int `json:"exchange_rate_id,omitempty"`
+	// Rate applied to a currency conversion, after the spread
+	AppliedRate *decimal.Decimal `json:"applied_rate,omitempty"`
+	// ID of the journal entry holding the postings of the transaction
+	JournalEntryID *int `json:"journal_entry_id,omitempty"`
+	// Balance amount in the currency right after the transaction was posted
+	BalanceAfter *decimal.Decimal `json:"balance_after,omitempty"`
+	// Position of the transaction in the sequence of changes of its balance
+	BalanceSequence *int64 `json:"balance_sequence,omitempty"`
+	// Human-readable reason of the transaction
+	Description *string `json:"description,omitempty"`
+	// Category of the transaction, e.g. payroll or refund
+	Category *string `json:"category,omitempty"`
+	// System the transaction originates from
+	Source *string `json:"source,omitempty"`
+	// Reference of the transaction in its source system
+	ExternalRef *string `json:"external_ref,omitempty"`
+	// Free-form key-value data attached by the client
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Time the transaction takes effect in the books: when it was posted, or earlier when back-dated
+	EffectiveAt time.Time `json:"effective_at,omitempty"`
 	// Time of the transaction creation
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -39,9 +81,21 @@ type Transaction struct {
 type TransactionEdges struct {
 	// User, to which the transaction belongs
 	User *User `json:"user,omitempty"`
+	// Journal entry holding the postings of the transaction
+	JournalEntry *JournalEntry `json:"journal_entry,omitempty"`
+	// Reversals compensating the transaction, and the transaction compensated by a reversal
+	ReversalOf *Transaction `json:"reversal_of,omitempty"`
+	// Reversals holds the value of the reversals edge.
+	Reversals []*Transaction `json:"reversals,omitempty"`
+	// Fees charged on the transaction, and the transaction a fee is charged on
+	FeeOf *Transaction `json:"fee_of,omitempty"`
+	// Fees holds the value of the fees edge.
+	Fees []*Transaction `json:"fees,omitempty"`
+	// Exchange rate applied to a currency conversion
+	ExchangeRate *ExchangeRate `json:"exchange_rate,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [7]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -55,18 +109,84 @@ func (e TransactionEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// JournalEntryOrErr returns the JournalEntry value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) JournalEntryOrErr() (*JournalEntry, error) {
+	if e.JournalEntry != nil {
+		return e.JournalEntry, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: journalentry.Label}
+	}
+	return nil, &NotLoadedError{edge: "journal_entry"}
+}
+
+// ReversalOfOrErr returns the ReversalOf value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) ReversalOfOrErr() (*Transaction, error) {
+	if e.ReversalOf != nil {
+		return e.ReversalOf, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "reversal_of"}
+}
+
+// ReversalsOrErr returns the Reversals value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) ReversalsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[3] {
+		return e.Reversals, nil
+	}
+	return nil, &NotLoadedError{edge: "reversals"}
+}
+
+// FeeOfOrErr returns the FeeOf value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) FeeOfOrErr() (*Transaction, error) {
+	if e.FeeOf != nil {
+		return e.FeeOf, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "fee_of"}
+}
+
+// FeesOrErr returns the Fees value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) FeesOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[5] {
+		return e.Fees, nil
+	}
+	return nil, &NotLoadedError{edge: "fees"}
+}
+
+// ExchangeRateOrErr returns the ExchangeRate value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) ExchangeRateOrErr() (*ExchangeRate, error) {
+	if e.ExchangeRate != nil {
+		return e.ExchangeRate, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: exchangerate.Label}
+	}
+	return nil, &NotLoadedError{edge: "exchange_rate"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Transaction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case transaction.FieldAmount:
-			values[i] = new(sql.NullFloat64)
-		case transaction.FieldUserID:
+		case transaction.FieldAppliedRate, transaction.FieldBalanceAfter:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case transaction.FieldMetadata:
+			values[i] = new([]byte)
+		case transaction.FieldAmount, transaction.FieldReversedAmount:
+			values[i] = new(decimal.Decimal)
+		case transaction.FieldUserID, transaction.FieldExchangeRateID, transaction.FieldJournalEntryID, transaction.FieldBalanceSequence:
 			values[i] = new(sql.NullInt64)
-		case transaction.FieldID, transaction.FieldCurrency, transaction.FieldType:
+		case transaction.FieldID, transaction.FieldCurrency, transaction.FieldType, transaction.FieldStatus, transaction.FieldFailureReason, transaction.FieldReversalOfID, transaction.FieldFeeOfID, transaction.FieldTransferID, transaction.FieldExchangeID, transaction.FieldDescription, transaction.FieldCategory, transaction.FieldSource, transaction.FieldExternalRef:
 			values[i] = new(sql.NullString)
-		case transaction.FieldCreatedAt:
+		case transaction.FieldPostedAt, transaction.FieldEffectiveAt, transaction.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -96,10 +216,10 @@ func (t *Transaction) assignValues(columns []string, values []any) error {
 				t.UserID = int(value.Int64)
 			}
 		case transaction.FieldAmount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field amount", values[i])
-			} else if value.Valid {
-				t.Amount = value.Float64
+			} else if value != nil {
+				t.Amount = *value
 			}
 		case transaction.FieldCurrency:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -113,6 +233,137 @@ func (t *Transaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.Type = transaction.Type(value.String)
 			}
+		case transaction.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				t.Status = transaction.Status(value.String)
+			}
+		case transaction.FieldFailureReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_reason", values[i])
+			} else if value.Valid {
+				t.FailureReason = new(string)
+				*t.FailureReason = value.String
+			}
+		case transaction.FieldPostedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field posted_at", values[i])
+			} else if value.Valid {
+				t.PostedAt = new(time.Time)
+				*t.PostedAt = value.Time
+			}
+		case transaction.FieldReversedAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field reversed_amount", values[i])
+			} else if value != nil {
+				t.ReversedAmount = *value
+			}
+		case transaction.FieldReversalOfID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reversal_of_id", values[i])
+			} else if value.Valid {
+				t.ReversalOfID = new(string)
+				*t.ReversalOfID = value.String
+			}
+		case transaction.FieldFeeOfID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fee_of_id", values[i])
+			} else if value.Valid {
+				t.FeeOfID = new(string)
+				*t.FeeOfID = value.String
+			}
+		case transaction.FieldTransferID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transfer_id", values[i])
+			} else if value.Valid {
+				t.TransferID = new(string)
+				*t.TransferID = value.String
+			}
+		case transaction.FieldExchangeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_id", values[i])
+			} else if value.Valid {
+				t.ExchangeID = new(string)
+				*t.ExchangeID = value.String
+			}
+		case transaction.FieldExchangeRateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate_id", values[i])
+			} else if value.Valid {
+				t.ExchangeRateID = new(int)
+				*t.ExchangeRateID = int(value.Int64)
+			}
+		case transaction.FieldAppliedRate:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_rate", values[i])
+			} else if value.Valid {
+				t.AppliedRate = new(decimal.Decimal)
+				*t.AppliedRate = *value.S.(*decimal.Decimal)
+			}
+		case transaction.FieldJournalEntryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value.Valid {
+				t.JournalEntryID = new(int)
+				*t.JournalEntryID = int(value.Int64)
+			}
+		case transaction.FieldBalanceAfter:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_after", values[i])
+			} else if value.Valid {
+				t.BalanceAfter = new(decimal.Decimal)
+				*t.BalanceAfter = *value.S.(*decimal.Decimal)
+			}
+		case transaction.FieldBalanceSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_sequence", values[i])
+			} else if value.Valid {
+				t.BalanceSequence = new(int64)
+				*t.BalanceSequence = value.Int64
+			}
+		case transaction.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				t.Description = new(string)
+				*t.Description = value.String
+			}
+		case transaction.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				t.Category = new(string)
+				*t.Category = value.String
+			}
+		case transaction.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				t.Source = new(string)
+				*t.Source = value.String
+			}
+		case transaction.FieldExternalRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_ref", values[i])
+			} else if value.Valid {
+				t.ExternalRef = new(string)
+				*t.ExternalRef = value.String
+			}
+		case transaction.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case transaction.FieldEffectiveAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_at", values[i])
+			} else if value.Valid {
+				t.EffectiveAt = value.Time
+			}
 		case transaction.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -137,6 +388,36 @@ func (t *Transaction) QueryUser() *UserQuery {
 	return NewTransactionClient(t.config).QueryUser(t)
 }
 
+// QueryJournalEntry queries the "journal_entry" edge of the Transaction entity.
+func (t *Transaction) QueryJournalEntry() *JournalEntryQuery {
+	return NewTransactionClient(t.config).QueryJournalEntry(t)
+}
+
+// QueryReversalOf queries the "reversal_of" edge of the Transaction entity.
+func (t *Transaction) QueryReversalOf() *TransactionQuery {
+	return NewTransactionClient(t.config).QueryReversalOf(t)
+}
+
+// QueryReversals queries the "reversals" edge of the Transaction entity.
+func (t *Transaction) QueryReversals() *TransactionQuery {
+	return NewTransactionClient(t.config).QueryReversals(t)
+}
+
+// QueryFeeOf queries the "fee_of" edge of the Transaction entity.
+func (t *Transaction) QueryFeeOf() *TransactionQuery {
+	return NewTransactionClient(t.config).QueryFeeOf(t)
+}
+
+// QueryFees queries the "fees" edge of the Transaction entity.
+func (t *Transaction) QueryFees() *TransactionQuery {
+	return NewTransactionClient(t.config).QueryFees(t)
+}
+
+// QueryExchangeRate queries the "exchange_rate" edge of the Transaction entity.
+func (t *Transaction) QueryExchangeRate() *ExchangeRateQuery {
+	return NewTransactionClient(t.config).QueryExchangeRate(t)
+}
+
 // Update returns a builder for updating this Transaction.
 // Note that you need to call Transaction.Unwrap() before calling this method if this Transaction
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -172,6 +453,93 @@ func (t *Transaction) String() string {
 	builder.WriteString("type=")
 	builder.WriteString(fmt.Sprintf("%v", t.Type))
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", t.Status))
+	builder.WriteString(", ")
+	if v := t.FailureReason; v != nil {
+		builder.WriteString("failure_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.PostedAt; v != nil {
+		builder.WriteString("posted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reversed_amount=")
+	builder.WriteString(fmt.Sprintf("%v", t.ReversedAmount))
+	builder.WriteString(", ")
+	if v := t.ReversalOfID; v != nil {
+		builder.WriteString("reversal_of_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.FeeOfID; v != nil {
+		builder.WriteString("fee_of_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.TransferID; v != nil {
+		builder.WriteString("transfer_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.ExchangeID; v != nil {
+		builder.WriteString("exchange_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.ExchangeRateID; v != nil {
+		builder.WriteString("exchange_rate_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.AppliedRate; v != nil {
+		builder.WriteString("applied_rate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.JournalEntryID; v != nil {
+		builder.WriteString("journal_entry_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.BalanceAfter; v != nil {
+		builder.WriteString("balance_after=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.BalanceSequence; v != nil {
+		builder.WriteString("balance_sequence=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.Category; v != nil {
+		builder.WriteString("category=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.Source; v != nil {
+		builder.WriteString("source=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.ExternalRef; v != nil {
+		builder.WriteString("external_ref=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", t.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("effective_at=")
+	builder.WriteString(t.EffectiveAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -23,10 +25,60 @@ const (
 	FieldCurrency = "currency"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldFailureReason holds the string denoting the failure_reason field in the database.
+	FieldFailureReason = "failure_reason"
+	// FieldPostedAt holds the string denoting the posted_at field in the database.
+	FieldPostedAt = "posted_at"
+	// FieldReversedAmount holds the string denoting the reversed_amount field in the database.
+	FieldReversedAmount = "reversed_amount"
+	// FieldReversalOfID holds the string denoting the reversal_of_id field in the database.
+	FieldReversalOfID = "reversal_of_id"
+	// FieldFeeOfID holds the string denoting the fee_of_id field in the database.
+	FieldFeeOfID = "fee_of_id"
+	// FieldTransferID holds the string denoting the transfer_id field in the database.
+	FieldTransferID = "transfer_id"
+	// FieldExchangeID holds the string denoting the exchange_id field in the database.
+	FieldExchangeID = "exchange_id"
+	// FieldExchangeRateID holds the string denoting the exchange_rate_id field in the database.
+	FieldExchangeRateID = "exchange_rate_id"
+	// FieldAppliedRate holds the string denoting the applied_rate field in the database.
+	FieldAppliedRate = "applied_rate"
+	// FieldJournalEntryID holds the string denoting the journal_entry_id field in the database.
+	FieldJournalEntryID = "journal_entry_id"
+	// FieldBalanceAfter holds the string denoting the balance_after field in the database.
+	FieldBalanceAfter = "balance_after"
+	// FieldBalanceSequence holds the string denoting the balance_sequence field in the database.
+	FieldBalanceSequence = "balance_sequence"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldExternalRef holds the string denoting the external_ref field in the database.
+	FieldExternalRef = "external_ref"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldEffectiveAt holds the string denoting the effective_at field in the database.
+	FieldEffectiveAt = "effective_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeJournalEntry holds the string denoting the journal_entry edge name in mutations.
+	EdgeJournalEntry = "journal_entry"
+	// EdgeReversalOf holds the string denoting the reversal_of edge name in mutations.
+	EdgeReversalOf = "reversal_of"
+	// EdgeReversals holds the string denoting the reversals edge name in mutations.
+	EdgeReversals = "reversals"
+	// EdgeFeeOf holds the string denoting the fee_of edge name in mutations.
+	EdgeFeeOf = "fee_of"
+	// EdgeFees holds the string denoting the fees edge name in mutations.
+	EdgeFees = "fees"
+	// EdgeExchangeRate holds the string denoting the exchange_rate edge name in mutations.
+	EdgeExchangeRate = "exchange_rate"
 	// Table holds the table name of the transaction in the database.
 	Table = "transactions"
 	// UserTable is the table that holds the user relation/edge.
@@ -36,6 +88,36 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_id"
+	// JournalEntryTable is the table that holds the journal_entry relation/edge.
+	JournalEntryTable = "transactions"
+	// JournalEntryInverseTable is the table name for the JournalEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "journalentry" package.
+	JournalEntryInverseTable = "journal_entries"
+	// JournalEntryColumn is the table column denoting the journal_entry relation/edge.
+	JournalEntryColumn = "journal_entry_id"
+	// ReversalOfTable is the table that holds the reversal_of relation/edge.
+	ReversalOfTable = "transactions"
+	// ReversalOfColumn is the table column denoting the reversal_of relation/edge.
+	ReversalOfColumn = "reversal_of_id"
+	// ReversalsTable is the table that holds the reversals relation/edge.
+	ReversalsTable = "transactions"
+	// ReversalsColumn is the table column denoting the reversals relation/edge.
+	ReversalsColumn = "reversal_of_id"
+	// FeeOfTable is the table that holds the fee_of relation/edge.
+	FeeOfTable = "transactions"
+	// FeeOfColumn is the table column denoting the fee_of relation/edge.
+	FeeOfColumn = "fee_of_id"
+	// FeesTable is the table that holds the fees relation/edge.
+	FeesTable = "transactions"
+	// FeesColumn is the table column denoting the fees relation/edge.
+	FeesColumn = "fee_of_id"
+	// ExchangeRateTable is the table that holds the exchange_rate relation/edge.
+	ExchangeRateTable = "transactions"
+	// ExchangeRateInverseTable is the table name for the ExchangeRate entity.
+	// It exists in this package in order to avoid circular dependency with the "exchangerate" package.
+	ExchangeRateInverseTable = "exchange_rates"
+	// ExchangeRateColumn is the table column denoting the exchange_rate relation/edge.
+	ExchangeRateColumn = "exchange_rate_id"
 )
 
 // Columns holds all SQL columns for transaction fields.
@@ -45,6 +127,25 @@ var Columns = []string{
 	FieldAmount,
 	FieldCurrency,
 	FieldType,
+	FieldStatus,
+	FieldFailureReason,
+	FieldPostedAt,
+	FieldReversedAmount,
+	FieldReversalOfID,
+	FieldFeeOfID,
+	FieldTransferID,
+	FieldExchangeID,
+	FieldExchangeRateID,
+	FieldAppliedRate,
+	FieldJournalEntryID,
+	FieldBalanceAfter,
+	FieldBalanceSequence,
+	FieldDescription,
+	FieldCategory,
+	FieldSource,
+	FieldExternalRef,
+	FieldMetadata,
+	FieldEffectiveAt,
 	FieldCreatedAt,
 }
 
@@ -58,9 +159,27 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "accounting/ent/runtime"
 var (
+	Hooks [3]ent.Hook
 	// DefaultCurrency holds the default value on creation for the "currency" field.
 	DefaultCurrency string
+	// DefaultReversedAmount holds the default value on creation for the "reversed_amount" field.
+	DefaultReversedAmount func() decimal.Decimal
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	CategoryValidator func(string) error
+	// SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	SourceValidator func(string) error
+	// ExternalRefValidator is a validator for the "external_ref" field. It is called by the builders before save.
+	ExternalRefValidator func(string) error
+	// DefaultEffectiveAt holds the default value on creation for the "effective_at" field.
+	DefaultEffectiveAt func() time.Time
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -72,8 +191,17 @@ type Type string
 
 // Type values.
 const (
-	TypeDeposit    Type = "deposit"
-	TypeWithdrawal Type = "withdrawal"
+	TypeDeposit       Type = "deposit"
+	TypeWithdrawal    Type = "withdrawal"
+	TypeTransferIn    Type = "transfer_in"
+	TypeTransferOut   Type = "transfer_out"
+	TypeExchangeIn    Type = "exchange_in"
+	TypeExchangeOut   Type = "exchange_out"
+	TypeAdjustmentIn  Type = "adjustment_in"
+	TypeAdjustmentOut Type = "adjustment_out"
+	TypeFee           Type = "fee"
+	TypeFeeRefund     Type = "fee_refund"
+	TypeInterest      Type = "interest"
 )
 
 func (_type Type) String() string {
@@ -83,13 +211,42 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeDeposit, TypeWithdrawal:
+	case TypeDeposit, TypeWithdrawal, TypeTransferIn, TypeTransferOut, TypeExchangeIn, TypeExchangeOut, TypeAdjustmentIn, TypeAdjustmentOut, TypeFee, TypeFeeRefund, TypeInterest:
 		return nil
 	default:
 		return fmt.Errorf("transaction: invalid enum value for type field: %q", _type)
 	}
 }
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPosted is the default value of the Status enum.
+const DefaultStatus = StatusPosted
+
+// Status values.
+const (
+	StatusPending           Status = "pending"
+	StatusPosted            Status = "posted"
+	StatusFailed            Status = "failed"
+	StatusPartiallyReversed Status = "partially_reversed"
+	StatusReversed          Status = "reversed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusPosted, StatusFailed, StatusPartiallyReversed, StatusReversed:
+		return nil
+	default:
+		return fmt.Errorf("transaction: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Transaction queries.
 type OrderOption func(*sql.Selector)
 
@@ -118,6 +275,96 @@ func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
 }
 
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByFailureReason orders the results by the failure_reason field.
+func ByFailureReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureReason, opts...).ToFunc()
+}
+
+// ByPostedAt orders the results by the posted_at field.
+func ByPostedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPostedAt, opts...).ToFunc()
+}
+
+// ByReversedAmount orders the results by the reversed_amount field.
+func ByReversedAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReversedAmount, opts...).ToFunc()
+}
+
+// ByReversalOfID orders the results by the reversal_of_id field.
+func ByReversalOfID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReversalOfID, opts...).ToFunc()
+}
+
+// ByFeeOfID orders the results by the fee_of_id field.
+func ByFeeOfID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeeOfID, opts...).ToFunc()
+}
+
+// ByTransferID orders the results by the transfer_id field.
+func ByTransferID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransferID, opts...).ToFunc()
+}
+
+// ByExchangeID orders the results by the exchange_id field.
+func ByExchangeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchangeID, opts...).ToFunc()
+}
+
+// ByExchangeRateID orders the results by the exchange_rate_id field.
+func ByExchangeRateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchangeRateID, opts...).ToFunc()
+}
+
+// ByAppliedRate orders the results by the applied_rate field.
+func ByAppliedRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppliedRate, opts...).ToFunc()
+}
+
+// ByJournalEntryID orders the results by the journal_entry_id field.
+func ByJournalEntryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJournalEntryID, opts...).ToFunc()
+}
+
+// ByBalanceAfter orders the results by the balance_after field.
+func ByBalanceAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalanceAfter, opts...).ToFunc()
+}
+
+// ByBalanceSequence orders the results by the balance_sequence field.
+func ByBalanceSequence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalanceSequence, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByExternalRef orders the results by the external_ref field.
+func ByExternalRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalRef, opts...).ToFunc()
+}
+
+// ByEffectiveAt orders the results by the effective_at field.
+func ByEffectiveAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEffectiveAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -129,6 +376,62 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByJournalEntryField orders the results by journal_entry field.
+func ByJournalEntryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJournalEntryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReversalOfField orders the results by reversal_of field.
+func ByReversalOfField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReversalOfStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReversalsCount orders the results by reversals count.
+func ByReversalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReversalsStep(), opts...)
+	}
+}
+
+// ByReversals orders the results by reversals terms.
+func ByReversals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReversalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFeeOfField orders the results by fee_of field.
+func ByFeeOfField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFeeOfStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFeesCount orders the results by fees count.
+func ByFeesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFeesStep(), opts...)
+	}
+}
+
+// ByFees orders the results by fees terms.
+func ByFees(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFeesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByExchangeRateField orders the results by exchange_rate field.
+func ByExchangeRateField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExchangeRateStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -136,3 +439,45 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newJournalEntryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JournalEntryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, JournalEntryTable, JournalEntryColumn),
+	)
+}
+func newReversalOfStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReversalOfTable, ReversalOfColumn),
+	)
+}
+func newReversalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReversalsTable, ReversalsColumn),
+	)
+}
+func newFeeOfStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FeeOfTable, FeeOfColumn),
+	)
+}
+func newFeesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FeesTable, FeesColumn),
+	)
+}
+func newExchangeRateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExchangeRateInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ExchangeRateTable, ExchangeRateColumn),
+	)
+}
@@ -8,6 +8,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
 )
 
 // ID filters vertices based on their ID field.
@@ -71,7 +72,7 @@ func UserID(v int) predicate.Transaction {
 }
 
 // Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
-func Amount(v float64) predicate.Transaction {
+func Amount(v decimal.Decimal) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldAmount, v))
 }
 
@@ -80,6 +81,91 @@ func Currency(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldCurrency, v))
 }
 
+// FailureReason applies equality check predicate on the "failure_reason" field. It's identical to FailureReasonEQ.
+func FailureReason(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// PostedAt applies equality check predicate on the "posted_at" field. It's identical to PostedAtEQ.
+func PostedAt(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldPostedAt, v))
+}
+
+// ReversedAmount applies equality check predicate on the "reversed_amount" field. It's identical to ReversedAmountEQ.
+func ReversedAmount(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldReversedAmount, v))
+}
+
+// ReversalOfID applies equality check predicate on the "reversal_of_id" field. It's identical to ReversalOfIDEQ.
+func ReversalOfID(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldReversalOfID, v))
+}
+
+// FeeOfID applies equality check predicate on the "fee_of_id" field. It's identical to FeeOfIDEQ.
+func FeeOfID(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldFeeOfID, v))
+}
+
+// TransferID applies equality check predicate on the "transfer_id" field. It's identical to TransferIDEQ.
+func TransferID(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldTransferID, v))
+}
+
+// ExchangeID applies equality check predicate on the "exchange_id" field. It's identical to ExchangeIDEQ.
+func ExchangeID(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExchangeID, v))
+}
+
+// ExchangeRateID applies equality check predicate on the "exchange_rate_id" field. It's identical to ExchangeRateIDEQ.
+func ExchangeRateID(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExchangeRateID, v))
+}
+
+// AppliedRate applies equality check predicate on the "applied_rate" field. It's identical to AppliedRateEQ.
+func AppliedRate(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldAppliedRate, v))
+}
+
+// JournalEntryID applies equality check predicate on the "journal_entry_id" field. It's identical to JournalEntryIDEQ.
+func JournalEntryID(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// BalanceAfter applies equality check predicate on the "balance_after" field. It's identical to BalanceAfterEQ.
+func BalanceAfter(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// BalanceSequence applies equality check predicate on the "balance_sequence" field. It's identical to BalanceSequenceEQ.
+func BalanceSequence(v int64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldBalanceSequence, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldDescription, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCategory, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldSource, v))
+}
+
+// ExternalRef applies equality check predicate on the "external_ref" field. It's identical to ExternalRefEQ.
+func ExternalRef(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExternalRef, v))
+}
+
+// EffectiveAt applies equality check predicate on the "effective_at" field. It's identical to EffectiveAtEQ.
+func EffectiveAt(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldEffectiveAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldCreatedAt, v))
@@ -106,42 +192,42 @@ func UserIDNotIn(vs ...int) predicate.Transaction {
 }
 
 // AmountEQ applies the EQ predicate on the "amount" field.
-func AmountEQ(v float64) predicate.Transaction {
+func AmountEQ(v decimal.Decimal) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldAmount, v))
 }
 
 // AmountNEQ applies the NEQ predicate on the "amount" field.
-func AmountNEQ(v float64) predicate.Transaction {
+func AmountNEQ(v decimal.Decimal) predicate.Transaction {
 	return predicate.Transaction(sql.FieldNEQ(FieldAmount, v))
 }
 
 // AmountIn applies the In predicate on the "amount" field.
-func AmountIn(vs ...float64) predicate.Transaction {
+func AmountIn(vs ...decimal.Decimal) predicate.Transaction {
 	return predicate.Transaction(sql.FieldIn(FieldAmount, vs...))
 }
 
 // AmountNotIn applies the NotIn predicate on the "amount" field.
-func AmountNotIn(vs ...float64) predicate.Transaction {
+func AmountNotIn(vs ...decimal.Decimal) predicate.Transaction {
 	return predicate.Transaction(sql.FieldNotIn(FieldAmount, vs...))
 }
 
 // AmountGT applies the GT predicate on the "amount" field.
-func AmountGT(v float64) predicate.Transaction {
+func AmountGT(v decimal.Decimal) predicate.Transaction {
 	return predicate.Transaction(sql.FieldGT(FieldAmount, v))
 }
 
 // AmountGTE applies the GTE predicate on the "amount" field.
-func AmountGTE(v float64) predicate.Transaction {
+func AmountGTE(v decimal.Decimal) predicate.Transaction {
 	return predicate.Transaction(sql.FieldGTE(FieldAmount, v))
 }
 
 // AmountLT applies the LT predicate on the "amount" field.
-func AmountLT(v float64) predicate.Transaction {
+func AmountLT(v decimal.Decimal) predicate.Transaction {
 	return predicate.Transaction(sql.FieldLT(FieldAmount, v))
 }
 
 // AmountLTE applies the LTE predicate on the "amount" field.
-func AmountLTE(v float64) predicate.Transaction {
+func AmountLTE(v decimal.Decimal) predicate.Transaction {
 	return predicate.Transaction(sql.FieldLTE(FieldAmount, v))
 }
 
@@ -230,61 +316,1244 @@ func TypeNotIn(vs ...Type) predicate.Transaction {
 	return predicate.Transaction(sql.FieldNotIn(FieldType, vs...))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.Transaction {
-	return predicate.Transaction(sql.FieldEQ(FieldCreatedAt, v))
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldStatus, v))
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.Transaction {
-	return predicate.Transaction(sql.FieldNEQ(FieldCreatedAt, v))
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldStatus, v))
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.Transaction {
-	return predicate.Transaction(sql.FieldIn(FieldCreatedAt, vs...))
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldStatus, vs...))
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.Transaction {
-	return predicate.Transaction(sql.FieldNotIn(FieldCreatedAt, vs...))
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldStatus, vs...))
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.Transaction {
-	return predicate.Transaction(sql.FieldGT(FieldCreatedAt, v))
+// FailureReasonEQ applies the EQ predicate on the "failure_reason" field.
+func FailureReasonEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldFailureReason, v))
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.Transaction {
-	return predicate.Transaction(sql.FieldGTE(FieldCreatedAt, v))
+// FailureReasonNEQ applies the NEQ predicate on the "failure_reason" field.
+func FailureReasonNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldFailureReason, v))
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.Transaction {
-	return predicate.Transaction(sql.FieldLT(FieldCreatedAt, v))
+// FailureReasonIn applies the In predicate on the "failure_reason" field.
+func FailureReasonIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldFailureReason, vs...))
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.Transaction {
-	return predicate.Transaction(sql.FieldLTE(FieldCreatedAt, v))
+// FailureReasonNotIn applies the NotIn predicate on the "failure_reason" field.
+func FailureReasonNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldFailureReason, vs...))
 }
 
-// HasUser applies the HasEdge predicate on the "user" edge.
-func HasUser() predicate.Transaction {
-	return predicate.Transaction(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// FailureReasonGT applies the GT predicate on the "failure_reason" field.
+func FailureReasonGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldFailureReason, v))
 }
 
-// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
-func HasUserWith(preds ...predicate.User) predicate.Transaction {
-	return predicate.Transaction(func(s *sql.Selector) {
-		step := newUserStep()
+// FailureReasonGTE applies the GTE predicate on the "failure_reason" field.
+func FailureReasonGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldFailureReason, v))
+}
+
+// FailureReasonLT applies the LT predicate on the "failure_reason" field.
+func FailureReasonLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldFailureReason, v))
+}
+
+// FailureReasonLTE applies the LTE predicate on the "failure_reason" field.
+func FailureReasonLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldFailureReason, v))
+}
+
+// FailureReasonContains applies the Contains predicate on the "failure_reason" field.
+func FailureReasonContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldFailureReason, v))
+}
+
+// FailureReasonHasPrefix applies the HasPrefix predicate on the "failure_reason" field.
+func FailureReasonHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldFailureReason, v))
+}
+
+// FailureReasonHasSuffix applies the HasSuffix predicate on the "failure_reason" field.
+func FailureReasonHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldFailureReason, v))
+}
+
+// FailureReasonIsNil applies the IsNil predicate on the "failure_reason" field.
+func FailureReasonIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldFailureReason))
+}
+
+// FailureReasonNotNil applies the NotNil predicate on the "failure_reason" field.
+func FailureReasonNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldFailureReason))
+}
+
+// FailureReasonEqualFold applies the EqualFold predicate on the "failure_reason" field.
+func FailureReasonEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldFailureReason, v))
+}
+
+// FailureReasonContainsFold applies the ContainsFold predicate on the "failure_reason" field.
+func FailureReasonContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldFailureReason, v))
+}
+
+// PostedAtEQ applies the EQ predicate on the "posted_at" field.
+func PostedAtEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldPostedAt, v))
+}
+
+// PostedAtNEQ applies the NEQ predicate on the "posted_at" field.
+func PostedAtNEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldPostedAt, v))
+}
+
+// PostedAtIn applies the In predicate on the "posted_at" field.
+func PostedAtIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldPostedAt, vs...))
+}
+
+// PostedAtNotIn applies the NotIn predicate on the "posted_at" field.
+func PostedAtNotIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldPostedAt, vs...))
+}
+
+// PostedAtGT applies the GT predicate on the "posted_at" field.
+func PostedAtGT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldPostedAt, v))
+}
+
+// PostedAtGTE applies the GTE predicate on the "posted_at" field.
+func PostedAtGTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldPostedAt, v))
+}
+
+// PostedAtLT applies the LT predicate on the "posted_at" field.
+func PostedAtLT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldPostedAt, v))
+}
+
+// PostedAtLTE applies the LTE predicate on the "posted_at" field.
+func PostedAtLTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldPostedAt, v))
+}
+
+// PostedAtIsNil applies the IsNil predicate on the "posted_at" field.
+func PostedAtIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldPostedAt))
+}
+
+// PostedAtNotNil applies the NotNil predicate on the "posted_at" field.
+func PostedAtNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldPostedAt))
+}
+
+// ReversedAmountEQ applies the EQ predicate on the "reversed_amount" field.
+func ReversedAmountEQ(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldReversedAmount, v))
+}
+
+// ReversedAmountNEQ applies the NEQ predicate on the "reversed_amount" field.
+func ReversedAmountNEQ(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldReversedAmount, v))
+}
+
+// ReversedAmountIn applies the In predicate on the "reversed_amount" field.
+func ReversedAmountIn(vs ...decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldReversedAmount, vs...))
+}
+
+// ReversedAmountNotIn applies the NotIn predicate on the "reversed_amount" field.
+func ReversedAmountNotIn(vs ...decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldReversedAmount, vs...))
+}
+
+// ReversedAmountGT applies the GT predicate on the "reversed_amount" field.
+func ReversedAmountGT(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldReversedAmount, v))
+}
+
+// ReversedAmountGTE applies the GTE predicate on the "reversed_amount" field.
+func ReversedAmountGTE(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldReversedAmount, v))
+}
+
+// ReversedAmountLT applies the LT predicate on the "reversed_amount" field.
+func ReversedAmountLT(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldReversedAmount, v))
+}
+
+// ReversedAmountLTE applies the LTE predicate on the "reversed_amount" field.
+func ReversedAmountLTE(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldReversedAmount, v))
+}
+
+// ReversalOfIDEQ applies the EQ predicate on the "reversal_of_id" field.
+func ReversalOfIDEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldReversalOfID, v))
+}
+
+// ReversalOfIDNEQ applies the NEQ predicate on the "reversal_of_id" field.
+func ReversalOfIDNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldReversalOfID, v))
+}
+
+// ReversalOfIDIn applies the In predicate on the "reversal_of_id" field.
+func ReversalOfIDIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldReversalOfID, vs...))
+}
+
+// ReversalOfIDNotIn applies the NotIn predicate on the "reversal_of_id" field.
+func ReversalOfIDNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldReversalOfID, vs...))
+}
+
+// ReversalOfIDGT applies the GT predicate on the "reversal_of_id" field.
+func ReversalOfIDGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldReversalOfID, v))
+}
+
+// ReversalOfIDGTE applies the GTE predicate on the "reversal_of_id" field.
+func ReversalOfIDGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldReversalOfID, v))
+}
+
+// ReversalOfIDLT applies the LT predicate on the "reversal_of_id" field.
+func ReversalOfIDLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldReversalOfID, v))
+}
+
+// ReversalOfIDLTE applies the LTE predicate on the "reversal_of_id" field.
+func ReversalOfIDLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldReversalOfID, v))
+}
+
+// ReversalOfIDContains applies the Contains predicate on the "reversal_of_id" field.
+func ReversalOfIDContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldReversalOfID, v))
+}
+
+// ReversalOfIDHasPrefix applies the HasPrefix predicate on the "reversal_of_id" field.
+func ReversalOfIDHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldReversalOfID, v))
+}
+
+// ReversalOfIDHasSuffix applies the HasSuffix predicate on the "reversal_of_id" field.
+func ReversalOfIDHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldReversalOfID, v))
+}
+
+// ReversalOfIDIsNil applies the IsNil predicate on the "reversal_of_id" field.
+func ReversalOfIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldReversalOfID))
+}
+
+// ReversalOfIDNotNil applies the NotNil predicate on the "reversal_of_id" field.
+func ReversalOfIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldReversalOfID))
+}
+
+// ReversalOfIDEqualFold applies the EqualFold predicate on the "reversal_of_id" field.
+func ReversalOfIDEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldReversalOfID, v))
+}
+
+// ReversalOfIDContainsFold applies the ContainsFold predicate on the "reversal_of_id" field.
+func ReversalOfIDContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldReversalOfID, v))
+}
+
+// FeeOfIDEQ applies the EQ predicate on the "fee_of_id" field.
+func FeeOfIDEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldFeeOfID, v))
+}
+
+// FeeOfIDNEQ applies the NEQ predicate on the "fee_of_id" field.
+func FeeOfIDNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldFeeOfID, v))
+}
+
+// FeeOfIDIn applies the In predicate on the "fee_of_id" field.
+func FeeOfIDIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldFeeOfID, vs...))
+}
+
+// FeeOfIDNotIn applies the NotIn predicate on the "fee_of_id" field.
+func FeeOfIDNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldFeeOfID, vs...))
+}
+
+// FeeOfIDGT applies the GT predicate on the "fee_of_id" field.
+func FeeOfIDGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldFeeOfID, v))
+}
+
+// FeeOfIDGTE applies the GTE predicate on the "fee_of_id" field.
+func FeeOfIDGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldFeeOfID, v))
+}
+
+// FeeOfIDLT applies the LT predicate on the "fee_of_id" field.
+func FeeOfIDLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldFeeOfID, v))
+}
+
+// FeeOfIDLTE applies the LTE predicate on the "fee_of_id" field.
+func FeeOfIDLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldFeeOfID, v))
+}
+
+// FeeOfIDContains applies the Contains predicate on the "fee_of_id" field.
+func FeeOfIDContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldFeeOfID, v))
+}
+
+// FeeOfIDHasPrefix applies the HasPrefix predicate on the "fee_of_id" field.
+func FeeOfIDHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldFeeOfID, v))
+}
+
+// FeeOfIDHasSuffix applies the HasSuffix predicate on the "fee_of_id" field.
+func FeeOfIDHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldFeeOfID, v))
+}
+
+// FeeOfIDIsNil applies the IsNil predicate on the "fee_of_id" field.
+func FeeOfIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldFeeOfID))
+}
+
+// FeeOfIDNotNil applies the NotNil predicate on the "fee_of_id" field.
+func FeeOfIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldFeeOfID))
+}
+
+// FeeOfIDEqualFold applies the EqualFold predicate on the "fee_of_id" field.
+func FeeOfIDEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldFeeOfID, v))
+}
+
+// FeeOfIDContainsFold applies the ContainsFold predicate on the "fee_of_id" field.
+func FeeOfIDContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldFeeOfID, v))
+}
+
+// TransferIDEQ applies the EQ predicate on the "transfer_id" field.
+func TransferIDEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldTransferID, v))
+}
+
+// TransferIDNEQ applies the NEQ predicate on the "transfer_id" field.
+func TransferIDNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldTransferID, v))
+}
+
+// TransferIDIn applies the In predicate on the "transfer_id" field.
+func TransferIDIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldTransferID, vs...))
+}
+
+// TransferIDNotIn applies the NotIn predicate on the "transfer_id" field.
+func TransferIDNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldTransferID, vs...))
+}
+
+// TransferIDGT applies the GT predicate on the "transfer_id" field.
+func TransferIDGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldTransferID, v))
+}
+
+// TransferIDGTE applies the GTE predicate on the "transfer_id" field.
+func TransferIDGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldTransferID, v))
+}
+
+// TransferIDLT applies the LT predicate on the "transfer_id" field.
+func TransferIDLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldTransferID, v))
+}
+
+// TransferIDLTE applies the LTE predicate on the "transfer_id" field.
+func TransferIDLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldTransferID, v))
+}
+
+// TransferIDContains applies the Contains predicate on the "transfer_id" field.
+func TransferIDContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldTransferID, v))
+}
+
+// TransferIDHasPrefix applies the HasPrefix predicate on the "transfer_id" field.
+func TransferIDHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldTransferID, v))
+}
+
+// TransferIDHasSuffix applies the HasSuffix predicate on the "transfer_id" field.
+func TransferIDHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldTransferID, v))
+}
+
+// TransferIDIsNil applies the IsNil predicate on the "transfer_id" field.
+func TransferIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldTransferID))
+}
+
+// TransferIDNotNil applies the NotNil predicate on the "transfer_id" field.
+func TransferIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldTransferID))
+}
+
+// TransferIDEqualFold applies the EqualFold predicate on the "transfer_id" field.
+func TransferIDEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldTransferID, v))
+}
+
+// TransferIDContainsFold applies the ContainsFold predicate on the "transfer_id" field.
+func TransferIDContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldTransferID, v))
+}
+
+// ExchangeIDEQ applies the EQ predicate on the "exchange_id" field.
+func ExchangeIDEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExchangeID, v))
+}
+
+// ExchangeIDNEQ applies the NEQ predicate on the "exchange_id" field.
+func ExchangeIDNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldExchangeID, v))
+}
+
+// ExchangeIDIn applies the In predicate on the "exchange_id" field.
+func ExchangeIDIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldExchangeID, vs...))
+}
+
+// ExchangeIDNotIn applies the NotIn predicate on the "exchange_id" field.
+func ExchangeIDNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldExchangeID, vs...))
+}
+
+// ExchangeIDGT applies the GT predicate on the "exchange_id" field.
+func ExchangeIDGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldExchangeID, v))
+}
+
+// ExchangeIDGTE applies the GTE predicate on the "exchange_id" field.
+func ExchangeIDGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldExchangeID, v))
+}
+
+// ExchangeIDLT applies the LT predicate on the "exchange_id" field.
+func ExchangeIDLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldExchangeID, v))
+}
+
+// ExchangeIDLTE applies the LTE predicate on the "exchange_id" field.
+func ExchangeIDLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldExchangeID, v))
+}
+
+// ExchangeIDContains applies the Contains predicate on the "exchange_id" field.
+func ExchangeIDContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldExchangeID, v))
+}
+
+// ExchangeIDHasPrefix applies the HasPrefix predicate on the "exchange_id" field.
+func ExchangeIDHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldExchangeID, v))
+}
+
+// ExchangeIDHasSuffix applies the HasSuffix predicate on the "exchange_id" field.
+func ExchangeIDHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldExchangeID, v))
+}
+
+// ExchangeIDIsNil applies the IsNil predicate on the "exchange_id" field.
+func ExchangeIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldExchangeID))
+}
+
+// ExchangeIDNotNil applies the NotNil predicate on the "exchange_id" field.
+func ExchangeIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldExchangeID))
+}
+
+// ExchangeIDEqualFold applies the EqualFold predicate on the "exchange_id" field.
+func ExchangeIDEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldExchangeID, v))
+}
+
+// ExchangeIDContainsFold applies the ContainsFold predicate on the "exchange_id" field.
+func ExchangeIDContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldExchangeID, v))
+}
+
+// ExchangeRateIDEQ applies the EQ predicate on the "exchange_rate_id" field.
+func ExchangeRateIDEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExchangeRateID, v))
+}
+
+// ExchangeRateIDNEQ applies the NEQ predicate on the "exchange_rate_id" field.
+func ExchangeRateIDNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldExchangeRateID, v))
+}
+
+// ExchangeRateIDIn applies the In predicate on the "exchange_rate_id" field.
+func ExchangeRateIDIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldExchangeRateID, vs...))
+}
+
+// ExchangeRateIDNotIn applies the NotIn predicate on the "exchange_rate_id" field.
+func ExchangeRateIDNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldExchangeRateID, vs...))
+}
+
+// ExchangeRateIDIsNil applies the IsNil predicate on the "exchange_rate_id" field.
+func ExchangeRateIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldExchangeRateID))
+}
+
+// ExchangeRateIDNotNil applies the NotNil predicate on the "exchange_rate_id" field.
+func ExchangeRateIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldExchangeRateID))
+}
+
+// AppliedRateEQ applies the EQ predicate on the "applied_rate" field.
+func AppliedRateEQ(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldAppliedRate, v))
+}
+
+// AppliedRateNEQ applies the NEQ predicate on the "applied_rate" field.
+func AppliedRateNEQ(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldAppliedRate, v))
+}
+
+// AppliedRateIn applies the In predicate on the "applied_rate" field.
+func AppliedRateIn(vs ...decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldAppliedRate, vs...))
+}
+
+// AppliedRateNotIn applies the NotIn predicate on the "applied_rate" field.
+func AppliedRateNotIn(vs ...decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldAppliedRate, vs...))
+}
+
+// AppliedRateGT applies the GT predicate on the "applied_rate" field.
+func AppliedRateGT(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldAppliedRate, v))
+}
+
+// AppliedRateGTE applies the GTE predicate on the "applied_rate" field.
+func AppliedRateGTE(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldAppliedRate, v))
+}
+
+// AppliedRateLT applies the LT predicate on the "applied_rate" field.
+func AppliedRateLT(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldAppliedRate, v))
+}
+
+// AppliedRateLTE applies the LTE predicate on the "applied_rate" field.
+func AppliedRateLTE(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldAppliedRate, v))
+}
+
+// AppliedRateIsNil applies the IsNil predicate on the "applied_rate" field.
+func AppliedRateIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldAppliedRate))
+}
+
+// AppliedRateNotNil applies the NotNil predicate on the "applied_rate" field.
+func AppliedRateNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldAppliedRate))
+}
+
+// JournalEntryIDEQ applies the EQ predicate on the "journal_entry_id" field.
+func JournalEntryIDEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDNEQ applies the NEQ predicate on the "journal_entry_id" field.
+func JournalEntryIDNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldJournalEntryID, v))
+}
+
+// JournalEntryIDIn applies the In predicate on the "journal_entry_id" field.
+func JournalEntryIDIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDNotIn applies the NotIn predicate on the "journal_entry_id" field.
+func JournalEntryIDNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldJournalEntryID, vs...))
+}
+
+// JournalEntryIDIsNil applies the IsNil predicate on the "journal_entry_id" field.
+func JournalEntryIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldJournalEntryID))
+}
+
+// JournalEntryIDNotNil applies the NotNil predicate on the "journal_entry_id" field.
+func JournalEntryIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldJournalEntryID))
+}
+
+// BalanceAfterEQ applies the EQ predicate on the "balance_after" field.
+func BalanceAfterEQ(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterNEQ applies the NEQ predicate on the "balance_after" field.
+func BalanceAfterNEQ(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterIn applies the In predicate on the "balance_after" field.
+func BalanceAfterIn(vs ...decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterNotIn applies the NotIn predicate on the "balance_after" field.
+func BalanceAfterNotIn(vs ...decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterGT applies the GT predicate on the "balance_after" field.
+func BalanceAfterGT(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterGTE applies the GTE predicate on the "balance_after" field.
+func BalanceAfterGTE(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLT applies the LT predicate on the "balance_after" field.
+func BalanceAfterLT(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLTE applies the LTE predicate on the "balance_after" field.
+func BalanceAfterLTE(v decimal.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldBalanceAfter, v))
+}
+
+// BalanceAfterIsNil applies the IsNil predicate on the "balance_after" field.
+func BalanceAfterIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldBalanceAfter))
+}
+
+// BalanceAfterNotNil applies the NotNil predicate on the "balance_after" field.
+func BalanceAfterNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldBalanceAfter))
+}
+
+// BalanceSequenceEQ applies the EQ predicate on the "balance_sequence" field.
+func BalanceSequenceEQ(v int64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldBalanceSequence, v))
+}
+
+// BalanceSequenceNEQ applies the NEQ predicate on the "balance_sequence" field.
+func BalanceSequenceNEQ(v int64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldBalanceSequence, v))
+}
+
+// BalanceSequenceIn applies the In predicate on the "balance_sequence" field.
+func BalanceSequenceIn(vs ...int64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldBalanceSequence, vs...))
+}
+
+// BalanceSequenceNotIn applies the NotIn predicate on the "balance_sequence" field.
+func BalanceSequenceNotIn(vs ...int64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldBalanceSequence, vs...))
+}
+
+// BalanceSequenceGT applies the GT predicate on the "balance_sequence" field.
+func BalanceSequenceGT(v int64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldBalanceSequence, v))
+}
+
+// BalanceSequenceGTE applies the GTE predicate on the "balance_sequence" field.
+func BalanceSequenceGTE(v int64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldBalanceSequence, v))
+}
+
+// BalanceSequenceLT applies the LT predicate on the "balance_sequence" field.
+func BalanceSequenceLT(v int64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldBalanceSequence, v))
+}
+
+// BalanceSequenceLTE applies the LTE predicate on the "balance_sequence" field.
+func BalanceSequenceLTE(v int64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldBalanceSequence, v))
+}
+
+// BalanceSequenceIsNil applies the IsNil predicate on the "balance_sequence" field.
+func BalanceSequenceIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldBalanceSequence))
+}
+
+// BalanceSequenceNotNil applies the NotNil predicate on the "balance_sequence" field.
+func BalanceSequenceNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldBalanceSequence))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryIsNil applies the IsNil predicate on the "category" field.
+func CategoryIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldCategory))
+}
+
+// CategoryNotNil applies the NotNil predicate on the "category" field.
+func CategoryNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldCategory))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceIsNil applies the IsNil predicate on the "source" field.
+func SourceIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldSource))
+}
+
+// SourceNotNil applies the NotNil predicate on the "source" field.
+func SourceNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldSource))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldSource, v))
+}
+
+// ExternalRefEQ applies the EQ predicate on the "external_ref" field.
+func ExternalRefEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExternalRef, v))
+}
+
+// ExternalRefNEQ applies the NEQ predicate on the "external_ref" field.
+func ExternalRefNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldExternalRef, v))
+}
+
+// ExternalRefIn applies the In predicate on the "external_ref" field.
+func ExternalRefIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldExternalRef, vs...))
+}
+
+// ExternalRefNotIn applies the NotIn predicate on the "external_ref" field.
+func ExternalRefNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldExternalRef, vs...))
+}
+
+// ExternalRefGT applies the GT predicate on the "external_ref" field.
+func ExternalRefGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldExternalRef, v))
+}
+
+// ExternalRefGTE applies the GTE predicate on the "external_ref" field.
+func ExternalRefGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldExternalRef, v))
+}
+
+// ExternalRefLT applies the LT predicate on the "external_ref" field.
+func ExternalRefLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldExternalRef, v))
+}
+
+// ExternalRefLTE applies the LTE predicate on the "external_ref" field.
+func ExternalRefLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldExternalRef, v))
+}
+
+// ExternalRefContains applies the Contains predicate on the "external_ref" field.
+func ExternalRefContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldExternalRef, v))
+}
+
+// ExternalRefHasPrefix applies the HasPrefix predicate on the "external_ref" field.
+func ExternalRefHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldExternalRef, v))
+}
+
+// ExternalRefHasSuffix applies the HasSuffix predicate on the "external_ref" field.
+func ExternalRefHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldExternalRef, v))
+}
+
+// ExternalRefIsNil applies the IsNil predicate on the "external_ref" field.
+func ExternalRefIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldExternalRef))
+}
+
+// ExternalRefNotNil applies the NotNil predicate on the "external_ref" field.
+func ExternalRefNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldExternalRef))
+}
+
+// ExternalRefEqualFold applies the EqualFold predicate on the "external_ref" field.
+func ExternalRefEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldExternalRef, v))
+}
+
+// ExternalRefContainsFold applies the ContainsFold predicate on the "external_ref" field.
+func ExternalRefContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldExternalRef, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldMetadata))
+}
+
+// EffectiveAtEQ applies the EQ predicate on the "effective_at" field.
+func EffectiveAtEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldEffectiveAt, v))
+}
+
+// EffectiveAtNEQ applies the NEQ predicate on the "effective_at" field.
+func EffectiveAtNEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldEffectiveAt, v))
+}
+
+// EffectiveAtIn applies the In predicate on the "effective_at" field.
+func EffectiveAtIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldEffectiveAt, vs...))
+}
+
+// EffectiveAtNotIn applies the NotIn predicate on the "effective_at" field.
+func EffectiveAtNotIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldEffectiveAt, vs...))
+}
+
+// EffectiveAtGT applies the GT predicate on the "effective_at" field.
+func EffectiveAtGT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldEffectiveAt, v))
+}
+
+// EffectiveAtGTE applies the GTE predicate on the "effective_at" field.
+func EffectiveAtGTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldEffectiveAt, v))
+}
+
+// EffectiveAtLT applies the LT predicate on the "effective_at" field.
+func EffectiveAtLT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldEffectiveAt, v))
+}
+
+// EffectiveAtLTE applies the LTE predicate on the "effective_at" field.
+func EffectiveAtLTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldEffectiveAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasJournalEntry applies the HasEdge predicate on the "journal_entry" edge.
+func HasJournalEntry() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, JournalEntryTable, JournalEntryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJournalEntryWith applies the HasEdge predicate on the "journal_entry" edge with a given conditions (other predicates).
+func HasJournalEntryWith(preds ...predicate.JournalEntry) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newJournalEntryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReversalOf applies the HasEdge predicate on the "reversal_of" edge.
+func HasReversalOf() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReversalOfTable, ReversalOfColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReversalOfWith applies the HasEdge predicate on the "reversal_of" edge with a given conditions (other predicates).
+func HasReversalOfWith(preds ...predicate.Transaction) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newReversalOfStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReversals applies the HasEdge predicate on the "reversals" edge.
+func HasReversals() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReversalsTable, ReversalsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReversalsWith applies the HasEdge predicate on the "reversals" edge with a given conditions (other predicates).
+func HasReversalsWith(preds ...predicate.Transaction) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newReversalsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFeeOf applies the HasEdge predicate on the "fee_of" edge.
+func HasFeeOf() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FeeOfTable, FeeOfColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFeeOfWith applies the HasEdge predicate on the "fee_of" edge with a given conditions (other predicates).
+func HasFeeOfWith(preds ...predicate.Transaction) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newFeeOfStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFees applies the HasEdge predicate on the "fees" edge.
+func HasFees() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FeesTable, FeesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFeesWith applies the HasEdge predicate on the "fees" edge with a given conditions (other predicates).
+func HasFeesWith(preds ...predicate.Transaction) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newFeesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExchangeRate applies the HasEdge predicate on the "exchange_rate" edge.
+func HasExchangeRate() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ExchangeRateTable, ExchangeRateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExchangeRateWith applies the HasEdge predicate on the "exchange_rate" edge with a given conditions (other predicates).
+func HasExchangeRateWith(preds ...predicate.ExchangeRate) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newExchangeRateStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -3,15 +3,20 @@
 package ent
 
 import (
-	"context"
+	"accounting/ent/exchangerate"
+	"accounting/ent/journalentry"
 	"accounting/ent/transaction"
 	"accounting/ent/user"
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
 )
 
 // TransactionCreate is the builder for creating a Transaction entity.
@@ -19,6 +24,7 @@ type TransactionCreate struct {
 	config
 	mutation *TransactionMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -28,8 +34,8 @@ func (tc *TransactionCreate) SetUserID(i int) *TransactionCreate {
 }
 
 // SetAmount sets the "amount" field.
-func (tc *TransactionCreate) SetAmount(f float64) *TransactionCreate {
-	tc.mutation.SetAmount(f)
+func (tc *TransactionCreate) SetAmount(d decimal.Decimal) *TransactionCreate {
+	tc.mutation.SetAmount(d)
 	return tc
 }
 
@@ -53,6 +59,264 @@ func (tc *TransactionCreate) SetType(t transaction.Type) *TransactionCreate {
 	return tc
 }
 
+// SetStatus sets the "status" field.
+func (tc *TransactionCreate) SetStatus(t transaction.Status) *TransactionCreate {
+	tc.mutation.SetStatus(t)
+	return tc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableStatus(t *transaction.Status) *TransactionCreate {
+	if t != nil {
+		tc.SetStatus(*t)
+	}
+	return tc
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (tc *TransactionCreate) SetFailureReason(s string) *TransactionCreate {
+	tc.mutation.SetFailureReason(s)
+	return tc
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableFailureReason(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetFailureReason(*s)
+	}
+	return tc
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (tc *TransactionCreate) SetPostedAt(t time.Time) *TransactionCreate {
+	tc.mutation.SetPostedAt(t)
+	return tc
+}
+
+// SetNillablePostedAt sets the "posted_at" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillablePostedAt(t *time.Time) *TransactionCreate {
+	if t != nil {
+		tc.SetPostedAt(*t)
+	}
+	return tc
+}
+
+// SetReversedAmount sets the "reversed_amount" field.
+func (tc *TransactionCreate) SetReversedAmount(d decimal.Decimal) *TransactionCreate {
+	tc.mutation.SetReversedAmount(d)
+	return tc
+}
+
+// SetNillableReversedAmount sets the "reversed_amount" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableReversedAmount(d *decimal.Decimal) *TransactionCreate {
+	if d != nil {
+		tc.SetReversedAmount(*d)
+	}
+	return tc
+}
+
+// SetReversalOfID sets the "reversal_of_id" field.
+func (tc *TransactionCreate) SetReversalOfID(s string) *TransactionCreate {
+	tc.mutation.SetReversalOfID(s)
+	return tc
+}
+
+// SetNillableReversalOfID sets the "reversal_of_id" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableReversalOfID(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetReversalOfID(*s)
+	}
+	return tc
+}
+
+// SetFeeOfID sets the "fee_of_id" field.
+func (tc *TransactionCreate) SetFeeOfID(s string) *TransactionCreate {
+	tc.mutation.SetFeeOfID(s)
+	return tc
+}
+
+// SetNillableFeeOfID sets the "fee_of_id" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableFeeOfID(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetFeeOfID(*s)
+	}
+	return tc
+}
+
+// SetTransferID sets the "transfer_id" field.
+func (tc *TransactionCreate) SetTransferID(s string) *TransactionCreate {
+	tc.mutation.SetTransferID(s)
+	return tc
+}
+
+// SetNillableTransferID sets the "transfer_id" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableTransferID(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetTransferID(*s)
+	}
+	return tc
+}
+
+// SetExchangeID sets the "exchange_id" field.
+func (tc *TransactionCreate) SetExchangeID(s string) *TransactionCreate {
+	tc.mutation.SetExchangeID(s)
+	return tc
+}
+
+// SetNillableExchangeID sets the "exchange_id" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableExchangeID(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetExchangeID(*s)
+	}
+	return tc
+}
+
+// SetExchangeRateID sets the "exchange_rate_id" field.
+func (tc *TransactionCreate) SetExchangeRateID(i int) *TransactionCreate {
+	tc.mutation.SetExchangeRateID(i)
+	return tc
+}
+
+// SetNillableExchangeRateID sets the "exchange_rate_id" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableExchangeRateID(i *int) *TransactionCreate {
+	if i != nil {
+		tc.SetExchangeRateID(*i)
+	}
+	return tc
+}
+
+// SetAppliedRate sets the "applied_rate" field.
+func (tc *TransactionCreate) SetAppliedRate(d decimal.Decimal) *TransactionCreate {
+	tc.mutation.SetAppliedRate(d)
+	return tc
+}
+
+// SetNillableAppliedRate sets the "applied_rate" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableAppliedRate(d *decimal.Decimal) *TransactionCreate {
+	if d != nil {
+		tc.SetAppliedRate(*d)
+	}
+	return tc
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (tc *TransactionCreate) SetJournalEntryID(i int) *TransactionCreate {
+	tc.mutation.SetJournalEntryID(i)
+	return tc
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableJournalEntryID(i *int) *TransactionCreate {
+	if i != nil {
+		tc.SetJournalEntryID(*i)
+	}
+	return tc
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (tc *TransactionCreate) SetBalanceAfter(d decimal.Decimal) *TransactionCreate {
+	tc.mutation.SetBalanceAfter(d)
+	return tc
+}
+
+// SetNillableBalanceAfter sets the "balance_after" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableBalanceAfter(d *decimal.Decimal) *TransactionCreate {
+	if d != nil {
+		tc.SetBalanceAfter(*d)
+	}
+	return tc
+}
+
+// SetBalanceSequence sets the "balance_sequence" field.
+func (tc *TransactionCreate) SetBalanceSequence(i int64) *TransactionCreate {
+	tc.mutation.SetBalanceSequence(i)
+	return tc
+}
+
+// SetNillableBalanceSequence sets the "balance_sequence" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableBalanceSequence(i *int64) *TransactionCreate {
+	if i != nil {
+		tc.SetBalanceSequence(*i)
+	}
+	return tc
+}
+
+// SetDescription sets the "description" field.
+func (tc *TransactionCreate) SetDescription(s string) *TransactionCreate {
+	tc.mutation.SetDescription(s)
+	return tc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableDescription(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetDescription(*s)
+	}
+	return tc
+}
+
+// SetCategory sets the "category" field.
+func (tc *TransactionCreate) SetCategory(s string) *TransactionCreate {
+	tc.mutation.SetCategory(s)
+	return tc
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableCategory(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetCategory(*s)
+	}
+	return tc
+}
+
+// SetSource sets the "source" field.
+func (tc *TransactionCreate) SetSource(s string) *TransactionCreate {
+	tc.mutation.SetSource(s)
+	return tc
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableSource(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetSource(*s)
+	}
+	return tc
+}
+
+// SetExternalRef sets the "external_ref" field.
+func (tc *TransactionCreate) SetExternalRef(s string) *TransactionCreate {
+	tc.mutation.SetExternalRef(s)
+	return tc
+}
+
+// SetNillableExternalRef sets the "external_ref" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableExternalRef(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetExternalRef(*s)
+	}
+	return tc
+}
+
+// SetMetadata sets the "metadata" field.
+func (tc *TransactionCreate) SetMetadata(m map[string]interface{}) *TransactionCreate {
+	tc.mutation.SetMetadata(m)
+	return tc
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (tc *TransactionCreate) SetEffectiveAt(t time.Time) *TransactionCreate {
+	tc.mutation.SetEffectiveAt(t)
+	return tc
+}
+
+// SetNillableEffectiveAt sets the "effective_at" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableEffectiveAt(t *time.Time) *TransactionCreate {
+	if t != nil {
+		tc.SetEffectiveAt(*t)
+	}
+	return tc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (tc *TransactionCreate) SetCreatedAt(t time.Time) *TransactionCreate {
 	tc.mutation.SetCreatedAt(t)
@@ -78,6 +342,56 @@ func (tc *TransactionCreate) SetUser(u *User) *TransactionCreate {
 	return tc.SetUserID(u.ID)
 }
 
+// SetJournalEntry sets the "journal_entry" edge to the JournalEntry entity.
+func (tc *TransactionCreate) SetJournalEntry(j *JournalEntry) *TransactionCreate {
+	return tc.SetJournalEntryID(j.ID)
+}
+
+// SetReversalOf sets the "reversal_of" edge to the Transaction entity.
+func (tc *TransactionCreate) SetReversalOf(t *Transaction) *TransactionCreate {
+	return tc.SetReversalOfID(t.ID)
+}
+
+// AddReversalIDs adds the "reversals" edge to the Transaction entity by IDs.
+func (tc *TransactionCreate) AddReversalIDs(ids ...string) *TransactionCreate {
+	tc.mutation.AddReversalIDs(ids...)
+	return tc
+}
+
+// AddReversals adds the "reversals" edges to the Transaction entity.
+func (tc *TransactionCreate) AddReversals(t ...*Transaction) *TransactionCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddReversalIDs(ids...)
+}
+
+// SetFeeOf sets the "fee_of" edge to the Transaction entity.
+func (tc *TransactionCreate) SetFeeOf(t *Transaction) *TransactionCreate {
+	return tc.SetFeeOfID(t.ID)
+}
+
+// AddFeeIDs adds the "fees" edge to the Transaction entity by IDs.
+func (tc *TransactionCreate) AddFeeIDs(ids ...string) *TransactionCreate {
+	tc.mutation.AddFeeIDs(ids...)
+	return tc
+}
+
+// AddFees adds the "fees" edges to the Transaction entity.
+func (tc *TransactionCreate) AddFees(t ...*Transaction) *TransactionCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddFeeIDs(ids...)
+}
+
+// SetExchangeRate sets the "exchange_rate" edge to the ExchangeRate entity.
+func (tc *TransactionCreate) SetExchangeRate(e *ExchangeRate) *TransactionCreate {
+	return tc.SetExchangeRateID(e.ID)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (tc *TransactionCreate) Mutation() *TransactionMutation {
 	return tc.mutation
@@ -85,7 +399,9 @@ func (tc *TransactionCreate) Mutation() *TransactionMutation {
 
 // Save creates the Transaction in the database.
 func (tc *TransactionCreate) Save(ctx context.Context) (*Transaction, error) {
-	tc.defaults()
+	if err := tc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
 }
 
@@ -112,15 +428,37 @@ func (tc *TransactionCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (tc *TransactionCreate) defaults() {
+func (tc *TransactionCreate) defaults() error {
 	if _, ok := tc.mutation.Currency(); !ok {
 		v := transaction.DefaultCurrency
 		tc.mutation.SetCurrency(v)
 	}
+	if _, ok := tc.mutation.Status(); !ok {
+		v := transaction.DefaultStatus
+		tc.mutation.SetStatus(v)
+	}
+	if _, ok := tc.mutation.ReversedAmount(); !ok {
+		if transaction.DefaultReversedAmount == nil {
+			return fmt.Errorf("ent: uninitialized transaction.DefaultReversedAmount (forgotten import ent/runtime?)")
+		}
+		v := transaction.DefaultReversedAmount()
+		tc.mutation.SetReversedAmount(v)
+	}
+	if _, ok := tc.mutation.EffectiveAt(); !ok {
+		if transaction.DefaultEffectiveAt == nil {
+			return fmt.Errorf("ent: uninitialized transaction.DefaultEffectiveAt (forgotten import ent/runtime?)")
+		}
+		v := transaction.DefaultEffectiveAt()
+		tc.mutation.SetEffectiveAt(v)
+	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
+		if transaction.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized transaction.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := transaction.DefaultCreatedAt()
 		tc.mutation.SetCreatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -142,6 +480,37 @@ func (tc *TransactionCreate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Transaction.type": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Transaction.status"`)}
+	}
+	if v, ok := tc.mutation.Status(); ok {
+		if err := transaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Transaction.status": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.ReversedAmount(); !ok {
+		return &ValidationError{Name: "reversed_amount", err: errors.New(`ent: missing required field "Transaction.reversed_amount"`)}
+	}
+	if v, ok := tc.mutation.Description(); ok {
+		if err := transaction.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Transaction.description": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.Category(); ok {
+		if err := transaction.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Transaction.category": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.Source(); ok {
+		if err := transaction.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Transaction.source": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.ExternalRef(); ok {
+		if err := transaction.ExternalRefValidator(v); err != nil {
+			return &ValidationError{Name: "external_ref", err: fmt.Errorf(`ent: validator failed for field "Transaction.external_ref": %w`, err)}
+		}
+	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Transaction.created_at"`)}
 	}
@@ -184,6 +553,7 @@ func (tc *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node = &Transaction{config: tc.config}
 		_spec = sqlgraph.NewCreateSpec(transaction.Table, sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = tc.conflict
 	if id, ok := tc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -200,6 +570,66 @@ func (tc *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_spec.SetField(transaction.FieldType, field.TypeEnum, value)
 		_node.Type = value
 	}
+	if value, ok := tc.mutation.Status(); ok {
+		_spec.SetField(transaction.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := tc.mutation.FailureReason(); ok {
+		_spec.SetField(transaction.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = &value
+	}
+	if value, ok := tc.mutation.PostedAt(); ok {
+		_spec.SetField(transaction.FieldPostedAt, field.TypeTime, value)
+		_node.PostedAt = &value
+	}
+	if value, ok := tc.mutation.ReversedAmount(); ok {
+		_spec.SetField(transaction.FieldReversedAmount, field.TypeFloat64, value)
+		_node.ReversedAmount = value
+	}
+	if value, ok := tc.mutation.TransferID(); ok {
+		_spec.SetField(transaction.FieldTransferID, field.TypeString, value)
+		_node.TransferID = &value
+	}
+	if value, ok := tc.mutation.ExchangeID(); ok {
+		_spec.SetField(transaction.FieldExchangeID, field.TypeString, value)
+		_node.ExchangeID = &value
+	}
+	if value, ok := tc.mutation.AppliedRate(); ok {
+		_spec.SetField(transaction.FieldAppliedRate, field.TypeFloat64, value)
+		_node.AppliedRate = &value
+	}
+	if value, ok := tc.mutation.BalanceAfter(); ok {
+		_spec.SetField(transaction.FieldBalanceAfter, field.TypeFloat64, value)
+		_node.BalanceAfter = &value
+	}
+	if value, ok := tc.mutation.BalanceSequence(); ok {
+		_spec.SetField(transaction.FieldBalanceSequence, field.TypeInt64, value)
+		_node.BalanceSequence = &value
+	}
+	if value, ok := tc.mutation.Description(); ok {
+		_spec.SetField(transaction.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := tc.mutation.Category(); ok {
+		_spec.SetField(transaction.FieldCategory, field.TypeString, value)
+		_node.Category = &value
+	}
+	if value, ok := tc.mutation.Source(); ok {
+		_spec.SetField(transaction.FieldSource, field.TypeString, value)
+		_node.Source = &value
+	}
+	if value, ok := tc.mutation.ExternalRef(); ok {
+		_spec.SetField(transaction.FieldExternalRef, field.TypeString, value)
+		_node.ExternalRef = &value
+	}
+	if value, ok := tc.mutation.Metadata(); ok {
+		_spec.SetField(transaction.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := tc.mutation.EffectiveAt(); ok {
+		_spec.SetField(transaction.FieldEffectiveAt, field.TypeTime, value)
+		_node.EffectiveAt = value
+	}
 	if value, ok := tc.mutation.CreatedAt(); ok {
 		_spec.SetField(transaction.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -221,53 +651,863 @@ func (tc *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	return _node, _spec
-}
-
-// TransactionCreateBulk is the builder for creating many Transaction entities in bulk.
-type TransactionCreateBulk struct {
-	config
-	err      error
-	builders []*TransactionCreate
-}
-
-// Save creates the Transaction entities in the database.
-func (tcb *TransactionCreateBulk) Save(ctx context.Context) ([]*Transaction, error) {
-	if tcb.err != nil {
-		return nil, tcb.err
+	if nodes := tc.mutation.JournalEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.JournalEntryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
-	nodes := make([]*Transaction, len(tcb.builders))
-	mutators := make([]Mutator, len(tcb.builders))
-	for i := range tcb.builders {
-		func(i int, root context.Context) {
-			builder := tcb.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*TransactionMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
+	if nodes := tc.mutation.ReversalOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.ReversalOfTable,
+			Columns: []string{transaction.ReversalOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReversalOfID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ReversalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalsTable,
+			Columns: []string{transaction.ReversalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.FeeOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.FeeOfTable,
+			Columns: []string{transaction.FeeOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FeeOfID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.FeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.FeesTable,
+			Columns: []string{transaction.FeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ExchangeRateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.ExchangeRateTable,
+			Columns: []string{transaction.ExchangeRateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ExchangeRateID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Transaction.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransactionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (tc *TransactionCreate) OnConflict(opts ...sql.ConflictOption) *TransactionUpsertOne {
+	tc.conflict = opts
+	return &TransactionUpsertOne{
+		create: tc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tc *TransactionCreate) OnConflictColumns(columns ...string) *TransactionUpsertOne {
+	tc.conflict = append(tc.conflict, sql.ConflictColumns(columns...))
+	return &TransactionUpsertOne{
+		create: tc,
+	}
+}
+
+type (
+	// TransactionUpsertOne is the builder for "upsert"-ing
+	//  one Transaction node.
+	TransactionUpsertOne struct {
+		create *TransactionCreate
+	}
+
+	// TransactionUpsert is the "OnConflict" setter.
+	TransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *TransactionUpsert) SetUserID(v int) *TransactionUpsert {
+	u.Set(transaction.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateUserID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldUserID)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionUpsert) SetAmount(v decimal.Decimal) *TransactionUpsert {
+	u.Set(transaction.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateAmount() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionUpsert) AddAmount(v decimal.Decimal) *TransactionUpsert {
+	u.Add(transaction.FieldAmount, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *TransactionUpsert) SetCurrency(v string) *TransactionUpsert {
+	u.Set(transaction.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateCurrency() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldCurrency)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *TransactionUpsert) SetType(v transaction.Type) *TransactionUpsert {
+	u.Set(transaction.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateType() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldType)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TransactionUpsert) SetStatus(v transaction.Status) *TransactionUpsert {
+	u.Set(transaction.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateStatus() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldStatus)
+	return u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *TransactionUpsert) SetFailureReason(v string) *TransactionUpsert {
+	u.Set(transaction.FieldFailureReason, v)
+	return u
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateFailureReason() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldFailureReason)
+	return u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *TransactionUpsert) ClearFailureReason() *TransactionUpsert {
+	u.SetNull(transaction.FieldFailureReason)
+	return u
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (u *TransactionUpsert) SetPostedAt(v time.Time) *TransactionUpsert {
+	u.Set(transaction.FieldPostedAt, v)
+	return u
+}
+
+// UpdatePostedAt sets the "posted_at" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdatePostedAt() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldPostedAt)
+	return u
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (u *TransactionUpsert) ClearPostedAt() *TransactionUpsert {
+	u.SetNull(transaction.FieldPostedAt)
+	return u
+}
+
+// SetReversedAmount sets the "reversed_amount" field.
+func (u *TransactionUpsert) SetReversedAmount(v decimal.Decimal) *TransactionUpsert {
+	u.Set(transaction.FieldReversedAmount, v)
+	return u
+}
+
+// UpdateReversedAmount sets the "reversed_amount" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateReversedAmount() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldReversedAmount)
+	return u
+}
+
+// AddReversedAmount adds v to the "reversed_amount" field.
+func (u *TransactionUpsert) AddReversedAmount(v decimal.Decimal) *TransactionUpsert {
+	u.Add(transaction.FieldReversedAmount, v)
+	return u
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *TransactionUpsert) SetJournalEntryID(v int) *TransactionUpsert {
+	u.Set(transaction.FieldJournalEntryID, v)
+	return u
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateJournalEntryID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldJournalEntryID)
+	return u
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *TransactionUpsert) ClearJournalEntryID() *TransactionUpsert {
+	u.SetNull(transaction.FieldJournalEntryID)
+	return u
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (u *TransactionUpsert) SetBalanceAfter(v decimal.Decimal) *TransactionUpsert {
+	u.Set(transaction.FieldBalanceAfter, v)
+	return u
+}
+
+// UpdateBalanceAfter sets the "balance_after" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateBalanceAfter() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldBalanceAfter)
+	return u
+}
+
+// AddBalanceAfter adds v to the "balance_after" field.
+func (u *TransactionUpsert) AddBalanceAfter(v decimal.Decimal) *TransactionUpsert {
+	u.Add(transaction.FieldBalanceAfter, v)
+	return u
+}
+
+// ClearBalanceAfter clears the value of the "balance_after" field.
+func (u *TransactionUpsert) ClearBalanceAfter() *TransactionUpsert {
+	u.SetNull(transaction.FieldBalanceAfter)
+	return u
+}
+
+// SetBalanceSequence sets the "balance_sequence" field.
+func (u *TransactionUpsert) SetBalanceSequence(v int64) *TransactionUpsert {
+	u.Set(transaction.FieldBalanceSequence, v)
+	return u
+}
+
+// UpdateBalanceSequence sets the "balance_sequence" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateBalanceSequence() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldBalanceSequence)
+	return u
+}
+
+// AddBalanceSequence adds v to the "balance_sequence" field.
+func (u *TransactionUpsert) AddBalanceSequence(v int64) *TransactionUpsert {
+	u.Add(transaction.FieldBalanceSequence, v)
+	return u
+}
+
+// ClearBalanceSequence clears the value of the "balance_sequence" field.
+func (u *TransactionUpsert) ClearBalanceSequence() *TransactionUpsert {
+	u.SetNull(transaction.FieldBalanceSequence)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *TransactionUpsert) SetDescription(v string) *TransactionUpsert {
+	u.Set(transaction.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateDescription() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TransactionUpsert) ClearDescription() *TransactionUpsert {
+	u.SetNull(transaction.FieldDescription)
+	return u
+}
+
+// SetCategory sets the "category" field.
+func (u *TransactionUpsert) SetCategory(v string) *TransactionUpsert {
+	u.Set(transaction.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateCategory() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldCategory)
+	return u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *TransactionUpsert) ClearCategory() *TransactionUpsert {
+	u.SetNull(transaction.FieldCategory)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *TransactionUpsert) SetMetadata(v map[string]interface{}) *TransactionUpsert {
+	u.Set(transaction.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateMetadata() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *TransactionUpsert) ClearMetadata() *TransactionUpsert {
+	u.SetNull(transaction.FieldMetadata)
+	return u
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (u *TransactionUpsert) SetEffectiveAt(v time.Time) *TransactionUpsert {
+	u.Set(transaction.FieldEffectiveAt, v)
+	return u
+}
+
+// UpdateEffectiveAt sets the "effective_at" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateEffectiveAt() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldEffectiveAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransactionUpsertOne) UpdateNewValues() *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(transaction.FieldID)
+		}
+		if _, exists := u.create.mutation.ReversalOfID(); exists {
+			s.SetIgnore(transaction.FieldReversalOfID)
+		}
+		if _, exists := u.create.mutation.FeeOfID(); exists {
+			s.SetIgnore(transaction.FieldFeeOfID)
+		}
+		if _, exists := u.create.mutation.TransferID(); exists {
+			s.SetIgnore(transaction.FieldTransferID)
+		}
+		if _, exists := u.create.mutation.ExchangeID(); exists {
+			s.SetIgnore(transaction.FieldExchangeID)
+		}
+		if _, exists := u.create.mutation.ExchangeRateID(); exists {
+			s.SetIgnore(transaction.FieldExchangeRateID)
+		}
+		if _, exists := u.create.mutation.AppliedRate(); exists {
+			s.SetIgnore(transaction.FieldAppliedRate)
+		}
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(transaction.FieldSource)
+		}
+		if _, exists := u.create.mutation.ExternalRef(); exists {
+			s.SetIgnore(transaction.FieldExternalRef)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(transaction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TransactionUpsertOne) Ignore() *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransactionUpsertOne) DoNothing() *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransactionCreate.OnConflict
+// documentation for more info.
+func (u *TransactionUpsertOne) Update(set func(*TransactionUpsert)) *TransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TransactionUpsertOne) SetUserID(v int) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateUserID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionUpsertOne) SetAmount(v decimal.Decimal) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionUpsertOne) AddAmount(v decimal.Decimal) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateAmount() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *TransactionUpsertOne) SetCurrency(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateCurrency() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *TransactionUpsertOne) SetType(v transaction.Type) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateType() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TransactionUpsertOne) SetStatus(v transaction.Status) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateStatus() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *TransactionUpsertOne) SetFailureReason(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateFailureReason() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *TransactionUpsertOne) ClearFailureReason() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (u *TransactionUpsertOne) SetPostedAt(v time.Time) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetPostedAt(v)
+	})
+}
+
+// UpdatePostedAt sets the "posted_at" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdatePostedAt() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdatePostedAt()
+	})
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (u *TransactionUpsertOne) ClearPostedAt() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearPostedAt()
+	})
+}
+
+// SetReversedAmount sets the "reversed_amount" field.
+func (u *TransactionUpsertOne) SetReversedAmount(v decimal.Decimal) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetReversedAmount(v)
+	})
+}
+
+// AddReversedAmount adds v to the "reversed_amount" field.
+func (u *TransactionUpsertOne) AddReversedAmount(v decimal.Decimal) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddReversedAmount(v)
+	})
+}
+
+// UpdateReversedAmount sets the "reversed_amount" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateReversedAmount() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateReversedAmount()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *TransactionUpsertOne) SetJournalEntryID(v int) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateJournalEntryID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *TransactionUpsertOne) ClearJournalEntryID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (u *TransactionUpsertOne) SetBalanceAfter(v decimal.Decimal) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetBalanceAfter(v)
+	})
+}
+
+// AddBalanceAfter adds v to the "balance_after" field.
+func (u *TransactionUpsertOne) AddBalanceAfter(v decimal.Decimal) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddBalanceAfter(v)
+	})
+}
+
+// UpdateBalanceAfter sets the "balance_after" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateBalanceAfter() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateBalanceAfter()
+	})
+}
+
+// ClearBalanceAfter clears the value of the "balance_after" field.
+func (u *TransactionUpsertOne) ClearBalanceAfter() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearBalanceAfter()
+	})
+}
+
+// SetBalanceSequence sets the "balance_sequence" field.
+func (u *TransactionUpsertOne) SetBalanceSequence(v int64) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetBalanceSequence(v)
+	})
+}
+
+// AddBalanceSequence adds v to the "balance_sequence" field.
+func (u *TransactionUpsertOne) AddBalanceSequence(v int64) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddBalanceSequence(v)
+	})
+}
+
+// UpdateBalanceSequence sets the "balance_sequence" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateBalanceSequence() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateBalanceSequence()
+	})
+}
+
+// ClearBalanceSequence clears the value of the "balance_sequence" field.
+func (u *TransactionUpsertOne) ClearBalanceSequence() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearBalanceSequence()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TransactionUpsertOne) SetDescription(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateDescription() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TransactionUpsertOne) ClearDescription() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *TransactionUpsertOne) SetCategory(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateCategory() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *TransactionUpsertOne) ClearCategory() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearCategory()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *TransactionUpsertOne) SetMetadata(v map[string]interface{}) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateMetadata() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *TransactionUpsertOne) ClearMetadata() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (u *TransactionUpsertOne) SetEffectiveAt(v time.Time) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetEffectiveAt(v)
+	})
+}
+
+// UpdateEffectiveAt sets the "effective_at" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateEffectiveAt() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateEffectiveAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TransactionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TransactionUpsertOne.ID is not supported by MySQL driver. Use TransactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TransactionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TransactionCreateBulk is the builder for creating many Transaction entities in bulk.
+type TransactionCreateBulk struct {
+	config
+	err      error
+	builders []*TransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Transaction entities in the database.
+func (tcb *TransactionCreateBulk) Save(ctx context.Context) ([]*Transaction, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Transaction, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
 				mutation.id = &nodes[i].ID
 				mutation.done = true
 				return nodes[i], nil
@@ -307,3 +1547,438 @@ func (tcb *TransactionCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Transaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransactionUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (tcb *TransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *TransactionUpsertBulk {
+	tcb.conflict = opts
+	return &TransactionUpsertBulk{
+		create: tcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcb *TransactionCreateBulk) OnConflictColumns(columns ...string) *TransactionUpsertBulk {
+	tcb.conflict = append(tcb.conflict, sql.ConflictColumns(columns...))
+	return &TransactionUpsertBulk{
+		create: tcb,
+	}
+}
+
+// TransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Transaction nodes.
+type TransactionUpsertBulk struct {
+	create *TransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransactionUpsertBulk) UpdateNewValues() *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(transaction.FieldID)
+			}
+			if _, exists := b.mutation.ReversalOfID(); exists {
+				s.SetIgnore(transaction.FieldReversalOfID)
+			}
+			if _, exists := b.mutation.FeeOfID(); exists {
+				s.SetIgnore(transaction.FieldFeeOfID)
+			}
+			if _, exists := b.mutation.TransferID(); exists {
+				s.SetIgnore(transaction.FieldTransferID)
+			}
+			if _, exists := b.mutation.ExchangeID(); exists {
+				s.SetIgnore(transaction.FieldExchangeID)
+			}
+			if _, exists := b.mutation.ExchangeRateID(); exists {
+				s.SetIgnore(transaction.FieldExchangeRateID)
+			}
+			if _, exists := b.mutation.AppliedRate(); exists {
+				s.SetIgnore(transaction.FieldAppliedRate)
+			}
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(transaction.FieldSource)
+			}
+			if _, exists := b.mutation.ExternalRef(); exists {
+				s.SetIgnore(transaction.FieldExternalRef)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(transaction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Transaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TransactionUpsertBulk) Ignore() *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransactionUpsertBulk) DoNothing() *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *TransactionUpsertBulk) Update(set func(*TransactionUpsert)) *TransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TransactionUpsertBulk) SetUserID(v int) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateUserID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *TransactionUpsertBulk) SetAmount(v decimal.Decimal) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *TransactionUpsertBulk) AddAmount(v decimal.Decimal) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateAmount() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *TransactionUpsertBulk) SetCurrency(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateCurrency() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *TransactionUpsertBulk) SetType(v transaction.Type) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateType() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TransactionUpsertBulk) SetStatus(v transaction.Status) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateStatus() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *TransactionUpsertBulk) SetFailureReason(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateFailureReason() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *TransactionUpsertBulk) ClearFailureReason() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (u *TransactionUpsertBulk) SetPostedAt(v time.Time) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetPostedAt(v)
+	})
+}
+
+// UpdatePostedAt sets the "posted_at" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdatePostedAt() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdatePostedAt()
+	})
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (u *TransactionUpsertBulk) ClearPostedAt() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearPostedAt()
+	})
+}
+
+// SetReversedAmount sets the "reversed_amount" field.
+func (u *TransactionUpsertBulk) SetReversedAmount(v decimal.Decimal) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetReversedAmount(v)
+	})
+}
+
+// AddReversedAmount adds v to the "reversed_amount" field.
+func (u *TransactionUpsertBulk) AddReversedAmount(v decimal.Decimal) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddReversedAmount(v)
+	})
+}
+
+// UpdateReversedAmount sets the "reversed_amount" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateReversedAmount() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateReversedAmount()
+	})
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (u *TransactionUpsertBulk) SetJournalEntryID(v int) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetJournalEntryID(v)
+	})
+}
+
+// UpdateJournalEntryID sets the "journal_entry_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateJournalEntryID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateJournalEntryID()
+	})
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (u *TransactionUpsertBulk) ClearJournalEntryID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearJournalEntryID()
+	})
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (u *TransactionUpsertBulk) SetBalanceAfter(v decimal.Decimal) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetBalanceAfter(v)
+	})
+}
+
+// AddBalanceAfter adds v to the "balance_after" field.
+func (u *TransactionUpsertBulk) AddBalanceAfter(v decimal.Decimal) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddBalanceAfter(v)
+	})
+}
+
+// UpdateBalanceAfter sets the "balance_after" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateBalanceAfter() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateBalanceAfter()
+	})
+}
+
+// ClearBalanceAfter clears the value of the "balance_after" field.
+func (u *TransactionUpsertBulk) ClearBalanceAfter() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearBalanceAfter()
+	})
+}
+
+// SetBalanceSequence sets the "balance_sequence" field.
+func (u *TransactionUpsertBulk) SetBalanceSequence(v int64) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetBalanceSequence(v)
+	})
+}
+
+// AddBalanceSequence adds v to the "balance_sequence" field.
+func (u *TransactionUpsertBulk) AddBalanceSequence(v int64) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddBalanceSequence(v)
+	})
+}
+
+// UpdateBalanceSequence sets the "balance_sequence" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateBalanceSequence() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateBalanceSequence()
+	})
+}
+
+// ClearBalanceSequence clears the value of the "balance_sequence" field.
+func (u *TransactionUpsertBulk) ClearBalanceSequence() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearBalanceSequence()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TransactionUpsertBulk) SetDescription(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateDescription() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TransactionUpsertBulk) ClearDescription() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *TransactionUpsertBulk) SetCategory(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateCategory() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *TransactionUpsertBulk) ClearCategory() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearCategory()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *TransactionUpsertBulk) SetMetadata(v map[string]interface{}) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateMetadata() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *TransactionUpsertBulk) ClearMetadata() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (u *TransactionUpsertBulk) SetEffectiveAt(v time.Time) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetEffectiveAt(v)
+	})
+}
+
+// UpdateEffectiveAt sets the "effective_at" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateEffectiveAt() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateEffectiveAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
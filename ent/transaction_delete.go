@@ -3,9 +3,9 @@
 package ent
 
 import (
-	"context"
 	"accounting/ent/predicate"
 	"accounting/ent/transaction"
+	"context"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
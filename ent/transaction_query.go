@@ -3,14 +3,18 @@
 package ent
 
 import (
-	"context"
+	"accounting/ent/exchangerate"
+	"accounting/ent/journalentry"
 	"accounting/ent/predicate"
 	"accounting/ent/transaction"
 	"accounting/ent/user"
+	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -19,11 +23,18 @@ import (
 // TransactionQuery is the builder for querying Transaction entities.
 type TransactionQuery struct {
 	config
-	ctx        *QueryContext
-	order      []transaction.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Transaction
-	withUser   *UserQuery
+	ctx              *QueryContext
+	order            []transaction.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Transaction
+	withUser         *UserQuery
+	withJournalEntry *JournalEntryQuery
+	withReversalOf   *TransactionQuery
+	withReversals    *TransactionQuery
+	withFeeOf        *TransactionQuery
+	withFees         *TransactionQuery
+	withExchangeRate *ExchangeRateQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +93,138 @@ func (tq *TransactionQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryJournalEntry chains the current query on the "journal_entry" edge.
+func (tq *TransactionQuery) QueryJournalEntry() *JournalEntryQuery {
+	query := (&JournalEntryClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(journalentry.Table, journalentry.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.JournalEntryTable, transaction.JournalEntryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReversalOf chains the current query on the "reversal_of" edge.
+func (tq *TransactionQuery) QueryReversalOf() *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.ReversalOfTable, transaction.ReversalOfColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReversals chains the current query on the "reversals" edge.
+func (tq *TransactionQuery) QueryReversals() *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, transaction.ReversalsTable, transaction.ReversalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFeeOf chains the current query on the "fee_of" edge.
+func (tq *TransactionQuery) QueryFeeOf() *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.FeeOfTable, transaction.FeeOfColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFees chains the current query on the "fees" edge.
+func (tq *TransactionQuery) QueryFees() *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, transaction.FeesTable, transaction.FeesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryExchangeRate chains the current query on the "exchange_rate" edge.
+func (tq *TransactionQuery) QueryExchangeRate() *ExchangeRateQuery {
+	query := (&ExchangeRateClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(exchangerate.Table, exchangerate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.ExchangeRateTable, transaction.ExchangeRateColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Transaction entity from the query.
 // Returns a *NotFoundError when no Transaction was found.
 func (tq *TransactionQuery) First(ctx context.Context) (*Transaction, error) {
@@ -269,12 +412,18 @@ func (tq *TransactionQuery) Clone() *TransactionQuery {
 		return nil
 	}
 	return &TransactionQuery{
-		config:     tq.config,
-		ctx:        tq.ctx.Clone(),
-		order:      append([]transaction.OrderOption{}, tq.order...),
-		inters:     append([]Interceptor{}, tq.inters...),
-		predicates: append([]predicate.Transaction{}, tq.predicates...),
-		withUser:   tq.withUser.Clone(),
+		config:           tq.config,
+		ctx:              tq.ctx.Clone(),
+		order:            append([]transaction.OrderOption{}, tq.order...),
+		inters:           append([]Interceptor{}, tq.inters...),
+		predicates:       append([]predicate.Transaction{}, tq.predicates...),
+		withUser:         tq.withUser.Clone(),
+		withJournalEntry: tq.withJournalEntry.Clone(),
+		withReversalOf:   tq.withReversalOf.Clone(),
+		withReversals:    tq.withReversals.Clone(),
+		withFeeOf:        tq.withFeeOf.Clone(),
+		withFees:         tq.withFees.Clone(),
+		withExchangeRate: tq.withExchangeRate.Clone(),
 		// clone intermediate query.
 		sql:  tq.sql.Clone(),
 		path: tq.path,
@@ -292,6 +441,72 @@ func (tq *TransactionQuery) WithUser(opts ...func(*UserQuery)) *TransactionQuery
 	return tq
 }
 
+// WithJournalEntry tells the query-builder to eager-load the nodes that are connected to
+// the "journal_entry" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithJournalEntry(opts ...func(*JournalEntryQuery)) *TransactionQuery {
+	query := (&JournalEntryClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withJournalEntry = query
+	return tq
+}
+
+// WithReversalOf tells the query-builder to eager-load the nodes that are connected to
+// the "reversal_of" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithReversalOf(opts ...func(*TransactionQuery)) *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withReversalOf = query
+	return tq
+}
+
+// WithReversals tells the query-builder to eager-load the nodes that are connected to
+// the "reversals" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithReversals(opts ...func(*TransactionQuery)) *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withReversals = query
+	return tq
+}
+
+// WithFeeOf tells the query-builder to eager-load the nodes that are connected to
+// the "fee_of" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithFeeOf(opts ...func(*TransactionQuery)) *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withFeeOf = query
+	return tq
+}
+
+// WithFees tells the query-builder to eager-load the nodes that are connected to
+// the "fees" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithFees(opts ...func(*TransactionQuery)) *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withFees = query
+	return tq
+}
+
+// WithExchangeRate tells the query-builder to eager-load the nodes that are connected to
+// the "exchange_rate" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithExchangeRate(opts ...func(*ExchangeRateQuery)) *TransactionQuery {
+	query := (&ExchangeRateClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withExchangeRate = query
+	return tq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +585,14 @@ func (tq *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*Transaction{}
 		_spec       = tq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [7]bool{
 			tq.withUser != nil,
+			tq.withJournalEntry != nil,
+			tq.withReversalOf != nil,
+			tq.withReversals != nil,
+			tq.withFeeOf != nil,
+			tq.withFees != nil,
+			tq.withExchangeRate != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -383,6 +604,9 @@ func (tq *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(tq.modifiers) > 0 {
+		_spec.Modifiers = tq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -398,6 +622,44 @@ func (tq *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := tq.withJournalEntry; query != nil {
+		if err := tq.loadJournalEntry(ctx, query, nodes, nil,
+			func(n *Transaction, e *JournalEntry) { n.Edges.JournalEntry = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withReversalOf; query != nil {
+		if err := tq.loadReversalOf(ctx, query, nodes, nil,
+			func(n *Transaction, e *Transaction) { n.Edges.ReversalOf = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withReversals; query != nil {
+		if err := tq.loadReversals(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.Reversals = []*Transaction{} },
+			func(n *Transaction, e *Transaction) { n.Edges.Reversals = append(n.Edges.Reversals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withFeeOf; query != nil {
+		if err := tq.loadFeeOf(ctx, query, nodes, nil,
+			func(n *Transaction, e *Transaction) { n.Edges.FeeOf = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withFees; query != nil {
+		if err := tq.loadFees(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.Fees = []*Transaction{} },
+			func(n *Transaction, e *Transaction) { n.Edges.Fees = append(n.Edges.Fees, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withExchangeRate; query != nil {
+		if err := tq.loadExchangeRate(ctx, query, nodes, nil,
+			func(n *Transaction, e *ExchangeRate) { n.Edges.ExchangeRate = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -430,9 +692,206 @@ func (tq *TransactionQuery) loadUser(ctx context.Context, query *UserQuery, node
 	}
 	return nil
 }
+func (tq *TransactionQuery) loadJournalEntry(ctx context.Context, query *JournalEntryQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *JournalEntry)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Transaction)
+	for i := range nodes {
+		if nodes[i].JournalEntryID == nil {
+			continue
+		}
+		fk := *nodes[i].JournalEntryID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(journalentry.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "journal_entry_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadReversalOf(ctx context.Context, query *TransactionQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Transaction)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Transaction)
+	for i := range nodes {
+		if nodes[i].ReversalOfID == nil {
+			continue
+		}
+		fk := *nodes[i].ReversalOfID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transaction.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "reversal_of_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadReversals(ctx context.Context, query *TransactionQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Transaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(transaction.FieldReversalOfID)
+	}
+	query.Where(predicate.Transaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.ReversalsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ReversalOfID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "reversal_of_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "reversal_of_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadFeeOf(ctx context.Context, query *TransactionQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Transaction)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Transaction)
+	for i := range nodes {
+		if nodes[i].FeeOfID == nil {
+			continue
+		}
+		fk := *nodes[i].FeeOfID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transaction.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "fee_of_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadFees(ctx context.Context, query *TransactionQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Transaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(transaction.FieldFeeOfID)
+	}
+	query.Where(predicate.Transaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.FeesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FeeOfID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "fee_of_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "fee_of_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadExchangeRate(ctx context.Context, query *ExchangeRateQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *ExchangeRate)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Transaction)
+	for i := range nodes {
+		if nodes[i].ExchangeRateID == nil {
+			continue
+		}
+		fk := *nodes[i].ExchangeRateID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(exchangerate.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "exchange_rate_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (tq *TransactionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
+	if len(tq.modifiers) > 0 {
+		_spec.Modifiers = tq.modifiers
+	}
 	_spec.Node.Columns = tq.ctx.Fields
 	if len(tq.ctx.Fields) > 0 {
 		_spec.Unique = tq.ctx.Unique != nil && *tq.ctx.Unique
@@ -459,6 +918,18 @@ func (tq *TransactionQuery) querySpec() *sqlgraph.QuerySpec {
 		if tq.withUser != nil {
 			_spec.Node.AddColumnOnce(transaction.FieldUserID)
 		}
+		if tq.withJournalEntry != nil {
+			_spec.Node.AddColumnOnce(transaction.FieldJournalEntryID)
+		}
+		if tq.withReversalOf != nil {
+			_spec.Node.AddColumnOnce(transaction.FieldReversalOfID)
+		}
+		if tq.withFeeOf != nil {
+			_spec.Node.AddColumnOnce(transaction.FieldFeeOfID)
+		}
+		if tq.withExchangeRate != nil {
+			_spec.Node.AddColumnOnce(transaction.FieldExchangeRateID)
+		}
 	}
 	if ps := tq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -498,6 +969,9 @@ func (tq *TransactionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if tq.ctx.Unique != nil && *tq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range tq.modifiers {
+		m(selector)
+	}
 	for _, p := range tq.predicates {
 		p(selector)
 	}
@@ -515,6 +989,32 @@ func (tq *TransactionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tq *TransactionQuery) ForUpdate(opts ...sql.LockOption) *TransactionQuery {
+	if tq.driver.Dialect() == dialect.Postgres {
+		tq.Unique(false)
+	}
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tq *TransactionQuery) ForShare(opts ...sql.LockOption) *TransactionQuery {
+	if tq.driver.Dialect() == dialect.Postgres {
+		tq.Unique(false)
+	}
+	tq.modifiers = append(tq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tq
+}
+
 // TransactionGroupBy is the group-by builder for Transaction entities.
 type TransactionGroupBy struct {
 	selector
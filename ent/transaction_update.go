@@ -3,16 +3,19 @@
 package ent
 
 import (
-	"context"
+	"accounting/ent/journalentry"
 	"accounting/ent/predicate"
 	"accounting/ent/transaction"
 	"accounting/ent/user"
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
 )
 
 // TransactionUpdate is the builder for updating Transaction entities.
@@ -43,23 +46,23 @@ func (tu *TransactionUpdate) SetNillableUserID(i *int) *TransactionUpdate {
 }
 
 // SetAmount sets the "amount" field.
-func (tu *TransactionUpdate) SetAmount(f float64) *TransactionUpdate {
+func (tu *TransactionUpdate) SetAmount(d decimal.Decimal) *TransactionUpdate {
 	tu.mutation.ResetAmount()
-	tu.mutation.SetAmount(f)
+	tu.mutation.SetAmount(d)
 	return tu
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (tu *TransactionUpdate) SetNillableAmount(f *float64) *TransactionUpdate {
-	if f != nil {
-		tu.SetAmount(*f)
+func (tu *TransactionUpdate) SetNillableAmount(d *decimal.Decimal) *TransactionUpdate {
+	if d != nil {
+		tu.SetAmount(*d)
 	}
 	return tu
 }
 
-// AddAmount adds f to the "amount" field.
-func (tu *TransactionUpdate) AddAmount(f float64) *TransactionUpdate {
-	tu.mutation.AddAmount(f)
+// AddAmount adds d to the "amount" field.
+func (tu *TransactionUpdate) AddAmount(d decimal.Decimal) *TransactionUpdate {
+	tu.mutation.AddAmount(d)
 	return tu
 }
 
@@ -91,11 +94,261 @@ func (tu *TransactionUpdate) SetNillableType(t *transaction.Type) *TransactionUp
 	return tu
 }
 
+// SetStatus sets the "status" field.
+func (tu *TransactionUpdate) SetStatus(t transaction.Status) *TransactionUpdate {
+	tu.mutation.SetStatus(t)
+	return tu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableStatus(t *transaction.Status) *TransactionUpdate {
+	if t != nil {
+		tu.SetStatus(*t)
+	}
+	return tu
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (tu *TransactionUpdate) SetFailureReason(s string) *TransactionUpdate {
+	tu.mutation.SetFailureReason(s)
+	return tu
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableFailureReason(s *string) *TransactionUpdate {
+	if s != nil {
+		tu.SetFailureReason(*s)
+	}
+	return tu
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (tu *TransactionUpdate) ClearFailureReason() *TransactionUpdate {
+	tu.mutation.ClearFailureReason()
+	return tu
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (tu *TransactionUpdate) SetPostedAt(t time.Time) *TransactionUpdate {
+	tu.mutation.SetPostedAt(t)
+	return tu
+}
+
+// SetNillablePostedAt sets the "posted_at" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillablePostedAt(t *time.Time) *TransactionUpdate {
+	if t != nil {
+		tu.SetPostedAt(*t)
+	}
+	return tu
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (tu *TransactionUpdate) ClearPostedAt() *TransactionUpdate {
+	tu.mutation.ClearPostedAt()
+	return tu
+}
+
+// SetReversedAmount sets the "reversed_amount" field.
+func (tu *TransactionUpdate) SetReversedAmount(d decimal.Decimal) *TransactionUpdate {
+	tu.mutation.ResetReversedAmount()
+	tu.mutation.SetReversedAmount(d)
+	return tu
+}
+
+// SetNillableReversedAmount sets the "reversed_amount" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableReversedAmount(d *decimal.Decimal) *TransactionUpdate {
+	if d != nil {
+		tu.SetReversedAmount(*d)
+	}
+	return tu
+}
+
+// AddReversedAmount adds d to the "reversed_amount" field.
+func (tu *TransactionUpdate) AddReversedAmount(d decimal.Decimal) *TransactionUpdate {
+	tu.mutation.AddReversedAmount(d)
+	return tu
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (tu *TransactionUpdate) SetJournalEntryID(i int) *TransactionUpdate {
+	tu.mutation.SetJournalEntryID(i)
+	return tu
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableJournalEntryID(i *int) *TransactionUpdate {
+	if i != nil {
+		tu.SetJournalEntryID(*i)
+	}
+	return tu
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (tu *TransactionUpdate) ClearJournalEntryID() *TransactionUpdate {
+	tu.mutation.ClearJournalEntryID()
+	return tu
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (tu *TransactionUpdate) SetBalanceAfter(d decimal.Decimal) *TransactionUpdate {
+	tu.mutation.ResetBalanceAfter()
+	tu.mutation.SetBalanceAfter(d)
+	return tu
+}
+
+// SetNillableBalanceAfter sets the "balance_after" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableBalanceAfter(d *decimal.Decimal) *TransactionUpdate {
+	if d != nil {
+		tu.SetBalanceAfter(*d)
+	}
+	return tu
+}
+
+// AddBalanceAfter adds d to the "balance_after" field.
+func (tu *TransactionUpdate) AddBalanceAfter(d decimal.Decimal) *TransactionUpdate {
+	tu.mutation.AddBalanceAfter(d)
+	return tu
+}
+
+// ClearBalanceAfter clears the value of the "balance_after" field.
+func (tu *TransactionUpdate) ClearBalanceAfter() *TransactionUpdate {
+	tu.mutation.ClearBalanceAfter()
+	return tu
+}
+
+// SetBalanceSequence sets the "balance_sequence" field.
+func (tu *TransactionUpdate) SetBalanceSequence(i int64) *TransactionUpdate {
+	tu.mutation.ResetBalanceSequence()
+	tu.mutation.SetBalanceSequence(i)
+	return tu
+}
+
+// SetNillableBalanceSequence sets the "balance_sequence" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableBalanceSequence(i *int64) *TransactionUpdate {
+	if i != nil {
+		tu.SetBalanceSequence(*i)
+	}
+	return tu
+}
+
+// AddBalanceSequence adds i to the "balance_sequence" field.
+func (tu *TransactionUpdate) AddBalanceSequence(i int64) *TransactionUpdate {
+	tu.mutation.AddBalanceSequence(i)
+	return tu
+}
+
+// ClearBalanceSequence clears the value of the "balance_sequence" field.
+func (tu *TransactionUpdate) ClearBalanceSequence() *TransactionUpdate {
+	tu.mutation.ClearBalanceSequence()
+	return tu
+}
+
+// SetDescription sets the "description" field.
+func (tu *TransactionUpdate) SetDescription(s string) *TransactionUpdate {
+	tu.mutation.SetDescription(s)
+	return tu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableDescription(s *string) *TransactionUpdate {
+	if s != nil {
+		tu.SetDescription(*s)
+	}
+	return tu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (tu *TransactionUpdate) ClearDescription() *TransactionUpdate {
+	tu.mutation.ClearDescription()
+	return tu
+}
+
+// SetCategory sets the "category" field.
+func (tu *TransactionUpdate) SetCategory(s string) *TransactionUpdate {
+	tu.mutation.SetCategory(s)
+	return tu
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableCategory(s *string) *TransactionUpdate {
+	if s != nil {
+		tu.SetCategory(*s)
+	}
+	return tu
+}
+
+// ClearCategory clears the value of the "category" field.
+func (tu *TransactionUpdate) ClearCategory() *TransactionUpdate {
+	tu.mutation.ClearCategory()
+	return tu
+}
+
+// SetMetadata sets the "metadata" field.
+func (tu *TransactionUpdate) SetMetadata(m map[string]interface{}) *TransactionUpdate {
+	tu.mutation.SetMetadata(m)
+	return tu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (tu *TransactionUpdate) ClearMetadata() *TransactionUpdate {
+	tu.mutation.ClearMetadata()
+	return tu
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (tu *TransactionUpdate) SetEffectiveAt(t time.Time) *TransactionUpdate {
+	tu.mutation.SetEffectiveAt(t)
+	return tu
+}
+
+// SetNillableEffectiveAt sets the "effective_at" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableEffectiveAt(t *time.Time) *TransactionUpdate {
+	if t != nil {
+		tu.SetEffectiveAt(*t)
+	}
+	return tu
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (tu *TransactionUpdate) SetUser(u *User) *TransactionUpdate {
 	return tu.SetUserID(u.ID)
 }
 
+// SetJournalEntry sets the "journal_entry" edge to the JournalEntry entity.
+func (tu *TransactionUpdate) SetJournalEntry(j *JournalEntry) *TransactionUpdate {
+	return tu.SetJournalEntryID(j.ID)
+}
+
+// AddReversalIDs adds the "reversals" edge to the Transaction entity by IDs.
+func (tu *TransactionUpdate) AddReversalIDs(ids ...string) *TransactionUpdate {
+	tu.mutation.AddReversalIDs(ids...)
+	return tu
+}
+
+// AddReversals adds the "reversals" edges to the Transaction entity.
+func (tu *TransactionUpdate) AddReversals(t ...*Transaction) *TransactionUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddReversalIDs(ids...)
+}
+
+// AddFeeIDs adds the "fees" edge to the Transaction entity by IDs.
+func (tu *TransactionUpdate) AddFeeIDs(ids ...string) *TransactionUpdate {
+	tu.mutation.AddFeeIDs(ids...)
+	return tu
+}
+
+// AddFees adds the "fees" edges to the Transaction entity.
+func (tu *TransactionUpdate) AddFees(t ...*Transaction) *TransactionUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddFeeIDs(ids...)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (tu *TransactionUpdate) Mutation() *TransactionMutation {
 	return tu.mutation
@@ -107,6 +360,54 @@ func (tu *TransactionUpdate) ClearUser() *TransactionUpdate {
 	return tu
 }
 
+// ClearJournalEntry clears the "journal_entry" edge to the JournalEntry entity.
+func (tu *TransactionUpdate) ClearJournalEntry() *TransactionUpdate {
+	tu.mutation.ClearJournalEntry()
+	return tu
+}
+
+// ClearReversals clears all "reversals" edges to the Transaction entity.
+func (tu *TransactionUpdate) ClearReversals() *TransactionUpdate {
+	tu.mutation.ClearReversals()
+	return tu
+}
+
+// RemoveReversalIDs removes the "reversals" edge to Transaction entities by IDs.
+func (tu *TransactionUpdate) RemoveReversalIDs(ids ...string) *TransactionUpdate {
+	tu.mutation.RemoveReversalIDs(ids...)
+	return tu
+}
+
+// RemoveReversals removes "reversals" edges to Transaction entities.
+func (tu *TransactionUpdate) RemoveReversals(t ...*Transaction) *TransactionUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveReversalIDs(ids...)
+}
+
+// ClearFees clears all "fees" edges to the Transaction entity.
+func (tu *TransactionUpdate) ClearFees() *TransactionUpdate {
+	tu.mutation.ClearFees()
+	return tu
+}
+
+// RemoveFeeIDs removes the "fees" edge to Transaction entities by IDs.
+func (tu *TransactionUpdate) RemoveFeeIDs(ids ...string) *TransactionUpdate {
+	tu.mutation.RemoveFeeIDs(ids...)
+	return tu
+}
+
+// RemoveFees removes "fees" edges to Transaction entities.
+func (tu *TransactionUpdate) RemoveFees(t ...*Transaction) *TransactionUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveFeeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TransactionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
@@ -141,6 +442,21 @@ func (tu *TransactionUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Transaction.type": %w`, err)}
 		}
 	}
+	if v, ok := tu.mutation.Status(); ok {
+		if err := transaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Transaction.status": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Description(); ok {
+		if err := transaction.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Transaction.description": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Category(); ok {
+		if err := transaction.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Transaction.category": %w`, err)}
+		}
+	}
 	if tu.mutation.UserCleared() && len(tu.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Transaction.user"`)
 	}
@@ -171,6 +487,81 @@ func (tu *TransactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.GetType(); ok {
 		_spec.SetField(transaction.FieldType, field.TypeEnum, value)
 	}
+	if value, ok := tu.mutation.Status(); ok {
+		_spec.SetField(transaction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tu.mutation.FailureReason(); ok {
+		_spec.SetField(transaction.FieldFailureReason, field.TypeString, value)
+	}
+	if tu.mutation.FailureReasonCleared() {
+		_spec.ClearField(transaction.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := tu.mutation.PostedAt(); ok {
+		_spec.SetField(transaction.FieldPostedAt, field.TypeTime, value)
+	}
+	if tu.mutation.PostedAtCleared() {
+		_spec.ClearField(transaction.FieldPostedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.ReversedAmount(); ok {
+		_spec.SetField(transaction.FieldReversedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.AddedReversedAmount(); ok {
+		_spec.AddField(transaction.FieldReversedAmount, field.TypeFloat64, value)
+	}
+	if tu.mutation.TransferIDCleared() {
+		_spec.ClearField(transaction.FieldTransferID, field.TypeString)
+	}
+	if tu.mutation.ExchangeIDCleared() {
+		_spec.ClearField(transaction.FieldExchangeID, field.TypeString)
+	}
+	if tu.mutation.AppliedRateCleared() {
+		_spec.ClearField(transaction.FieldAppliedRate, field.TypeFloat64)
+	}
+	if value, ok := tu.mutation.BalanceAfter(); ok {
+		_spec.SetField(transaction.FieldBalanceAfter, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.AddedBalanceAfter(); ok {
+		_spec.AddField(transaction.FieldBalanceAfter, field.TypeFloat64, value)
+	}
+	if tu.mutation.BalanceAfterCleared() {
+		_spec.ClearField(transaction.FieldBalanceAfter, field.TypeFloat64)
+	}
+	if value, ok := tu.mutation.BalanceSequence(); ok {
+		_spec.SetField(transaction.FieldBalanceSequence, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedBalanceSequence(); ok {
+		_spec.AddField(transaction.FieldBalanceSequence, field.TypeInt64, value)
+	}
+	if tu.mutation.BalanceSequenceCleared() {
+		_spec.ClearField(transaction.FieldBalanceSequence, field.TypeInt64)
+	}
+	if value, ok := tu.mutation.Description(); ok {
+		_spec.SetField(transaction.FieldDescription, field.TypeString, value)
+	}
+	if tu.mutation.DescriptionCleared() {
+		_spec.ClearField(transaction.FieldDescription, field.TypeString)
+	}
+	if value, ok := tu.mutation.Category(); ok {
+		_spec.SetField(transaction.FieldCategory, field.TypeString, value)
+	}
+	if tu.mutation.CategoryCleared() {
+		_spec.ClearField(transaction.FieldCategory, field.TypeString)
+	}
+	if tu.mutation.SourceCleared() {
+		_spec.ClearField(transaction.FieldSource, field.TypeString)
+	}
+	if tu.mutation.ExternalRefCleared() {
+		_spec.ClearField(transaction.FieldExternalRef, field.TypeString)
+	}
+	if value, ok := tu.mutation.Metadata(); ok {
+		_spec.SetField(transaction.FieldMetadata, field.TypeJSON, value)
+	}
+	if tu.mutation.MetadataCleared() {
+		_spec.ClearField(transaction.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.EffectiveAt(); ok {
+		_spec.SetField(transaction.FieldEffectiveAt, field.TypeTime, value)
+	}
 	if tu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -200,6 +591,125 @@ func (tu *TransactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.JournalEntryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.JournalEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ReversalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalsTable,
+			Columns: []string{transaction.ReversalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedReversalsIDs(); len(nodes) > 0 && !tu.mutation.ReversalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalsTable,
+			Columns: []string{transaction.ReversalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ReversalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalsTable,
+			Columns: []string{transaction.ReversalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.FeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.FeesTable,
+			Columns: []string{transaction.FeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedFeesIDs(); len(nodes) > 0 && !tu.mutation.FeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.FeesTable,
+			Columns: []string{transaction.FeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.FeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.FeesTable,
+			Columns: []string{transaction.FeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{transaction.Label}
@@ -235,23 +745,23 @@ func (tuo *TransactionUpdateOne) SetNillableUserID(i *int) *TransactionUpdateOne
 }
 
 // SetAmount sets the "amount" field.
-func (tuo *TransactionUpdateOne) SetAmount(f float64) *TransactionUpdateOne {
+func (tuo *TransactionUpdateOne) SetAmount(d decimal.Decimal) *TransactionUpdateOne {
 	tuo.mutation.ResetAmount()
-	tuo.mutation.SetAmount(f)
+	tuo.mutation.SetAmount(d)
 	return tuo
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (tuo *TransactionUpdateOne) SetNillableAmount(f *float64) *TransactionUpdateOne {
-	if f != nil {
-		tuo.SetAmount(*f)
+func (tuo *TransactionUpdateOne) SetNillableAmount(d *decimal.Decimal) *TransactionUpdateOne {
+	if d != nil {
+		tuo.SetAmount(*d)
 	}
 	return tuo
 }
 
-// AddAmount adds f to the "amount" field.
-func (tuo *TransactionUpdateOne) AddAmount(f float64) *TransactionUpdateOne {
-	tuo.mutation.AddAmount(f)
+// AddAmount adds d to the "amount" field.
+func (tuo *TransactionUpdateOne) AddAmount(d decimal.Decimal) *TransactionUpdateOne {
+	tuo.mutation.AddAmount(d)
 	return tuo
 }
 
@@ -283,11 +793,261 @@ func (tuo *TransactionUpdateOne) SetNillableType(t *transaction.Type) *Transacti
 	return tuo
 }
 
+// SetStatus sets the "status" field.
+func (tuo *TransactionUpdateOne) SetStatus(t transaction.Status) *TransactionUpdateOne {
+	tuo.mutation.SetStatus(t)
+	return tuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableStatus(t *transaction.Status) *TransactionUpdateOne {
+	if t != nil {
+		tuo.SetStatus(*t)
+	}
+	return tuo
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (tuo *TransactionUpdateOne) SetFailureReason(s string) *TransactionUpdateOne {
+	tuo.mutation.SetFailureReason(s)
+	return tuo
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableFailureReason(s *string) *TransactionUpdateOne {
+	if s != nil {
+		tuo.SetFailureReason(*s)
+	}
+	return tuo
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (tuo *TransactionUpdateOne) ClearFailureReason() *TransactionUpdateOne {
+	tuo.mutation.ClearFailureReason()
+	return tuo
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (tuo *TransactionUpdateOne) SetPostedAt(t time.Time) *TransactionUpdateOne {
+	tuo.mutation.SetPostedAt(t)
+	return tuo
+}
+
+// SetNillablePostedAt sets the "posted_at" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillablePostedAt(t *time.Time) *TransactionUpdateOne {
+	if t != nil {
+		tuo.SetPostedAt(*t)
+	}
+	return tuo
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (tuo *TransactionUpdateOne) ClearPostedAt() *TransactionUpdateOne {
+	tuo.mutation.ClearPostedAt()
+	return tuo
+}
+
+// SetReversedAmount sets the "reversed_amount" field.
+func (tuo *TransactionUpdateOne) SetReversedAmount(d decimal.Decimal) *TransactionUpdateOne {
+	tuo.mutation.ResetReversedAmount()
+	tuo.mutation.SetReversedAmount(d)
+	return tuo
+}
+
+// SetNillableReversedAmount sets the "reversed_amount" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableReversedAmount(d *decimal.Decimal) *TransactionUpdateOne {
+	if d != nil {
+		tuo.SetReversedAmount(*d)
+	}
+	return tuo
+}
+
+// AddReversedAmount adds d to the "reversed_amount" field.
+func (tuo *TransactionUpdateOne) AddReversedAmount(d decimal.Decimal) *TransactionUpdateOne {
+	tuo.mutation.AddReversedAmount(d)
+	return tuo
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (tuo *TransactionUpdateOne) SetJournalEntryID(i int) *TransactionUpdateOne {
+	tuo.mutation.SetJournalEntryID(i)
+	return tuo
+}
+
+// SetNillableJournalEntryID sets the "journal_entry_id" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableJournalEntryID(i *int) *TransactionUpdateOne {
+	if i != nil {
+		tuo.SetJournalEntryID(*i)
+	}
+	return tuo
+}
+
+// ClearJournalEntryID clears the value of the "journal_entry_id" field.
+func (tuo *TransactionUpdateOne) ClearJournalEntryID() *TransactionUpdateOne {
+	tuo.mutation.ClearJournalEntryID()
+	return tuo
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (tuo *TransactionUpdateOne) SetBalanceAfter(d decimal.Decimal) *TransactionUpdateOne {
+	tuo.mutation.ResetBalanceAfter()
+	tuo.mutation.SetBalanceAfter(d)
+	return tuo
+}
+
+// SetNillableBalanceAfter sets the "balance_after" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableBalanceAfter(d *decimal.Decimal) *TransactionUpdateOne {
+	if d != nil {
+		tuo.SetBalanceAfter(*d)
+	}
+	return tuo
+}
+
+// AddBalanceAfter adds d to the "balance_after" field.
+func (tuo *TransactionUpdateOne) AddBalanceAfter(d decimal.Decimal) *TransactionUpdateOne {
+	tuo.mutation.AddBalanceAfter(d)
+	return tuo
+}
+
+// ClearBalanceAfter clears the value of the "balance_after" field.
+func (tuo *TransactionUpdateOne) ClearBalanceAfter() *TransactionUpdateOne {
+	tuo.mutation.ClearBalanceAfter()
+	return tuo
+}
+
+// SetBalanceSequence sets the "balance_sequence" field.
+func (tuo *TransactionUpdateOne) SetBalanceSequence(i int64) *TransactionUpdateOne {
+	tuo.mutation.ResetBalanceSequence()
+	tuo.mutation.SetBalanceSequence(i)
+	return tuo
+}
+
+// SetNillableBalanceSequence sets the "balance_sequence" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableBalanceSequence(i *int64) *TransactionUpdateOne {
+	if i != nil {
+		tuo.SetBalanceSequence(*i)
+	}
+	return tuo
+}
+
+// AddBalanceSequence adds i to the "balance_sequence" field.
+func (tuo *TransactionUpdateOne) AddBalanceSequence(i int64) *TransactionUpdateOne {
+	tuo.mutation.AddBalanceSequence(i)
+	return tuo
+}
+
+// ClearBalanceSequence clears the value of the "balance_sequence" field.
+func (tuo *TransactionUpdateOne) ClearBalanceSequence() *TransactionUpdateOne {
+	tuo.mutation.ClearBalanceSequence()
+	return tuo
+}
+
+// SetDescription sets the "description" field.
+func (tuo *TransactionUpdateOne) SetDescription(s string) *TransactionUpdateOne {
+	tuo.mutation.SetDescription(s)
+	return tuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableDescription(s *string) *TransactionUpdateOne {
+	if s != nil {
+		tuo.SetDescription(*s)
+	}
+	return tuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (tuo *TransactionUpdateOne) ClearDescription() *TransactionUpdateOne {
+	tuo.mutation.ClearDescription()
+	return tuo
+}
+
+// SetCategory sets the "category" field.
+func (tuo *TransactionUpdateOne) SetCategory(s string) *TransactionUpdateOne {
+	tuo.mutation.SetCategory(s)
+	return tuo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableCategory(s *string) *TransactionUpdateOne {
+	if s != nil {
+		tuo.SetCategory(*s)
+	}
+	return tuo
+}
+
+// ClearCategory clears the value of the "category" field.
+func (tuo *TransactionUpdateOne) ClearCategory() *TransactionUpdateOne {
+	tuo.mutation.ClearCategory()
+	return tuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (tuo *TransactionUpdateOne) SetMetadata(m map[string]interface{}) *TransactionUpdateOne {
+	tuo.mutation.SetMetadata(m)
+	return tuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (tuo *TransactionUpdateOne) ClearMetadata() *TransactionUpdateOne {
+	tuo.mutation.ClearMetadata()
+	return tuo
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (tuo *TransactionUpdateOne) SetEffectiveAt(t time.Time) *TransactionUpdateOne {
+	tuo.mutation.SetEffectiveAt(t)
+	return tuo
+}
+
+// SetNillableEffectiveAt sets the "effective_at" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableEffectiveAt(t *time.Time) *TransactionUpdateOne {
+	if t != nil {
+		tuo.SetEffectiveAt(*t)
+	}
+	return tuo
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (tuo *TransactionUpdateOne) SetUser(u *User) *TransactionUpdateOne {
 	return tuo.SetUserID(u.ID)
 }
 
+// SetJournalEntry sets the "journal_entry" edge to the JournalEntry entity.
+func (tuo *TransactionUpdateOne) SetJournalEntry(j *JournalEntry) *TransactionUpdateOne {
+	return tuo.SetJournalEntryID(j.ID)
+}
+
+// AddReversalIDs adds the "reversals" edge to the Transaction entity by IDs.
+func (tuo *TransactionUpdateOne) AddReversalIDs(ids ...string) *TransactionUpdateOne {
+	tuo.mutation.AddReversalIDs(ids...)
+	return tuo
+}
+
+// AddReversals adds the "reversals" edges to the Transaction entity.
+func (tuo *TransactionUpdateOne) AddReversals(t ...*Transaction) *TransactionUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddReversalIDs(ids...)
+}
+
+// AddFeeIDs adds the "fees" edge to the Transaction entity by IDs.
+func (tuo *TransactionUpdateOne) AddFeeIDs(ids ...string) *TransactionUpdateOne {
+	tuo.mutation.AddFeeIDs(ids...)
+	return tuo
+}
+
+// AddFees adds the "fees" edges to the Transaction entity.
+func (tuo *TransactionUpdateOne) AddFees(t ...*Transaction) *TransactionUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddFeeIDs(ids...)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (tuo *TransactionUpdateOne) Mutation() *TransactionMutation {
 	return tuo.mutation
@@ -299,6 +1059,54 @@ func (tuo *TransactionUpdateOne) ClearUser() *TransactionUpdateOne {
 	return tuo
 }
 
+// ClearJournalEntry clears the "journal_entry" edge to the JournalEntry entity.
+func (tuo *TransactionUpdateOne) ClearJournalEntry() *TransactionUpdateOne {
+	tuo.mutation.ClearJournalEntry()
+	return tuo
+}
+
+// ClearReversals clears all "reversals" edges to the Transaction entity.
+func (tuo *TransactionUpdateOne) ClearReversals() *TransactionUpdateOne {
+	tuo.mutation.ClearReversals()
+	return tuo
+}
+
+// RemoveReversalIDs removes the "reversals" edge to Transaction entities by IDs.
+func (tuo *TransactionUpdateOne) RemoveReversalIDs(ids ...string) *TransactionUpdateOne {
+	tuo.mutation.RemoveReversalIDs(ids...)
+	return tuo
+}
+
+// RemoveReversals removes "reversals" edges to Transaction entities.
+func (tuo *TransactionUpdateOne) RemoveReversals(t ...*Transaction) *TransactionUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveReversalIDs(ids...)
+}
+
+// ClearFees clears all "fees" edges to the Transaction entity.
+func (tuo *TransactionUpdateOne) ClearFees() *TransactionUpdateOne {
+	tuo.mutation.ClearFees()
+	return tuo
+}
+
+// RemoveFeeIDs removes the "fees" edge to Transaction entities by IDs.
+func (tuo *TransactionUpdateOne) RemoveFeeIDs(ids ...string) *TransactionUpdateOne {
+	tuo.mutation.RemoveFeeIDs(ids...)
+	return tuo
+}
+
+// RemoveFees removes "fees" edges to Transaction entities.
+func (tuo *TransactionUpdateOne) RemoveFees(t ...*Transaction) *TransactionUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveFeeIDs(ids...)
+}
+
 // Where appends a list predicates to the TransactionUpdate builder.
 func (tuo *TransactionUpdateOne) Where(ps ...predicate.Transaction) *TransactionUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -346,6 +1154,21 @@ func (tuo *TransactionUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Transaction.type": %w`, err)}
 		}
 	}
+	if v, ok := tuo.mutation.Status(); ok {
+		if err := transaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Transaction.status": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Description(); ok {
+		if err := transaction.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Transaction.description": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Category(); ok {
+		if err := transaction.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Transaction.category": %w`, err)}
+		}
+	}
 	if tuo.mutation.UserCleared() && len(tuo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Transaction.user"`)
 	}
@@ -393,6 +1216,81 @@ func (tuo *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transactio
 	if value, ok := tuo.mutation.GetType(); ok {
 		_spec.SetField(transaction.FieldType, field.TypeEnum, value)
 	}
+	if value, ok := tuo.mutation.Status(); ok {
+		_spec.SetField(transaction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tuo.mutation.FailureReason(); ok {
+		_spec.SetField(transaction.FieldFailureReason, field.TypeString, value)
+	}
+	if tuo.mutation.FailureReasonCleared() {
+		_spec.ClearField(transaction.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := tuo.mutation.PostedAt(); ok {
+		_spec.SetField(transaction.FieldPostedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.PostedAtCleared() {
+		_spec.ClearField(transaction.FieldPostedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.ReversedAmount(); ok {
+		_spec.SetField(transaction.FieldReversedAmount, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.AddedReversedAmount(); ok {
+		_spec.AddField(transaction.FieldReversedAmount, field.TypeFloat64, value)
+	}
+	if tuo.mutation.TransferIDCleared() {
+		_spec.ClearField(transaction.FieldTransferID, field.TypeString)
+	}
+	if tuo.mutation.ExchangeIDCleared() {
+		_spec.ClearField(transaction.FieldExchangeID, field.TypeString)
+	}
+	if tuo.mutation.AppliedRateCleared() {
+		_spec.ClearField(transaction.FieldAppliedRate, field.TypeFloat64)
+	}
+	if value, ok := tuo.mutation.BalanceAfter(); ok {
+		_spec.SetField(transaction.FieldBalanceAfter, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.AddedBalanceAfter(); ok {
+		_spec.AddField(transaction.FieldBalanceAfter, field.TypeFloat64, value)
+	}
+	if tuo.mutation.BalanceAfterCleared() {
+		_spec.ClearField(transaction.FieldBalanceAfter, field.TypeFloat64)
+	}
+	if value, ok := tuo.mutation.BalanceSequence(); ok {
+		_spec.SetField(transaction.FieldBalanceSequence, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedBalanceSequence(); ok {
+		_spec.AddField(transaction.FieldBalanceSequence, field.TypeInt64, value)
+	}
+	if tuo.mutation.BalanceSequenceCleared() {
+		_spec.ClearField(transaction.FieldBalanceSequence, field.TypeInt64)
+	}
+	if value, ok := tuo.mutation.Description(); ok {
+		_spec.SetField(transaction.FieldDescription, field.TypeString, value)
+	}
+	if tuo.mutation.DescriptionCleared() {
+		_spec.ClearField(transaction.FieldDescription, field.TypeString)
+	}
+	if value, ok := tuo.mutation.Category(); ok {
+		_spec.SetField(transaction.FieldCategory, field.TypeString, value)
+	}
+	if tuo.mutation.CategoryCleared() {
+		_spec.ClearField(transaction.FieldCategory, field.TypeString)
+	}
+	if tuo.mutation.SourceCleared() {
+		_spec.ClearField(transaction.FieldSource, field.TypeString)
+	}
+	if tuo.mutation.ExternalRefCleared() {
+		_spec.ClearField(transaction.FieldExternalRef, field.TypeString)
+	}
+	if value, ok := tuo.mutation.Metadata(); ok {
+		_spec.SetField(transaction.FieldMetadata, field.TypeJSON, value)
+	}
+	if tuo.mutation.MetadataCleared() {
+		_spec.ClearField(transaction.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.EffectiveAt(); ok {
+		_spec.SetField(transaction.FieldEffectiveAt, field.TypeTime, value)
+	}
 	if tuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -422,6 +1320,125 @@ func (tuo *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transactio
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.JournalEntryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.JournalEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ReversalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalsTable,
+			Columns: []string{transaction.ReversalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedReversalsIDs(); len(nodes) > 0 && !tuo.mutation.ReversalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalsTable,
+			Columns: []string{transaction.ReversalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ReversalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalsTable,
+			Columns: []string{transaction.ReversalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.FeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.FeesTable,
+			Columns: []string{transaction.FeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedFeesIDs(); len(nodes) > 0 && !tuo.mutation.FeesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.FeesTable,
+			Columns: []string{transaction.FeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.FeesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.FeesTable,
+			Columns: []string{transaction.FeesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Transaction{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/account"
+	"accounting/ent/accountingperiod"
+	"accounting/ent/trialbalanceline"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// TrialBalanceLine is the model entity for the TrialBalanceLine schema.
+type TrialBalanceLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID of the accounting period, whose trial balance the line belongs to
+	PeriodID int `json:"period_id,omitempty"`
+	// ID of the account, whose balance is captured
+	AccountID int `json:"account_id,omitempty"`
+	// Currency of the account
+	Currency string `json:"currency,omitempty"`
+	// Sum of the postings to the account effective within the period
+	Movement decimal.Decimal `json:"movement,omitempty"`
+	// Sum of the postings to the account effective before the end of the period
+	Balance decimal.Decimal `json:"balance,omitempty"`
+	// Time of the line creation
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TrialBalanceLineQuery when eager-loading is set.
+	Edges        TrialBalanceLineEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TrialBalanceLineEdges holds the relations/edges for other nodes in the graph.
+type TrialBalanceLineEdges struct {
+	// Accounting period, whose trial balance the line belongs to
+	Period *AccountingPeriod `json:"period,omitempty"`
+	// Account, whose balance is captured
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PeriodOrErr returns the Period value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TrialBalanceLineEdges) PeriodOrErr() (*AccountingPeriod, error) {
+	if e.Period != nil {
+		return e.Period, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: accountingperiod.Label}
+	}
+	return nil, &NotLoadedError{edge: "period"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TrialBalanceLineEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TrialBalanceLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case trialbalanceline.FieldMovement, trialbalanceline.FieldBalance:
+			values[i] = new(decimal.Decimal)
+		case trialbalanceline.FieldID, trialbalanceline.FieldPeriodID, trialbalanceline.FieldAccountID:
+			values[i] = new(sql.NullInt64)
+		case trialbalanceline.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case trialbalanceline.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TrialBalanceLine fields.
+func (tbl *TrialBalanceLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case trialbalanceline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tbl.ID = int(value.Int64)
+		case trialbalanceline.FieldPeriodID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field period_id", values[i])
+			} else if value.Valid {
+				tbl.PeriodID = int(value.Int64)
+			}
+		case trialbalanceline.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				tbl.AccountID = int(value.Int64)
+			}
+		case trialbalanceline.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				tbl.Currency = value.String
+			}
+		case trialbalanceline.FieldMovement:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field movement", values[i])
+			} else if value != nil {
+				tbl.Movement = *value
+			}
+		case trialbalanceline.FieldBalance:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value != nil {
+				tbl.Balance = *value
+			}
+		case trialbalanceline.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tbl.CreatedAt = value.Time
+			}
+		default:
+			tbl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TrialBalanceLine.
+// This includes values selected through modifiers, order, etc.
+func (tbl *TrialBalanceLine) Value(name string) (ent.Value, error) {
+	return tbl.selectValues.Get(name)
+}
+
+// QueryPeriod queries the "period" edge of the TrialBalanceLine entity.
+func (tbl *TrialBalanceLine) QueryPeriod() *AccountingPeriodQuery {
+	return NewTrialBalanceLineClient(tbl.config).QueryPeriod(tbl)
+}
+
+// QueryAccount queries the "account" edge of the TrialBalanceLine entity.
+func (tbl *TrialBalanceLine) QueryAccount() *AccountQuery {
+	return NewTrialBalanceLineClient(tbl.config).QueryAccount(tbl)
+}
+
+// Update returns a builder for updating this TrialBalanceLine.
+// Note that you need to call TrialBalanceLine.Unwrap() before calling this method if this TrialBalanceLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tbl *TrialBalanceLine) Update() *TrialBalanceLineUpdateOne {
+	return NewTrialBalanceLineClient(tbl.config).UpdateOne(tbl)
+}
+
+// Unwrap unwraps the TrialBalanceLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tbl *TrialBalanceLine) Unwrap() *TrialBalanceLine {
+	_tx, ok := tbl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TrialBalanceLine is not a transactional entity")
+	}
+	tbl.config.driver = _tx.drv
+	return tbl
+}
+
+// String implements the fmt.Stringer.
+func (tbl *TrialBalanceLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("TrialBalanceLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tbl.ID))
+	builder.WriteString("period_id=")
+	builder.WriteString(fmt.Sprintf("%v", tbl.PeriodID))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", tbl.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(tbl.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("movement=")
+	builder.WriteString(fmt.Sprintf("%v", tbl.Movement))
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", tbl.Balance))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tbl.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TrialBalanceLines is a parsable slice of TrialBalanceLine.
+type TrialBalanceLines []*TrialBalanceLine
@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trialbalanceline
+
+import (
+	"accounting/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldLTE(FieldID, id))
+}
+
+// PeriodID applies equality check predicate on the "period_id" field. It's identical to PeriodIDEQ.
+func PeriodID(v int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldPeriodID, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Movement applies equality check predicate on the "movement" field. It's identical to MovementEQ.
+func Movement(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldMovement, v))
+}
+
+// Balance applies equality check predicate on the "balance" field. It's identical to BalanceEQ.
+func Balance(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldBalance, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PeriodIDEQ applies the EQ predicate on the "period_id" field.
+func PeriodIDEQ(v int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldPeriodID, v))
+}
+
+// PeriodIDNEQ applies the NEQ predicate on the "period_id" field.
+func PeriodIDNEQ(v int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNEQ(FieldPeriodID, v))
+}
+
+// PeriodIDIn applies the In predicate on the "period_id" field.
+func PeriodIDIn(vs ...int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldIn(FieldPeriodID, vs...))
+}
+
+// PeriodIDNotIn applies the NotIn predicate on the "period_id" field.
+func PeriodIDNotIn(vs ...int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNotIn(FieldPeriodID, vs...))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...int) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// MovementEQ applies the EQ predicate on the "movement" field.
+func MovementEQ(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldMovement, v))
+}
+
+// MovementNEQ applies the NEQ predicate on the "movement" field.
+func MovementNEQ(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNEQ(FieldMovement, v))
+}
+
+// MovementIn applies the In predicate on the "movement" field.
+func MovementIn(vs ...decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldIn(FieldMovement, vs...))
+}
+
+// MovementNotIn applies the NotIn predicate on the "movement" field.
+func MovementNotIn(vs ...decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNotIn(FieldMovement, vs...))
+}
+
+// MovementGT applies the GT predicate on the "movement" field.
+func MovementGT(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldGT(FieldMovement, v))
+}
+
+// MovementGTE applies the GTE predicate on the "movement" field.
+func MovementGTE(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldGTE(FieldMovement, v))
+}
+
+// MovementLT applies the LT predicate on the "movement" field.
+func MovementLT(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldLT(FieldMovement, v))
+}
+
+// MovementLTE applies the LTE predicate on the "movement" field.
+func MovementLTE(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldLTE(FieldMovement, v))
+}
+
+// BalanceEQ applies the EQ predicate on the "balance" field.
+func BalanceEQ(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldBalance, v))
+}
+
+// BalanceNEQ applies the NEQ predicate on the "balance" field.
+func BalanceNEQ(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNEQ(FieldBalance, v))
+}
+
+// BalanceIn applies the In predicate on the "balance" field.
+func BalanceIn(vs ...decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldIn(FieldBalance, vs...))
+}
+
+// BalanceNotIn applies the NotIn predicate on the "balance" field.
+func BalanceNotIn(vs ...decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNotIn(FieldBalance, vs...))
+}
+
+// BalanceGT applies the GT predicate on the "balance" field.
+func BalanceGT(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldGT(FieldBalance, v))
+}
+
+// BalanceGTE applies the GTE predicate on the "balance" field.
+func BalanceGTE(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldGTE(FieldBalance, v))
+}
+
+// BalanceLT applies the LT predicate on the "balance" field.
+func BalanceLT(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldLT(FieldBalance, v))
+}
+
+// BalanceLTE applies the LTE predicate on the "balance" field.
+func BalanceLTE(v decimal.Decimal) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldLTE(FieldBalance, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPeriod applies the HasEdge predicate on the "period" edge.
+func HasPeriod() predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PeriodTable, PeriodColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPeriodWith applies the HasEdge predicate on the "period" edge with a given conditions (other predicates).
+func HasPeriodWith(preds ...predicate.AccountingPeriod) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(func(s *sql.Selector) {
+		step := newPeriodStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TrialBalanceLine) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TrialBalanceLine) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TrialBalanceLine) predicate.TrialBalanceLine {
+	return predicate.TrialBalanceLine(sql.NotPredicates(p))
+}
@@ -0,0 +1,596 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/account"
+	"accounting/ent/accountingperiod"
+	"accounting/ent/trialbalanceline"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// TrialBalanceLineCreate is the builder for creating a TrialBalanceLine entity.
+type TrialBalanceLineCreate struct {
+	config
+	mutation *TrialBalanceLineMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPeriodID sets the "period_id" field.
+func (tblc *TrialBalanceLineCreate) SetPeriodID(i int) *TrialBalanceLineCreate {
+	tblc.mutation.SetPeriodID(i)
+	return tblc
+}
+
+// SetAccountID sets the "account_id" field.
+func (tblc *TrialBalanceLineCreate) SetAccountID(i int) *TrialBalanceLineCreate {
+	tblc.mutation.SetAccountID(i)
+	return tblc
+}
+
+// SetCurrency sets the "currency" field.
+func (tblc *TrialBalanceLineCreate) SetCurrency(s string) *TrialBalanceLineCreate {
+	tblc.mutation.SetCurrency(s)
+	return tblc
+}
+
+// SetMovement sets the "movement" field.
+func (tblc *TrialBalanceLineCreate) SetMovement(d decimal.Decimal) *TrialBalanceLineCreate {
+	tblc.mutation.SetMovement(d)
+	return tblc
+}
+
+// SetBalance sets the "balance" field.
+func (tblc *TrialBalanceLineCreate) SetBalance(d decimal.Decimal) *TrialBalanceLineCreate {
+	tblc.mutation.SetBalance(d)
+	return tblc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tblc *TrialBalanceLineCreate) SetCreatedAt(t time.Time) *TrialBalanceLineCreate {
+	tblc.mutation.SetCreatedAt(t)
+	return tblc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tblc *TrialBalanceLineCreate) SetNillableCreatedAt(t *time.Time) *TrialBalanceLineCreate {
+	if t != nil {
+		tblc.SetCreatedAt(*t)
+	}
+	return tblc
+}
+
+// SetPeriod sets the "period" edge to the AccountingPeriod entity.
+func (tblc *TrialBalanceLineCreate) SetPeriod(a *AccountingPeriod) *TrialBalanceLineCreate {
+	return tblc.SetPeriodID(a.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (tblc *TrialBalanceLineCreate) SetAccount(a *Account) *TrialBalanceLineCreate {
+	return tblc.SetAccountID(a.ID)
+}
+
+// Mutation returns the TrialBalanceLineMutation object of the builder.
+func (tblc *TrialBalanceLineCreate) Mutation() *TrialBalanceLineMutation {
+	return tblc.mutation
+}
+
+// Save creates the TrialBalanceLine in the database.
+func (tblc *TrialBalanceLineCreate) Save(ctx context.Context) (*TrialBalanceLine, error) {
+	if err := tblc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tblc.sqlSave, tblc.mutation, tblc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tblc *TrialBalanceLineCreate) SaveX(ctx context.Context) *TrialBalanceLine {
+	v, err := tblc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tblc *TrialBalanceLineCreate) Exec(ctx context.Context) error {
+	_, err := tblc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tblc *TrialBalanceLineCreate) ExecX(ctx context.Context) {
+	if err := tblc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tblc *TrialBalanceLineCreate) defaults() error {
+	if _, ok := tblc.mutation.CreatedAt(); !ok {
+		if trialbalanceline.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized trialbalanceline.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := trialbalanceline.DefaultCreatedAt()
+		tblc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tblc *TrialBalanceLineCreate) check() error {
+	if _, ok := tblc.mutation.PeriodID(); !ok {
+		return &ValidationError{Name: "period_id", err: errors.New(`ent: missing required field "TrialBalanceLine.period_id"`)}
+	}
+	if _, ok := tblc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "TrialBalanceLine.account_id"`)}
+	}
+	if _, ok := tblc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "TrialBalanceLine.currency"`)}
+	}
+	if v, ok := tblc.mutation.Currency(); ok {
+		if err := trialbalanceline.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "TrialBalanceLine.currency": %w`, err)}
+		}
+	}
+	if _, ok := tblc.mutation.Movement(); !ok {
+		return &ValidationError{Name: "movement", err: errors.New(`ent: missing required field "TrialBalanceLine.movement"`)}
+	}
+	if _, ok := tblc.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "TrialBalanceLine.balance"`)}
+	}
+	if _, ok := tblc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TrialBalanceLine.created_at"`)}
+	}
+	if len(tblc.mutation.PeriodIDs()) == 0 {
+		return &ValidationError{Name: "period", err: errors.New(`ent: missing required edge "TrialBalanceLine.period"`)}
+	}
+	if len(tblc.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "TrialBalanceLine.account"`)}
+	}
+	return nil
+}
+
+func (tblc *TrialBalanceLineCreate) sqlSave(ctx context.Context) (*TrialBalanceLine, error) {
+	if err := tblc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tblc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tblc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tblc.mutation.id = &_node.ID
+	tblc.mutation.done = true
+	return _node, nil
+}
+
+func (tblc *TrialBalanceLineCreate) createSpec() (*TrialBalanceLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TrialBalanceLine{config: tblc.config}
+		_spec = sqlgraph.NewCreateSpec(trialbalanceline.Table, sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = tblc.conflict
+	if value, ok := tblc.mutation.Currency(); ok {
+		_spec.SetField(trialbalanceline.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := tblc.mutation.Movement(); ok {
+		_spec.SetField(trialbalanceline.FieldMovement, field.TypeFloat64, value)
+		_node.Movement = value
+	}
+	if value, ok := tblc.mutation.Balance(); ok {
+		_spec.SetField(trialbalanceline.FieldBalance, field.TypeFloat64, value)
+		_node.Balance = value
+	}
+	if value, ok := tblc.mutation.CreatedAt(); ok {
+		_spec.SetField(trialbalanceline.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tblc.mutation.PeriodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trialbalanceline.PeriodTable,
+			Columns: []string{trialbalanceline.PeriodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountingperiod.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PeriodID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tblc.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trialbalanceline.AccountTable,
+			Columns: []string{trialbalanceline.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TrialBalanceLine.Create().
+//		SetPeriodID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TrialBalanceLineUpsert) {
+//			SetPeriodID(v+v).
+//		}).
+//		Exec(ctx)
+func (tblc *TrialBalanceLineCreate) OnConflict(opts ...sql.ConflictOption) *TrialBalanceLineUpsertOne {
+	tblc.conflict = opts
+	return &TrialBalanceLineUpsertOne{
+		create: tblc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TrialBalanceLine.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tblc *TrialBalanceLineCreate) OnConflictColumns(columns ...string) *TrialBalanceLineUpsertOne {
+	tblc.conflict = append(tblc.conflict, sql.ConflictColumns(columns...))
+	return &TrialBalanceLineUpsertOne{
+		create: tblc,
+	}
+}
+
+type (
+	// TrialBalanceLineUpsertOne is the builder for "upsert"-ing
+	//  one TrialBalanceLine node.
+	TrialBalanceLineUpsertOne struct {
+		create *TrialBalanceLineCreate
+	}
+
+	// TrialBalanceLineUpsert is the "OnConflict" setter.
+	TrialBalanceLineUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TrialBalanceLine.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TrialBalanceLineUpsertOne) UpdateNewValues() *TrialBalanceLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.PeriodID(); exists {
+			s.SetIgnore(trialbalanceline.FieldPeriodID)
+		}
+		if _, exists := u.create.mutation.AccountID(); exists {
+			s.SetIgnore(trialbalanceline.FieldAccountID)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(trialbalanceline.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.Movement(); exists {
+			s.SetIgnore(trialbalanceline.FieldMovement)
+		}
+		if _, exists := u.create.mutation.Balance(); exists {
+			s.SetIgnore(trialbalanceline.FieldBalance)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(trialbalanceline.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TrialBalanceLine.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TrialBalanceLineUpsertOne) Ignore() *TrialBalanceLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TrialBalanceLineUpsertOne) DoNothing() *TrialBalanceLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TrialBalanceLineCreate.OnConflict
+// documentation for more info.
+func (u *TrialBalanceLineUpsertOne) Update(set func(*TrialBalanceLineUpsert)) *TrialBalanceLineUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TrialBalanceLineUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TrialBalanceLineUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TrialBalanceLineCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TrialBalanceLineUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TrialBalanceLineUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TrialBalanceLineUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TrialBalanceLineCreateBulk is the builder for creating many TrialBalanceLine entities in bulk.
+type TrialBalanceLineCreateBulk struct {
+	config
+	err      error
+	builders []*TrialBalanceLineCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TrialBalanceLine entities in the database.
+func (tblcb *TrialBalanceLineCreateBulk) Save(ctx context.Context) ([]*TrialBalanceLine, error) {
+	if tblcb.err != nil {
+		return nil, tblcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tblcb.builders))
+	nodes := make([]*TrialBalanceLine, len(tblcb.builders))
+	mutators := make([]Mutator, len(tblcb.builders))
+	for i := range tblcb.builders {
+		func(i int, root context.Context) {
+			builder := tblcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TrialBalanceLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tblcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tblcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tblcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tblcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tblcb *TrialBalanceLineCreateBulk) SaveX(ctx context.Context) []*TrialBalanceLine {
+	v, err := tblcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tblcb *TrialBalanceLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := tblcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tblcb *TrialBalanceLineCreateBulk) ExecX(ctx context.Context) {
+	if err := tblcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TrialBalanceLine.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TrialBalanceLineUpsert) {
+//			SetPeriodID(v+v).
+//		}).
+//		Exec(ctx)
+func (tblcb *TrialBalanceLineCreateBulk) OnConflict(opts ...sql.ConflictOption) *TrialBalanceLineUpsertBulk {
+	tblcb.conflict = opts
+	return &TrialBalanceLineUpsertBulk{
+		create: tblcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TrialBalanceLine.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tblcb *TrialBalanceLineCreateBulk) OnConflictColumns(columns ...string) *TrialBalanceLineUpsertBulk {
+	tblcb.conflict = append(tblcb.conflict, sql.ConflictColumns(columns...))
+	return &TrialBalanceLineUpsertBulk{
+		create: tblcb,
+	}
+}
+
+// TrialBalanceLineUpsertBulk is the builder for "upsert"-ing
+// a bulk of TrialBalanceLine nodes.
+type TrialBalanceLineUpsertBulk struct {
+	create *TrialBalanceLineCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TrialBalanceLine.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TrialBalanceLineUpsertBulk) UpdateNewValues() *TrialBalanceLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.PeriodID(); exists {
+				s.SetIgnore(trialbalanceline.FieldPeriodID)
+			}
+			if _, exists := b.mutation.AccountID(); exists {
+				s.SetIgnore(trialbalanceline.FieldAccountID)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(trialbalanceline.FieldCurrency)
+			}
+			if _, exists := b.mutation.Movement(); exists {
+				s.SetIgnore(trialbalanceline.FieldMovement)
+			}
+			if _, exists := b.mutation.Balance(); exists {
+				s.SetIgnore(trialbalanceline.FieldBalance)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(trialbalanceline.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TrialBalanceLine.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TrialBalanceLineUpsertBulk) Ignore() *TrialBalanceLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TrialBalanceLineUpsertBulk) DoNothing() *TrialBalanceLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TrialBalanceLineCreateBulk.OnConflict
+// documentation for more info.
+func (u *TrialBalanceLineUpsertBulk) Update(set func(*TrialBalanceLineUpsert)) *TrialBalanceLineUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TrialBalanceLineUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TrialBalanceLineUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TrialBalanceLineCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TrialBalanceLineCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TrialBalanceLineUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/predicate"
+	"accounting/ent/trialbalanceline"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TrialBalanceLineDelete is the builder for deleting a TrialBalanceLine entity.
+type TrialBalanceLineDelete struct {
+	config
+	hooks    []Hook
+	mutation *TrialBalanceLineMutation
+}
+
+// Where appends a list predicates to the TrialBalanceLineDelete builder.
+func (tbld *TrialBalanceLineDelete) Where(ps ...predicate.TrialBalanceLine) *TrialBalanceLineDelete {
+	tbld.mutation.Where(ps...)
+	return tbld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tbld *TrialBalanceLineDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tbld.sqlExec, tbld.mutation, tbld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbld *TrialBalanceLineDelete) ExecX(ctx context.Context) int {
+	n, err := tbld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tbld *TrialBalanceLineDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(trialbalanceline.Table, sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt))
+	if ps := tbld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tbld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tbld.mutation.done = true
+	return affected, err
+}
+
+// TrialBalanceLineDeleteOne is the builder for deleting a single TrialBalanceLine entity.
+type TrialBalanceLineDeleteOne struct {
+	tbld *TrialBalanceLineDelete
+}
+
+// Where appends a list predicates to the TrialBalanceLineDelete builder.
+func (tbldo *TrialBalanceLineDeleteOne) Where(ps ...predicate.TrialBalanceLine) *TrialBalanceLineDeleteOne {
+	tbldo.tbld.mutation.Where(ps...)
+	return tbldo
+}
+
+// Exec executes the deletion query.
+func (tbldo *TrialBalanceLineDeleteOne) Exec(ctx context.Context) error {
+	n, err := tbldo.tbld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{trialbalanceline.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbldo *TrialBalanceLineDeleteOne) ExecX(ctx context.Context) {
+	if err := tbldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/account"
+	"accounting/ent/accountingperiod"
+	"accounting/ent/predicate"
+	"accounting/ent/trialbalanceline"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TrialBalanceLineQuery is the builder for querying TrialBalanceLine entities.
+type TrialBalanceLineQuery struct {
+	config
+	ctx         *QueryContext
+	order       []trialbalanceline.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.TrialBalanceLine
+	withPeriod  *AccountingPeriodQuery
+	withAccount *AccountQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TrialBalanceLineQuery builder.
+func (tblq *TrialBalanceLineQuery) Where(ps ...predicate.TrialBalanceLine) *TrialBalanceLineQuery {
+	tblq.predicates = append(tblq.predicates, ps...)
+	return tblq
+}
+
+// Limit the number of records to be returned by this query.
+func (tblq *TrialBalanceLineQuery) Limit(limit int) *TrialBalanceLineQuery {
+	tblq.ctx.Limit = &limit
+	return tblq
+}
+
+// Offset to start from.
+func (tblq *TrialBalanceLineQuery) Offset(offset int) *TrialBalanceLineQuery {
+	tblq.ctx.Offset = &offset
+	return tblq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tblq *TrialBalanceLineQuery) Unique(unique bool) *TrialBalanceLineQuery {
+	tblq.ctx.Unique = &unique
+	return tblq
+}
+
+// Order specifies how the records should be ordered.
+func (tblq *TrialBalanceLineQuery) Order(o ...trialbalanceline.OrderOption) *TrialBalanceLineQuery {
+	tblq.order = append(tblq.order, o...)
+	return tblq
+}
+
+// QueryPeriod chains the current query on the "period" edge.
+func (tblq *TrialBalanceLineQuery) QueryPeriod() *AccountingPeriodQuery {
+	query := (&AccountingPeriodClient{config: tblq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tblq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tblq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(trialbalanceline.Table, trialbalanceline.FieldID, selector),
+			sqlgraph.To(accountingperiod.Table, accountingperiod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, trialbalanceline.PeriodTable, trialbalanceline.PeriodColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tblq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccount chains the current query on the "account" edge.
+func (tblq *TrialBalanceLineQuery) QueryAccount() *AccountQuery {
+	query := (&AccountClient{config: tblq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tblq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tblq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(trialbalanceline.Table, trialbalanceline.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, trialbalanceline.AccountTable, trialbalanceline.AccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tblq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TrialBalanceLine entity from the query.
+// Returns a *NotFoundError when no TrialBalanceLine was found.
+func (tblq *TrialBalanceLineQuery) First(ctx context.Context) (*TrialBalanceLine, error) {
+	nodes, err := tblq.Limit(1).All(setContextOp(ctx, tblq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{trialbalanceline.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tblq *TrialBalanceLineQuery) FirstX(ctx context.Context) *TrialBalanceLine {
+	node, err := tblq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TrialBalanceLine ID from the query.
+// Returns a *NotFoundError when no TrialBalanceLine ID was found.
+func (tblq *TrialBalanceLineQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tblq.Limit(1).IDs(setContextOp(ctx, tblq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{trialbalanceline.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tblq *TrialBalanceLineQuery) FirstIDX(ctx context.Context) int {
+	id, err := tblq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TrialBalanceLine entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TrialBalanceLine entity is found.
+// Returns a *NotFoundError when no TrialBalanceLine entities are found.
+func (tblq *TrialBalanceLineQuery) Only(ctx context.Context) (*TrialBalanceLine, error) {
+	nodes, err := tblq.Limit(2).All(setContextOp(ctx, tblq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{trialbalanceline.Label}
+	default:
+		return nil, &NotSingularError{trialbalanceline.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tblq *TrialBalanceLineQuery) OnlyX(ctx context.Context) *TrialBalanceLine {
+	node, err := tblq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TrialBalanceLine ID in the query.
+// Returns a *NotSingularError when more than one TrialBalanceLine ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tblq *TrialBalanceLineQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tblq.Limit(2).IDs(setContextOp(ctx, tblq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{trialbalanceline.Label}
+	default:
+		err = &NotSingularError{trialbalanceline.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tblq *TrialBalanceLineQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tblq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TrialBalanceLines.
+func (tblq *TrialBalanceLineQuery) All(ctx context.Context) ([]*TrialBalanceLine, error) {
+	ctx = setContextOp(ctx, tblq.ctx, ent.OpQueryAll)
+	if err := tblq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TrialBalanceLine, *TrialBalanceLineQuery]()
+	return withInterceptors[[]*TrialBalanceLine](ctx, tblq, qr, tblq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tblq *TrialBalanceLineQuery) AllX(ctx context.Context) []*TrialBalanceLine {
+	nodes, err := tblq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TrialBalanceLine IDs.
+func (tblq *TrialBalanceLineQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tblq.ctx.Unique == nil && tblq.path != nil {
+		tblq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tblq.ctx, ent.OpQueryIDs)
+	if err = tblq.Select(trialbalanceline.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tblq *TrialBalanceLineQuery) IDsX(ctx context.Context) []int {
+	ids, err := tblq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tblq *TrialBalanceLineQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tblq.ctx, ent.OpQueryCount)
+	if err := tblq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tblq, querierCount[*TrialBalanceLineQuery](), tblq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tblq *TrialBalanceLineQuery) CountX(ctx context.Context) int {
+	count, err := tblq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tblq *TrialBalanceLineQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tblq.ctx, ent.OpQueryExist)
+	switch _, err := tblq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tblq *TrialBalanceLineQuery) ExistX(ctx context.Context) bool {
+	exist, err := tblq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TrialBalanceLineQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tblq *TrialBalanceLineQuery) Clone() *TrialBalanceLineQuery {
+	if tblq == nil {
+		return nil
+	}
+	return &TrialBalanceLineQuery{
+		config:      tblq.config,
+		ctx:         tblq.ctx.Clone(),
+		order:       append([]trialbalanceline.OrderOption{}, tblq.order...),
+		inters:      append([]Interceptor{}, tblq.inters...),
+		predicates:  append([]predicate.TrialBalanceLine{}, tblq.predicates...),
+		withPeriod:  tblq.withPeriod.Clone(),
+		withAccount: tblq.withAccount.Clone(),
+		// clone intermediate query.
+		sql:  tblq.sql.Clone(),
+		path: tblq.path,
+	}
+}
+
+// WithPeriod tells the query-builder to eager-load the nodes that are connected to
+// the "period" edge. The optional arguments are used to configure the query builder of the edge.
+func (tblq *TrialBalanceLineQuery) WithPeriod(opts ...func(*AccountingPeriodQuery)) *TrialBalanceLineQuery {
+	query := (&AccountingPeriodClient{config: tblq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tblq.withPeriod = query
+	return tblq
+}
+
+// WithAccount tells the query-builder to eager-load the nodes that are connected to
+// the "account" edge. The optional arguments are used to configure the query builder of the edge.
+func (tblq *TrialBalanceLineQuery) WithAccount(opts ...func(*AccountQuery)) *TrialBalanceLineQuery {
+	query := (&AccountClient{config: tblq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tblq.withAccount = query
+	return tblq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PeriodID int `json:"period_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TrialBalanceLine.Query().
+//		GroupBy(trialbalanceline.FieldPeriodID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tblq *TrialBalanceLineQuery) GroupBy(field string, fields ...string) *TrialBalanceLineGroupBy {
+	tblq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TrialBalanceLineGroupBy{build: tblq}
+	grbuild.flds = &tblq.ctx.Fields
+	grbuild.label = trialbalanceline.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PeriodID int `json:"period_id,omitempty"`
+//	}
+//
+//	client.TrialBalanceLine.Query().
+//		Select(trialbalanceline.FieldPeriodID).
+//		Scan(ctx, &v)
+func (tblq *TrialBalanceLineQuery) Select(fields ...string) *TrialBalanceLineSelect {
+	tblq.ctx.Fields = append(tblq.ctx.Fields, fields...)
+	sbuild := &TrialBalanceLineSelect{TrialBalanceLineQuery: tblq}
+	sbuild.label = trialbalanceline.Label
+	sbuild.flds, sbuild.scan = &tblq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TrialBalanceLineSelect configured with the given aggregations.
+func (tblq *TrialBalanceLineQuery) Aggregate(fns ...AggregateFunc) *TrialBalanceLineSelect {
+	return tblq.Select().Aggregate(fns...)
+}
+
+func (tblq *TrialBalanceLineQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tblq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tblq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tblq.ctx.Fields {
+		if !trialbalanceline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tblq.path != nil {
+		prev, err := tblq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tblq.sql = prev
+	}
+	return nil
+}
+
+func (tblq *TrialBalanceLineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TrialBalanceLine, error) {
+	var (
+		nodes       = []*TrialBalanceLine{}
+		_spec       = tblq.querySpec()
+		loadedTypes = [2]bool{
+			tblq.withPeriod != nil,
+			tblq.withAccount != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TrialBalanceLine).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TrialBalanceLine{config: tblq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(tblq.modifiers) > 0 {
+		_spec.Modifiers = tblq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tblq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tblq.withPeriod; query != nil {
+		if err := tblq.loadPeriod(ctx, query, nodes, nil,
+			func(n *TrialBalanceLine, e *AccountingPeriod) { n.Edges.Period = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tblq.withAccount; query != nil {
+		if err := tblq.loadAccount(ctx, query, nodes, nil,
+			func(n *TrialBalanceLine, e *Account) { n.Edges.Account = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tblq *TrialBalanceLineQuery) loadPeriod(ctx context.Context, query *AccountingPeriodQuery, nodes []*TrialBalanceLine, init func(*TrialBalanceLine), assign func(*TrialBalanceLine, *AccountingPeriod)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TrialBalanceLine)
+	for i := range nodes {
+		fk := nodes[i].PeriodID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(accountingperiod.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "period_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tblq *TrialBalanceLineQuery) loadAccount(ctx context.Context, query *AccountQuery, nodes []*TrialBalanceLine, init func(*TrialBalanceLine), assign func(*TrialBalanceLine, *Account)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TrialBalanceLine)
+	for i := range nodes {
+		fk := nodes[i].AccountID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "account_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tblq *TrialBalanceLineQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tblq.querySpec()
+	if len(tblq.modifiers) > 0 {
+		_spec.Modifiers = tblq.modifiers
+	}
+	_spec.Node.Columns = tblq.ctx.Fields
+	if len(tblq.ctx.Fields) > 0 {
+		_spec.Unique = tblq.ctx.Unique != nil && *tblq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tblq.driver, _spec)
+}
+
+func (tblq *TrialBalanceLineQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(trialbalanceline.Table, trialbalanceline.Columns, sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt))
+	_spec.From = tblq.sql
+	if unique := tblq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tblq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tblq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trialbalanceline.FieldID)
+		for i := range fields {
+			if fields[i] != trialbalanceline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tblq.withPeriod != nil {
+			_spec.Node.AddColumnOnce(trialbalanceline.FieldPeriodID)
+		}
+		if tblq.withAccount != nil {
+			_spec.Node.AddColumnOnce(trialbalanceline.FieldAccountID)
+		}
+	}
+	if ps := tblq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tblq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tblq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tblq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tblq *TrialBalanceLineQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tblq.driver.Dialect())
+	t1 := builder.Table(trialbalanceline.Table)
+	columns := tblq.ctx.Fields
+	if len(columns) == 0 {
+		columns = trialbalanceline.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tblq.sql != nil {
+		selector = tblq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tblq.ctx.Unique != nil && *tblq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tblq.modifiers {
+		m(selector)
+	}
+	for _, p := range tblq.predicates {
+		p(selector)
+	}
+	for _, p := range tblq.order {
+		p(selector)
+	}
+	if offset := tblq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tblq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tblq *TrialBalanceLineQuery) ForUpdate(opts ...sql.LockOption) *TrialBalanceLineQuery {
+	if tblq.driver.Dialect() == dialect.Postgres {
+		tblq.Unique(false)
+	}
+	tblq.modifiers = append(tblq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tblq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tblq *TrialBalanceLineQuery) ForShare(opts ...sql.LockOption) *TrialBalanceLineQuery {
+	if tblq.driver.Dialect() == dialect.Postgres {
+		tblq.Unique(false)
+	}
+	tblq.modifiers = append(tblq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tblq
+}
+
+// TrialBalanceLineGroupBy is the group-by builder for TrialBalanceLine entities.
+type TrialBalanceLineGroupBy struct {
+	selector
+	build *TrialBalanceLineQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tblgb *TrialBalanceLineGroupBy) Aggregate(fns ...AggregateFunc) *TrialBalanceLineGroupBy {
+	tblgb.fns = append(tblgb.fns, fns...)
+	return tblgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tblgb *TrialBalanceLineGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tblgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tblgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TrialBalanceLineQuery, *TrialBalanceLineGroupBy](ctx, tblgb.build, tblgb, tblgb.build.inters, v)
+}
+
+func (tblgb *TrialBalanceLineGroupBy) sqlScan(ctx context.Context, root *TrialBalanceLineQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tblgb.fns))
+	for _, fn := range tblgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tblgb.flds)+len(tblgb.fns))
+		for _, f := range *tblgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tblgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tblgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TrialBalanceLineSelect is the builder for selecting fields of TrialBalanceLine entities.
+type TrialBalanceLineSelect struct {
+	*TrialBalanceLineQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tbls *TrialBalanceLineSelect) Aggregate(fns ...AggregateFunc) *TrialBalanceLineSelect {
+	tbls.fns = append(tbls.fns, fns...)
+	return tbls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tbls *TrialBalanceLineSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tbls.ctx, ent.OpQuerySelect)
+	if err := tbls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TrialBalanceLineQuery, *TrialBalanceLineSelect](ctx, tbls.TrialBalanceLineQuery, tbls, tbls.inters, v)
+}
+
+func (tbls *TrialBalanceLineSelect) sqlScan(ctx context.Context, root *TrialBalanceLineQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tbls.fns))
+	for _, fn := range tbls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tbls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tbls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/predicate"
+	"accounting/ent/trialbalanceline"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TrialBalanceLineUpdate is the builder for updating TrialBalanceLine entities.
+type TrialBalanceLineUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TrialBalanceLineMutation
+}
+
+// Where appends a list predicates to the TrialBalanceLineUpdate builder.
+func (tblu *TrialBalanceLineUpdate) Where(ps ...predicate.TrialBalanceLine) *TrialBalanceLineUpdate {
+	tblu.mutation.Where(ps...)
+	return tblu
+}
+
+// Mutation returns the TrialBalanceLineMutation object of the builder.
+func (tblu *TrialBalanceLineUpdate) Mutation() *TrialBalanceLineMutation {
+	return tblu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tblu *TrialBalanceLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tblu.sqlSave, tblu.mutation, tblu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tblu *TrialBalanceLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := tblu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tblu *TrialBalanceLineUpdate) Exec(ctx context.Context) error {
+	_, err := tblu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tblu *TrialBalanceLineUpdate) ExecX(ctx context.Context) {
+	if err := tblu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tblu *TrialBalanceLineUpdate) check() error {
+	if tblu.mutation.PeriodCleared() && len(tblu.mutation.PeriodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TrialBalanceLine.period"`)
+	}
+	if tblu.mutation.AccountCleared() && len(tblu.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TrialBalanceLine.account"`)
+	}
+	return nil
+}
+
+func (tblu *TrialBalanceLineUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tblu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(trialbalanceline.Table, trialbalanceline.Columns, sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt))
+	if ps := tblu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tblu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trialbalanceline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tblu.mutation.done = true
+	return n, nil
+}
+
+// TrialBalanceLineUpdateOne is the builder for updating a single TrialBalanceLine entity.
+type TrialBalanceLineUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TrialBalanceLineMutation
+}
+
+// Mutation returns the TrialBalanceLineMutation object of the builder.
+func (tbluo *TrialBalanceLineUpdateOne) Mutation() *TrialBalanceLineMutation {
+	return tbluo.mutation
+}
+
+// Where appends a list predicates to the TrialBalanceLineUpdate builder.
+func (tbluo *TrialBalanceLineUpdateOne) Where(ps ...predicate.TrialBalanceLine) *TrialBalanceLineUpdateOne {
+	tbluo.mutation.Where(ps...)
+	return tbluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tbluo *TrialBalanceLineUpdateOne) Select(field string, fields ...string) *TrialBalanceLineUpdateOne {
+	tbluo.fields = append([]string{field}, fields...)
+	return tbluo
+}
+
+// Save executes the query and returns the updated TrialBalanceLine entity.
+func (tbluo *TrialBalanceLineUpdateOne) Save(ctx context.Context) (*TrialBalanceLine, error) {
+	return withHooks(ctx, tbluo.sqlSave, tbluo.mutation, tbluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tbluo *TrialBalanceLineUpdateOne) SaveX(ctx context.Context) *TrialBalanceLine {
+	node, err := tbluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tbluo *TrialBalanceLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := tbluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbluo *TrialBalanceLineUpdateOne) ExecX(ctx context.Context) {
+	if err := tbluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tbluo *TrialBalanceLineUpdateOne) check() error {
+	if tbluo.mutation.PeriodCleared() && len(tbluo.mutation.PeriodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TrialBalanceLine.period"`)
+	}
+	if tbluo.mutation.AccountCleared() && len(tbluo.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TrialBalanceLine.account"`)
+	}
+	return nil
+}
+
+func (tbluo *TrialBalanceLineUpdateOne) sqlSave(ctx context.Context) (_node *TrialBalanceLine, err error) {
+	if err := tbluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(trialbalanceline.Table, trialbalanceline.Columns, sqlgraph.NewFieldSpec(trialbalanceline.FieldID, field.TypeInt))
+	id, ok := tbluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TrialBalanceLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tbluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trialbalanceline.FieldID)
+		for _, f := range fields {
+			if !trialbalanceline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != trialbalanceline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tbluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &TrialBalanceLine{config: tbluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tbluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trialbalanceline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tbluo.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,12 +14,44 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Account is the client for interacting with the Account builders.
+	Account *AccountClient
+	// AccountingPeriod is the client for interacting with the AccountingPeriod builders.
+	AccountingPeriod *AccountingPeriodClient
 	// Balance is the client for interacting with the Balance builders.
 	Balance *BalanceClient
+	// BalanceSnapshot is the client for interacting with the BalanceSnapshot builders.
+	BalanceSnapshot *BalanceSnapshotClient
+	// Currency is the client for interacting with the Currency builders.
+	Currency *CurrencyClient
+	// ExchangeRate is the client for interacting with the ExchangeRate builders.
+	ExchangeRate *ExchangeRateClient
+	// FeeRule is the client for interacting with the FeeRule builders.
+	FeeRule *FeeRuleClient
+	// Hold is the client for interacting with the Hold builders.
+	Hold *HoldClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// InterestAccrual is the client for interacting with the InterestAccrual builders.
+	InterestAccrual *InterestAccrualClient
+	// InterestPlan is the client for interacting with the InterestPlan builders.
+	InterestPlan *InterestPlanClient
+	// JournalEntry is the client for interacting with the JournalEntry builders.
+	JournalEntry *JournalEntryClient
+	// Posting is the client for interacting with the Posting builders.
+	Posting *PostingClient
+	// Schedule is the client for interacting with the Schedule builders.
+	Schedule *ScheduleClient
+	// ScheduleRun is the client for interacting with the ScheduleRun builders.
+	ScheduleRun *ScheduleRunClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
+	// TrialBalanceLine is the client for interacting with the TrialBalanceLine builders.
+	TrialBalanceLine *TrialBalanceLineClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// VelocityLimit is the client for interacting with the VelocityLimit builders.
+	VelocityLimit *VelocityLimitClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +183,25 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Account = NewAccountClient(tx.config)
+	tx.AccountingPeriod = NewAccountingPeriodClient(tx.config)
 	tx.Balance = NewBalanceClient(tx.config)
+	tx.BalanceSnapshot = NewBalanceSnapshotClient(tx.config)
+	tx.Currency = NewCurrencyClient(tx.config)
+	tx.ExchangeRate = NewExchangeRateClient(tx.config)
+	tx.FeeRule = NewFeeRuleClient(tx.config)
+	tx.Hold = NewHoldClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.InterestAccrual = NewInterestAccrualClient(tx.config)
+	tx.InterestPlan = NewInterestPlanClient(tx.config)
+	tx.JournalEntry = NewJournalEntryClient(tx.config)
+	tx.Posting = NewPostingClient(tx.config)
+	tx.Schedule = NewScheduleClient(tx.config)
+	tx.ScheduleRun = NewScheduleRunClient(tx.config)
 	tx.Transaction = NewTransactionClient(tx.config)
+	tx.TrialBalanceLine = NewTrialBalanceLineClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.VelocityLimit = NewVelocityLimitClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +211,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Balance.QueryXXX(), the query will be executed
+// applies a query, for example: Account.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -214,3 +264,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
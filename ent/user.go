@@ -22,10 +22,22 @@ type User struct {
 	Name string `json:"name,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
-	// Age holds the value of the "age" field.
-	Age int `json:"age,omitempty"`
+	// Age of the user; cleared when the user is erased
+	Age *int `json:"age,omitempty"`
+	// Pricing tier of the user, used to select fee rules
+	Tier string `json:"tier,omitempty"`
+	// Account status: active, frozen (deposits only), closed (no operations)
+	Status user.Status `json:"status,omitempty"`
+	// Reason of the last status change
+	StatusReason *string `json:"status_reason,omitempty"`
+	// Time of the last status change
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Time the user was soft-deleted; deleted users are hidden from queries
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Time the personal data of the user was pseudonymized
+	ErasedAt *time.Time `json:"erased_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -38,9 +50,15 @@ type UserEdges struct {
 	Transactions []*Transaction `json:"transactions,omitempty"`
 	// User's balances in different currencies
 	Balances []*Balance `json:"balances,omitempty"`
+	// User's ledger accounts
+	Accounts []*Account `json:"accounts,omitempty"`
+	// User's authorization holds
+	Holds []*Hold `json:"holds,omitempty"`
+	// User's scheduled and recurring transactions
+	Schedules []*Schedule `json:"schedules,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 }
 
 // TransactionsOrErr returns the Transactions value or an error if the edge
@@ -61,6 +79,33 @@ func (e UserEdges) BalancesOrErr() ([]*Balance, error) {
 	return nil, &NotLoadedError{edge: "balances"}
 }
 
+// AccountsOrErr returns the Accounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AccountsOrErr() ([]*Account, error) {
+	if e.loadedTypes[2] {
+		return e.Accounts, nil
+	}
+	return nil, &NotLoadedError{edge: "accounts"}
+}
+
+// HoldsOrErr returns the Holds value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) HoldsOrErr() ([]*Hold, error) {
+	if e.loadedTypes[3] {
+		return e.Holds, nil
+	}
+	return nil, &NotLoadedError{edge: "holds"}
+}
+
+// SchedulesOrErr returns the Schedules value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SchedulesOrErr() ([]*Schedule, error) {
+	if e.loadedTypes[4] {
+		return e.Schedules, nil
+	}
+	return nil, &NotLoadedError{edge: "schedules"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -68,9 +113,9 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldID, user.FieldAge:
 			values[i] = new(sql.NullInt64)
-		case user.FieldName, user.FieldEmail:
+		case user.FieldName, user.FieldEmail, user.FieldTier, user.FieldStatus, user.FieldStatusReason:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt:
+		case user.FieldStatusChangedAt, user.FieldCreatedAt, user.FieldDeletedAt, user.FieldErasedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -109,7 +154,34 @@ func (u *User) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field age", values[i])
 			} else if value.Valid {
-				u.Age = int(value.Int64)
+				u.Age = new(int)
+				*u.Age = int(value.Int64)
+			}
+		case user.FieldTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tier", values[i])
+			} else if value.Valid {
+				u.Tier = value.String
+			}
+		case user.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				u.Status = user.Status(value.String)
+			}
+		case user.FieldStatusReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_reason", values[i])
+			} else if value.Valid {
+				u.StatusReason = new(string)
+				*u.StatusReason = value.String
+			}
+		case user.FieldStatusChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field status_changed_at", values[i])
+			} else if value.Valid {
+				u.StatusChangedAt = new(time.Time)
+				*u.StatusChangedAt = value.Time
 			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -117,6 +189,20 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.CreatedAt = value.Time
 			}
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				u.DeletedAt = new(time.Time)
+				*u.DeletedAt = value.Time
+			}
+		case user.FieldErasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field erased_at", values[i])
+			} else if value.Valid {
+				u.ErasedAt = new(time.Time)
+				*u.ErasedAt = value.Time
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -140,6 +226,21 @@ func (u *User) QueryBalances() *BalanceQuery {
 	return NewUserClient(u.config).QueryBalances(u)
 }
 
+// QueryAccounts queries the "accounts" edge of the User entity.
+func (u *User) QueryAccounts() *AccountQuery {
+	return NewUserClient(u.config).QueryAccounts(u)
+}
+
+// QueryHolds queries the "holds" edge of the User entity.
+func (u *User) QueryHolds() *HoldQuery {
+	return NewUserClient(u.config).QueryHolds(u)
+}
+
+// QuerySchedules queries the "schedules" edge of the User entity.
+func (u *User) QuerySchedules() *ScheduleQuery {
+	return NewUserClient(u.config).QuerySchedules(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -169,11 +270,39 @@ func (u *User) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(u.Email)
 	builder.WriteString(", ")
-	builder.WriteString("age=")
-	builder.WriteString(fmt.Sprintf("%v", u.Age))
+	if v := u.Age; v != nil {
+		builder.WriteString("age=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tier=")
+	builder.WriteString(u.Tier)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", u.Status))
+	builder.WriteString(", ")
+	if v := u.StatusReason; v != nil {
+		builder.WriteString("status_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := u.StatusChangedAt; v != nil {
+		builder.WriteString("status_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := u.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.ErasedAt; v != nil {
+		builder.WriteString("erased_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,11 +3,14 @@
 package ent
 
 import (
-	"context"
+	"accounting/ent/account"
 	"accounting/ent/balance"
+	"accounting/ent/hold"
 	"accounting/ent/predicate"
+	"accounting/ent/schedule"
 	"accounting/ent/transaction"
 	"accounting/ent/user"
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -79,6 +82,80 @@ func (uu *UserUpdate) AddAge(i int) *UserUpdate {
 	return uu
 }
 
+// ClearAge clears the value of the "age" field.
+func (uu *UserUpdate) ClearAge() *UserUpdate {
+	uu.mutation.ClearAge()
+	return uu
+}
+
+// SetTier sets the "tier" field.
+func (uu *UserUpdate) SetTier(s string) *UserUpdate {
+	uu.mutation.SetTier(s)
+	return uu
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTier(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetTier(*s)
+	}
+	return uu
+}
+
+// SetStatus sets the "status" field.
+func (uu *UserUpdate) SetStatus(u user.Status) *UserUpdate {
+	uu.mutation.SetStatus(u)
+	return uu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableStatus(u *user.Status) *UserUpdate {
+	if u != nil {
+		uu.SetStatus(*u)
+	}
+	return uu
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (uu *UserUpdate) SetStatusReason(s string) *UserUpdate {
+	uu.mutation.SetStatusReason(s)
+	return uu
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableStatusReason(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetStatusReason(*s)
+	}
+	return uu
+}
+
+// ClearStatusReason clears the value of the "status_reason" field.
+func (uu *UserUpdate) ClearStatusReason() *UserUpdate {
+	uu.mutation.ClearStatusReason()
+	return uu
+}
+
+// SetStatusChangedAt sets the "status_changed_at" field.
+func (uu *UserUpdate) SetStatusChangedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetStatusChangedAt(t)
+	return uu
+}
+
+// SetNillableStatusChangedAt sets the "status_changed_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableStatusChangedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetStatusChangedAt(*t)
+	}
+	return uu
+}
+
+// ClearStatusChangedAt clears the value of the "status_changed_at" field.
+func (uu *UserUpdate) ClearStatusChangedAt() *UserUpdate {
+	uu.mutation.ClearStatusChangedAt()
+	return uu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uu *UserUpdate) SetCreatedAt(t time.Time) *UserUpdate {
 	uu.mutation.SetCreatedAt(t)
@@ -93,6 +170,46 @@ func (uu *UserUpdate) SetNillableCreatedAt(t *time.Time) *UserUpdate {
 	return uu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (uu *UserUpdate) SetDeletedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDeletedAt(t)
+	return uu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDeletedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDeletedAt(*t)
+	}
+	return uu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uu *UserUpdate) ClearDeletedAt() *UserUpdate {
+	uu.mutation.ClearDeletedAt()
+	return uu
+}
+
+// SetErasedAt sets the "erased_at" field.
+func (uu *UserUpdate) SetErasedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetErasedAt(t)
+	return uu
+}
+
+// SetNillableErasedAt sets the "erased_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableErasedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetErasedAt(*t)
+	}
+	return uu
+}
+
+// ClearErasedAt clears the value of the "erased_at" field.
+func (uu *UserUpdate) ClearErasedAt() *UserUpdate {
+	uu.mutation.ClearErasedAt()
+	return uu
+}
+
 // AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
 func (uu *UserUpdate) AddTransactionIDs(ids ...string) *UserUpdate {
 	uu.mutation.AddTransactionIDs(ids...)
@@ -123,6 +240,51 @@ func (uu *UserUpdate) AddBalances(b ...*Balance) *UserUpdate {
 	return uu.AddBalanceIDs(ids...)
 }
 
+// AddAccountIDs adds the "accounts" edge to the Account entity by IDs.
+func (uu *UserUpdate) AddAccountIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddAccountIDs(ids...)
+	return uu
+}
+
+// AddAccounts adds the "accounts" edges to the Account entity.
+func (uu *UserUpdate) AddAccounts(a ...*Account) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAccountIDs(ids...)
+}
+
+// AddHoldIDs adds the "holds" edge to the Hold entity by IDs.
+func (uu *UserUpdate) AddHoldIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddHoldIDs(ids...)
+	return uu
+}
+
+// AddHolds adds the "holds" edges to the Hold entity.
+func (uu *UserUpdate) AddHolds(h ...*Hold) *UserUpdate {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uu.AddHoldIDs(ids...)
+}
+
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by IDs.
+func (uu *UserUpdate) AddScheduleIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddScheduleIDs(ids...)
+	return uu
+}
+
+// AddSchedules adds the "schedules" edges to the Schedule entity.
+func (uu *UserUpdate) AddSchedules(s ...*Schedule) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddScheduleIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -170,6 +332,69 @@ func (uu *UserUpdate) RemoveBalances(b ...*Balance) *UserUpdate {
 	return uu.RemoveBalanceIDs(ids...)
 }
 
+// ClearAccounts clears all "accounts" edges to the Account entity.
+func (uu *UserUpdate) ClearAccounts() *UserUpdate {
+	uu.mutation.ClearAccounts()
+	return uu
+}
+
+// RemoveAccountIDs removes the "accounts" edge to Account entities by IDs.
+func (uu *UserUpdate) RemoveAccountIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveAccountIDs(ids...)
+	return uu
+}
+
+// RemoveAccounts removes "accounts" edges to Account entities.
+func (uu *UserUpdate) RemoveAccounts(a ...*Account) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAccountIDs(ids...)
+}
+
+// ClearHolds clears all "holds" edges to the Hold entity.
+func (uu *UserUpdate) ClearHolds() *UserUpdate {
+	uu.mutation.ClearHolds()
+	return uu
+}
+
+// RemoveHoldIDs removes the "holds" edge to Hold entities by IDs.
+func (uu *UserUpdate) RemoveHoldIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveHoldIDs(ids...)
+	return uu
+}
+
+// RemoveHolds removes "holds" edges to Hold entities.
+func (uu *UserUpdate) RemoveHolds(h ...*Hold) *UserUpdate {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uu.RemoveHoldIDs(ids...)
+}
+
+// ClearSchedules clears all "schedules" edges to the Schedule entity.
+func (uu *UserUpdate) ClearSchedules() *UserUpdate {
+	uu.mutation.ClearSchedules()
+	return uu
+}
+
+// RemoveScheduleIDs removes the "schedules" edge to Schedule entities by IDs.
+func (uu *UserUpdate) RemoveScheduleIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveScheduleIDs(ids...)
+	return uu
+}
+
+// RemoveSchedules removes "schedules" edges to Schedule entities.
+func (uu *UserUpdate) RemoveSchedules(s ...*Schedule) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveScheduleIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
@@ -214,6 +439,16 @@ func (uu *UserUpdate) check() error {
 			return &ValidationError{Name: "age", err: fmt.Errorf(`ent: validator failed for field "User.age": %w`, err)}
 		}
 	}
+	if v, ok := uu.mutation.Tier(); ok {
+		if err := user.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`ent: validator failed for field "User.tier": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Status(); ok {
+		if err := user.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -241,9 +476,42 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.AddedAge(); ok {
 		_spec.AddField(user.FieldAge, field.TypeInt, value)
 	}
+	if uu.mutation.AgeCleared() {
+		_spec.ClearField(user.FieldAge, field.TypeInt)
+	}
+	if value, ok := uu.mutation.Tier(); ok {
+		_spec.SetField(user.FieldTier, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := uu.mutation.StatusReason(); ok {
+		_spec.SetField(user.FieldStatusReason, field.TypeString, value)
+	}
+	if uu.mutation.StatusReasonCleared() {
+		_spec.ClearField(user.FieldStatusReason, field.TypeString)
+	}
+	if value, ok := uu.mutation.StatusChangedAt(); ok {
+		_spec.SetField(user.FieldStatusChangedAt, field.TypeTime, value)
+	}
+	if uu.mutation.StatusChangedAtCleared() {
+		_spec.ClearField(user.FieldStatusChangedAt, field.TypeTime)
+	}
 	if value, ok := uu.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := uu.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.ErasedAt(); ok {
+		_spec.SetField(user.FieldErasedAt, field.TypeTime, value)
+	}
+	if uu.mutation.ErasedAtCleared() {
+		_spec.ClearField(user.FieldErasedAt, field.TypeTime)
+	}
 	if uu.mutation.TransactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -334,6 +602,141 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.AccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccountsTable,
+			Columns: []string{user.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedAccountsIDs(); len(nodes) > 0 && !uu.mutation.AccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccountsTable,
+			Columns: []string{user.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.AccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccountsTable,
+			Columns: []string{user.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.HoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HoldsTable,
+			Columns: []string{user.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedHoldsIDs(); len(nodes) > 0 && !uu.mutation.HoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HoldsTable,
+			Columns: []string{user.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.HoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HoldsTable,
+			Columns: []string{user.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SchedulesTable,
+			Columns: []string{user.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSchedulesIDs(); len(nodes) > 0 && !uu.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SchedulesTable,
+			Columns: []string{user.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SchedulesTable,
+			Columns: []string{user.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -403,6 +806,80 @@ func (uuo *UserUpdateOne) AddAge(i int) *UserUpdateOne {
 	return uuo
 }
 
+// ClearAge clears the value of the "age" field.
+func (uuo *UserUpdateOne) ClearAge() *UserUpdateOne {
+	uuo.mutation.ClearAge()
+	return uuo
+}
+
+// SetTier sets the "tier" field.
+func (uuo *UserUpdateOne) SetTier(s string) *UserUpdateOne {
+	uuo.mutation.SetTier(s)
+	return uuo
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTier(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetTier(*s)
+	}
+	return uuo
+}
+
+// SetStatus sets the "status" field.
+func (uuo *UserUpdateOne) SetStatus(u user.Status) *UserUpdateOne {
+	uuo.mutation.SetStatus(u)
+	return uuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableStatus(u *user.Status) *UserUpdateOne {
+	if u != nil {
+		uuo.SetStatus(*u)
+	}
+	return uuo
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (uuo *UserUpdateOne) SetStatusReason(s string) *UserUpdateOne {
+	uuo.mutation.SetStatusReason(s)
+	return uuo
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableStatusReason(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetStatusReason(*s)
+	}
+	return uuo
+}
+
+// ClearStatusReason clears the value of the "status_reason" field.
+func (uuo *UserUpdateOne) ClearStatusReason() *UserUpdateOne {
+	uuo.mutation.ClearStatusReason()
+	return uuo
+}
+
+// SetStatusChangedAt sets the "status_changed_at" field.
+func (uuo *UserUpdateOne) SetStatusChangedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetStatusChangedAt(t)
+	return uuo
+}
+
+// SetNillableStatusChangedAt sets the "status_changed_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableStatusChangedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetStatusChangedAt(*t)
+	}
+	return uuo
+}
+
+// ClearStatusChangedAt clears the value of the "status_changed_at" field.
+func (uuo *UserUpdateOne) ClearStatusChangedAt() *UserUpdateOne {
+	uuo.mutation.ClearStatusChangedAt()
+	return uuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uuo *UserUpdateOne) SetCreatedAt(t time.Time) *UserUpdateOne {
 	uuo.mutation.SetCreatedAt(t)
@@ -417,6 +894,46 @@ func (uuo *UserUpdateOne) SetNillableCreatedAt(t *time.Time) *UserUpdateOne {
 	return uuo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletedAt(t)
+	return uuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletedAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletedAt()
+	return uuo
+}
+
+// SetErasedAt sets the "erased_at" field.
+func (uuo *UserUpdateOne) SetErasedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetErasedAt(t)
+	return uuo
+}
+
+// SetNillableErasedAt sets the "erased_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableErasedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetErasedAt(*t)
+	}
+	return uuo
+}
+
+// ClearErasedAt clears the value of the "erased_at" field.
+func (uuo *UserUpdateOne) ClearErasedAt() *UserUpdateOne {
+	uuo.mutation.ClearErasedAt()
+	return uuo
+}
+
 // AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
 func (uuo *UserUpdateOne) AddTransactionIDs(ids ...string) *UserUpdateOne {
 	uuo.mutation.AddTransactionIDs(ids...)
@@ -447,6 +964,51 @@ func (uuo *UserUpdateOne) AddBalances(b ...*Balance) *UserUpdateOne {
 	return uuo.AddBalanceIDs(ids...)
 }
 
+// AddAccountIDs adds the "accounts" edge to the Account entity by IDs.
+func (uuo *UserUpdateOne) AddAccountIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddAccountIDs(ids...)
+	return uuo
+}
+
+// AddAccounts adds the "accounts" edges to the Account entity.
+func (uuo *UserUpdateOne) AddAccounts(a ...*Account) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAccountIDs(ids...)
+}
+
+// AddHoldIDs adds the "holds" edge to the Hold entity by IDs.
+func (uuo *UserUpdateOne) AddHoldIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddHoldIDs(ids...)
+	return uuo
+}
+
+// AddHolds adds the "holds" edges to the Hold entity.
+func (uuo *UserUpdateOne) AddHolds(h ...*Hold) *UserUpdateOne {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uuo.AddHoldIDs(ids...)
+}
+
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by IDs.
+func (uuo *UserUpdateOne) AddScheduleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddScheduleIDs(ids...)
+	return uuo
+}
+
+// AddSchedules adds the "schedules" edges to the Schedule entity.
+func (uuo *UserUpdateOne) AddSchedules(s ...*Schedule) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddScheduleIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -494,6 +1056,69 @@ func (uuo *UserUpdateOne) RemoveBalances(b ...*Balance) *UserUpdateOne {
 	return uuo.RemoveBalanceIDs(ids...)
 }
 
+// ClearAccounts clears all "accounts" edges to the Account entity.
+func (uuo *UserUpdateOne) ClearAccounts() *UserUpdateOne {
+	uuo.mutation.ClearAccounts()
+	return uuo
+}
+
+// RemoveAccountIDs removes the "accounts" edge to Account entities by IDs.
+func (uuo *UserUpdateOne) RemoveAccountIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveAccountIDs(ids...)
+	return uuo
+}
+
+// RemoveAccounts removes "accounts" edges to Account entities.
+func (uuo *UserUpdateOne) RemoveAccounts(a ...*Account) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAccountIDs(ids...)
+}
+
+// ClearHolds clears all "holds" edges to the Hold entity.
+func (uuo *UserUpdateOne) ClearHolds() *UserUpdateOne {
+	uuo.mutation.ClearHolds()
+	return uuo
+}
+
+// RemoveHoldIDs removes the "holds" edge to Hold entities by IDs.
+func (uuo *UserUpdateOne) RemoveHoldIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveHoldIDs(ids...)
+	return uuo
+}
+
+// RemoveHolds removes "holds" edges to Hold entities.
+func (uuo *UserUpdateOne) RemoveHolds(h ...*Hold) *UserUpdateOne {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uuo.RemoveHoldIDs(ids...)
+}
+
+// ClearSchedules clears all "schedules" edges to the Schedule entity.
+func (uuo *UserUpdateOne) ClearSchedules() *UserUpdateOne {
+	uuo.mutation.ClearSchedules()
+	return uuo
+}
+
+// RemoveScheduleIDs removes the "schedules" edge to Schedule entities by IDs.
+func (uuo *UserUpdateOne) RemoveScheduleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveScheduleIDs(ids...)
+	return uuo
+}
+
+// RemoveSchedules removes "schedules" edges to Schedule entities.
+func (uuo *UserUpdateOne) RemoveSchedules(s ...*Schedule) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveScheduleIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -551,6 +1176,16 @@ func (uuo *UserUpdateOne) check() error {
 			return &ValidationError{Name: "age", err: fmt.Errorf(`ent: validator failed for field "User.age": %w`, err)}
 		}
 	}
+	if v, ok := uuo.mutation.Tier(); ok {
+		if err := user.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`ent: validator failed for field "User.tier": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Status(); ok {
+		if err := user.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -595,9 +1230,42 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.AddedAge(); ok {
 		_spec.AddField(user.FieldAge, field.TypeInt, value)
 	}
+	if uuo.mutation.AgeCleared() {
+		_spec.ClearField(user.FieldAge, field.TypeInt)
+	}
+	if value, ok := uuo.mutation.Tier(); ok {
+		_spec.SetField(user.FieldTier, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := uuo.mutation.StatusReason(); ok {
+		_spec.SetField(user.FieldStatusReason, field.TypeString, value)
+	}
+	if uuo.mutation.StatusReasonCleared() {
+		_spec.ClearField(user.FieldStatusReason, field.TypeString)
+	}
+	if value, ok := uuo.mutation.StatusChangedAt(); ok {
+		_spec.SetField(user.FieldStatusChangedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.StatusChangedAtCleared() {
+		_spec.ClearField(user.FieldStatusChangedAt, field.TypeTime)
+	}
 	if value, ok := uuo.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := uuo.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.ErasedAt(); ok {
+		_spec.SetField(user.FieldErasedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.ErasedAtCleared() {
+		_spec.ClearField(user.FieldErasedAt, field.TypeTime)
+	}
 	if uuo.mutation.TransactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -688,6 +1356,141 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.AccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccountsTable,
+			Columns: []string{user.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedAccountsIDs(); len(nodes) > 0 && !uuo.mutation.AccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccountsTable,
+			Columns: []string{user.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.AccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccountsTable,
+			Columns: []string{user.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.HoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HoldsTable,
+			Columns: []string{user.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedHoldsIDs(); len(nodes) > 0 && !uuo.mutation.HoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HoldsTable,
+			Columns: []string{user.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.HoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HoldsTable,
+			Columns: []string{user.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SchedulesTable,
+			Columns: []string{user.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedSchedulesIDs(); len(nodes) > 0 && !uuo.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SchedulesTable,
+			Columns: []string{user.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SchedulesTable,
+			Columns: []string{user.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/velocitylimit"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// VelocityLimit is the model entity for the VelocityLimit schema.
+type VelocityLimit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Human-readable name of the limit
+	Name string `json:"name,omitempty"`
+	// Type of the transactions the limit applies to
+	TransactionType velocitylimit.TransactionType `json:"transaction_type,omitempty"`
+	// Currency of the limited transactions and of max_amount
+	Currency string `json:"currency,omitempty"`
+	// User tier the limit is limited to; empty matches every tier
+	Tier *string `json:"tier,omitempty"`
+	// Length of the rolling window in seconds
+	WindowSeconds int64 `json:"window_seconds,omitempty"`
+	// Maximum number of transactions within the window
+	MaxCount *int `json:"max_count,omitempty"`
+	// Maximum total amount of the transactions within the window
+	MaxAmount *decimal.Decimal `json:"max_amount,omitempty"`
+	// Whether the limit is enforced
+	Enabled bool `json:"enabled,omitempty"`
+	// Time of the limit creation
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Time of the last limit update
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VelocityLimit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case velocitylimit.FieldMaxAmount:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case velocitylimit.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case velocitylimit.FieldID, velocitylimit.FieldWindowSeconds, velocitylimit.FieldMaxCount:
+			values[i] = new(sql.NullInt64)
+		case velocitylimit.FieldName, velocitylimit.FieldTransactionType, velocitylimit.FieldCurrency, velocitylimit.FieldTier:
+			values[i] = new(sql.NullString)
+		case velocitylimit.FieldCreatedAt, velocitylimit.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VelocityLimit fields.
+func (vl *VelocityLimit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case velocitylimit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			vl.ID = int(value.Int64)
+		case velocitylimit.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				vl.Name = value.String
+			}
+		case velocitylimit.FieldTransactionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_type", values[i])
+			} else if value.Valid {
+				vl.TransactionType = velocitylimit.TransactionType(value.String)
+			}
+		case velocitylimit.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				vl.Currency = value.String
+			}
+		case velocitylimit.FieldTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tier", values[i])
+			} else if value.Valid {
+				vl.Tier = new(string)
+				*vl.Tier = value.String
+			}
+		case velocitylimit.FieldWindowSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field window_seconds", values[i])
+			} else if value.Valid {
+				vl.WindowSeconds = value.Int64
+			}
+		case velocitylimit.FieldMaxCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_count", values[i])
+			} else if value.Valid {
+				vl.MaxCount = new(int)
+				*vl.MaxCount = int(value.Int64)
+			}
+		case velocitylimit.FieldMaxAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field max_amount", values[i])
+			} else if value.Valid {
+				vl.MaxAmount = new(decimal.Decimal)
+				*vl.MaxAmount = *value.S.(*decimal.Decimal)
+			}
+		case velocitylimit.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				vl.Enabled = value.Bool
+			}
+		case velocitylimit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				vl.CreatedAt = value.Time
+			}
+		case velocitylimit.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				vl.UpdatedAt = value.Time
+			}
+		default:
+			vl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VelocityLimit.
+// This includes values selected through modifiers, order, etc.
+func (vl *VelocityLimit) Value(name string) (ent.Value, error) {
+	return vl.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VelocityLimit.
+// Note that you need to call VelocityLimit.Unwrap() before calling this method if this VelocityLimit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vl *VelocityLimit) Update() *VelocityLimitUpdateOne {
+	return NewVelocityLimitClient(vl.config).UpdateOne(vl)
+}
+
+// Unwrap unwraps the VelocityLimit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vl *VelocityLimit) Unwrap() *VelocityLimit {
+	_tx, ok := vl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VelocityLimit is not a transactional entity")
+	}
+	vl.config.driver = _tx.drv
+	return vl
+}
+
+// String implements the fmt.Stringer.
+func (vl *VelocityLimit) String() string {
+	var builder strings.Builder
+	builder.WriteString("VelocityLimit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vl.ID))
+	builder.WriteString("name=")
+	builder.WriteString(vl.Name)
+	builder.WriteString(", ")
+	builder.WriteString("transaction_type=")
+	builder.WriteString(fmt.Sprintf("%v", vl.TransactionType))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(vl.Currency)
+	builder.WriteString(", ")
+	if v := vl.Tier; v != nil {
+		builder.WriteString("tier=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("window_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", vl.WindowSeconds))
+	builder.WriteString(", ")
+	if v := vl.MaxCount; v != nil {
+		builder.WriteString("max_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := vl.MaxAmount; v != nil {
+		builder.WriteString("max_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", vl.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(vl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(vl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VelocityLimits is a parsable slice of VelocityLimit.
+type VelocityLimits []*VelocityLimit
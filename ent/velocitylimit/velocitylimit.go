@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package velocitylimit
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the velocitylimit type in the database.
+	Label = "velocity_limit"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldTransactionType holds the string denoting the transaction_type field in the database.
+	FieldTransactionType = "transaction_type"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldTier holds the string denoting the tier field in the database.
+	FieldTier = "tier"
+	// FieldWindowSeconds holds the string denoting the window_seconds field in the database.
+	FieldWindowSeconds = "window_seconds"
+	// FieldMaxCount holds the string denoting the max_count field in the database.
+	FieldMaxCount = "max_count"
+	// FieldMaxAmount holds the string denoting the max_amount field in the database.
+	FieldMaxAmount = "max_amount"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the velocitylimit in the database.
+	Table = "velocity_limits"
+)
+
+// Columns holds all SQL columns for velocitylimit fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldTransactionType,
+	FieldCurrency,
+	FieldTier,
+	FieldWindowSeconds,
+	FieldMaxCount,
+	FieldMaxAmount,
+	FieldEnabled,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// WindowSecondsValidator is a validator for the "window_seconds" field. It is called by the builders before save.
+	WindowSecondsValidator func(int64) error
+	// MaxCountValidator is a validator for the "max_count" field. It is called by the builders before save.
+	MaxCountValidator func(int) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// TransactionType defines the type for the "transaction_type" enum field.
+type TransactionType string
+
+// TransactionType values.
+const (
+	TransactionTypeDeposit    TransactionType = "deposit"
+	TransactionTypeWithdrawal TransactionType = "withdrawal"
+)
+
+func (tt TransactionType) String() string {
+	return string(tt)
+}
+
+// TransactionTypeValidator is a validator for the "transaction_type" field enum values. It is called by the builders before save.
+func TransactionTypeValidator(tt TransactionType) error {
+	switch tt {
+	case TransactionTypeDeposit, TransactionTypeWithdrawal:
+		return nil
+	default:
+		return fmt.Errorf("velocitylimit: invalid enum value for transaction_type field: %q", tt)
+	}
+}
+
+// OrderOption defines the ordering options for the VelocityLimit queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByTransactionType orders the results by the transaction_type field.
+func ByTransactionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionType, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByTier orders the results by the tier field.
+func ByTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTier, opts...).ToFunc()
+}
+
+// ByWindowSeconds orders the results by the window_seconds field.
+func ByWindowSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowSeconds, opts...).ToFunc()
+}
+
+// ByMaxCount orders the results by the max_count field.
+func ByMaxCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxCount, opts...).ToFunc()
+}
+
+// ByMaxAmount orders the results by the max_amount field.
+func ByMaxAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAmount, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
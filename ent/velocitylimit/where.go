@@ -0,0 +1,571 @@
+// Code generated by ent, DO NOT EDIT.
+
+package velocitylimit
+
+import (
+	"accounting/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldName, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Tier applies equality check predicate on the "tier" field. It's identical to TierEQ.
+func Tier(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldTier, v))
+}
+
+// WindowSeconds applies equality check predicate on the "window_seconds" field. It's identical to WindowSecondsEQ.
+func WindowSeconds(v int64) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldWindowSeconds, v))
+}
+
+// MaxCount applies equality check predicate on the "max_count" field. It's identical to MaxCountEQ.
+func MaxCount(v int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldMaxCount, v))
+}
+
+// MaxAmount applies equality check predicate on the "max_amount" field. It's identical to MaxAmountEQ.
+func MaxAmount(v decimal.Decimal) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldMaxAmount, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldContainsFold(FieldName, v))
+}
+
+// TransactionTypeEQ applies the EQ predicate on the "transaction_type" field.
+func TransactionTypeEQ(v TransactionType) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldTransactionType, v))
+}
+
+// TransactionTypeNEQ applies the NEQ predicate on the "transaction_type" field.
+func TransactionTypeNEQ(v TransactionType) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNEQ(FieldTransactionType, v))
+}
+
+// TransactionTypeIn applies the In predicate on the "transaction_type" field.
+func TransactionTypeIn(vs ...TransactionType) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldIn(FieldTransactionType, vs...))
+}
+
+// TransactionTypeNotIn applies the NotIn predicate on the "transaction_type" field.
+func TransactionTypeNotIn(vs ...TransactionType) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNotIn(FieldTransactionType, vs...))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// TierEQ applies the EQ predicate on the "tier" field.
+func TierEQ(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldTier, v))
+}
+
+// TierNEQ applies the NEQ predicate on the "tier" field.
+func TierNEQ(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNEQ(FieldTier, v))
+}
+
+// TierIn applies the In predicate on the "tier" field.
+func TierIn(vs ...string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldIn(FieldTier, vs...))
+}
+
+// TierNotIn applies the NotIn predicate on the "tier" field.
+func TierNotIn(vs ...string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNotIn(FieldTier, vs...))
+}
+
+// TierGT applies the GT predicate on the "tier" field.
+func TierGT(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGT(FieldTier, v))
+}
+
+// TierGTE applies the GTE predicate on the "tier" field.
+func TierGTE(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGTE(FieldTier, v))
+}
+
+// TierLT applies the LT predicate on the "tier" field.
+func TierLT(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLT(FieldTier, v))
+}
+
+// TierLTE applies the LTE predicate on the "tier" field.
+func TierLTE(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLTE(FieldTier, v))
+}
+
+// TierContains applies the Contains predicate on the "tier" field.
+func TierContains(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldContains(FieldTier, v))
+}
+
+// TierHasPrefix applies the HasPrefix predicate on the "tier" field.
+func TierHasPrefix(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldHasPrefix(FieldTier, v))
+}
+
+// TierHasSuffix applies the HasSuffix predicate on the "tier" field.
+func TierHasSuffix(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldHasSuffix(FieldTier, v))
+}
+
+// TierIsNil applies the IsNil predicate on the "tier" field.
+func TierIsNil() predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldIsNull(FieldTier))
+}
+
+// TierNotNil applies the NotNil predicate on the "tier" field.
+func TierNotNil() predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNotNull(FieldTier))
+}
+
+// TierEqualFold applies the EqualFold predicate on the "tier" field.
+func TierEqualFold(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEqualFold(FieldTier, v))
+}
+
+// TierContainsFold applies the ContainsFold predicate on the "tier" field.
+func TierContainsFold(v string) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldContainsFold(FieldTier, v))
+}
+
+// WindowSecondsEQ applies the EQ predicate on the "window_seconds" field.
+func WindowSecondsEQ(v int64) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldWindowSeconds, v))
+}
+
+// WindowSecondsNEQ applies the NEQ predicate on the "window_seconds" field.
+func WindowSecondsNEQ(v int64) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNEQ(FieldWindowSeconds, v))
+}
+
+// WindowSecondsIn applies the In predicate on the "window_seconds" field.
+func WindowSecondsIn(vs ...int64) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldIn(FieldWindowSeconds, vs...))
+}
+
+// WindowSecondsNotIn applies the NotIn predicate on the "window_seconds" field.
+func WindowSecondsNotIn(vs ...int64) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNotIn(FieldWindowSeconds, vs...))
+}
+
+// WindowSecondsGT applies the GT predicate on the "window_seconds" field.
+func WindowSecondsGT(v int64) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGT(FieldWindowSeconds, v))
+}
+
+// WindowSecondsGTE applies the GTE predicate on the "window_seconds" field.
+func WindowSecondsGTE(v int64) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGTE(FieldWindowSeconds, v))
+}
+
+// WindowSecondsLT applies the LT predicate on the "window_seconds" field.
+func WindowSecondsLT(v int64) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLT(FieldWindowSeconds, v))
+}
+
+// WindowSecondsLTE applies the LTE predicate on the "window_seconds" field.
+func WindowSecondsLTE(v int64) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLTE(FieldWindowSeconds, v))
+}
+
+// MaxCountEQ applies the EQ predicate on the "max_count" field.
+func MaxCountEQ(v int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldMaxCount, v))
+}
+
+// MaxCountNEQ applies the NEQ predicate on the "max_count" field.
+func MaxCountNEQ(v int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNEQ(FieldMaxCount, v))
+}
+
+// MaxCountIn applies the In predicate on the "max_count" field.
+func MaxCountIn(vs ...int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldIn(FieldMaxCount, vs...))
+}
+
+// MaxCountNotIn applies the NotIn predicate on the "max_count" field.
+func MaxCountNotIn(vs ...int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNotIn(FieldMaxCount, vs...))
+}
+
+// MaxCountGT applies the GT predicate on the "max_count" field.
+func MaxCountGT(v int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGT(FieldMaxCount, v))
+}
+
+// MaxCountGTE applies the GTE predicate on the "max_count" field.
+func MaxCountGTE(v int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGTE(FieldMaxCount, v))
+}
+
+// MaxCountLT applies the LT predicate on the "max_count" field.
+func MaxCountLT(v int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLT(FieldMaxCount, v))
+}
+
+// MaxCountLTE applies the LTE predicate on the "max_count" field.
+func MaxCountLTE(v int) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLTE(FieldMaxCount, v))
+}
+
+// MaxCountIsNil applies the IsNil predicate on the "max_count" field.
+func MaxCountIsNil() predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldIsNull(FieldMaxCount))
+}
+
+// MaxCountNotNil applies the NotNil predicate on the "max_count" field.
+func MaxCountNotNil() predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNotNull(FieldMaxCount))
+}
+
+// MaxAmountEQ applies the EQ predicate on the "max_amount" field.
+func MaxAmountEQ(v decimal.Decimal) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldMaxAmount, v))
+}
+
+// MaxAmountNEQ applies the NEQ predicate on the "max_amount" field.
+func MaxAmountNEQ(v decimal.Decimal) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNEQ(FieldMaxAmount, v))
+}
+
+// MaxAmountIn applies the In predicate on the "max_amount" field.
+func MaxAmountIn(vs ...decimal.Decimal) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldIn(FieldMaxAmount, vs...))
+}
+
+// MaxAmountNotIn applies the NotIn predicate on the "max_amount" field.
+func MaxAmountNotIn(vs ...decimal.Decimal) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNotIn(FieldMaxAmount, vs...))
+}
+
+// MaxAmountGT applies the GT predicate on the "max_amount" field.
+func MaxAmountGT(v decimal.Decimal) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGT(FieldMaxAmount, v))
+}
+
+// MaxAmountGTE applies the GTE predicate on the "max_amount" field.
+func MaxAmountGTE(v decimal.Decimal) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGTE(FieldMaxAmount, v))
+}
+
+// MaxAmountLT applies the LT predicate on the "max_amount" field.
+func MaxAmountLT(v decimal.Decimal) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLT(FieldMaxAmount, v))
+}
+
+// MaxAmountLTE applies the LTE predicate on the "max_amount" field.
+func MaxAmountLTE(v decimal.Decimal) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLTE(FieldMaxAmount, v))
+}
+
+// MaxAmountIsNil applies the IsNil predicate on the "max_amount" field.
+func MaxAmountIsNil() predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldIsNull(FieldMaxAmount))
+}
+
+// MaxAmountNotNil applies the NotNil predicate on the "max_amount" field.
+func MaxAmountNotNil() predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNotNull(FieldMaxAmount))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VelocityLimit) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VelocityLimit) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VelocityLimit) predicate.VelocityLimit {
+	return predicate.VelocityLimit(sql.NotPredicates(p))
+}
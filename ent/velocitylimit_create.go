@@ -0,0 +1,1107 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/velocitylimit"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// VelocityLimitCreate is the builder for creating a VelocityLimit entity.
+type VelocityLimitCreate struct {
+	config
+	mutation *VelocityLimitMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (vlc *VelocityLimitCreate) SetName(s string) *VelocityLimitCreate {
+	vlc.mutation.SetName(s)
+	return vlc
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (vlc *VelocityLimitCreate) SetTransactionType(vt velocitylimit.TransactionType) *VelocityLimitCreate {
+	vlc.mutation.SetTransactionType(vt)
+	return vlc
+}
+
+// SetCurrency sets the "currency" field.
+func (vlc *VelocityLimitCreate) SetCurrency(s string) *VelocityLimitCreate {
+	vlc.mutation.SetCurrency(s)
+	return vlc
+}
+
+// SetTier sets the "tier" field.
+func (vlc *VelocityLimitCreate) SetTier(s string) *VelocityLimitCreate {
+	vlc.mutation.SetTier(s)
+	return vlc
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (vlc *VelocityLimitCreate) SetNillableTier(s *string) *VelocityLimitCreate {
+	if s != nil {
+		vlc.SetTier(*s)
+	}
+	return vlc
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (vlc *VelocityLimitCreate) SetWindowSeconds(i int64) *VelocityLimitCreate {
+	vlc.mutation.SetWindowSeconds(i)
+	return vlc
+}
+
+// SetMaxCount sets the "max_count" field.
+func (vlc *VelocityLimitCreate) SetMaxCount(i int) *VelocityLimitCreate {
+	vlc.mutation.SetMaxCount(i)
+	return vlc
+}
+
+// SetNillableMaxCount sets the "max_count" field if the given value is not nil.
+func (vlc *VelocityLimitCreate) SetNillableMaxCount(i *int) *VelocityLimitCreate {
+	if i != nil {
+		vlc.SetMaxCount(*i)
+	}
+	return vlc
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (vlc *VelocityLimitCreate) SetMaxAmount(d decimal.Decimal) *VelocityLimitCreate {
+	vlc.mutation.SetMaxAmount(d)
+	return vlc
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (vlc *VelocityLimitCreate) SetNillableMaxAmount(d *decimal.Decimal) *VelocityLimitCreate {
+	if d != nil {
+		vlc.SetMaxAmount(*d)
+	}
+	return vlc
+}
+
+// SetEnabled sets the "enabled" field.
+func (vlc *VelocityLimitCreate) SetEnabled(b bool) *VelocityLimitCreate {
+	vlc.mutation.SetEnabled(b)
+	return vlc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (vlc *VelocityLimitCreate) SetNillableEnabled(b *bool) *VelocityLimitCreate {
+	if b != nil {
+		vlc.SetEnabled(*b)
+	}
+	return vlc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vlc *VelocityLimitCreate) SetCreatedAt(t time.Time) *VelocityLimitCreate {
+	vlc.mutation.SetCreatedAt(t)
+	return vlc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vlc *VelocityLimitCreate) SetNillableCreatedAt(t *time.Time) *VelocityLimitCreate {
+	if t != nil {
+		vlc.SetCreatedAt(*t)
+	}
+	return vlc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vlc *VelocityLimitCreate) SetUpdatedAt(t time.Time) *VelocityLimitCreate {
+	vlc.mutation.SetUpdatedAt(t)
+	return vlc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (vlc *VelocityLimitCreate) SetNillableUpdatedAt(t *time.Time) *VelocityLimitCreate {
+	if t != nil {
+		vlc.SetUpdatedAt(*t)
+	}
+	return vlc
+}
+
+// Mutation returns the VelocityLimitMutation object of the builder.
+func (vlc *VelocityLimitCreate) Mutation() *VelocityLimitMutation {
+	return vlc.mutation
+}
+
+// Save creates the VelocityLimit in the database.
+func (vlc *VelocityLimitCreate) Save(ctx context.Context) (*VelocityLimit, error) {
+	vlc.defaults()
+	return withHooks(ctx, vlc.sqlSave, vlc.mutation, vlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vlc *VelocityLimitCreate) SaveX(ctx context.Context) *VelocityLimit {
+	v, err := vlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vlc *VelocityLimitCreate) Exec(ctx context.Context) error {
+	_, err := vlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vlc *VelocityLimitCreate) ExecX(ctx context.Context) {
+	if err := vlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vlc *VelocityLimitCreate) defaults() {
+	if _, ok := vlc.mutation.Enabled(); !ok {
+		v := velocitylimit.DefaultEnabled
+		vlc.mutation.SetEnabled(v)
+	}
+	if _, ok := vlc.mutation.CreatedAt(); !ok {
+		v := velocitylimit.DefaultCreatedAt()
+		vlc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := vlc.mutation.UpdatedAt(); !ok {
+		v := velocitylimit.DefaultUpdatedAt()
+		vlc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vlc *VelocityLimitCreate) check() error {
+	if _, ok := vlc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "VelocityLimit.name"`)}
+	}
+	if v, ok := vlc.mutation.Name(); ok {
+		if err := velocitylimit.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.name": %w`, err)}
+		}
+	}
+	if _, ok := vlc.mutation.TransactionType(); !ok {
+		return &ValidationError{Name: "transaction_type", err: errors.New(`ent: missing required field "VelocityLimit.transaction_type"`)}
+	}
+	if v, ok := vlc.mutation.TransactionType(); ok {
+		if err := velocitylimit.TransactionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_type", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.transaction_type": %w`, err)}
+		}
+	}
+	if _, ok := vlc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "VelocityLimit.currency"`)}
+	}
+	if v, ok := vlc.mutation.Currency(); ok {
+		if err := velocitylimit.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.currency": %w`, err)}
+		}
+	}
+	if _, ok := vlc.mutation.WindowSeconds(); !ok {
+		return &ValidationError{Name: "window_seconds", err: errors.New(`ent: missing required field "VelocityLimit.window_seconds"`)}
+	}
+	if v, ok := vlc.mutation.WindowSeconds(); ok {
+		if err := velocitylimit.WindowSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "window_seconds", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.window_seconds": %w`, err)}
+		}
+	}
+	if v, ok := vlc.mutation.MaxCount(); ok {
+		if err := velocitylimit.MaxCountValidator(v); err != nil {
+			return &ValidationError{Name: "max_count", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.max_count": %w`, err)}
+		}
+	}
+	if _, ok := vlc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "VelocityLimit.enabled"`)}
+	}
+	if _, ok := vlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VelocityLimit.created_at"`)}
+	}
+	if _, ok := vlc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "VelocityLimit.updated_at"`)}
+	}
+	return nil
+}
+
+func (vlc *VelocityLimitCreate) sqlSave(ctx context.Context) (*VelocityLimit, error) {
+	if err := vlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	vlc.mutation.id = &_node.ID
+	vlc.mutation.done = true
+	return _node, nil
+}
+
+func (vlc *VelocityLimitCreate) createSpec() (*VelocityLimit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VelocityLimit{config: vlc.config}
+		_spec = sqlgraph.NewCreateSpec(velocitylimit.Table, sqlgraph.NewFieldSpec(velocitylimit.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = vlc.conflict
+	if value, ok := vlc.mutation.Name(); ok {
+		_spec.SetField(velocitylimit.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := vlc.mutation.TransactionType(); ok {
+		_spec.SetField(velocitylimit.FieldTransactionType, field.TypeEnum, value)
+		_node.TransactionType = value
+	}
+	if value, ok := vlc.mutation.Currency(); ok {
+		_spec.SetField(velocitylimit.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := vlc.mutation.Tier(); ok {
+		_spec.SetField(velocitylimit.FieldTier, field.TypeString, value)
+		_node.Tier = &value
+	}
+	if value, ok := vlc.mutation.WindowSeconds(); ok {
+		_spec.SetField(velocitylimit.FieldWindowSeconds, field.TypeInt64, value)
+		_node.WindowSeconds = value
+	}
+	if value, ok := vlc.mutation.MaxCount(); ok {
+		_spec.SetField(velocitylimit.FieldMaxCount, field.TypeInt, value)
+		_node.MaxCount = &value
+	}
+	if value, ok := vlc.mutation.MaxAmount(); ok {
+		_spec.SetField(velocitylimit.FieldMaxAmount, field.TypeFloat64, value)
+		_node.MaxAmount = &value
+	}
+	if value, ok := vlc.mutation.Enabled(); ok {
+		_spec.SetField(velocitylimit.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := vlc.mutation.CreatedAt(); ok {
+		_spec.SetField(velocitylimit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := vlc.mutation.UpdatedAt(); ok {
+		_spec.SetField(velocitylimit.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VelocityLimit.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VelocityLimitUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (vlc *VelocityLimitCreate) OnConflict(opts ...sql.ConflictOption) *VelocityLimitUpsertOne {
+	vlc.conflict = opts
+	return &VelocityLimitUpsertOne{
+		create: vlc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VelocityLimit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vlc *VelocityLimitCreate) OnConflictColumns(columns ...string) *VelocityLimitUpsertOne {
+	vlc.conflict = append(vlc.conflict, sql.ConflictColumns(columns...))
+	return &VelocityLimitUpsertOne{
+		create: vlc,
+	}
+}
+
+type (
+	// VelocityLimitUpsertOne is the builder for "upsert"-ing
+	//  one VelocityLimit node.
+	VelocityLimitUpsertOne struct {
+		create *VelocityLimitCreate
+	}
+
+	// VelocityLimitUpsert is the "OnConflict" setter.
+	VelocityLimitUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *VelocityLimitUpsert) SetName(v string) *VelocityLimitUpsert {
+	u.Set(velocitylimit.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *VelocityLimitUpsert) UpdateName() *VelocityLimitUpsert {
+	u.SetExcluded(velocitylimit.FieldName)
+	return u
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (u *VelocityLimitUpsert) SetTransactionType(v velocitylimit.TransactionType) *VelocityLimitUpsert {
+	u.Set(velocitylimit.FieldTransactionType, v)
+	return u
+}
+
+// UpdateTransactionType sets the "transaction_type" field to the value that was provided on create.
+func (u *VelocityLimitUpsert) UpdateTransactionType() *VelocityLimitUpsert {
+	u.SetExcluded(velocitylimit.FieldTransactionType)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *VelocityLimitUpsert) SetCurrency(v string) *VelocityLimitUpsert {
+	u.Set(velocitylimit.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *VelocityLimitUpsert) UpdateCurrency() *VelocityLimitUpsert {
+	u.SetExcluded(velocitylimit.FieldCurrency)
+	return u
+}
+
+// SetTier sets the "tier" field.
+func (u *VelocityLimitUpsert) SetTier(v string) *VelocityLimitUpsert {
+	u.Set(velocitylimit.FieldTier, v)
+	return u
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *VelocityLimitUpsert) UpdateTier() *VelocityLimitUpsert {
+	u.SetExcluded(velocitylimit.FieldTier)
+	return u
+}
+
+// ClearTier clears the value of the "tier" field.
+func (u *VelocityLimitUpsert) ClearTier() *VelocityLimitUpsert {
+	u.SetNull(velocitylimit.FieldTier)
+	return u
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (u *VelocityLimitUpsert) SetWindowSeconds(v int64) *VelocityLimitUpsert {
+	u.Set(velocitylimit.FieldWindowSeconds, v)
+	return u
+}
+
+// UpdateWindowSeconds sets the "window_seconds" field to the value that was provided on create.
+func (u *VelocityLimitUpsert) UpdateWindowSeconds() *VelocityLimitUpsert {
+	u.SetExcluded(velocitylimit.FieldWindowSeconds)
+	return u
+}
+
+// AddWindowSeconds adds v to the "window_seconds" field.
+func (u *VelocityLimitUpsert) AddWindowSeconds(v int64) *VelocityLimitUpsert {
+	u.Add(velocitylimit.FieldWindowSeconds, v)
+	return u
+}
+
+// SetMaxCount sets the "max_count" field.
+func (u *VelocityLimitUpsert) SetMaxCount(v int) *VelocityLimitUpsert {
+	u.Set(velocitylimit.FieldMaxCount, v)
+	return u
+}
+
+// UpdateMaxCount sets the "max_count" field to the value that was provided on create.
+func (u *VelocityLimitUpsert) UpdateMaxCount() *VelocityLimitUpsert {
+	u.SetExcluded(velocitylimit.FieldMaxCount)
+	return u
+}
+
+// AddMaxCount adds v to the "max_count" field.
+func (u *VelocityLimitUpsert) AddMaxCount(v int) *VelocityLimitUpsert {
+	u.Add(velocitylimit.FieldMaxCount, v)
+	return u
+}
+
+// ClearMaxCount clears the value of the "max_count" field.
+func (u *VelocityLimitUpsert) ClearMaxCount() *VelocityLimitUpsert {
+	u.SetNull(velocitylimit.FieldMaxCount)
+	return u
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *VelocityLimitUpsert) SetMaxAmount(v decimal.Decimal) *VelocityLimitUpsert {
+	u.Set(velocitylimit.FieldMaxAmount, v)
+	return u
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *VelocityLimitUpsert) UpdateMaxAmount() *VelocityLimitUpsert {
+	u.SetExcluded(velocitylimit.FieldMaxAmount)
+	return u
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *VelocityLimitUpsert) AddMaxAmount(v decimal.Decimal) *VelocityLimitUpsert {
+	u.Add(velocitylimit.FieldMaxAmount, v)
+	return u
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (u *VelocityLimitUpsert) ClearMaxAmount() *VelocityLimitUpsert {
+	u.SetNull(velocitylimit.FieldMaxAmount)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *VelocityLimitUpsert) SetEnabled(v bool) *VelocityLimitUpsert {
+	u.Set(velocitylimit.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *VelocityLimitUpsert) UpdateEnabled() *VelocityLimitUpsert {
+	u.SetExcluded(velocitylimit.FieldEnabled)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VelocityLimitUpsert) SetUpdatedAt(v time.Time) *VelocityLimitUpsert {
+	u.Set(velocitylimit.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VelocityLimitUpsert) UpdateUpdatedAt() *VelocityLimitUpsert {
+	u.SetExcluded(velocitylimit.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.VelocityLimit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *VelocityLimitUpsertOne) UpdateNewValues() *VelocityLimitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(velocitylimit.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VelocityLimit.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VelocityLimitUpsertOne) Ignore() *VelocityLimitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VelocityLimitUpsertOne) DoNothing() *VelocityLimitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VelocityLimitCreate.OnConflict
+// documentation for more info.
+func (u *VelocityLimitUpsertOne) Update(set func(*VelocityLimitUpsert)) *VelocityLimitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VelocityLimitUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *VelocityLimitUpsertOne) SetName(v string) *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *VelocityLimitUpsertOne) UpdateName() *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (u *VelocityLimitUpsertOne) SetTransactionType(v velocitylimit.TransactionType) *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetTransactionType(v)
+	})
+}
+
+// UpdateTransactionType sets the "transaction_type" field to the value that was provided on create.
+func (u *VelocityLimitUpsertOne) UpdateTransactionType() *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateTransactionType()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *VelocityLimitUpsertOne) SetCurrency(v string) *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *VelocityLimitUpsertOne) UpdateCurrency() *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetTier sets the "tier" field.
+func (u *VelocityLimitUpsertOne) SetTier(v string) *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetTier(v)
+	})
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *VelocityLimitUpsertOne) UpdateTier() *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateTier()
+	})
+}
+
+// ClearTier clears the value of the "tier" field.
+func (u *VelocityLimitUpsertOne) ClearTier() *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.ClearTier()
+	})
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (u *VelocityLimitUpsertOne) SetWindowSeconds(v int64) *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetWindowSeconds(v)
+	})
+}
+
+// AddWindowSeconds adds v to the "window_seconds" field.
+func (u *VelocityLimitUpsertOne) AddWindowSeconds(v int64) *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.AddWindowSeconds(v)
+	})
+}
+
+// UpdateWindowSeconds sets the "window_seconds" field to the value that was provided on create.
+func (u *VelocityLimitUpsertOne) UpdateWindowSeconds() *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateWindowSeconds()
+	})
+}
+
+// SetMaxCount sets the "max_count" field.
+func (u *VelocityLimitUpsertOne) SetMaxCount(v int) *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetMaxCount(v)
+	})
+}
+
+// AddMaxCount adds v to the "max_count" field.
+func (u *VelocityLimitUpsertOne) AddMaxCount(v int) *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.AddMaxCount(v)
+	})
+}
+
+// UpdateMaxCount sets the "max_count" field to the value that was provided on create.
+func (u *VelocityLimitUpsertOne) UpdateMaxCount() *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateMaxCount()
+	})
+}
+
+// ClearMaxCount clears the value of the "max_count" field.
+func (u *VelocityLimitUpsertOne) ClearMaxCount() *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.ClearMaxCount()
+	})
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *VelocityLimitUpsertOne) SetMaxAmount(v decimal.Decimal) *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetMaxAmount(v)
+	})
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *VelocityLimitUpsertOne) AddMaxAmount(v decimal.Decimal) *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.AddMaxAmount(v)
+	})
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *VelocityLimitUpsertOne) UpdateMaxAmount() *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateMaxAmount()
+	})
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (u *VelocityLimitUpsertOne) ClearMaxAmount() *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.ClearMaxAmount()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *VelocityLimitUpsertOne) SetEnabled(v bool) *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *VelocityLimitUpsertOne) UpdateEnabled() *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VelocityLimitUpsertOne) SetUpdatedAt(v time.Time) *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VelocityLimitUpsertOne) UpdateUpdatedAt() *VelocityLimitUpsertOne {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VelocityLimitUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VelocityLimitCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VelocityLimitUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VelocityLimitUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VelocityLimitUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VelocityLimitCreateBulk is the builder for creating many VelocityLimit entities in bulk.
+type VelocityLimitCreateBulk struct {
+	config
+	err      error
+	builders []*VelocityLimitCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the VelocityLimit entities in the database.
+func (vlcb *VelocityLimitCreateBulk) Save(ctx context.Context) ([]*VelocityLimit, error) {
+	if vlcb.err != nil {
+		return nil, vlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vlcb.builders))
+	nodes := make([]*VelocityLimit, len(vlcb.builders))
+	mutators := make([]Mutator, len(vlcb.builders))
+	for i := range vlcb.builders {
+		func(i int, root context.Context) {
+			builder := vlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VelocityLimitMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = vlcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vlcb *VelocityLimitCreateBulk) SaveX(ctx context.Context) []*VelocityLimit {
+	v, err := vlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vlcb *VelocityLimitCreateBulk) Exec(ctx context.Context) error {
+	_, err := vlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vlcb *VelocityLimitCreateBulk) ExecX(ctx context.Context) {
+	if err := vlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VelocityLimit.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VelocityLimitUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (vlcb *VelocityLimitCreateBulk) OnConflict(opts ...sql.ConflictOption) *VelocityLimitUpsertBulk {
+	vlcb.conflict = opts
+	return &VelocityLimitUpsertBulk{
+		create: vlcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VelocityLimit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vlcb *VelocityLimitCreateBulk) OnConflictColumns(columns ...string) *VelocityLimitUpsertBulk {
+	vlcb.conflict = append(vlcb.conflict, sql.ConflictColumns(columns...))
+	return &VelocityLimitUpsertBulk{
+		create: vlcb,
+	}
+}
+
+// VelocityLimitUpsertBulk is the builder for "upsert"-ing
+// a bulk of VelocityLimit nodes.
+type VelocityLimitUpsertBulk struct {
+	create *VelocityLimitCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.VelocityLimit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *VelocityLimitUpsertBulk) UpdateNewValues() *VelocityLimitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(velocitylimit.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VelocityLimit.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VelocityLimitUpsertBulk) Ignore() *VelocityLimitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VelocityLimitUpsertBulk) DoNothing() *VelocityLimitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VelocityLimitCreateBulk.OnConflict
+// documentation for more info.
+func (u *VelocityLimitUpsertBulk) Update(set func(*VelocityLimitUpsert)) *VelocityLimitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VelocityLimitUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *VelocityLimitUpsertBulk) SetName(v string) *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *VelocityLimitUpsertBulk) UpdateName() *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (u *VelocityLimitUpsertBulk) SetTransactionType(v velocitylimit.TransactionType) *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetTransactionType(v)
+	})
+}
+
+// UpdateTransactionType sets the "transaction_type" field to the value that was provided on create.
+func (u *VelocityLimitUpsertBulk) UpdateTransactionType() *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateTransactionType()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *VelocityLimitUpsertBulk) SetCurrency(v string) *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *VelocityLimitUpsertBulk) UpdateCurrency() *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetTier sets the "tier" field.
+func (u *VelocityLimitUpsertBulk) SetTier(v string) *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetTier(v)
+	})
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *VelocityLimitUpsertBulk) UpdateTier() *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateTier()
+	})
+}
+
+// ClearTier clears the value of the "tier" field.
+func (u *VelocityLimitUpsertBulk) ClearTier() *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.ClearTier()
+	})
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (u *VelocityLimitUpsertBulk) SetWindowSeconds(v int64) *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetWindowSeconds(v)
+	})
+}
+
+// AddWindowSeconds adds v to the "window_seconds" field.
+func (u *VelocityLimitUpsertBulk) AddWindowSeconds(v int64) *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.AddWindowSeconds(v)
+	})
+}
+
+// UpdateWindowSeconds sets the "window_seconds" field to the value that was provided on create.
+func (u *VelocityLimitUpsertBulk) UpdateWindowSeconds() *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateWindowSeconds()
+	})
+}
+
+// SetMaxCount sets the "max_count" field.
+func (u *VelocityLimitUpsertBulk) SetMaxCount(v int) *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetMaxCount(v)
+	})
+}
+
+// AddMaxCount adds v to the "max_count" field.
+func (u *VelocityLimitUpsertBulk) AddMaxCount(v int) *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.AddMaxCount(v)
+	})
+}
+
+// UpdateMaxCount sets the "max_count" field to the value that was provided on create.
+func (u *VelocityLimitUpsertBulk) UpdateMaxCount() *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateMaxCount()
+	})
+}
+
+// ClearMaxCount clears the value of the "max_count" field.
+func (u *VelocityLimitUpsertBulk) ClearMaxCount() *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.ClearMaxCount()
+	})
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (u *VelocityLimitUpsertBulk) SetMaxAmount(v decimal.Decimal) *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetMaxAmount(v)
+	})
+}
+
+// AddMaxAmount adds v to the "max_amount" field.
+func (u *VelocityLimitUpsertBulk) AddMaxAmount(v decimal.Decimal) *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.AddMaxAmount(v)
+	})
+}
+
+// UpdateMaxAmount sets the "max_amount" field to the value that was provided on create.
+func (u *VelocityLimitUpsertBulk) UpdateMaxAmount() *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateMaxAmount()
+	})
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (u *VelocityLimitUpsertBulk) ClearMaxAmount() *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.ClearMaxAmount()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *VelocityLimitUpsertBulk) SetEnabled(v bool) *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *VelocityLimitUpsertBulk) UpdateEnabled() *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VelocityLimitUpsertBulk) SetUpdatedAt(v time.Time) *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VelocityLimitUpsertBulk) UpdateUpdatedAt() *VelocityLimitUpsertBulk {
+	return u.Update(func(s *VelocityLimitUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VelocityLimitUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VelocityLimitCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VelocityLimitCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VelocityLimitUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
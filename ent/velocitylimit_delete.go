@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/predicate"
+	"accounting/ent/velocitylimit"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VelocityLimitDelete is the builder for deleting a VelocityLimit entity.
+type VelocityLimitDelete struct {
+	config
+	hooks    []Hook
+	mutation *VelocityLimitMutation
+}
+
+// Where appends a list predicates to the VelocityLimitDelete builder.
+func (vld *VelocityLimitDelete) Where(ps ...predicate.VelocityLimit) *VelocityLimitDelete {
+	vld.mutation.Where(ps...)
+	return vld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vld *VelocityLimitDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, vld.sqlExec, vld.mutation, vld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vld *VelocityLimitDelete) ExecX(ctx context.Context) int {
+	n, err := vld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vld *VelocityLimitDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(velocitylimit.Table, sqlgraph.NewFieldSpec(velocitylimit.FieldID, field.TypeInt))
+	if ps := vld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vld.mutation.done = true
+	return affected, err
+}
+
+// VelocityLimitDeleteOne is the builder for deleting a single VelocityLimit entity.
+type VelocityLimitDeleteOne struct {
+	vld *VelocityLimitDelete
+}
+
+// Where appends a list predicates to the VelocityLimitDelete builder.
+func (vldo *VelocityLimitDeleteOne) Where(ps ...predicate.VelocityLimit) *VelocityLimitDeleteOne {
+	vldo.vld.mutation.Where(ps...)
+	return vldo
+}
+
+// Exec executes the deletion query.
+func (vldo *VelocityLimitDeleteOne) Exec(ctx context.Context) error {
+	n, err := vldo.vld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{velocitylimit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vldo *VelocityLimitDeleteOne) ExecX(ctx context.Context) {
+	if err := vldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
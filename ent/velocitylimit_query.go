@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/predicate"
+	"accounting/ent/velocitylimit"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VelocityLimitQuery is the builder for querying VelocityLimit entities.
+type VelocityLimitQuery struct {
+	config
+	ctx        *QueryContext
+	order      []velocitylimit.OrderOption
+	inters     []Interceptor
+	predicates []predicate.VelocityLimit
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VelocityLimitQuery builder.
+func (vlq *VelocityLimitQuery) Where(ps ...predicate.VelocityLimit) *VelocityLimitQuery {
+	vlq.predicates = append(vlq.predicates, ps...)
+	return vlq
+}
+
+// Limit the number of records to be returned by this query.
+func (vlq *VelocityLimitQuery) Limit(limit int) *VelocityLimitQuery {
+	vlq.ctx.Limit = &limit
+	return vlq
+}
+
+// Offset to start from.
+func (vlq *VelocityLimitQuery) Offset(offset int) *VelocityLimitQuery {
+	vlq.ctx.Offset = &offset
+	return vlq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vlq *VelocityLimitQuery) Unique(unique bool) *VelocityLimitQuery {
+	vlq.ctx.Unique = &unique
+	return vlq
+}
+
+// Order specifies how the records should be ordered.
+func (vlq *VelocityLimitQuery) Order(o ...velocitylimit.OrderOption) *VelocityLimitQuery {
+	vlq.order = append(vlq.order, o...)
+	return vlq
+}
+
+// First returns the first VelocityLimit entity from the query.
+// Returns a *NotFoundError when no VelocityLimit was found.
+func (vlq *VelocityLimitQuery) First(ctx context.Context) (*VelocityLimit, error) {
+	nodes, err := vlq.Limit(1).All(setContextOp(ctx, vlq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{velocitylimit.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vlq *VelocityLimitQuery) FirstX(ctx context.Context) *VelocityLimit {
+	node, err := vlq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first VelocityLimit ID from the query.
+// Returns a *NotFoundError when no VelocityLimit ID was found.
+func (vlq *VelocityLimitQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vlq.Limit(1).IDs(setContextOp(ctx, vlq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{velocitylimit.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vlq *VelocityLimitQuery) FirstIDX(ctx context.Context) int {
+	id, err := vlq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single VelocityLimit entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one VelocityLimit entity is found.
+// Returns a *NotFoundError when no VelocityLimit entities are found.
+func (vlq *VelocityLimitQuery) Only(ctx context.Context) (*VelocityLimit, error) {
+	nodes, err := vlq.Limit(2).All(setContextOp(ctx, vlq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{velocitylimit.Label}
+	default:
+		return nil, &NotSingularError{velocitylimit.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vlq *VelocityLimitQuery) OnlyX(ctx context.Context) *VelocityLimit {
+	node, err := vlq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only VelocityLimit ID in the query.
+// Returns a *NotSingularError when more than one VelocityLimit ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vlq *VelocityLimitQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vlq.Limit(2).IDs(setContextOp(ctx, vlq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{velocitylimit.Label}
+	default:
+		err = &NotSingularError{velocitylimit.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vlq *VelocityLimitQuery) OnlyIDX(ctx context.Context) int {
+	id, err := vlq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of VelocityLimits.
+func (vlq *VelocityLimitQuery) All(ctx context.Context) ([]*VelocityLimit, error) {
+	ctx = setContextOp(ctx, vlq.ctx, ent.OpQueryAll)
+	if err := vlq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*VelocityLimit, *VelocityLimitQuery]()
+	return withInterceptors[[]*VelocityLimit](ctx, vlq, qr, vlq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vlq *VelocityLimitQuery) AllX(ctx context.Context) []*VelocityLimit {
+	nodes, err := vlq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of VelocityLimit IDs.
+func (vlq *VelocityLimitQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if vlq.ctx.Unique == nil && vlq.path != nil {
+		vlq.Unique(true)
+	}
+	ctx = setContextOp(ctx, vlq.ctx, ent.OpQueryIDs)
+	if err = vlq.Select(velocitylimit.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vlq *VelocityLimitQuery) IDsX(ctx context.Context) []int {
+	ids, err := vlq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vlq *VelocityLimitQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, vlq.ctx, ent.OpQueryCount)
+	if err := vlq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, vlq, querierCount[*VelocityLimitQuery](), vlq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vlq *VelocityLimitQuery) CountX(ctx context.Context) int {
+	count, err := vlq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vlq *VelocityLimitQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, vlq.ctx, ent.OpQueryExist)
+	switch _, err := vlq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vlq *VelocityLimitQuery) ExistX(ctx context.Context) bool {
+	exist, err := vlq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VelocityLimitQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vlq *VelocityLimitQuery) Clone() *VelocityLimitQuery {
+	if vlq == nil {
+		return nil
+	}
+	return &VelocityLimitQuery{
+		config:     vlq.config,
+		ctx:        vlq.ctx.Clone(),
+		order:      append([]velocitylimit.OrderOption{}, vlq.order...),
+		inters:     append([]Interceptor{}, vlq.inters...),
+		predicates: append([]predicate.VelocityLimit{}, vlq.predicates...),
+		// clone intermediate query.
+		sql:  vlq.sql.Clone(),
+		path: vlq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.VelocityLimit.Query().
+//		GroupBy(velocitylimit.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (vlq *VelocityLimitQuery) GroupBy(field string, fields ...string) *VelocityLimitGroupBy {
+	vlq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &VelocityLimitGroupBy{build: vlq}
+	grbuild.flds = &vlq.ctx.Fields
+	grbuild.label = velocitylimit.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.VelocityLimit.Query().
+//		Select(velocitylimit.FieldName).
+//		Scan(ctx, &v)
+func (vlq *VelocityLimitQuery) Select(fields ...string) *VelocityLimitSelect {
+	vlq.ctx.Fields = append(vlq.ctx.Fields, fields...)
+	sbuild := &VelocityLimitSelect{VelocityLimitQuery: vlq}
+	sbuild.label = velocitylimit.Label
+	sbuild.flds, sbuild.scan = &vlq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a VelocityLimitSelect configured with the given aggregations.
+func (vlq *VelocityLimitQuery) Aggregate(fns ...AggregateFunc) *VelocityLimitSelect {
+	return vlq.Select().Aggregate(fns...)
+}
+
+func (vlq *VelocityLimitQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range vlq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, vlq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range vlq.ctx.Fields {
+		if !velocitylimit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if vlq.path != nil {
+		prev, err := vlq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vlq.sql = prev
+	}
+	return nil
+}
+
+func (vlq *VelocityLimitQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*VelocityLimit, error) {
+	var (
+		nodes = []*VelocityLimit{}
+		_spec = vlq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*VelocityLimit).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &VelocityLimit{config: vlq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(vlq.modifiers) > 0 {
+		_spec.Modifiers = vlq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vlq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (vlq *VelocityLimitQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vlq.querySpec()
+	if len(vlq.modifiers) > 0 {
+		_spec.Modifiers = vlq.modifiers
+	}
+	_spec.Node.Columns = vlq.ctx.Fields
+	if len(vlq.ctx.Fields) > 0 {
+		_spec.Unique = vlq.ctx.Unique != nil && *vlq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, vlq.driver, _spec)
+}
+
+func (vlq *VelocityLimitQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(velocitylimit.Table, velocitylimit.Columns, sqlgraph.NewFieldSpec(velocitylimit.FieldID, field.TypeInt))
+	_spec.From = vlq.sql
+	if unique := vlq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if vlq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := vlq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, velocitylimit.FieldID)
+		for i := range fields {
+			if fields[i] != velocitylimit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := vlq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vlq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vlq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vlq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vlq *VelocityLimitQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vlq.driver.Dialect())
+	t1 := builder.Table(velocitylimit.Table)
+	columns := vlq.ctx.Fields
+	if len(columns) == 0 {
+		columns = velocitylimit.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vlq.sql != nil {
+		selector = vlq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vlq.ctx.Unique != nil && *vlq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range vlq.modifiers {
+		m(selector)
+	}
+	for _, p := range vlq.predicates {
+		p(selector)
+	}
+	for _, p := range vlq.order {
+		p(selector)
+	}
+	if offset := vlq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vlq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (vlq *VelocityLimitQuery) ForUpdate(opts ...sql.LockOption) *VelocityLimitQuery {
+	if vlq.driver.Dialect() == dialect.Postgres {
+		vlq.Unique(false)
+	}
+	vlq.modifiers = append(vlq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return vlq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (vlq *VelocityLimitQuery) ForShare(opts ...sql.LockOption) *VelocityLimitQuery {
+	if vlq.driver.Dialect() == dialect.Postgres {
+		vlq.Unique(false)
+	}
+	vlq.modifiers = append(vlq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return vlq
+}
+
+// VelocityLimitGroupBy is the group-by builder for VelocityLimit entities.
+type VelocityLimitGroupBy struct {
+	selector
+	build *VelocityLimitQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vlgb *VelocityLimitGroupBy) Aggregate(fns ...AggregateFunc) *VelocityLimitGroupBy {
+	vlgb.fns = append(vlgb.fns, fns...)
+	return vlgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vlgb *VelocityLimitGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vlgb.build.ctx, ent.OpQueryGroupBy)
+	if err := vlgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VelocityLimitQuery, *VelocityLimitGroupBy](ctx, vlgb.build, vlgb, vlgb.build.inters, v)
+}
+
+func (vlgb *VelocityLimitGroupBy) sqlScan(ctx context.Context, root *VelocityLimitQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(vlgb.fns))
+	for _, fn := range vlgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*vlgb.flds)+len(vlgb.fns))
+		for _, f := range *vlgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*vlgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vlgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// VelocityLimitSelect is the builder for selecting fields of VelocityLimit entities.
+type VelocityLimitSelect struct {
+	*VelocityLimitQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (vls *VelocityLimitSelect) Aggregate(fns ...AggregateFunc) *VelocityLimitSelect {
+	vls.fns = append(vls.fns, fns...)
+	return vls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vls *VelocityLimitSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vls.ctx, ent.OpQuerySelect)
+	if err := vls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VelocityLimitQuery, *VelocityLimitSelect](ctx, vls.VelocityLimitQuery, vls, vls.inters, v)
+}
+
+func (vls *VelocityLimitSelect) sqlScan(ctx context.Context, root *VelocityLimitQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(vls.fns))
+	for _, fn := range vls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*vls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"accounting/ent/predicate"
+	"accounting/ent/velocitylimit"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// VelocityLimitUpdate is the builder for updating VelocityLimit entities.
+type VelocityLimitUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VelocityLimitMutation
+}
+
+// Where appends a list predicates to the VelocityLimitUpdate builder.
+func (vlu *VelocityLimitUpdate) Where(ps ...predicate.VelocityLimit) *VelocityLimitUpdate {
+	vlu.mutation.Where(ps...)
+	return vlu
+}
+
+// SetName sets the "name" field.
+func (vlu *VelocityLimitUpdate) SetName(s string) *VelocityLimitUpdate {
+	vlu.mutation.SetName(s)
+	return vlu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (vlu *VelocityLimitUpdate) SetNillableName(s *string) *VelocityLimitUpdate {
+	if s != nil {
+		vlu.SetName(*s)
+	}
+	return vlu
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (vlu *VelocityLimitUpdate) SetTransactionType(vt velocitylimit.TransactionType) *VelocityLimitUpdate {
+	vlu.mutation.SetTransactionType(vt)
+	return vlu
+}
+
+// SetNillableTransactionType sets the "transaction_type" field if the given value is not nil.
+func (vlu *VelocityLimitUpdate) SetNillableTransactionType(vt *velocitylimit.TransactionType) *VelocityLimitUpdate {
+	if vt != nil {
+		vlu.SetTransactionType(*vt)
+	}
+	return vlu
+}
+
+// SetCurrency sets the "currency" field.
+func (vlu *VelocityLimitUpdate) SetCurrency(s string) *VelocityLimitUpdate {
+	vlu.mutation.SetCurrency(s)
+	return vlu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (vlu *VelocityLimitUpdate) SetNillableCurrency(s *string) *VelocityLimitUpdate {
+	if s != nil {
+		vlu.SetCurrency(*s)
+	}
+	return vlu
+}
+
+// SetTier sets the "tier" field.
+func (vlu *VelocityLimitUpdate) SetTier(s string) *VelocityLimitUpdate {
+	vlu.mutation.SetTier(s)
+	return vlu
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (vlu *VelocityLimitUpdate) SetNillableTier(s *string) *VelocityLimitUpdate {
+	if s != nil {
+		vlu.SetTier(*s)
+	}
+	return vlu
+}
+
+// ClearTier clears the value of the "tier" field.
+func (vlu *VelocityLimitUpdate) ClearTier() *VelocityLimitUpdate {
+	vlu.mutation.ClearTier()
+	return vlu
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (vlu *VelocityLimitUpdate) SetWindowSeconds(i int64) *VelocityLimitUpdate {
+	vlu.mutation.ResetWindowSeconds()
+	vlu.mutation.SetWindowSeconds(i)
+	return vlu
+}
+
+// SetNillableWindowSeconds sets the "window_seconds" field if the given value is not nil.
+func (vlu *VelocityLimitUpdate) SetNillableWindowSeconds(i *int64) *VelocityLimitUpdate {
+	if i != nil {
+		vlu.SetWindowSeconds(*i)
+	}
+	return vlu
+}
+
+// AddWindowSeconds adds i to the "window_seconds" field.
+func (vlu *VelocityLimitUpdate) AddWindowSeconds(i int64) *VelocityLimitUpdate {
+	vlu.mutation.AddWindowSeconds(i)
+	return vlu
+}
+
+// SetMaxCount sets the "max_count" field.
+func (vlu *VelocityLimitUpdate) SetMaxCount(i int) *VelocityLimitUpdate {
+	vlu.mutation.ResetMaxCount()
+	vlu.mutation.SetMaxCount(i)
+	return vlu
+}
+
+// SetNillableMaxCount sets the "max_count" field if the given value is not nil.
+func (vlu *VelocityLimitUpdate) SetNillableMaxCount(i *int) *VelocityLimitUpdate {
+	if i != nil {
+		vlu.SetMaxCount(*i)
+	}
+	return vlu
+}
+
+// AddMaxCount adds i to the "max_count" field.
+func (vlu *VelocityLimitUpdate) AddMaxCount(i int) *VelocityLimitUpdate {
+	vlu.mutation.AddMaxCount(i)
+	return vlu
+}
+
+// ClearMaxCount clears the value of the "max_count" field.
+func (vlu *VelocityLimitUpdate) ClearMaxCount() *VelocityLimitUpdate {
+	vlu.mutation.ClearMaxCount()
+	return vlu
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (vlu *VelocityLimitUpdate) SetMaxAmount(d decimal.Decimal) *VelocityLimitUpdate {
+	vlu.mutation.ResetMaxAmount()
+	vlu.mutation.SetMaxAmount(d)
+	return vlu
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (vlu *VelocityLimitUpdate) SetNillableMaxAmount(d *decimal.Decimal) *VelocityLimitUpdate {
+	if d != nil {
+		vlu.SetMaxAmount(*d)
+	}
+	return vlu
+}
+
+// AddMaxAmount adds d to the "max_amount" field.
+func (vlu *VelocityLimitUpdate) AddMaxAmount(d decimal.Decimal) *VelocityLimitUpdate {
+	vlu.mutation.AddMaxAmount(d)
+	return vlu
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (vlu *VelocityLimitUpdate) ClearMaxAmount() *VelocityLimitUpdate {
+	vlu.mutation.ClearMaxAmount()
+	return vlu
+}
+
+// SetEnabled sets the "enabled" field.
+func (vlu *VelocityLimitUpdate) SetEnabled(b bool) *VelocityLimitUpdate {
+	vlu.mutation.SetEnabled(b)
+	return vlu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (vlu *VelocityLimitUpdate) SetNillableEnabled(b *bool) *VelocityLimitUpdate {
+	if b != nil {
+		vlu.SetEnabled(*b)
+	}
+	return vlu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vlu *VelocityLimitUpdate) SetUpdatedAt(t time.Time) *VelocityLimitUpdate {
+	vlu.mutation.SetUpdatedAt(t)
+	return vlu
+}
+
+// Mutation returns the VelocityLimitMutation object of the builder.
+func (vlu *VelocityLimitUpdate) Mutation() *VelocityLimitMutation {
+	return vlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vlu *VelocityLimitUpdate) Save(ctx context.Context) (int, error) {
+	vlu.defaults()
+	return withHooks(ctx, vlu.sqlSave, vlu.mutation, vlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vlu *VelocityLimitUpdate) SaveX(ctx context.Context) int {
+	affected, err := vlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vlu *VelocityLimitUpdate) Exec(ctx context.Context) error {
+	_, err := vlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vlu *VelocityLimitUpdate) ExecX(ctx context.Context) {
+	if err := vlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vlu *VelocityLimitUpdate) defaults() {
+	if _, ok := vlu.mutation.UpdatedAt(); !ok {
+		v := velocitylimit.UpdateDefaultUpdatedAt()
+		vlu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vlu *VelocityLimitUpdate) check() error {
+	if v, ok := vlu.mutation.Name(); ok {
+		if err := velocitylimit.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.name": %w`, err)}
+		}
+	}
+	if v, ok := vlu.mutation.TransactionType(); ok {
+		if err := velocitylimit.TransactionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_type", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.transaction_type": %w`, err)}
+		}
+	}
+	if v, ok := vlu.mutation.Currency(); ok {
+		if err := velocitylimit.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.currency": %w`, err)}
+		}
+	}
+	if v, ok := vlu.mutation.WindowSeconds(); ok {
+		if err := velocitylimit.WindowSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "window_seconds", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.window_seconds": %w`, err)}
+		}
+	}
+	if v, ok := vlu.mutation.MaxCount(); ok {
+		if err := velocitylimit.MaxCountValidator(v); err != nil {
+			return &ValidationError{Name: "max_count", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.max_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vlu *VelocityLimitUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vlu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(velocitylimit.Table, velocitylimit.Columns, sqlgraph.NewFieldSpec(velocitylimit.FieldID, field.TypeInt))
+	if ps := vlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vlu.mutation.Name(); ok {
+		_spec.SetField(velocitylimit.FieldName, field.TypeString, value)
+	}
+	if value, ok := vlu.mutation.TransactionType(); ok {
+		_spec.SetField(velocitylimit.FieldTransactionType, field.TypeEnum, value)
+	}
+	if value, ok := vlu.mutation.Currency(); ok {
+		_spec.SetField(velocitylimit.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := vlu.mutation.Tier(); ok {
+		_spec.SetField(velocitylimit.FieldTier, field.TypeString, value)
+	}
+	if vlu.mutation.TierCleared() {
+		_spec.ClearField(velocitylimit.FieldTier, field.TypeString)
+	}
+	if value, ok := vlu.mutation.WindowSeconds(); ok {
+		_spec.SetField(velocitylimit.FieldWindowSeconds, field.TypeInt64, value)
+	}
+	if value, ok := vlu.mutation.AddedWindowSeconds(); ok {
+		_spec.AddField(velocitylimit.FieldWindowSeconds, field.TypeInt64, value)
+	}
+	if value, ok := vlu.mutation.MaxCount(); ok {
+		_spec.SetField(velocitylimit.FieldMaxCount, field.TypeInt, value)
+	}
+	if value, ok := vlu.mutation.AddedMaxCount(); ok {
+		_spec.AddField(velocitylimit.FieldMaxCount, field.TypeInt, value)
+	}
+	if vlu.mutation.MaxCountCleared() {
+		_spec.ClearField(velocitylimit.FieldMaxCount, field.TypeInt)
+	}
+	if value, ok := vlu.mutation.MaxAmount(); ok {
+		_spec.SetField(velocitylimit.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vlu.mutation.AddedMaxAmount(); ok {
+		_spec.AddField(velocitylimit.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if vlu.mutation.MaxAmountCleared() {
+		_spec.ClearField(velocitylimit.FieldMaxAmount, field.TypeFloat64)
+	}
+	if value, ok := vlu.mutation.Enabled(); ok {
+		_spec.SetField(velocitylimit.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := vlu.mutation.UpdatedAt(); ok {
+		_spec.SetField(velocitylimit.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{velocitylimit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vlu.mutation.done = true
+	return n, nil
+}
+
+// VelocityLimitUpdateOne is the builder for updating a single VelocityLimit entity.
+type VelocityLimitUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VelocityLimitMutation
+}
+
+// SetName sets the "name" field.
+func (vluo *VelocityLimitUpdateOne) SetName(s string) *VelocityLimitUpdateOne {
+	vluo.mutation.SetName(s)
+	return vluo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (vluo *VelocityLimitUpdateOne) SetNillableName(s *string) *VelocityLimitUpdateOne {
+	if s != nil {
+		vluo.SetName(*s)
+	}
+	return vluo
+}
+
+// SetTransactionType sets the "transaction_type" field.
+func (vluo *VelocityLimitUpdateOne) SetTransactionType(vt velocitylimit.TransactionType) *VelocityLimitUpdateOne {
+	vluo.mutation.SetTransactionType(vt)
+	return vluo
+}
+
+// SetNillableTransactionType sets the "transaction_type" field if the given value is not nil.
+func (vluo *VelocityLimitUpdateOne) SetNillableTransactionType(vt *velocitylimit.TransactionType) *VelocityLimitUpdateOne {
+	if vt != nil {
+		vluo.SetTransactionType(*vt)
+	}
+	return vluo
+}
+
+// SetCurrency sets the "currency" field.
+func (vluo *VelocityLimitUpdateOne) SetCurrency(s string) *VelocityLimitUpdateOne {
+	vluo.mutation.SetCurrency(s)
+	return vluo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (vluo *VelocityLimitUpdateOne) SetNillableCurrency(s *string) *VelocityLimitUpdateOne {
+	if s != nil {
+		vluo.SetCurrency(*s)
+	}
+	return vluo
+}
+
+// SetTier sets the "tier" field.
+func (vluo *VelocityLimitUpdateOne) SetTier(s string) *VelocityLimitUpdateOne {
+	vluo.mutation.SetTier(s)
+	return vluo
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (vluo *VelocityLimitUpdateOne) SetNillableTier(s *string) *VelocityLimitUpdateOne {
+	if s != nil {
+		vluo.SetTier(*s)
+	}
+	return vluo
+}
+
+// ClearTier clears the value of the "tier" field.
+func (vluo *VelocityLimitUpdateOne) ClearTier() *VelocityLimitUpdateOne {
+	vluo.mutation.ClearTier()
+	return vluo
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (vluo *VelocityLimitUpdateOne) SetWindowSeconds(i int64) *VelocityLimitUpdateOne {
+	vluo.mutation.ResetWindowSeconds()
+	vluo.mutation.SetWindowSeconds(i)
+	return vluo
+}
+
+// SetNillableWindowSeconds sets the "window_seconds" field if the given value is not nil.
+func (vluo *VelocityLimitUpdateOne) SetNillableWindowSeconds(i *int64) *VelocityLimitUpdateOne {
+	if i != nil {
+		vluo.SetWindowSeconds(*i)
+	}
+	return vluo
+}
+
+// AddWindowSeconds adds i to the "window_seconds" field.
+func (vluo *VelocityLimitUpdateOne) AddWindowSeconds(i int64) *VelocityLimitUpdateOne {
+	vluo.mutation.AddWindowSeconds(i)
+	return vluo
+}
+
+// SetMaxCount sets the "max_count" field.
+func (vluo *VelocityLimitUpdateOne) SetMaxCount(i int) *VelocityLimitUpdateOne {
+	vluo.mutation.ResetMaxCount()
+	vluo.mutation.SetMaxCount(i)
+	return vluo
+}
+
+// SetNillableMaxCount sets the "max_count" field if the given value is not nil.
+func (vluo *VelocityLimitUpdateOne) SetNillableMaxCount(i *int) *VelocityLimitUpdateOne {
+	if i != nil {
+		vluo.SetMaxCount(*i)
+	}
+	return vluo
+}
+
+// AddMaxCount adds i to the "max_count" field.
+func (vluo *VelocityLimitUpdateOne) AddMaxCount(i int) *VelocityLimitUpdateOne {
+	vluo.mutation.AddMaxCount(i)
+	return vluo
+}
+
+// ClearMaxCount clears the value of the "max_count" field.
+func (vluo *VelocityLimitUpdateOne) ClearMaxCount() *VelocityLimitUpdateOne {
+	vluo.mutation.ClearMaxCount()
+	return vluo
+}
+
+// SetMaxAmount sets the "max_amount" field.
+func (vluo *VelocityLimitUpdateOne) SetMaxAmount(d decimal.Decimal) *VelocityLimitUpdateOne {
+	vluo.mutation.ResetMaxAmount()
+	vluo.mutation.SetMaxAmount(d)
+	return vluo
+}
+
+// SetNillableMaxAmount sets the "max_amount" field if the given value is not nil.
+func (vluo *VelocityLimitUpdateOne) SetNillableMaxAmount(d *decimal.Decimal) *VelocityLimitUpdateOne {
+	if d != nil {
+		vluo.SetMaxAmount(*d)
+	}
+	return vluo
+}
+
+// AddMaxAmount adds d to the "max_amount" field.
+func (vluo *VelocityLimitUpdateOne) AddMaxAmount(d decimal.Decimal) *VelocityLimitUpdateOne {
+	vluo.mutation.AddMaxAmount(d)
+	return vluo
+}
+
+// ClearMaxAmount clears the value of the "max_amount" field.
+func (vluo *VelocityLimitUpdateOne) ClearMaxAmount() *VelocityLimitUpdateOne {
+	vluo.mutation.ClearMaxAmount()
+	return vluo
+}
+
+// SetEnabled sets the "enabled" field.
+func (vluo *VelocityLimitUpdateOne) SetEnabled(b bool) *VelocityLimitUpdateOne {
+	vluo.mutation.SetEnabled(b)
+	return vluo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (vluo *VelocityLimitUpdateOne) SetNillableEnabled(b *bool) *VelocityLimitUpdateOne {
+	if b != nil {
+		vluo.SetEnabled(*b)
+	}
+	return vluo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vluo *VelocityLimitUpdateOne) SetUpdatedAt(t time.Time) *VelocityLimitUpdateOne {
+	vluo.mutation.SetUpdatedAt(t)
+	return vluo
+}
+
+// Mutation returns the VelocityLimitMutation object of the builder.
+func (vluo *VelocityLimitUpdateOne) Mutation() *VelocityLimitMutation {
+	return vluo.mutation
+}
+
+// Where appends a list predicates to the VelocityLimitUpdate builder.
+func (vluo *VelocityLimitUpdateOne) Where(ps ...predicate.VelocityLimit) *VelocityLimitUpdateOne {
+	vluo.mutation.Where(ps...)
+	return vluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vluo *VelocityLimitUpdateOne) Select(field string, fields ...string) *VelocityLimitUpdateOne {
+	vluo.fields = append([]string{field}, fields...)
+	return vluo
+}
+
+// Save executes the query and returns the updated VelocityLimit entity.
+func (vluo *VelocityLimitUpdateOne) Save(ctx context.Context) (*VelocityLimit, error) {
+	vluo.defaults()
+	return withHooks(ctx, vluo.sqlSave, vluo.mutation, vluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vluo *VelocityLimitUpdateOne) SaveX(ctx context.Context) *VelocityLimit {
+	node, err := vluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vluo *VelocityLimitUpdateOne) Exec(ctx context.Context) error {
+	_, err := vluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vluo *VelocityLimitUpdateOne) ExecX(ctx context.Context) {
+	if err := vluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vluo *VelocityLimitUpdateOne) defaults() {
+	if _, ok := vluo.mutation.UpdatedAt(); !ok {
+		v := velocitylimit.UpdateDefaultUpdatedAt()
+		vluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vluo *VelocityLimitUpdateOne) check() error {
+	if v, ok := vluo.mutation.Name(); ok {
+		if err := velocitylimit.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.name": %w`, err)}
+		}
+	}
+	if v, ok := vluo.mutation.TransactionType(); ok {
+		if err := velocitylimit.TransactionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_type", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.transaction_type": %w`, err)}
+		}
+	}
+	if v, ok := vluo.mutation.Currency(); ok {
+		if err := velocitylimit.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.currency": %w`, err)}
+		}
+	}
+	if v, ok := vluo.mutation.WindowSeconds(); ok {
+		if err := velocitylimit.WindowSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "window_seconds", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.window_seconds": %w`, err)}
+		}
+	}
+	if v, ok := vluo.mutation.MaxCount(); ok {
+		if err := velocitylimit.MaxCountValidator(v); err != nil {
+			return &ValidationError{Name: "max_count", err: fmt.Errorf(`ent: validator failed for field "VelocityLimit.max_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vluo *VelocityLimitUpdateOne) sqlSave(ctx context.Context) (_node *VelocityLimit, err error) {
+	if err := vluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(velocitylimit.Table, velocitylimit.Columns, sqlgraph.NewFieldSpec(velocitylimit.FieldID, field.TypeInt))
+	id, ok := vluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VelocityLimit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, velocitylimit.FieldID)
+		for _, f := range fields {
+			if !velocitylimit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != velocitylimit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vluo.mutation.Name(); ok {
+		_spec.SetField(velocitylimit.FieldName, field.TypeString, value)
+	}
+	if value, ok := vluo.mutation.TransactionType(); ok {
+		_spec.SetField(velocitylimit.FieldTransactionType, field.TypeEnum, value)
+	}
+	if value, ok := vluo.mutation.Currency(); ok {
+		_spec.SetField(velocitylimit.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := vluo.mutation.Tier(); ok {
+		_spec.SetField(velocitylimit.FieldTier, field.TypeString, value)
+	}
+	if vluo.mutation.TierCleared() {
+		_spec.ClearField(velocitylimit.FieldTier, field.TypeString)
+	}
+	if value, ok := vluo.mutation.WindowSeconds(); ok {
+		_spec.SetField(velocitylimit.FieldWindowSeconds, field.TypeInt64, value)
+	}
+	if value, ok := vluo.mutation.AddedWindowSeconds(); ok {
+		_spec.AddField(velocitylimit.FieldWindowSeconds, field.TypeInt64, value)
+	}
+	if value, ok := vluo.mutation.MaxCount(); ok {
+		_spec.SetField(velocitylimit.FieldMaxCount, field.TypeInt, value)
+	}
+	if value, ok := vluo.mutation.AddedMaxCount(); ok {
+		_spec.AddField(velocitylimit.FieldMaxCount, field.TypeInt, value)
+	}
+	if vluo.mutation.MaxCountCleared() {
+		_spec.ClearField(velocitylimit.FieldMaxCount, field.TypeInt)
+	}
+	if value, ok := vluo.mutation.MaxAmount(); ok {
+		_spec.SetField(velocitylimit.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vluo.mutation.AddedMaxAmount(); ok {
+		_spec.AddField(velocitylimit.FieldMaxAmount, field.TypeFloat64, value)
+	}
+	if vluo.mutation.MaxAmountCleared() {
+		_spec.ClearField(velocitylimit.FieldMaxAmount, field.TypeFloat64)
+	}
+	if value, ok := vluo.mutation.Enabled(); ok {
+		_spec.SetField(velocitylimit.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := vluo.mutation.UpdatedAt(); ok {
+		_spec.SetField(velocitylimit.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &VelocityLimit{config: vluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{velocitylimit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vluo.mutation.done = true
+	return _node, nil
+}
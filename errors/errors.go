@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // Standard error definitions
@@ -29,8 +32,72 @@ var (
 
 	// ErrNegativeBalance indicates that an operation would result in a negative balance.
 	ErrNegativeBalance = errors.New("operation would result in negative balance")
+
+	// ErrInvalidState indicates that an operation is not allowed in the current state of a resource.
+	ErrInvalidState = errors.New("operation not allowed in the current state")
+
+	// ErrUnbalancedEntry indicates that the postings of a journal entry do not sum to zero.
+	ErrUnbalancedEntry = errors.New("unbalanced journal entry")
+
+	// ErrVelocityLimitExceeded indicates that an operation would exceed a velocity limit.
+	ErrVelocityLimitExceeded = errors.New("velocity limit exceeded")
 )
 
+// InsufficientFundsError reports a debit that would take a balance below its
+// overdraft limit. It matches ErrInsufficientFunds with errors.Is.
+type InsufficientFundsError struct {
+	Currency       string
+	Requested      decimal.Decimal
+	Available      decimal.Decimal
+	OverdraftLimit decimal.Decimal
+}
+
+// Error implements the error interface.
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("%s: %s %s requested, %s available, overdraft limit %s",
+		ErrInsufficientFunds, e.Requested, e.Currency, e.Available, e.OverdraftLimit)
+}
+
+// Unwrap returns ErrInsufficientFunds.
+func (e *InsufficientFundsError) Unwrap() error {
+	return ErrInsufficientFunds
+}
+
+// AsInsufficientFunds returns the InsufficientFundsError in the chain of err, if any.
+func AsInsufficientFunds(err error) (*InsufficientFundsError, bool) {
+	var target *InsufficientFundsError
+	ok := errors.As(err, &target)
+	return target, ok
+}
+
+// VelocityLimitError reports a transaction that would take the number or the
+// total amount of a user's transactions within a rolling window above a
+// velocity limit. It matches ErrVelocityLimitExceeded with errors.Is.
+type VelocityLimitError struct {
+	Limit     string
+	Currency  string
+	Window    time.Duration
+	Count     int
+	MaxCount  *int
+	Amount    decimal.Decimal
+	MaxAmount *decimal.Decimal
+}
+
+// Error implements the error interface.
+func (e *VelocityLimitError) Error() string {
+	if e.MaxCount != nil && e.Count > *e.MaxCount {
+		return fmt.Sprintf("%s: %q allows %d transactions per %s",
+			ErrVelocityLimitExceeded, e.Limit, *e.MaxCount, e.Window)
+	}
+	return fmt.Sprintf("%s: %q allows %s %s per %s, the transaction would make it %s %s",
+		ErrVelocityLimitExceeded, e.Limit, e.MaxAmount, e.Currency, e.Window, e.Amount, e.Currency)
+}
+
+// Unwrap returns ErrVelocityLimitExceeded.
+func (e *VelocityLimitError) Unwrap() error {
+	return ErrVelocityLimitExceeded
+}
+
 // WithDetails wraps an error with additional context information.
 func WithDetails(err error, format string, args ...interface{}) error {
 	details := fmt.Sprintf(format, args...)
@@ -72,8 +139,24 @@ func IsNegativeBalance(err error) bool {
 	return errors.Is(err, ErrNegativeBalance)
 }
 
+// IsInvalidState checks if the given error is an ErrInvalidState error.
+func IsInvalidState(err error) bool {
+	return errors.Is(err, ErrInvalidState)
+}
+
+// IsUnbalancedEntry checks if the given error is an ErrUnbalancedEntry error.
+func IsUnbalancedEntry(err error) bool {
+	return errors.Is(err, ErrUnbalancedEntry)
+}
+
+// IsVelocityLimitExceeded checks if the given error is an ErrVelocityLimitExceeded error.
+func IsVelocityLimitExceeded(err error) bool {
+	return errors.Is(err, ErrVelocityLimitExceeded)
+}
+
 // IsNegativeBalanceConstraintError checks if the given error is related to the
-// PostgreSQL constraint violation for a negative balance.
+// PostgreSQL constraint violation for a balance or its available funds going
+// below the overdraft limit.
 func IsNegativeBalanceConstraintError(err error) bool {
 	if err == nil {
 		return false
@@ -82,7 +165,10 @@ func IsNegativeBalanceConstraintError(err error) bool {
 	errMsg := err.Error()
 	return strings.Contains(errMsg, "pq:") &&
 		strings.Contains(errMsg, "relation \"balances\"") &&
-		strings.Contains(errMsg, "violates check constraint \"balance_amount_non_negative\"")
+		(strings.Contains(errMsg, "violates check constraint \"balance_amount_within_overdraft\"") ||
+			strings.Contains(errMsg, "violates check constraint \"balance_available_within_overdraft\"") ||
+			strings.Contains(errMsg, "violates check constraint \"balance_amount_non_negative\"") ||
+			strings.Contains(errMsg, "violates check constraint \"balance_available_non_negative\""))
 }
 
 // WrapNegativeBalanceError wraps a PostgreSQL constraint error into a more
@@ -90,7 +176,7 @@ func IsNegativeBalanceConstraintError(err error) bool {
 func WrapNegativeBalanceError(err error, details string) error {
 	if IsNegativeBalanceConstraintError(err) {
 		if details != "" {
-			return WithDetails(ErrNegativeBalance, details)
+			return WithDetails(ErrNegativeBalance, "%s", details)
 		}
 		return ErrNegativeBalance
 	}
@@ -0,0 +1,27 @@
+// Package fee defines the building blocks of transaction fee rules.
+package fee
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// Tier is a band of a tiered fee rule. The first tier whose UpTo covers the
+// amount of a transaction applies to the whole amount.
+type Tier struct {
+	// UpTo is the largest amount the tier applies to; nil means no upper bound
+	UpTo *decimal.Decimal `json:"up_to,omitempty"`
+	// Fixed is charged on top of the percentage
+	Fixed decimal.Decimal `json:"fixed"`
+	// Percentage of the amount, e.g. 1.5 for 1.5%
+	Percentage decimal.Decimal `json:"percentage"`
+}
+
+// Covers reports whether the tier applies to an amount
+func (t Tier) Covers(amount decimal.Decimal) bool {
+	return t.UpTo == nil || amount.LessThanOrEqual(*t.UpTo)
+}
+
+// Percent returns the given percentage of an amount
+func Percent(amount decimal.Decimal, percentage decimal.Decimal) decimal.Decimal {
+	return amount.Mul(percentage).Div(decimal.NewFromInt(100))
+}
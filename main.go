@@ -6,10 +6,13 @@ import (
 	"log"
 
 	"accounting/ent"
+	_ "accounting/ent/runtime"
+	"accounting/repository"
 	"accounting/service"
 
 	"github.com/google/uuid"
 	_ "github.com/lib/pq"
+	"github.com/shopspring/decimal"
 )
 
 func main() {
@@ -29,6 +32,11 @@ func main() {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 
+	// Register ISO 4217 currencies that are not registered yet
+	if err := service.NewCurrencyService(client).SeedISO4217(context.Background()); err != nil {
+		log.Fatalf("failed seeding currencies: %v", err)
+	}
+
 	// Working with the client
 	ctx := context.Background()
 
@@ -45,15 +53,18 @@ func main() {
 	fmt.Printf("Created user with ID: %d\n", user.ID)
 
 	// Create balances for the user
-	_, err = transactionService.Create(ctx, uuid.New().String(), user.ID, "USD", 1000.00, "deposit")
+	_, err = transactionService.Create(ctx, uuid.New().String(), user.ID, "USD", decimal.NewFromInt(1000), "deposit",
+		repository.TransactionDetails{})
 	if err != nil {
 		log.Fatalf("failed creating balances: %v", err)
 	}
-	_, err = transactionService.Create(ctx, uuid.New().String(), user.ID, "EUR", 500.00, "deposit")
+	_, err = transactionService.Create(ctx, uuid.New().String(), user.ID, "EUR", decimal.NewFromInt(500), "deposit",
+		repository.TransactionDetails{})
 	if err != nil {
 		log.Fatalf("failed creating balances: %v", err)
 	}
-	_, err = transactionService.Create(ctx, uuid.New().String(), user.ID, "RUB", 50000.00, "deposit")
+	_, err = transactionService.Create(ctx, uuid.New().String(), user.ID, "RUB", decimal.NewFromInt(50000), "deposit",
+		repository.TransactionDetails{})
 	if err != nil {
 		log.Fatalf("failed creating balances: %v", err)
 	}
@@ -76,7 +87,7 @@ func main() {
 	fmt.Printf("\n--- Querying Balances ---\n")
 	fmt.Printf("User with ID %d has %d balances:\n", user.ID, len(balances))
 	for _, b := range balances {
-		fmt.Printf("- %s: %.2f\n", b.Currency, b.Amount)
+		fmt.Printf("- %s: %s\n", b.Currency, b.Amount.StringFixed(2))
 	}
 
 	// Demonstrate balance update with a transaction
@@ -111,8 +122,8 @@ func QueryByID(ctx context.Context, userService *service.UserService, txService
 	if err != nil {
 		return fmt.Errorf("failed querying transaction by ID: %w", err)
 	}
-	fmt.Printf("Found transaction by ID %s: amount: %.2f %s\n",
-		tx.ID, tx.Amount, tx.Currency)
+	fmt.Printf("Found transaction by ID %s: amount: %s %s\n",
+		tx.ID, tx.Amount.StringFixed(2), tx.Currency)
 
 	// Get all transactions for a user by user ID
 	userWithTx, err := userService.GetUserWithTransactions(ctx, userID)
@@ -142,11 +153,12 @@ func UpdateBalanceWithTransaction(ctx context.Context, client *ent.Client, balan
 		return fmt.Errorf("failed querying user's USD balance: %w", err)
 	}
 
-	fmt.Printf("Initial USD balance: %.2f\n", usdBalance.Amount)
+	fmt.Printf("Initial USD balance: %s\n", usdBalance.Amount.StringFixed(2))
 
 	// Deposit amount - use IncrementBalance from the service
-	depositAmount := 250.0
-	_, err = transactionService.Create(ctx, uuid.New().String(), userID, "USD", depositAmount, "deposit")
+	depositAmount := decimal.NewFromInt(250)
+	_, err = transactionService.Create(ctx, uuid.New().String(), userID, "USD", depositAmount, "deposit",
+		repository.TransactionDetails{})
 	if err != nil {
 		return fmt.Errorf("failed incrementing balance: %w", err)
 	}
@@ -157,7 +169,7 @@ func UpdateBalanceWithTransaction(ctx context.Context, client *ent.Client, balan
 		return fmt.Errorf("failed querying updated balance: %w", err)
 	}
 
-	fmt.Printf("Updated USD balance after deposit of %.2f: %.2f\n", depositAmount, updatedBalance.Amount)
+	fmt.Printf("Updated USD balance after deposit of %s: %s\n", depositAmount.StringFixed(2), updatedBalance.Amount.StringFixed(2))
 
 	return nil
 }
@@ -185,7 +197,7 @@ func QueryByUserID(ctx context.Context, client *ent.Client, balanceService *serv
 	if err != nil {
 		return fmt.Errorf("failed querying USD balance by user_id: %w", err)
 	}
-	fmt.Printf("Found USD balance with amount %.2f using user_id field directly\n", usdBalance.Amount)
+	fmt.Printf("Found USD balance with amount %s using user_id field directly\n", usdBalance.Amount.StringFixed(2))
 
 	// Get all transactions for user using user_id field directly
 	transactions, err = txService.GetAllTransactionsByUserID(ctx, userID)
@@ -0,0 +1,35 @@
+-- Converts float amounts to exact NUMERIC values.
+--
+-- Transaction amounts were entered as decimals, so casting them to numeric
+-- recovers the exact value (PostgreSQL keeps 15 significant digits when
+-- casting double precision to numeric). Balances, however, accumulated
+-- floating point drift over many AddAmount updates, so they are recomputed
+-- from the converted transactions instead of being cast directly.
+--
+-- Run this once before starting the new version of the application; the
+-- auto-migration on startup is a no-op afterwards.
+
+BEGIN;
+
+ALTER TABLE transactions
+    ALTER COLUMN amount TYPE numeric(30,8) USING round(amount::numeric, 8);
+
+ALTER TABLE balances
+    ALTER COLUMN amount TYPE numeric(30,8) USING round(amount::numeric, 8),
+    ALTER COLUMN amount SET DEFAULT 0;
+
+UPDATE balances b
+SET amount = COALESCE(t.total, 0),
+    updated_at = now()
+FROM (
+    SELECT user_id,
+           currency,
+           SUM(CASE WHEN type = 'deposit' THEN amount ELSE -amount END) AS total
+    FROM transactions
+    GROUP BY user_id, currency
+) t
+WHERE b.user_id = t.user_id
+  AND b.currency = t.currency
+  AND b.amount <> t.total;
+
+COMMIT;
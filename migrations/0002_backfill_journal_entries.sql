@@ -0,0 +1,57 @@
+-- Backfills journal entries for transactions created before the double-entry
+-- ledger was introduced.
+--
+-- Every legacy transaction gets its own journal entry with two postings: one
+-- against the user's wallet account and one against the external cash system
+-- account of the same currency. Balances are left untouched since they
+-- already reflect these transactions.
+--
+-- Run this once after the new version has created the ledger tables.
+
+BEGIN;
+
+INSERT INTO accounts (code, type, name, user_id, currency, created_at)
+SELECT DISTINCT 'user:' || user_id || ':' || currency, 'user', 'wallet', user_id, currency, now()
+FROM transactions
+WHERE journal_entry_id IS NULL
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO accounts (code, type, name, currency, created_at)
+SELECT DISTINCT 'system:external_cash:' || currency, 'system', 'external_cash', currency, now()
+FROM transactions
+WHERE journal_entry_id IS NULL
+ON CONFLICT (code) DO NOTHING;
+
+DO $$
+DECLARE
+    t        record;
+    entry_id bigint;
+    signed   numeric;
+BEGIN
+    FOR t IN
+        SELECT id, user_id, currency, amount, type, created_at
+        FROM transactions
+        WHERE journal_entry_id IS NULL
+        ORDER BY created_at
+    LOOP
+        signed := CASE WHEN t.type = 'deposit' THEN t.amount ELSE -t.amount END;
+
+        INSERT INTO journal_entries (description, created_at)
+        VALUES (t.type || ' ' || t.id, t.created_at)
+        RETURNING id INTO entry_id;
+
+        INSERT INTO postings (journal_entry_id, account_id, amount, currency, created_at)
+        SELECT entry_id, a.id, signed, t.currency, t.created_at
+        FROM accounts a
+        WHERE a.code = 'user:' || t.user_id || ':' || t.currency;
+
+        INSERT INTO postings (journal_entry_id, account_id, amount, currency, created_at)
+        SELECT entry_id, a.id, -signed, t.currency, t.created_at
+        FROM accounts a
+        WHERE a.code = 'system:external_cash:' || t.currency;
+
+        UPDATE transactions SET journal_entry_id = entry_id WHERE id = t.id;
+    END LOOP;
+END $$;
+
+COMMIT;
@@ -0,0 +1,27 @@
+-- Initializes the available funds of balances created before holds existed.
+--
+-- Auto-migration adds the "available" column with a default of 0, which
+-- would make every existing balance look fully reserved. Since no holds
+-- existed before, the available funds equal the balance amount.
+--
+-- Run this once right after the new version has migrated the schema.
+
+BEGIN;
+
+ALTER TABLE balances
+    ADD COLUMN IF NOT EXISTS available numeric(30,8) NOT NULL DEFAULT 0;
+
+UPDATE balances b
+SET available = b.amount - COALESCE((
+        SELECT SUM(h.amount)
+        FROM holds h
+        WHERE h.user_id = b.user_id
+          AND h.currency = b.currency
+          AND h.status = 'active'
+    ), 0);
+
+ALTER TABLE balances
+    DROP CONSTRAINT IF EXISTS balance_available_non_negative,
+    ADD CONSTRAINT balance_available_non_negative CHECK (available >= 0);
+
+COMMIT;
@@ -0,0 +1,45 @@
+-- Backfills the running balance and balance sequence of transactions posted
+-- before they were recorded.
+--
+-- Every posting to a user wallet belongs to exactly one transaction of that
+-- user and currency, so replaying the postings of each wallet in journal
+-- entry order yields the balance after each transaction and its position in
+-- the sequence of changes. The sequence of each balance is then set to the
+-- number of changes applied to it.
+--
+-- Run this once right after the new version has migrated the schema, after
+-- make migrate-ledger if transactions without journal entries still exist.
+
+BEGIN;
+
+WITH running AS (
+    SELECT p.journal_entry_id,
+           a.user_id,
+           a.currency,
+           SUM(p.amount) OVER w AS balance_after,
+           ROW_NUMBER() OVER w AS balance_sequence
+    FROM postings p
+    JOIN accounts a ON a.id = p.account_id
+    WHERE a.type = 'user'
+    WINDOW w AS (PARTITION BY p.account_id ORDER BY p.journal_entry_id, p.id)
+)
+UPDATE transactions t
+SET balance_after = r.balance_after,
+    balance_sequence = r.balance_sequence
+FROM running r
+WHERE t.journal_entry_id = r.journal_entry_id
+  AND t.user_id = r.user_id
+  AND t.currency = r.currency;
+
+UPDATE balances b
+SET sequence = s.last_sequence
+FROM (
+    SELECT user_id, currency, MAX(balance_sequence) AS last_sequence
+    FROM transactions
+    WHERE balance_sequence IS NOT NULL
+    GROUP BY user_id, currency
+) s
+WHERE b.user_id = s.user_id
+  AND b.currency = s.currency;
+
+COMMIT;
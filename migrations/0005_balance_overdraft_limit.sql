@@ -0,0 +1,25 @@
+-- Replaces the non-negative balance checks with checks against the overdraft
+-- limit of each balance.
+--
+-- Auto-migration adds the "overdraft_limit" column but does not drop the old
+-- named CHECK constraints, which would keep rejecting any negative balance.
+-- Existing balances get a limit of 0, so their behaviour does not change.
+--
+-- Run this once right after the new version has migrated the schema.
+
+BEGIN;
+
+ALTER TABLE balances
+    ADD COLUMN IF NOT EXISTS overdraft_limit numeric(30,8) NOT NULL DEFAULT 0;
+
+ALTER TABLE balances
+    DROP CONSTRAINT IF EXISTS balance_amount_non_negative,
+    DROP CONSTRAINT IF EXISTS balance_available_non_negative,
+    DROP CONSTRAINT IF EXISTS balance_amount_within_overdraft,
+    DROP CONSTRAINT IF EXISTS balance_available_within_overdraft,
+    DROP CONSTRAINT IF EXISTS balance_overdraft_limit_non_negative,
+    ADD CONSTRAINT balance_amount_within_overdraft CHECK (amount >= -overdraft_limit),
+    ADD CONSTRAINT balance_available_within_overdraft CHECK (available >= -overdraft_limit),
+    ADD CONSTRAINT balance_overdraft_limit_non_negative CHECK (overdraft_limit >= 0);
+
+COMMIT;
@@ -0,0 +1,27 @@
+-- Backfills the effective dates of journal entries, postings and transactions
+-- recorded before they were tracked.
+--
+-- Auto-migration adds the "effective_at" columns with the migration time as
+-- default. Existing rows took effect when they were created, or for
+-- transactions when they were posted. Rows written by the new version are
+-- never effective after that time, so only rows still holding the default are
+-- changed and the script can be re-run safely.
+--
+-- Run this once right after the new version has migrated the schema, before
+-- the first accounting period is closed.
+
+BEGIN;
+
+UPDATE journal_entries
+SET effective_at = created_at
+WHERE effective_at > created_at;
+
+UPDATE postings
+SET effective_at = created_at
+WHERE effective_at > created_at;
+
+UPDATE transactions
+SET effective_at = COALESCE(posted_at, created_at)
+WHERE effective_at > COALESCE(posted_at, created_at);
+
+COMMIT;
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/account"
+	"accounting/ent/posting"
+)
+
+// System account names
+const (
+	// SystemAccountExternalCash is the counterpart of money entering or leaving
+	// the ledger through deposits and withdrawals
+	SystemAccountExternalCash = "external_cash"
+
+	// SystemAccountCurrencyExchange is the counterpart of both legs of a
+	// currency conversion; it accumulates the spread
+	SystemAccountCurrencyExchange = "currency_exchange"
+
+	// SystemAccountSuspense is the counterpart of reconciliation adjustments;
+	// its balance is the total drift found between balances and transactions
+	SystemAccountSuspense = "suspense"
+
+	// SystemAccountFeeRevenue collects the fees charged on transactions
+	SystemAccountFeeRevenue = "fee_revenue"
+
+	// SystemAccountInterestExpense is the counterpart of the interest paid out to users
+	SystemAccountInterestExpense = "interest_expense"
+)
+
+// UserAccountName is the name of the wallet account of a user in a currency
+const UserAccountName = "wallet"
+
+// AccountRepository represents a repository for working with ledger accounts
+type AccountRepository struct {
+	client *ent.Client
+}
+
+// NewAccountRepository creates a new account repository
+func NewAccountRepository(client *ent.Client) *AccountRepository {
+	return &AccountRepository{
+		client: client,
+	}
+}
+
+// UserAccountCode returns the code of the wallet account of a user in a currency
+func UserAccountCode(userID int, currency string) string {
+	return fmt.Sprintf("user:%d:%s", userID, currency)
+}
+
+// SystemAccountCode returns the code of a system account in a currency
+func SystemAccountCode(name string, currency string) string {
+	return fmt.Sprintf("system:%s:%s", name, currency)
+}
+
+// GetOrCreateUserAccountWithTx returns the wallet account of a user in a currency,
+// creating it within an existing DB transaction if it does not exist yet
+func (r *AccountRepository) GetOrCreateUserAccountWithTx(ctx context.Context, tx *ent.Tx, userID int,
+	currency string) (*ent.Account, error) {
+
+	return r.getOrCreateWithTx(ctx, tx, tx.Account.
+		Create().
+		SetCode(UserAccountCode(userID, currency)).
+		SetType(account.TypeUser).
+		SetName(UserAccountName).
+		SetUserID(userID).
+		SetCurrency(currency).
+		SetCreatedAt(time.Now()))
+}
+
+// GetOrCreateSystemAccountWithTx returns a system account in a currency,
+// creating it within an existing DB transaction if it does not exist yet
+func (r *AccountRepository) GetOrCreateSystemAccountWithTx(ctx context.Context, tx *ent.Tx, name string,
+	currency string) (*ent.Account, error) {
+
+	return r.getOrCreateWithTx(ctx, tx, tx.Account.
+		Create().
+		SetCode(SystemAccountCode(name, currency)).
+		SetType(account.TypeSystem).
+		SetName(name).
+		SetCurrency(currency).
+		SetCreatedAt(time.Now()))
+}
+
+// getOrCreateWithTx returns the account with the code of the builder, inserting
+// it first if it does not exist yet
+func (r *AccountRepository) getOrCreateWithTx(ctx context.Context, tx *ent.Tx,
+	builder *ent.AccountCreate) (*ent.Account, error) {
+
+	code, _ := builder.Mutation().Code()
+
+	acc, err := tx.Account.
+		Query().
+		Where(account.Code(code)).
+		Only(ctx)
+	if err == nil {
+		return acc, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed querying account %s: %w", code, err)
+	}
+
+	// ON CONFLICT DO NOTHING keeps the DB transaction usable when a concurrent
+	// request has created the same account in the meantime; no row is returned then
+	err = builder.
+		OnConflictColumns(account.FieldCode).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && !stderrors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed creating account %s: %w", code, err)
+	}
+
+	acc, err = tx.Account.
+		Query().
+		Where(account.Code(code)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying account %s: %w", code, err)
+	}
+
+	return acc, nil
+}
+
+// GetByCode returns an account by its code
+func (r *AccountRepository) GetByCode(ctx context.Context, code string) (*ent.Account, error) {
+	acc, err := r.client.Account.
+		Query().
+		Where(account.Code(code)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying account by code: %w", err)
+	}
+
+	return acc, nil
+}
+
+// GetUserAccountsPostedBy returns the wallet accounts of a user with postings
+// that take effect at or before an instant. Accounts are created on their first
+// posting, which may be back-dated before the account was created.
+func (r *AccountRepository) GetUserAccountsPostedBy(ctx context.Context, userID int, at time.Time) ([]*ent.Account, error) {
+	accounts, err := r.client.Account.
+		Query().
+		Where(
+			account.UserID(userID),
+			account.TypeEQ(account.TypeUser),
+			account.HasPostingsWith(posting.EffectiveAtLTE(at)),
+		).
+		Order(ent.Asc(account.FieldCurrency)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying user accounts: %w", err)
+	}
+
+	return accounts, nil
+}
+
+// ListUserAccounts returns up to limit wallet accounts with an ID greater than
+// afterID, ordered by ID, so all accounts can be walked through in pages
+func (r *AccountRepository) ListUserAccounts(ctx context.Context, afterID int, limit int) ([]*ent.Account, error) {
+	accounts, err := r.client.Account.
+		Query().
+		Where(
+			account.TypeEQ(account.TypeUser),
+			account.IDGT(afterID),
+		).
+		Order(ent.Asc(account.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed listing user accounts: %w", err)
+	}
+
+	return accounts, nil
+}
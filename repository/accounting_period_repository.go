@@ -0,0 +1,369 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/accountingperiod"
+	"accounting/ent/posting"
+	"accounting/ent/trialbalanceline"
+	"accounting/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// periodLockNamespace is the first key of the advisory lock that serializes
+// the creation of accounting periods, so concurrent periods cannot overlap
+const periodLockNamespace = 2
+
+// trialBalanceChunkSize is the number of trial balance lines inserted per statement
+const trialBalanceChunkSize = 1000
+
+// AccountingPeriodRepository represents a repository for working with accounting periods
+type AccountingPeriodRepository struct {
+	client *ent.Client
+}
+
+// NewAccountingPeriodRepository creates a new accounting period repository
+func NewAccountingPeriodRepository(client *ent.Client) *AccountingPeriodRepository {
+	return &AccountingPeriodRepository{
+		client: client,
+	}
+}
+
+// Create creates an open accounting period from startsAt up to endsAt. Periods
+// cannot overlap, and no period can be added before a period that is already
+// being closed.
+func (r *AccountingPeriodRepository) Create(ctx context.Context, name string, startsAt time.Time,
+	endsAt time.Time) (*ent.AccountingPeriod, error) {
+
+	var period *ent.AccountingPeriod
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1, 0)", periodLockNamespace); err != nil {
+			return fmt.Errorf("failed locking accounting periods: %w", err)
+		}
+
+		overlapping, err := tx.AccountingPeriod.
+			Query().
+			Where(
+				accountingperiod.StartsAtLT(endsAt),
+				accountingperiod.EndsAtGT(startsAt),
+			).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed querying overlapping accounting periods: %w", err)
+		}
+		if overlapping != nil {
+			return errors.WithDetails(errors.ErrInvalidInput, "period overlaps accounting period %s", overlapping.Name)
+		}
+
+		later, err := tx.AccountingPeriod.
+			Query().
+			Where(
+				accountingperiod.StartsAtGTE(endsAt),
+				accountingperiod.StatusNEQ(accountingperiod.StatusOpen),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed querying later accounting periods: %w", err)
+		}
+		if later {
+			return errors.WithDetails(errors.ErrInvalidState, "a later accounting period is already being closed")
+		}
+
+		period, err = tx.AccountingPeriod.
+			Create().
+			SetName(name).
+			SetStartsAt(startsAt).
+			SetEndsAt(endsAt).
+			SetCreatedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed creating accounting period: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return period, nil
+}
+
+// GetAll gets all accounting periods in chronological order
+func (r *AccountingPeriodRepository) GetAll(ctx context.Context) ([]*ent.AccountingPeriod, error) {
+	periods, err := r.client.AccountingPeriod.
+		Query().
+		Order(ent.Asc(accountingperiod.FieldStartsAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying accounting periods: %w", err)
+	}
+
+	return periods, nil
+}
+
+// GetWithTrialBalance gets an accounting period together with the lines of its
+// trial balance, which only closed periods have
+func (r *AccountingPeriodRepository) GetWithTrialBalance(ctx context.Context, id int) (*ent.AccountingPeriod, error) {
+	period, err := r.client.AccountingPeriod.
+		Query().
+		Where(accountingperiod.ID(id)).
+		WithTrialBalanceLines(func(q *ent.TrialBalanceLineQuery) {
+			q.Order(ent.Asc(trialbalanceline.FieldCurrency), ent.Asc(trialbalanceline.FieldAccountID))
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.WithDetails(errors.ErrNotFound, "unknown accounting period %d", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed querying accounting period %d: %w", id, err)
+	}
+
+	return period, nil
+}
+
+// StartClose locks an open period that has ended for review: from then on no
+// transaction can take effect inside it
+func (r *AccountingPeriodRepository) StartClose(ctx context.Context, id int) (*ent.AccountingPeriod, error) {
+	var period *ent.AccountingPeriod
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		var err error
+		period, err = lockPeriodWithTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if period.Status != accountingperiod.StatusOpen {
+			return errors.WithDetails(errors.ErrInvalidState, "accounting period %s is %s", period.Name, period.Status)
+		}
+		if period.EndsAt.After(time.Now()) {
+			return errors.WithDetails(errors.ErrInvalidState, "accounting period %s has not ended yet", period.Name)
+		}
+
+		period, err = tx.AccountingPeriod.
+			UpdateOne(period).
+			SetStatus(accountingperiod.StatusClosing).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed updating accounting period status: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return period, nil
+}
+
+// Reopen opens a period under review again, e.g. to back-date a missed transaction
+func (r *AccountingPeriodRepository) Reopen(ctx context.Context, id int) (*ent.AccountingPeriod, error) {
+	var period *ent.AccountingPeriod
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		var err error
+		period, err = lockPeriodWithTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if period.Status != accountingperiod.StatusClosing {
+			return errors.WithDetails(errors.ErrInvalidState, "accounting period %s is %s", period.Name, period.Status)
+		}
+
+		period, err = tx.AccountingPeriod.
+			UpdateOne(period).
+			SetStatus(accountingperiod.StatusOpen).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed updating accounting period status: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return period, nil
+}
+
+// Close closes a period under review for good and captures its trial balance:
+// the movement within the period and the balance at its end of every account.
+// Earlier periods must be closed first. A closed period cannot be reopened;
+// corrections are posted in the current open period instead.
+func (r *AccountingPeriodRepository) Close(ctx context.Context, id int) (*ent.AccountingPeriod, error) {
+	var period *ent.AccountingPeriod
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		var err error
+		period, err = lockPeriodWithTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if period.Status != accountingperiod.StatusClosing {
+			return errors.WithDetails(errors.ErrInvalidState, "accounting period %s is %s, only periods under review can be closed",
+				period.Name, period.Status)
+		}
+
+		earlier, err := tx.AccountingPeriod.
+			Query().
+			Where(
+				accountingperiod.StartsAtLT(period.StartsAt),
+				accountingperiod.StatusNEQ(accountingperiod.StatusClosed),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed querying earlier accounting periods: %w", err)
+		}
+		if earlier {
+			return errors.WithDetails(errors.ErrInvalidState, "earlier accounting periods must be closed first")
+		}
+
+		if err := r.captureTrialBalanceWithTx(ctx, tx, period); err != nil {
+			return err
+		}
+
+		period, err = tx.AccountingPeriod.
+			UpdateOne(period).
+			SetStatus(accountingperiod.StatusClosed).
+			SetClosedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed updating accounting period status: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return period, nil
+}
+
+// trialBalanceRow is the sum of the postings of an account within a time range
+type trialBalanceRow struct {
+	AccountID int             `json:"account_id"`
+	Currency  string          `json:"currency"`
+	Sum       decimal.Decimal `json:"sum"`
+}
+
+// captureTrialBalanceWithTx writes the trial balance of a period within an
+// existing DB transaction. The postings of every currency sum to zero, so
+// the balances of every currency must too; otherwise the ledger is broken and
+// the period is not closed.
+func (r *AccountingPeriodRepository) captureTrialBalanceWithTx(ctx context.Context, tx *ent.Tx,
+	period *ent.AccountingPeriod) error {
+
+	var balances, movements []trialBalanceRow
+	err := tx.Posting.
+		Query().
+		Where(posting.EffectiveAtLT(period.EndsAt)).
+		GroupBy(posting.FieldAccountID, posting.FieldCurrency).
+		Aggregate(ent.As(ent.Sum(posting.FieldAmount), "sum")).
+		Scan(ctx, &balances)
+	if err != nil {
+		return fmt.Errorf("failed summing account balances: %w", err)
+	}
+	err = tx.Posting.
+		Query().
+		Where(
+			posting.EffectiveAtGTE(period.StartsAt),
+			posting.EffectiveAtLT(period.EndsAt),
+		).
+		GroupBy(posting.FieldAccountID, posting.FieldCurrency).
+		Aggregate(ent.As(ent.Sum(posting.FieldAmount), "sum")).
+		Scan(ctx, &movements)
+	if err != nil {
+		return fmt.Errorf("failed summing account movements: %w", err)
+	}
+
+	movementByAccount := make(map[int]decimal.Decimal, len(movements))
+	for _, row := range movements {
+		movementByAccount[row.AccountID] = row.Sum
+	}
+
+	totals := make(map[string]decimal.Decimal)
+	for _, row := range balances {
+		totals[row.Currency] = totals[row.Currency].Add(row.Sum)
+	}
+	for currency, total := range totals {
+		if !total.IsZero() {
+			return errors.WithDetails(errors.ErrUnbalancedEntry, "%s balances of accounting period %s sum to %s",
+				currency, period.Name, total)
+		}
+	}
+
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].AccountID < balances[j].AccountID
+	})
+
+	now := time.Now()
+	for start := 0; start < len(balances); start += trialBalanceChunkSize {
+		end := min(start+trialBalanceChunkSize, len(balances))
+
+		builders := make([]*ent.TrialBalanceLineCreate, 0, end-start)
+		for _, row := range balances[start:end] {
+			builders = append(builders, tx.TrialBalanceLine.
+				Create().
+				SetPeriodID(period.ID).
+				SetAccountID(row.AccountID).
+				SetCurrency(row.Currency).
+				SetMovement(movementByAccount[row.AccountID]).
+				SetBalance(row.Sum).
+				SetCreatedAt(now))
+		}
+		if _, err := tx.TrialBalanceLine.CreateBulk(builders...).Save(ctx); err != nil {
+			return fmt.Errorf("failed creating trial balance lines: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// lockPeriodWithTx locks an accounting period for update. Status changes wait
+// for the transactions that checked the period to finish.
+func lockPeriodWithTx(ctx context.Context, tx *ent.Tx, id int) (*ent.AccountingPeriod, error) {
+	period, err := tx.AccountingPeriod.
+		Query().
+		Where(accountingperiod.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.WithDetails(errors.ErrNotFound, "unknown accounting period %d", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed locking accounting period: %w", err)
+	}
+
+	return period, nil
+}
+
+// checkPeriodOpenWithTx checks that a transaction can take effect at an
+// instant: instants outside of any period are allowed, instants inside a
+// period only while it is open. The period row is locked for share until the
+// DB transaction ends, so the period cannot start closing while the
+// transaction is being written.
+func checkPeriodOpenWithTx(ctx context.Context, tx *ent.Tx, at time.Time) error {
+	period, err := tx.AccountingPeriod.
+		Query().
+		Where(
+			accountingperiod.StartsAtLTE(at),
+			accountingperiod.EndsAtGT(at),
+		).
+		ForShare().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed querying accounting period: %w", err)
+	}
+
+	if period.Status != accountingperiod.StatusOpen {
+		return errors.WithDetails(errors.ErrInvalidState,
+			"accounting period %s is %s, corrections must be posted in the current open period",
+			period.Name, period.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"accounting/ent/accountingperiod"
+	"accounting/errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestTransferIntoClosingPeriodIsRejected(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	users := NewUserRepository(client)
+	from, err := users.Create(ctx, "Sender", uuid.NewString()+"@example.com", 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, err := users.Create(ctx, "Recipient", uuid.NewString()+"@example.com", 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	period, err := client.AccountingPeriod.
+		Create().
+		SetName("closing-" + uuid.NewString()).
+		SetStartsAt(now.Add(-time.Hour)).
+		SetEndsAt(now.Add(time.Hour)).
+		SetStatus(accountingperiod.StatusClosing).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.AccountingPeriod.DeleteOne(period).Exec(context.Background()) })
+
+	_, err = newTestTransactionRepository(client).CreateTransfer(ctx, CreateTransferParams{
+		TransferID: uuid.NewString(),
+		FromUserID: from.ID,
+		ToUserID:   to.ID,
+		Currency:   "USD",
+		Amount:     decimal.NewFromInt(10),
+	})
+	if !errors.IsInvalidState(err) {
+		t.Fatalf("transfer into a closing period: got error %v, want invalid state", err)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"accounting/ent/balance"
 	"accounting/ent/user"
 	"accounting/errors"
+
+	"github.com/shopspring/decimal"
 )
 
 // BalanceRepository represents a repository for working with balances
@@ -43,53 +45,184 @@ func (r *BalanceRepository) GetByUserIDAndCurrency(ctx context.Context, userID i
 type UpsertBalanceParams struct {
 	UserID   int
 	Currency string
-	Amount   float64
+	Amount   decimal.Decimal
 }
 
 // UpsertWithTx creates or updates a balance within an existing DB transaction
-func (r *BalanceRepository) UpsertWithTx(ctx context.Context, tx *ent.Tx, params UpsertBalanceParams) error {
+// and returns the resulting balance. The change is applied to both the amount
+// and the available funds, so a debit fails when the funds not reserved by
+// active holds would go below the overdraft limit. Every change increments the
+// balance sequence.
+func (r *BalanceRepository) UpsertWithTx(ctx context.Context, tx *ent.Tx, params UpsertBalanceParams) (*ent.Balance, error) {
+	current, err := r.lockWithTx(ctx, tx, params.UserID, params.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the balance is not found, create a new one
+	if current == nil {
+		if params.Amount.IsNegative() {
+			return nil, &errors.InsufficientFundsError{
+				Currency:       params.Currency,
+				Requested:      params.Amount.Neg(),
+				Available:      decimal.Zero,
+				OverdraftLimit: decimal.Zero,
+			}
+		}
+
+		created, err := tx.Balance.
+			Create().
+			SetUserID(params.UserID).
+			SetCurrency(params.Currency).
+			SetAmount(params.Amount).
+			SetAvailable(params.Amount).
+			SetSequence(1).
+			SetCreatedAt(time.Now()).
+			SetUpdatedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed creating %s balance: %w", params.Currency, err)
+		}
+		return created, nil
+	}
+
+	if err := checkOverdraft(current, params.Amount); err != nil {
+		return nil, err
+	}
+
 	updated, err := tx.Balance.
-		Update().
-		Where(
-			balance.UserID(params.UserID),
-			balance.CurrencyEQ(params.Currency),
-		).
+		UpdateOne(current).
 		AddAmount(params.Amount).
+		AddAvailable(params.Amount).
+		AddSequence(1).
 		SetUpdatedAt(time.Now()).
 		Save(ctx)
-	if updated != 0 {
-		return nil
+	if err != nil {
+		if errors.IsNegativeBalanceConstraintError(err) {
+			return nil, errors.ErrInsufficientFunds
+		}
+
+		return nil, fmt.Errorf("failed upserting %s balance: %w", params.Currency, err)
 	}
+
+	return updated, nil
+}
+
+// AdjustAvailableWithTx changes the available funds of a balance without
+// changing its amount within an existing DB transaction. A negative delta
+// reserves funds for a hold, a positive one releases them.
+func (r *BalanceRepository) AdjustAvailableWithTx(ctx context.Context, tx *ent.Tx, params UpsertBalanceParams) error {
+	current, err := r.lockWithTx(ctx, tx, params.UserID, params.Currency)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return errors.WithDetails(errors.ErrInsufficientFunds, "no %s balance to reserve", params.Currency)
+	}
+	if err := checkOverdraft(current, params.Amount); err != nil {
+		return err
+	}
+
+	err = tx.Balance.
+		UpdateOne(current).
+		AddAvailable(params.Amount).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
 	if err != nil {
 		if errors.IsNegativeBalanceConstraintError(err) {
 			return errors.ErrInsufficientFunds
 		}
 
-		return fmt.Errorf("failed upserting %s balance: %w", params.Currency, err)
+		return fmt.Errorf("failed adjusting available %s balance: %w", params.Currency, err)
 	}
 
-	// If the balance is not found, create a new one
-	if updated == 0 {
-		if params.Amount < 0 {
-			return fmt.Errorf("failed creating %s balance: insufficient initial funds", params.Currency)
+	return nil
+}
+
+// SetOverdraftLimit sets how far below zero the available funds of a balance may
+// go, creating an empty balance if the user has none in the currency yet. A limit
+// that the current available funds already exceed is rejected.
+func (r *BalanceRepository) SetOverdraftLimit(ctx context.Context, userID int, currency string,
+	limit decimal.Decimal) (*ent.Balance, error) {
+
+	var result *ent.Balance
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		current, err := r.lockWithTx(ctx, tx, userID, currency)
+		if err != nil {
+			return err
 		}
 
-		_, err = tx.Balance.
-			Create().
-			SetUserID(params.UserID).
-			SetCurrency(params.Currency).
-			SetAmount(params.Amount).
-			SetCreatedAt(time.Now()).
+		if current == nil {
+			result, err = tx.Balance.
+				Create().
+				SetUserID(userID).
+				SetCurrency(currency).
+				SetOverdraftLimit(limit).
+				SetCreatedAt(time.Now()).
+				SetUpdatedAt(time.Now()).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed creating %s balance: %w", currency, err)
+			}
+			return nil
+		}
+
+		if current.Available.LessThan(limit.Neg()) {
+			return errors.WithDetails(errors.ErrInvalidState, "available %s %s is below the overdraft limit %s",
+				current.Available, currency, limit)
+		}
+
+		result, err = tx.Balance.
+			UpdateOne(current).
+			SetOverdraftLimit(limit).
 			SetUpdatedAt(time.Now()).
 			Save(ctx)
 		if err != nil {
-			return fmt.Errorf("failed creating %s balance: %w", params.Currency, err)
+			return fmt.Errorf("failed setting %s overdraft limit: %w", currency, err)
 		}
 		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// If another error occurred
-	return fmt.Errorf("failed upserting %s balance: %w", params.Currency, err)
+	return result, nil
+}
+
+// lockWithTx locks the balance of a user in a currency within an existing DB
+// transaction and returns it, or nil when there is none
+func (r *BalanceRepository) lockWithTx(ctx context.Context, tx *ent.Tx, userID int, currency string) (*ent.Balance, error) {
+	b, err := tx.Balance.
+		Query().
+		Where(
+			balance.UserID(userID),
+			balance.CurrencyEQ(currency),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed locking %s balance: %w", currency, err)
+	}
+
+	return b, nil
+}
+
+// checkOverdraft checks that a change keeps the available funds of a balance
+// within its overdraft limit; credits are always allowed
+func checkOverdraft(b *ent.Balance, delta decimal.Decimal) error {
+	if !delta.IsNegative() || !b.Available.Add(delta).LessThan(b.OverdraftLimit.Neg()) {
+		return nil
+	}
+
+	return &errors.InsufficientFundsError{
+		Currency:       b.Currency,
+		Requested:      delta.Neg(),
+		Available:      b.Available,
+		OverdraftLimit: b.OverdraftLimit,
+	}
 }
 
 // GetAllByUserID returns all balances of a user
@@ -105,6 +238,21 @@ func (r *BalanceRepository) GetAllByUserID(ctx context.Context, userID int) ([]*
 	return balances, nil
 }
 
+// GetPage returns up to limit balances with an ID greater than afterID, ordered by ID
+func (r *BalanceRepository) GetPage(ctx context.Context, afterID int, limit int) ([]*ent.Balance, error) {
+	balances, err := r.client.Balance.
+		Query().
+		Where(balance.IDGT(afterID)).
+		Order(ent.Asc(balance.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying balances: %w", err)
+	}
+
+	return balances, nil
+}
+
 // GetAllByUserIDUsingEdge returns all balances of a user through the edge in the graph
 func (r *BalanceRepository) GetAllByUserIDUsingEdge(ctx context.Context, userID int) ([]*ent.Balance, error) {
 	balances, err := r.client.User.
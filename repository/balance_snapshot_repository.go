@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/balance"
+	"accounting/ent/balancesnapshot"
+
+	"github.com/shopspring/decimal"
+)
+
+// BalanceSnapshotRepository represents a repository for working with balance snapshots
+type BalanceSnapshotRepository struct {
+	client *ent.Client
+}
+
+// NewBalanceSnapshotRepository creates a new balance snapshot repository
+func NewBalanceSnapshotRepository(client *ent.Client) *BalanceSnapshotRepository {
+	return &BalanceSnapshotRepository{
+		client: client,
+	}
+}
+
+// GetLatestAt returns the latest snapshot of an account taken at or before an
+// instant, or nil when there is none
+func (r *BalanceSnapshotRepository) GetLatestAt(ctx context.Context, accountID int,
+	at time.Time) (*ent.BalanceSnapshot, error) {
+
+	snapshot, err := r.client.BalanceSnapshot.
+		Query().
+		Where(
+			balancesnapshot.AccountID(accountID),
+			balancesnapshot.TakenAtLTE(at),
+		).
+		Order(ent.Desc(balancesnapshot.FieldTakenAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed querying balance snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// Capture stores the balance of a user account at the cutoff and reports whether
+// it did; an account without postings since its previous snapshot is skipped.
+// The balance is locked while it is summed, so a back-dated posting either is
+// summed or adjusts the snapshot when it commits. A snapshot of the same
+// account and instant taken concurrently is kept as is.
+func (r *BalanceSnapshotRepository) Capture(ctx context.Context, a *ent.Account, cutoff time.Time) (bool, error) {
+	captured := false
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		if a.UserID != nil {
+			_, err := tx.Balance.
+				Query().
+				Where(
+					balance.UserID(*a.UserID),
+					balance.CurrencyEQ(a.Currency),
+				).
+				ForShare().
+				All(ctx)
+			if err != nil {
+				return fmt.Errorf("failed locking %s balance: %w", a.Currency, err)
+			}
+		}
+
+		amount := decimal.Zero
+		var from *time.Time
+		previous, err := tx.BalanceSnapshot.
+			Query().
+			Where(
+				balancesnapshot.AccountID(a.ID),
+				balancesnapshot.TakenAtLTE(cutoff),
+			).
+			Order(ent.Desc(balancesnapshot.FieldTakenAt)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed querying balance snapshot: %w", err)
+		}
+		if previous != nil {
+			amount = previous.Amount
+			from = &previous.TakenAt
+		}
+
+		sum, err := sumPostings(ctx, tx.Posting, a.ID, from, cutoff)
+		if err != nil {
+			return err
+		}
+		if sum.Count == 0 {
+			return nil
+		}
+
+		err = tx.BalanceSnapshot.
+			Create().
+			SetAccountID(a.ID).
+			SetAmount(amount.Add(sum.Amount)).
+			SetTakenAt(cutoff).
+			SetCreatedAt(time.Now()).
+			OnConflictColumns(balancesnapshot.FieldAccountID, balancesnapshot.FieldTakenAt).
+			DoNothing().
+			Exec(ctx)
+		if err != nil && !stderrors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed creating balance snapshot: %w", err)
+		}
+		captured = err == nil
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return captured, nil
+}
@@ -0,0 +1,164 @@
+package repository
+
+// ISO4217Currencies lists the active ISO 4217 currencies with the number of
+// decimal places of their minor unit. It is used to seed the currency registry.
+var ISO4217Currencies = []CurrencySeed{
+	{Code: "AED", NumericCode: "784", Name: "UAE Dirham", Exponent: 2},
+	{Code: "AFN", NumericCode: "971", Name: "Afghani", Exponent: 2},
+	{Code: "ALL", NumericCode: "008", Name: "Lek", Exponent: 2},
+	{Code: "AMD", NumericCode: "051", Name: "Armenian Dram", Exponent: 2},
+	{Code: "ANG", NumericCode: "532", Name: "Netherlands Antillean Guilder", Exponent: 2},
+	{Code: "AOA", NumericCode: "973", Name: "Kwanza", Exponent: 2},
+	{Code: "ARS", NumericCode: "032", Name: "Argentine Peso", Exponent: 2},
+	{Code: "AUD", NumericCode: "036", Name: "Australian Dollar", Exponent: 2},
+	{Code: "AWG", NumericCode: "533", Name: "Aruban Florin", Exponent: 2},
+	{Code: "AZN", NumericCode: "944", Name: "Azerbaijan Manat", Exponent: 2},
+	{Code: "BAM", NumericCode: "977", Name: "Convertible Mark", Exponent: 2},
+	{Code: "BBD", NumericCode: "052", Name: "Barbados Dollar", Exponent: 2},
+	{Code: "BDT", NumericCode: "050", Name: "Taka", Exponent: 2},
+	{Code: "BGN", NumericCode: "975", Name: "Bulgarian Lev", Exponent: 2},
+	{Code: "BHD", NumericCode: "048", Name: "Bahraini Dinar", Exponent: 3},
+	{Code: "BIF", NumericCode: "108", Name: "Burundi Franc", Exponent: 0},
+	{Code: "BMD", NumericCode: "060", Name: "Bermudian Dollar", Exponent: 2},
+	{Code: "BND", NumericCode: "096", Name: "Brunei Dollar", Exponent: 2},
+	{Code: "BOB", NumericCode: "068", Name: "Boliviano", Exponent: 2},
+	{Code: "BRL", NumericCode: "986", Name: "Brazilian Real", Exponent: 2},
+	{Code: "BSD", NumericCode: "044", Name: "Bahamian Dollar", Exponent: 2},
+	{Code: "BTN", NumericCode: "064", Name: "Ngultrum", Exponent: 2},
+	{Code: "BWP", NumericCode: "072", Name: "Pula", Exponent: 2},
+	{Code: "BYN", NumericCode: "933", Name: "Belarusian Ruble", Exponent: 2},
+	{Code: "BZD", NumericCode: "084", Name: "Belize Dollar", Exponent: 2},
+	{Code: "CAD", NumericCode: "124", Name: "Canadian Dollar", Exponent: 2},
+	{Code: "CDF", NumericCode: "976", Name: "Congolese Franc", Exponent: 2},
+	{Code: "CHF", NumericCode: "756", Name: "Swiss Franc", Exponent: 2},
+	{Code: "CLF", NumericCode: "990", Name: "Unidad de Fomento", Exponent: 4},
+	{Code: "CLP", NumericCode: "152", Name: "Chilean Peso", Exponent: 0},
+	{Code: "CNY", NumericCode: "156", Name: "Yuan Renminbi", Exponent: 2},
+	{Code: "COP", NumericCode: "170", Name: "Colombian Peso", Exponent: 2},
+	{Code: "CRC", NumericCode: "188", Name: "Costa Rican Colon", Exponent: 2},
+	{Code: "CUP", NumericCode: "192", Name: "Cuban Peso", Exponent: 2},
+	{Code: "CVE", NumericCode: "132", Name: "Cabo Verde Escudo", Exponent: 2},
+	{Code: "CZK", NumericCode: "203", Name: "Czech Koruna", Exponent: 2},
+	{Code: "DJF", NumericCode: "262", Name: "Djibouti Franc", Exponent: 0},
+	{Code: "DKK", NumericCode: "208", Name: "Danish Krone", Exponent: 2},
+	{Code: "DOP", NumericCode: "214", Name: "Dominican Peso", Exponent: 2},
+	{Code: "DZD", NumericCode: "012", Name: "Algerian Dinar", Exponent: 2},
+	{Code: "EGP", NumericCode: "818", Name: "Egyptian Pound", Exponent: 2},
+	{Code: "ERN", NumericCode: "232", Name: "Nakfa", Exponent: 2},
+	{Code: "ETB", NumericCode: "230", Name: "Ethiopian Birr", Exponent: 2},
+	{Code: "EUR", NumericCode: "978", Name: "Euro", Exponent: 2},
+	{Code: "FJD", NumericCode: "242", Name: "Fiji Dollar", Exponent: 2},
+	{Code: "FKP", NumericCode: "238", Name: "Falkland Islands Pound", Exponent: 2},
+	{Code: "GBP", NumericCode: "826", Name: "Pound Sterling", Exponent: 2},
+	{Code: "GEL", NumericCode: "981", Name: "Lari", Exponent: 2},
+	{Code: "GHS", NumericCode: "936", Name: "Ghana Cedi", Exponent: 2},
+	{Code: "GIP", NumericCode: "292", Name: "Gibraltar Pound", Exponent: 2},
+	{Code: "GMD", NumericCode: "270", Name: "Dalasi", Exponent: 2},
+	{Code: "GNF", NumericCode: "324", Name: "Guinean Franc", Exponent: 0},
+	{Code: "GTQ", NumericCode: "320", Name: "Quetzal", Exponent: 2},
+	{Code: "GYD", NumericCode: "328", Name: "Guyana Dollar", Exponent: 2},
+	{Code: "HKD", NumericCode: "344", Name: "Hong Kong Dollar", Exponent: 2},
+	{Code: "HNL", NumericCode: "340", Name: "Lempira", Exponent: 2},
+	{Code: "HTG", NumericCode: "332", Name: "Gourde", Exponent: 2},
+	{Code: "HUF", NumericCode: "348", Name: "Forint", Exponent: 2},
+	{Code: "IDR", NumericCode: "360", Name: "Rupiah", Exponent: 2},
+	{Code: "ILS", NumericCode: "376", Name: "New Israeli Sheqel", Exponent: 2},
+	{Code: "INR", NumericCode: "356", Name: "Indian Rupee", Exponent: 2},
+	{Code: "IQD", NumericCode: "368", Name: "Iraqi Dinar", Exponent: 3},
+	{Code: "IRR", NumericCode: "364", Name: "Iranian Rial", Exponent: 2},
+	{Code: "ISK", NumericCode: "352", Name: "Iceland Krona", Exponent: 0},
+	{Code: "JMD", NumericCode: "388", Name: "Jamaican Dollar", Exponent: 2},
+	{Code: "JOD", NumericCode: "400", Name: "Jordanian Dinar", Exponent: 3},
+	{Code: "JPY", NumericCode: "392", Name: "Yen", Exponent: 0},
+	{Code: "KES", NumericCode: "404", Name: "Kenyan Shilling", Exponent: 2},
+	{Code: "KGS", NumericCode: "417", Name: "Som", Exponent: 2},
+	{Code: "KHR", NumericCode: "116", Name: "Riel", Exponent: 2},
+	{Code: "KMF", NumericCode: "174", Name: "Comorian Franc", Exponent: 0},
+	{Code: "KPW", NumericCode: "408", Name: "North Korean Won", Exponent: 2},
+	{Code: "KRW", NumericCode: "410", Name: "Won", Exponent: 0},
+	{Code: "KWD", NumericCode: "414", Name: "Kuwaiti Dinar", Exponent: 3},
+	{Code: "KYD", NumericCode: "136", Name: "Cayman Islands Dollar", Exponent: 2},
+	{Code: "KZT", NumericCode: "398", Name: "Tenge", Exponent: 2},
+	{Code: "LAK", NumericCode: "418", Name: "Lao Kip", Exponent: 2},
+	{Code: "LBP", NumericCode: "422", Name: "Lebanese Pound", Exponent: 2},
+	{Code: "LKR", NumericCode: "144", Name: "Sri Lanka Rupee", Exponent: 2},
+	{Code: "LRD", NumericCode: "430", Name: "Liberian Dollar", Exponent: 2},
+	{Code: "LSL", NumericCode: "426", Name: "Loti", Exponent: 2},
+	{Code: "LYD", NumericCode: "434", Name: "Libyan Dinar", Exponent: 3},
+	{Code: "MAD", NumericCode: "504", Name: "Moroccan Dirham", Exponent: 2},
+	{Code: "MDL", NumericCode: "498", Name: "Moldovan Leu", Exponent: 2},
+	{Code: "MGA", NumericCode: "969", Name: "Malagasy Ariary", Exponent: 2},
+	{Code: "MKD", NumericCode: "807", Name: "Denar", Exponent: 2},
+	{Code: "MMK", NumericCode: "104", Name: "Kyat", Exponent: 2},
+	{Code: "MNT", NumericCode: "496", Name: "Tugrik", Exponent: 2},
+	{Code: "MOP", NumericCode: "446", Name: "Pataca", Exponent: 2},
+	{Code: "MRU", NumericCode: "929", Name: "Ouguiya", Exponent: 2},
+	{Code: "MUR", NumericCode: "480", Name: "Mauritius Rupee", Exponent: 2},
+	{Code: "MVR", NumericCode: "462", Name: "Rufiyaa", Exponent: 2},
+	{Code: "MWK", NumericCode: "454", Name: "Malawi Kwacha", Exponent: 2},
+	{Code: "MXN", NumericCode: "484", Name: "Mexican Peso", Exponent: 2},
+	{Code: "MYR", NumericCode: "458", Name: "Malaysian Ringgit", Exponent: 2},
+	{Code: "MZN", NumericCode: "943", Name: "Mozambique Metical", Exponent: 2},
+	{Code: "NAD", NumericCode: "516", Name: "Namibia Dollar", Exponent: 2},
+	{Code: "NGN", NumericCode: "566", Name: "Naira", Exponent: 2},
+	{Code: "NIO", NumericCode: "558", Name: "Cordoba Oro", Exponent: 2},
+	{Code: "NOK", NumericCode: "578", Name: "Norwegian Krone", Exponent: 2},
+	{Code: "NPR", NumericCode: "524", Name: "Nepalese Rupee", Exponent: 2},
+	{Code: "NZD", NumericCode: "554", Name: "New Zealand Dollar", Exponent: 2},
+	{Code: "OMR", NumericCode: "512", Name: "Rial Omani", Exponent: 3},
+	{Code: "PAB", NumericCode: "590", Name: "Balboa", Exponent: 2},
+	{Code: "PEN", NumericCode: "604", Name: "Sol", Exponent: 2},
+	{Code: "PGK", NumericCode: "598", Name: "Kina", Exponent: 2},
+	{Code: "PHP", NumericCode: "608", Name: "Philippine Peso", Exponent: 2},
+	{Code: "PKR", NumericCode: "586", Name: "Pakistan Rupee", Exponent: 2},
+	{Code: "PLN", NumericCode: "985", Name: "Zloty", Exponent: 2},
+	{Code: "PYG", NumericCode: "600", Name: "Guarani", Exponent: 0},
+	{Code: "QAR", NumericCode: "634", Name: "Qatari Rial", Exponent: 2},
+	{Code: "RON", NumericCode: "946", Name: "Romanian Leu", Exponent: 2},
+	{Code: "RSD", NumericCode: "941", Name: "Serbian Dinar", Exponent: 2},
+	{Code: "RUB", NumericCode: "643", Name: "Russian Ruble", Exponent: 2},
+	{Code: "RWF", NumericCode: "646", Name: "Rwanda Franc", Exponent: 0},
+	{Code: "SAR", NumericCode: "682", Name: "Saudi Riyal", Exponent: 2},
+	{Code: "SBD", NumericCode: "090", Name: "Solomon Islands Dollar", Exponent: 2},
+	{Code: "SCR", NumericCode: "690", Name: "Seychelles Rupee", Exponent: 2},
+	{Code: "SDG", NumericCode: "938", Name: "Sudanese Pound", Exponent: 2},
+	{Code: "SEK", NumericCode: "752", Name: "Swedish Krona", Exponent: 2},
+	{Code: "SGD", NumericCode: "702", Name: "Singapore Dollar", Exponent: 2},
+	{Code: "SHP", NumericCode: "654", Name: "Saint Helena Pound", Exponent: 2},
+	{Code: "SLE", NumericCode: "925", Name: "Leone", Exponent: 2},
+	{Code: "SOS", NumericCode: "706", Name: "Somali Shilling", Exponent: 2},
+	{Code: "SRD", NumericCode: "968", Name: "Surinam Dollar", Exponent: 2},
+	{Code: "SSP", NumericCode: "728", Name: "South Sudanese Pound", Exponent: 2},
+	{Code: "STN", NumericCode: "930", Name: "Dobra", Exponent: 2},
+	{Code: "SVC", NumericCode: "222", Name: "El Salvador Colon", Exponent: 2},
+	{Code: "SYP", NumericCode: "760", Name: "Syrian Pound", Exponent: 2},
+	{Code: "SZL", NumericCode: "748", Name: "Lilangeni", Exponent: 2},
+	{Code: "THB", NumericCode: "764", Name: "Baht", Exponent: 2},
+	{Code: "TJS", NumericCode: "972", Name: "Somoni", Exponent: 2},
+	{Code: "TMT", NumericCode: "934", Name: "Turkmenistan New Manat", Exponent: 2},
+	{Code: "TND", NumericCode: "788", Name: "Tunisian Dinar", Exponent: 3},
+	{Code: "TOP", NumericCode: "776", Name: "Pa'anga", Exponent: 2},
+	{Code: "TRY", NumericCode: "949", Name: "Turkish Lira", Exponent: 2},
+	{Code: "TTD", NumericCode: "780", Name: "Trinidad and Tobago Dollar", Exponent: 2},
+	{Code: "TWD", NumericCode: "901", Name: "New Taiwan Dollar", Exponent: 2},
+	{Code: "TZS", NumericCode: "834", Name: "Tanzanian Shilling", Exponent: 2},
+	{Code: "UAH", NumericCode: "980", Name: "Hryvnia", Exponent: 2},
+	{Code: "UGX", NumericCode: "800", Name: "Uganda Shilling", Exponent: 0},
+	{Code: "USD", NumericCode: "840", Name: "US Dollar", Exponent: 2},
+	{Code: "UYU", NumericCode: "858", Name: "Peso Uruguayo", Exponent: 2},
+	{Code: "UYW", NumericCode: "927", Name: "Unidad Previsional", Exponent: 4},
+	{Code: "UZS", NumericCode: "860", Name: "Uzbekistan Sum", Exponent: 2},
+	{Code: "VED", NumericCode: "926", Name: "Bolivar Soberano", Exponent: 2},
+	{Code: "VES", NumericCode: "928", Name: "Bolivar Soberano", Exponent: 2},
+	{Code: "VND", NumericCode: "704", Name: "Dong", Exponent: 0},
+	{Code: "VUV", NumericCode: "548", Name: "Vatu", Exponent: 0},
+	{Code: "WST", NumericCode: "882", Name: "Tala", Exponent: 2},
+	{Code: "XAF", NumericCode: "950", Name: "CFA Franc BEAC", Exponent: 0},
+	{Code: "XCD", NumericCode: "951", Name: "East Caribbean Dollar", Exponent: 2},
+	{Code: "XOF", NumericCode: "952", Name: "CFA Franc BCEAO", Exponent: 0},
+	{Code: "XPF", NumericCode: "953", Name: "CFP Franc", Exponent: 0},
+	{Code: "YER", NumericCode: "886", Name: "Yemeni Rial", Exponent: 2},
+	{Code: "ZAR", NumericCode: "710", Name: "Rand", Exponent: 2},
+	{Code: "ZMW", NumericCode: "967", Name: "Zambian Kwacha", Exponent: 2},
+	{Code: "ZWG", NumericCode: "924", Name: "Zimbabwe Gold", Exponent: 2},
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/currency"
+	"accounting/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// CurrencyRepository represents a repository for working with the currency registry
+type CurrencyRepository struct {
+	client *ent.Client
+}
+
+// NewCurrencyRepository creates a new currency repository
+func NewCurrencyRepository(client *ent.Client) *CurrencyRepository {
+	return &CurrencyRepository{
+		client: client,
+	}
+}
+
+// CurrencySeed represents a currency inserted into the registry on startup
+type CurrencySeed struct {
+	Code        string
+	NumericCode string
+	Name        string
+	Exponent    int
+}
+
+// Seed inserts the given currencies, leaving already registered ones untouched
+// so that operators can keep currencies disabled across restarts
+func (r *CurrencyRepository) Seed(ctx context.Context, seeds []CurrencySeed) error {
+	now := time.Now()
+	builders := make([]*ent.CurrencyCreate, 0, len(seeds))
+	for _, s := range seeds {
+		builders = append(builders, r.client.Currency.
+			Create().
+			SetID(s.Code).
+			SetNumericCode(s.NumericCode).
+			SetName(s.Name).
+			SetExponent(s.Exponent).
+			SetCreatedAt(now).
+			SetUpdatedAt(now))
+	}
+
+	err := r.client.Currency.
+		CreateBulk(builders...).
+		OnConflictColumns(currency.FieldID).
+		DoNothing().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed seeding currencies: %w", err)
+	}
+
+	return nil
+}
+
+// GetByCode returns a currency by its ISO 4217 code
+func (r *CurrencyRepository) GetByCode(ctx context.Context, code string) (*ent.Currency, error) {
+	return r.getByCode(ctx, r.client.Currency, code)
+}
+
+// GetByCodeWithTx is like GetByCode but runs within an existing DB transaction
+func (r *CurrencyRepository) GetByCodeWithTx(ctx context.Context, tx *ent.Tx, code string) (*ent.Currency, error) {
+	return r.getByCode(ctx, tx.Currency, code)
+}
+
+func (r *CurrencyRepository) getByCode(ctx context.Context, client *ent.CurrencyClient, code string) (*ent.Currency, error) {
+	c, err := client.Get(ctx, code)
+	if ent.IsNotFound(err) {
+		return nil, errors.WithDetails(errors.ErrInvalidInput, "unknown currency %q", code)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed querying currency %s: %w", code, err)
+	}
+
+	return c, nil
+}
+
+// GetAll returns all registered currencies ordered by code
+func (r *CurrencyRepository) GetAll(ctx context.Context) ([]*ent.Currency, error) {
+	currencies, err := r.client.Currency.
+		Query().
+		Order(ent.Asc(currency.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying currencies: %w", err)
+	}
+
+	return currencies, nil
+}
+
+// SetEnabled enables or disables a currency
+func (r *CurrencyRepository) SetEnabled(ctx context.Context, code string, enabled bool) (*ent.Currency, error) {
+	c, err := r.client.Currency.
+		UpdateOneID(code).
+		SetEnabled(enabled).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.WithDetails(errors.ErrNotFound, "unknown currency %q", code)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed updating currency %s: %w", code, err)
+	}
+
+	return c, nil
+}
+
+// ValidateAmount checks that a currency is registered and enabled and that the
+// amount has no more decimal places than the currency's minor unit allows
+func ValidateAmount(c *ent.Currency, amount decimal.Decimal) error {
+	if !c.Enabled {
+		return errors.WithDetails(errors.ErrInvalidInput, "currency %s is disabled", c.ID)
+	}
+	if !amount.Equal(amount.Truncate(int32(c.Exponent))) {
+		return errors.WithDetails(errors.ErrInvalidInput, "%s amounts allow at most %d decimal places, got %s",
+			c.ID, c.Exponent, amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/exchangerate"
+	"accounting/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRateRepository represents a repository for working with exchange rates
+type ExchangeRateRepository struct {
+	client *ent.Client
+}
+
+// NewExchangeRateRepository creates a new exchange rate repository
+func NewExchangeRateRepository(client *ent.Client) *ExchangeRateRepository {
+	return &ExchangeRateRepository{
+		client: client,
+	}
+}
+
+// CreateExchangeRateParams represents the parameters for the Create method
+type CreateExchangeRateParams struct {
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          decimal.Decimal
+	Spread        decimal.Decimal
+	ValidFrom     time.Time
+	ValidTo       *time.Time
+}
+
+// Create stores a new exchange rate
+func (r *ExchangeRateRepository) Create(ctx context.Context, params CreateExchangeRateParams) (*ent.ExchangeRate, error) {
+	rate, err := r.client.ExchangeRate.
+		Create().
+		SetBaseCurrency(params.BaseCurrency).
+		SetQuoteCurrency(params.QuoteCurrency).
+		SetRate(params.Rate).
+		SetSpread(params.Spread).
+		SetValidFrom(params.ValidFrom).
+		SetNillableValidTo(params.ValidTo).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating %s/%s exchange rate: %w", params.BaseCurrency, params.QuoteCurrency, err)
+	}
+
+	return rate, nil
+}
+
+// GetValidAt returns the most recent exchange rate for a currency pair that is valid at the given time
+func (r *ExchangeRateRepository) GetValidAt(ctx context.Context, baseCurrency, quoteCurrency string,
+	at time.Time) (*ent.ExchangeRate, error) {
+
+	return r.getValidAt(ctx, r.client.ExchangeRate, baseCurrency, quoteCurrency, at)
+}
+
+// GetValidAtWithTx is like GetValidAt but runs within an existing DB transaction
+func (r *ExchangeRateRepository) GetValidAtWithTx(ctx context.Context, tx *ent.Tx, baseCurrency, quoteCurrency string,
+	at time.Time) (*ent.ExchangeRate, error) {
+
+	return r.getValidAt(ctx, tx.ExchangeRate, baseCurrency, quoteCurrency, at)
+}
+
+func (r *ExchangeRateRepository) getValidAt(ctx context.Context, client *ent.ExchangeRateClient, baseCurrency,
+	quoteCurrency string, at time.Time) (*ent.ExchangeRate, error) {
+
+	rate, err := client.
+		Query().
+		Where(
+			exchangerate.BaseCurrency(baseCurrency),
+			exchangerate.QuoteCurrency(quoteCurrency),
+			exchangerate.ValidFromLTE(at),
+			exchangerate.Or(
+				exchangerate.ValidToIsNil(),
+				exchangerate.ValidToGT(at),
+			),
+		).
+		Order(ent.Desc(exchangerate.FieldValidFrom), ent.Desc(exchangerate.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.WithDetails(errors.ErrNotFound, "no %s/%s exchange rate valid at %s",
+			baseCurrency, quoteCurrency, at.Format(time.RFC3339))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed querying %s/%s exchange rate: %w", baseCurrency, quoteCurrency, err)
+	}
+
+	return rate, nil
+}
+
+// rateScale is the number of decimal places rates are stored with
+const rateScale = 12
+
+// AppliedRate returns the rate applied to a conversion after deducting the
+// spread, rounded to rateScale decimal places. The conversion uses the rounded
+// rate, so the amount converted times the stored applied rate always gives the
+// converted amount before rounding to the minor unit.
+func AppliedRate(rate *ent.ExchangeRate) decimal.Decimal {
+	return rate.Rate.Mul(decimal.NewFromInt(1).Sub(rate.Spread)).Round(rateScale)
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/feerule"
+	"accounting/errors"
+	"accounting/fee"
+
+	"github.com/shopspring/decimal"
+)
+
+// FeeRuleRepository represents a repository for working with fee rules
+type FeeRuleRepository struct {
+	client *ent.Client
+}
+
+// NewFeeRuleRepository creates a new fee rule repository
+func NewFeeRuleRepository(client *ent.Client) *FeeRuleRepository {
+	return &FeeRuleRepository{
+		client: client,
+	}
+}
+
+// CreateFeeRuleParams represents the parameters for the Create method
+type CreateFeeRuleParams struct {
+	Name            string
+	TransactionType feerule.TransactionType
+	Currency        *string
+	Tier            *string
+	Kind            feerule.Kind
+	FixedAmount     decimal.Decimal
+	Percentage      decimal.Decimal
+	Tiers           []fee.Tier
+	MinFee          *decimal.Decimal
+	MaxFee          *decimal.Decimal
+	Priority        int
+}
+
+// Create creates an enabled fee rule
+func (r *FeeRuleRepository) Create(ctx context.Context, params CreateFeeRuleParams) (*ent.FeeRule, error) {
+	rule, err := r.client.FeeRule.
+		Create().
+		SetName(params.Name).
+		SetTransactionType(params.TransactionType).
+		SetNillableCurrency(params.Currency).
+		SetNillableTier(params.Tier).
+		SetKind(params.Kind).
+		SetFixedAmount(params.FixedAmount).
+		SetPercentage(params.Percentage).
+		SetTiers(params.Tiers).
+		SetNillableMinFee(params.MinFee).
+		SetNillableMaxFee(params.MaxFee).
+		SetPriority(params.Priority).
+		SetCreatedAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating fee rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+// GetAll returns all fee rules
+func (r *FeeRuleRepository) GetAll(ctx context.Context) ([]*ent.FeeRule, error) {
+	rules, err := r.client.FeeRule.
+		Query().
+		Order(ent.Asc(feerule.FieldTransactionType), ent.Desc(feerule.FieldPriority), ent.Asc(feerule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying fee rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// SetEnabled turns a fee rule on or off
+func (r *FeeRuleRepository) SetEnabled(ctx context.Context, id int, enabled bool) (*ent.FeeRule, error) {
+	rule, err := r.client.FeeRule.
+		UpdateOneID(id).
+		SetEnabled(enabled).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.WithDetails(errors.ErrNotFound, "unknown fee rule %d", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed updating fee rule %d: %w", id, err)
+	}
+
+	return rule, nil
+}
+
+// FindWithTx returns the enabled rule applying to a transaction of a type and
+// currency made by a user of a tier, or nil when no rule applies. Of several
+// matching rules the one with the highest priority wins; on equal priority a
+// rule limited to the currency or tier wins over a catch-all one.
+func (r *FeeRuleRepository) FindWithTx(ctx context.Context, tx *ent.Tx, txType feerule.TransactionType,
+	currency string, tier string) (*ent.FeeRule, error) {
+
+	rules, err := tx.FeeRule.
+		Query().
+		Where(
+			feerule.TransactionTypeEQ(txType),
+			feerule.Enabled(true),
+			feerule.Or(feerule.CurrencyIsNil(), feerule.CurrencyEQ(currency)),
+			feerule.Or(feerule.TierIsNil(), feerule.TierEQ(tier)),
+		).
+		Order(ent.Asc(feerule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying fee rules: %w", err)
+	}
+
+	var best *ent.FeeRule
+	for _, rule := range rules {
+		if best == nil || rule.Priority > best.Priority ||
+			(rule.Priority == best.Priority && ruleSpecificity(rule) > ruleSpecificity(best)) {
+			best = rule
+		}
+	}
+
+	return best, nil
+}
+
+// ruleSpecificity counts the criteria a rule is limited by
+func ruleSpecificity(rule *ent.FeeRule) int {
+	specificity := 0
+	if rule.Currency != nil {
+		specificity++
+	}
+	if rule.Tier != nil {
+		specificity++
+	}
+	return specificity
+}
+
+// ComputeFee returns the fee a rule charges on an amount, capped by the rule's
+// minimum and maximum and rounded to the minor unit of the currency
+func ComputeFee(rule *ent.FeeRule, amount decimal.Decimal, exponent int32) decimal.Decimal {
+	var charged decimal.Decimal
+	switch rule.Kind {
+	case feerule.KindFixed:
+		charged = rule.FixedAmount
+	case feerule.KindPercentage:
+		charged = fee.Percent(amount, rule.Percentage).Add(rule.FixedAmount)
+	case feerule.KindTiered:
+		for _, tier := range rule.Tiers {
+			if tier.Covers(amount) {
+				charged = fee.Percent(amount, tier.Percentage).Add(tier.Fixed)
+				break
+			}
+		}
+	}
+
+	if rule.MinFee != nil && charged.LessThan(*rule.MinFee) {
+		charged = *rule.MinFee
+	}
+	if rule.MaxFee != nil && charged.GreaterThan(*rule.MaxFee) {
+		charged = *rule.MaxFee
+	}
+
+	return charged.Round(exponent)
+}
@@ -0,0 +1,272 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/hold"
+	"accounting/ent/transaction"
+	"accounting/errors"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// HoldRepository represents a repository for working with authorization holds
+type HoldRepository struct {
+	client      *ent.Client
+	balanceRepo *BalanceRepository
+	txRepo      *TransactionRepository
+}
+
+// NewHoldRepository creates a new hold repository
+func NewHoldRepository(client *ent.Client, balanceRepo *BalanceRepository, txRepo *TransactionRepository) *HoldRepository {
+	return &HoldRepository{
+		client:      client,
+		balanceRepo: balanceRepo,
+		txRepo:      txRepo,
+	}
+}
+
+// PlaceHoldParams represents the parameters for the Place method
+type PlaceHoldParams struct {
+	ID        string
+	UserID    int
+	Currency  string
+	Amount    decimal.Decimal
+	ExpiresAt time.Time
+}
+
+// Place reserves funds of a balance using an SQL transaction. The available
+// funds are reduced while the balance amount stays unchanged.
+func (r *HoldRepository) Place(ctx context.Context, params PlaceHoldParams) (*ent.Hold, error) {
+	var h *ent.Hold
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := checkUserStatusWithTx(ctx, tx, params.UserID, transaction.TypeWithdrawal); err != nil {
+			return err
+		}
+
+		err := r.balanceRepo.AdjustAvailableWithTx(ctx, tx, UpsertBalanceParams{
+			UserID:   params.UserID,
+			Currency: params.Currency,
+			Amount:   params.Amount.Neg(),
+		})
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		h, err = tx.Hold.
+			Create().
+			SetID(params.ID).
+			SetUserID(params.UserID).
+			SetCurrency(params.Currency).
+			SetAmount(params.Amount).
+			SetExpiresAt(params.ExpiresAt).
+			SetCreatedAt(now).
+			SetUpdatedAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed creating hold: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// Capture turns an active hold into a withdrawal of the given amount, which
+// may be lower than the reserved amount. The rest of the reservation is released.
+// The withdrawal counts towards the velocity limits of the user like any other;
+// placing a hold does not.
+func (r *HoldRepository) Capture(ctx context.Context, id string, amount decimal.Decimal) (*ent.Hold, error) {
+	var h *ent.Hold
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		var err error
+		h, err = r.getActiveForUpdateWithTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if !h.ExpiresAt.After(time.Now()) {
+			return errors.WithDetails(errors.ErrInvalidState, "hold %s has expired", id)
+		}
+		if amount.GreaterThan(h.Amount) {
+			return errors.WithDetails(errors.ErrInvalidInput, "capture amount %s exceeds held amount %s", amount, h.Amount)
+		}
+		if err := checkUserStatusWithTx(ctx, tx, h.UserID, transaction.TypeWithdrawal); err != nil {
+			return err
+		}
+		if err := checkPeriodOpenWithTx(ctx, tx, time.Now()); err != nil {
+			return err
+		}
+
+		// The velocity lock is taken before releasing the hold locks the balance
+		limits, err := findApplicableLimitsWithTx(ctx, tx, h.UserID, transaction.TypeWithdrawal, h.Currency)
+		if err != nil {
+			return err
+		}
+		if len(limits) > 0 {
+			if err := lockVelocityChecksWithTx(ctx, tx, h.UserID); err != nil {
+				return err
+			}
+		}
+
+		if err := r.releaseWithTx(ctx, tx, h); err != nil {
+			return err
+		}
+
+		withdrawal, err := r.txRepo.createWithTx(ctx, tx, CreateTransactionParams{
+			ID:       CaptureTransactionID(id),
+			UserID:   h.UserID,
+			Amount:   amount,
+			Currency: h.Currency,
+			Type:     transaction.TypeWithdrawal,
+			Limits:   limits,
+		})
+		if err != nil {
+			return err
+		}
+
+		h, err = tx.Hold.
+			UpdateOne(h).
+			SetStatus(hold.StatusCaptured).
+			SetCapturedAmount(amount).
+			SetTransactionID(withdrawal.ID).
+			SetUpdatedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed capturing hold: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// CaptureTransactionID returns the ID of the withdrawal created by capturing a hold
+func CaptureTransactionID(holdID string) string {
+	return holdID + DerivedIDSeparator + "capture"
+}
+
+// Void releases an active hold without moving any money
+func (r *HoldRepository) Void(ctx context.Context, id string) (*ent.Hold, error) {
+	var h *ent.Hold
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		var err error
+		h, err = r.getActiveForUpdateWithTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		h, err = r.closeWithTx(ctx, tx, h, hold.StatusVoided)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// ExpireDue releases up to limit active holds whose TTL has passed and returns
+// the number of expired holds. Holds locked by a concurrent capture or void are skipped.
+func (r *HoldRepository) ExpireDue(ctx context.Context, now time.Time, limit int) (int, error) {
+	var expired int
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		holds, err := tx.Hold.
+			Query().
+			Where(
+				hold.StatusEQ(hold.StatusActive),
+				hold.ExpiresAtLTE(now),
+			).
+			Order(ent.Asc(hold.FieldExpiresAt)).
+			Limit(limit).
+			ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed querying due holds: %w", err)
+		}
+
+		// Balances are locked in user ID and currency order, as everywhere else,
+		// so concurrent expiries, captures and voids cannot deadlock
+		sort.Slice(holds, func(i, j int) bool {
+			if holds[i].UserID != holds[j].UserID {
+				return holds[i].UserID < holds[j].UserID
+			}
+			return holds[i].Currency < holds[j].Currency
+		})
+
+		for _, h := range holds {
+			if _, err := r.closeWithTx(ctx, tx, h, hold.StatusExpired); err != nil {
+				return err
+			}
+		}
+		expired = len(holds)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return expired, nil
+}
+
+// GetByID gets a hold by its ID
+func (r *HoldRepository) GetByID(ctx context.Context, id string) (*ent.Hold, error) {
+	h, err := r.client.Hold.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying hold by ID: %w", err)
+	}
+	return h, nil
+}
+
+// getActiveForUpdateWithTx locks a hold and checks that it is still active
+func (r *HoldRepository) getActiveForUpdateWithTx(ctx context.Context, tx *ent.Tx, id string) (*ent.Hold, error) {
+	h, err := tx.Hold.
+		Query().
+		Where(hold.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying hold by ID: %w", err)
+	}
+	if h.Status != hold.StatusActive {
+		return nil, errors.WithDetails(errors.ErrInvalidState, "hold %s is %s", id, h.Status)
+	}
+	return h, nil
+}
+
+// closeWithTx releases the reservation of an active hold and sets its final status
+func (r *HoldRepository) closeWithTx(ctx context.Context, tx *ent.Tx, h *ent.Hold, status hold.Status) (*ent.Hold, error) {
+	if err := r.releaseWithTx(ctx, tx, h); err != nil {
+		return nil, err
+	}
+
+	h, err := tx.Hold.
+		UpdateOne(h).
+		SetStatus(status).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed updating hold status to %s: %w", status, err)
+	}
+	return h, nil
+}
+
+// releaseWithTx gives the reserved amount of a hold back to the available funds
+func (r *HoldRepository) releaseWithTx(ctx context.Context, tx *ent.Tx, h *ent.Hold) error {
+	return r.balanceRepo.AdjustAvailableWithTx(ctx, tx, UpsertBalanceParams{
+		UserID:   h.UserID,
+		Currency: h.Currency,
+		Amount:   h.Amount,
+	})
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/idempotencykey"
+)
+
+// idempotencyLease is how long a request may hold a key in progress. A key
+// still in progress after its lease was left behind by a crash and is taken
+// over by the next retry.
+const idempotencyLease = time.Minute
+
+// IdempotencyRepository represents a repository for working with idempotency keys
+type IdempotencyRepository struct {
+	client *ent.Client
+}
+
+// NewIdempotencyRepository creates a new idempotency repository
+func NewIdempotencyRepository(client *ent.Client) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		client: client,
+	}
+}
+
+// Acquire records the first use of a key with the request fingerprint. It
+// returns the stored key and whether this call created it; when it did not, the
+// key was used before and the caller must compare fingerprints or replay.
+func (r *IdempotencyRepository) Acquire(ctx context.Context, key string, requestHash string) (*ent.IdempotencyKey, bool, error) {
+	now := time.Now()
+	err := r.client.IdempotencyKey.
+		Create().
+		SetID(key).
+		SetRequestHash(requestHash).
+		SetCreatedAt(now).
+		SetLockedUntil(now.Add(idempotencyLease)).
+		OnConflictColumns(idempotencykey.FieldID).
+		DoNothing().
+		Exec(ctx)
+	created := err == nil
+	if err != nil && !stderrors.Is(err, sql.ErrNoRows) {
+		return nil, false, fmt.Errorf("failed creating idempotency key: %w", err)
+	}
+
+	k, err := r.client.IdempotencyKey.Get(ctx, key)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed querying idempotency key: %w", err)
+	}
+
+	return k, created, nil
+}
+
+// TakeOver renews the lease of a key whose request is still in progress after
+// its lease expired. It returns whether the caller now holds the key; it does
+// not when another retry took it over first or the request completed meanwhile.
+func (r *IdempotencyRepository) TakeOver(ctx context.Context, key string, requestHash string) (bool, error) {
+	now := time.Now()
+	n, err := r.client.IdempotencyKey.
+		Update().
+		Where(
+			idempotencykey.IDEQ(key),
+			idempotencykey.RequestHashEQ(requestHash),
+			idempotencykey.ResponseStatusIsNil(),
+			idempotencykey.Or(
+				idempotencykey.LockedUntilIsNil(),
+				idempotencykey.LockedUntilLT(now),
+			),
+		).
+		SetLockedUntil(now.Add(idempotencyLease)).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed taking over idempotency key: %w", err)
+	}
+	return n == 1, nil
+}
+
+// Complete stores the response of the request made with a key
+func (r *IdempotencyRepository) Complete(ctx context.Context, key string, status int, body []byte) error {
+	err := r.client.IdempotencyKey.
+		UpdateOneID(key).
+		SetResponseStatus(status).
+		SetResponseBody(body).
+		SetCompletedAt(time.Now()).
+		ClearLockedUntil().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed storing idempotent response: %w", err)
+	}
+	return nil
+}
+
+// Release deletes a key whose request failed, so the client can retry it
+func (r *IdempotencyRepository) Release(ctx context.Context, key string) error {
+	err := r.client.IdempotencyKey.
+		DeleteOneID(key).
+		Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed releasing idempotency key: %w", err)
+	}
+	return nil
+}
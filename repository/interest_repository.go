@@ -0,0 +1,443 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/balance"
+	"accounting/ent/interestaccrual"
+	"accounting/ent/interestplan"
+	"accounting/ent/transaction"
+	"accounting/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// accrualScale is the number of decimal places daily accruals are kept with
+const accrualScale = 12
+
+// InterestRepository represents a repository for working with interest plans and accruals
+type InterestRepository struct {
+	client       *ent.Client
+	accountRepo  *AccountRepository
+	ledgerRepo   *LedgerRepository
+	currencyRepo *CurrencyRepository
+}
+
+// NewInterestRepository creates a new interest repository
+func NewInterestRepository(client *ent.Client, accountRepo *AccountRepository, ledgerRepo *LedgerRepository,
+	currencyRepo *CurrencyRepository) *InterestRepository {
+
+	return &InterestRepository{
+		client:       client,
+		accountRepo:  accountRepo,
+		ledgerRepo:   ledgerRepo,
+		currencyRepo: currencyRepo,
+	}
+}
+
+// InterestTransactionID returns the ID of the interest transaction paying out
+// the accruals of a balance for the month ending on periodEnd
+func InterestTransactionID(balanceID int, periodEnd time.Time) string {
+	return fmt.Sprintf("interest:%d:%s", balanceID, periodEnd.Format("2006-01"))
+}
+
+// DailyInterest returns the interest a principal earns on a day under an annual
+// percentage and a day-count convention, kept with accrualScale decimal places.
+// ACT/365 counts every day as 1/365 of a year. 30/360 counts every month as 30
+// days: the 31st earns nothing and the last day of February earns the days up to
+// the 30th. Negative principals earn no interest.
+func DailyInterest(principal decimal.Decimal, annualPercentage decimal.Decimal, dayCount interestplan.DayCount,
+	date time.Time) decimal.Decimal {
+
+	if !principal.IsPositive() {
+		return decimal.Zero
+	}
+
+	var days, yearDays int64
+	switch dayCount {
+	case interestplan.DayCountThirty360:
+		yearDays = 360
+		days = 1
+		if date.Day() == 31 {
+			days = 0
+		} else if date.Month() == time.February && date.AddDate(0, 0, 1).Month() == time.March {
+			days = int64(30 - date.Day() + 1)
+		}
+	default:
+		yearDays = 365
+		days = 1
+	}
+
+	return principal.
+		Mul(annualPercentage).
+		Mul(decimal.NewFromInt(days)).
+		Div(decimal.NewFromInt(100 * yearDays)).
+		Round(accrualScale)
+}
+
+// CreatePlan creates an interest plan
+func (r *InterestRepository) CreatePlan(ctx context.Context, name string, annualPercentage decimal.Decimal,
+	dayCount interestplan.DayCount) (*ent.InterestPlan, error) {
+
+	plan, err := r.client.InterestPlan.
+		Create().
+		SetName(name).
+		SetAnnualPercentage(annualPercentage).
+		SetDayCount(dayCount).
+		SetCreatedAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating interest plan: %w", err)
+	}
+
+	return plan, nil
+}
+
+// GetPlans returns all interest plans
+func (r *InterestRepository) GetPlans(ctx context.Context) ([]*ent.InterestPlan, error) {
+	plans, err := r.client.InterestPlan.
+		Query().
+		Order(ent.Asc(interestplan.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying interest plans: %w", err)
+	}
+
+	return plans, nil
+}
+
+// AssignPlan sets the interest plan of a user's balance in a currency; a nil
+// plan stops the balance from earning interest
+func (r *InterestRepository) AssignPlan(ctx context.Context, userID int, currency string,
+	planID *int) (*ent.Balance, error) {
+
+	b, err := r.client.Balance.
+		Query().
+		Where(
+			balance.UserID(userID),
+			balance.CurrencyEQ(currency),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.WithDetails(errors.ErrNotFound, "user %d has no %s balance", userID, currency)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed querying %s balance: %w", currency, err)
+	}
+
+	update := r.client.Balance.
+		UpdateOne(b).
+		SetNillableInterestPlanID(planID).
+		SetUpdatedAt(time.Now())
+	if planID == nil {
+		update.ClearInterestPlanID()
+	}
+
+	b, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed assigning interest plan: %w", err)
+	}
+
+	return b, nil
+}
+
+// ListBalancesWithPlan returns up to limit balances with an interest plan and an
+// ID greater than afterID, ordered by ID and with their plan loaded
+func (r *InterestRepository) ListBalancesWithPlan(ctx context.Context, afterID int, limit int) ([]*ent.Balance, error) {
+	balances, err := r.client.Balance.
+		Query().
+		Where(
+			balance.InterestPlanIDNotNil(),
+			balance.IDGT(afterID),
+		).
+		WithInterestPlan().
+		Order(ent.Asc(balance.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying balances with interest plans: %w", err)
+	}
+
+	return balances, nil
+}
+
+// HasAccrual reports whether a balance has accrued interest for a day
+func (r *InterestRepository) HasAccrual(ctx context.Context, balanceID int, date time.Time) (bool, error) {
+	exists, err := r.client.InterestAccrual.
+		Query().
+		Where(
+			interestaccrual.BalanceID(balanceID),
+			interestaccrual.Date(date),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed querying interest accrual: %w", err)
+	}
+
+	return exists, nil
+}
+
+// LastAccrualDate returns the latest day any balance accrued interest for, or
+// nil when no interest was accrued yet
+func (r *InterestRepository) LastAccrualDate(ctx context.Context) (*time.Time, error) {
+	last, err := r.client.InterestAccrual.
+		Query().
+		Order(ent.Desc(interestaccrual.FieldDate)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed querying last interest accrual: %w", err)
+	}
+
+	date := last.Date.UTC()
+	return &date, nil
+}
+
+// CreateAccrualParams represents the parameters for the CreateAccrual method
+type CreateAccrualParams struct {
+	BalanceID        int
+	Date             time.Time
+	Principal        decimal.Decimal
+	AnnualPercentage decimal.Decimal
+	DayCount         interestplan.DayCount
+	Amount           decimal.Decimal
+}
+
+// CreateAccrual stores the interest of a balance for a day. An accrual of the
+// same balance and day is kept as is, so a day can be re-run safely.
+func (r *InterestRepository) CreateAccrual(ctx context.Context, params CreateAccrualParams) error {
+	err := r.client.InterestAccrual.
+		Create().
+		SetBalanceID(params.BalanceID).
+		SetDate(params.Date).
+		SetPrincipal(params.Principal).
+		SetAnnualPercentage(params.AnnualPercentage).
+		SetDayCount(interestaccrual.DayCount(params.DayCount)).
+		SetAmount(params.Amount).
+		SetCreatedAt(time.Now()).
+		OnConflictColumns(interestaccrual.FieldBalanceID, interestaccrual.FieldDate).
+		DoNothing().
+		Exec(ctx)
+	if err != nil && !stderrors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed creating interest accrual: %w", err)
+	}
+
+	return nil
+}
+
+// Capitalize pays out the interest a balance accrued up to periodEnd as an
+// interest transaction against the interest expense system account, effective
+// at periodEnd. The payout is everything accrued minus everything paid out
+// before, rounded down to the minor unit; the sub-unit remainder is carried into
+// the next period. The transaction ID is derived from the balance and month, so
+// a period is paid out at most once. Nil is returned when there is nothing to
+// pay out.
+func (r *InterestRepository) Capitalize(ctx context.Context, balanceID int, periodEnd time.Time) (*ent.Transaction, error) {
+	var result *ent.Transaction
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		b, err := tx.Balance.Get(ctx, balanceID)
+		if err != nil {
+			return fmt.Errorf("failed querying balance: %w", err)
+		}
+
+		// The user and the period are locked before the balance, like every
+		// other operation posting to it does
+		if err := checkUserStatusWithTx(ctx, tx, b.UserID, transaction.TypeInterest); err != nil {
+			return err
+		}
+		if err := checkPeriodOpenWithTx(ctx, tx, periodEnd); err != nil {
+			return err
+		}
+
+		b, err = tx.Balance.
+			Query().
+			Where(balance.ID(balanceID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("failed locking balance: %w", err)
+		}
+
+		result, err = r.capitalizeWithTx(ctx, tx, b, periodEnd)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// capitalizeWithTx pays out the interest of a balance within an existing DB
+// transaction holding the locks of its user, the period of periodEnd and the balance
+func (r *InterestRepository) capitalizeWithTx(ctx context.Context, tx *ent.Tx, b *ent.Balance,
+	periodEnd time.Time) (*ent.Transaction, error) {
+
+	id := InterestTransactionID(b.ID, periodEnd)
+	paid, err := tx.Transaction.
+		Query().
+		Where(transaction.ID(id)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying interest transaction: %w", err)
+	}
+	if paid {
+		return nil, nil
+	}
+
+	accrued, err := sumAccruals(ctx, tx, b.ID, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	paidOut, err := sumInterestPaid(ctx, tx, b.UserID, b.Currency)
+	if err != nil {
+		return nil, err
+	}
+	currency, err := r.currencyRepo.GetByCodeWithTx(ctx, tx, b.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := accrued.Sub(paidOut).RoundDown(int32(currency.Exponent))
+	if !amount.IsPositive() {
+		return nil, nil
+	}
+
+	userAccount, err := r.accountRepo.GetOrCreateUserAccountWithTx(ctx, tx, b.UserID, b.Currency)
+	if err != nil {
+		return nil, err
+	}
+	expenseAccount, err := r.accountRepo.GetOrCreateSystemAccountWithTx(ctx, tx, SystemAccountInterestExpense,
+		b.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := r.ledgerRepo.PostWithTx(ctx, tx, PostEntryParams{
+		Description: fmt.Sprintf("interest %s", id),
+		Postings: []PostingParams{
+			{Account: userAccount, Amount: amount},
+			{Account: expenseAccount, Amount: amount.Neg()},
+		},
+		EffectiveAt: &periodEnd,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result, err := tx.Transaction.
+		Create().
+		SetID(id).
+		SetUserID(b.UserID).
+		SetAmount(amount).
+		SetCurrency(b.Currency).
+		SetType(transaction.TypeInterest).
+		SetJournalEntryID(entry.ID).
+		SetBalanceAfter(entry.Balances[userAccount.ID].Amount).
+		SetBalanceSequence(entry.Balances[userAccount.ID].Sequence).
+		SetCreatedAt(now).
+		SetPostedAt(now).
+		SetEffectiveAt(periodEnd).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating %s transaction: %w", transaction.TypeInterest, err)
+	}
+
+	err = tx.InterestAccrual.
+		Update().
+		Where(
+			interestaccrual.BalanceID(b.ID),
+			interestaccrual.DateLTE(periodEnd),
+			interestaccrual.TransactionIDIsNil(),
+		).
+		SetTransactionID(id).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed marking interest accruals as paid: %w", err)
+	}
+
+	return result, nil
+}
+
+// lastUnpaidAccrualsWithTx returns the latest day of the accruals not paid out
+// yet of every balance of a user, keyed by balance ID
+func lastUnpaidAccrualsWithTx(ctx context.Context, tx *ent.Tx, userID int) (map[int]time.Time, error) {
+	var rows []struct {
+		BalanceID int       `json:"balance_id"`
+		Max       time.Time `json:"max"`
+	}
+	err := tx.InterestAccrual.
+		Query().
+		Where(
+			interestaccrual.HasBalanceWith(balance.UserID(userID)),
+			interestaccrual.TransactionIDIsNil(),
+		).
+		GroupBy(interestaccrual.FieldBalanceID).
+		Aggregate(ent.Max(interestaccrual.FieldDate)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying unpaid interest accruals: %w", err)
+	}
+
+	last := make(map[int]time.Time, len(rows))
+	for _, row := range rows {
+		last[row.BalanceID] = row.Max.UTC()
+	}
+	return last, nil
+}
+
+// sumAccruals returns the interest a balance accrued up to and including a day
+func sumAccruals(ctx context.Context, tx *ent.Tx, balanceID int, until time.Time) (decimal.Decimal, error) {
+	var rows []struct {
+		Sum decimal.NullDecimal `json:"sum"`
+	}
+	err := tx.InterestAccrual.
+		Query().
+		Where(
+			interestaccrual.BalanceID(balanceID),
+			interestaccrual.DateLTE(until),
+		).
+		Aggregate(ent.As(ent.Sum(interestaccrual.FieldAmount), "sum")).
+		Scan(ctx, &rows)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed summing interest accruals: %w", err)
+	}
+	if len(rows) == 0 {
+		return decimal.Zero, nil
+	}
+
+	return rows[0].Sum.Decimal, nil
+}
+
+// sumInterestPaid returns the interest paid out to a user's balance in a currency so far
+func sumInterestPaid(ctx context.Context, tx *ent.Tx, userID int, currency string) (decimal.Decimal, error) {
+	var rows []struct {
+		Sum decimal.NullDecimal `json:"sum"`
+	}
+	err := tx.Transaction.
+		Query().
+		Where(
+			transaction.UserID(userID),
+			transaction.CurrencyEQ(currency),
+			transaction.TypeEQ(transaction.TypeInterest),
+		).
+		Aggregate(ent.As(ent.Sum(transaction.FieldAmount), "sum")).
+		Scan(ctx, &rows)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed summing interest paid: %w", err)
+	}
+	if len(rows) == 0 {
+		return decimal.Zero, nil
+	}
+
+	return rows[0].Sum.Decimal, nil
+}
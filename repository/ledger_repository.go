@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/account"
+	"accounting/ent/balancesnapshot"
+	"accounting/ent/journalentry"
+	"accounting/ent/posting"
+	"accounting/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// LedgerRepository represents a repository for posting double-entry journal entries
+type LedgerRepository struct {
+	client      *ent.Client
+	balanceRepo *BalanceRepository
+}
+
+// NewLedgerRepository creates a new ledger repository
+func NewLedgerRepository(client *ent.Client, balanceRepo *BalanceRepository) *LedgerRepository {
+	return &LedgerRepository{
+		client:      client,
+		balanceRepo: balanceRepo,
+	}
+}
+
+// PostingParams represents a single leg of a journal entry
+type PostingParams struct {
+	Account *ent.Account
+	// Amount is signed: positive increases the account balance, negative decreases it
+	Amount decimal.Decimal
+}
+
+// PostEntryParams represents the parameters for the PostWithTx method
+type PostEntryParams struct {
+	Description string
+	Postings    []PostingParams
+	// SkipBalances records postings whose effect the user balances already hold,
+	// such as reconciliation adjustments, without changing the balances
+	SkipBalances bool
+	// EffectiveAt back-dates the entry in the books; nil means it takes effect now
+	EffectiveAt *time.Time
+}
+
+// PostedEntry is a written journal entry together with the user balances it changed
+type PostedEntry struct {
+	*ent.JournalEntry
+	// Balances holds the resulting balance of every user account posted to, keyed by account ID
+	Balances map[int]*ent.Balance
+}
+
+// PostWithTx validates and writes a journal entry with its postings within an
+// existing DB transaction. Postings against user accounts are applied to the
+// user's balance; system accounts have no materialized balance.
+func (r *LedgerRepository) PostWithTx(ctx context.Context, tx *ent.Tx, params PostEntryParams) (*PostedEntry, error) {
+	if err := validatePostings(params.Postings); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	effectiveAt := now
+	if params.EffectiveAt != nil {
+		effectiveAt = *params.EffectiveAt
+	}
+
+	entry, err := tx.JournalEntry.
+		Create().
+		SetDescription(params.Description).
+		SetEffectiveAt(effectiveAt).
+		SetCreatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating journal entry: %w", err)
+	}
+
+	builders := make([]*ent.PostingCreate, 0, len(params.Postings))
+	for _, p := range params.Postings {
+		builders = append(builders, tx.Posting.
+			Create().
+			SetJournalEntryID(entry.ID).
+			SetAccountID(p.Account.ID).
+			SetAmount(p.Amount).
+			SetCurrency(p.Account.Currency).
+			SetEffectiveAt(effectiveAt).
+			SetCreatedAt(now))
+	}
+	if _, err := tx.Posting.CreateBulk(builders...).Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed creating postings: %w", err)
+	}
+
+	balances := make(map[int]*ent.Balance)
+	for _, p := range params.Postings {
+		if params.SkipBalances || p.Account.Type != account.TypeUser || p.Account.UserID == nil {
+			continue
+		}
+
+		b, err := r.balanceRepo.UpsertWithTx(ctx, tx, UpsertBalanceParams{
+			UserID:   *p.Account.UserID,
+			Currency: p.Account.Currency,
+			Amount:   p.Amount,
+		})
+		if err != nil {
+			return nil, err
+		}
+		balances[p.Account.ID] = b
+	}
+
+	// Snapshots taken at or after a back-dated entry do not hold its postings
+	// yet. The locks on the balances above keep snapshots of them from being
+	// taken concurrently.
+	if params.EffectiveAt != nil {
+		for _, p := range params.Postings {
+			if p.Account.Type != account.TypeUser {
+				continue
+			}
+			err := tx.BalanceSnapshot.
+				Update().
+				Where(
+					balancesnapshot.AccountID(p.Account.ID),
+					balancesnapshot.TakenAtGTE(effectiveAt),
+				).
+				AddAmount(p.Amount).
+				Exec(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed adjusting balance snapshots: %w", err)
+			}
+		}
+	}
+
+	return &PostedEntry{JournalEntry: entry, Balances: balances}, nil
+}
+
+// validatePostings checks that an entry has at least two postings and that
+// its postings sum to zero in every currency
+func validatePostings(postings []PostingParams) error {
+	if len(postings) < 2 {
+		return errors.WithDetails(errors.ErrUnbalancedEntry, "entry needs at least two postings, got %d", len(postings))
+	}
+
+	sums := make(map[string]decimal.Decimal)
+	for _, p := range postings {
+		sums[p.Account.Currency] = sums[p.Account.Currency].Add(p.Amount)
+	}
+	for currency, sum := range sums {
+		if !sum.IsZero() {
+			return errors.WithDetails(errors.ErrUnbalancedEntry, "%s postings sum to %s", currency, sum)
+		}
+	}
+
+	return nil
+}
+
+// GetEntryWithPostings returns a journal entry together with its postings
+func (r *LedgerRepository) GetEntryWithPostings(ctx context.Context, id int) (*ent.JournalEntry, error) {
+	entry, err := r.client.JournalEntry.
+		Query().
+		Where(journalentry.ID(id)).
+		WithPostings().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying journal entry with postings: %w", err)
+	}
+
+	return entry, nil
+}
+
+// PostingSum is the total of the postings made to an account within a time range
+type PostingSum struct {
+	Amount decimal.Decimal
+	Count  int
+}
+
+// SumPostings returns the sum of the postings to an account that take effect
+// after from (or since the beginning when from is nil) up to and including until
+func (r *LedgerRepository) SumPostings(ctx context.Context, accountID int, from *time.Time,
+	until time.Time) (*PostingSum, error) {
+
+	return sumPostings(ctx, r.client.Posting, accountID, from, until)
+}
+
+// sumPostings sums the postings to an account by when they take effect, so
+// back-dated postings count towards the balances at their effective date
+func sumPostings(ctx context.Context, postings *ent.PostingClient, accountID int, from *time.Time,
+	until time.Time) (*PostingSum, error) {
+
+	query := postings.
+		Query().
+		Where(
+			posting.AccountID(accountID),
+			posting.EffectiveAtLTE(until),
+		)
+	if from != nil {
+		query = query.Where(posting.EffectiveAtGT(*from))
+	}
+
+	var rows []struct {
+		Sum   decimal.NullDecimal `json:"sum"`
+		Count int                 `json:"count"`
+	}
+	err := query.
+		Aggregate(
+			ent.As(ent.Sum(posting.FieldAmount), "sum"),
+			ent.As(ent.Count(), "count"),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed summing postings: %w", err)
+	}
+
+	sum := &PostingSum{Amount: decimal.Zero}
+	if len(rows) > 0 {
+		sum.Amount = rows[0].Sum.Decimal
+		sum.Count = rows[0].Count
+	}
+
+	return sum, nil
+}
@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/balance"
+	"accounting/ent/posting"
+	"accounting/ent/predicate"
+	"accounting/ent/transaction"
+	"accounting/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// CreditTypes are the transaction types that increase a balance
+var CreditTypes = []transaction.Type{
+	transaction.TypeDeposit,
+	transaction.TypeTransferIn,
+	transaction.TypeExchangeIn,
+	transaction.TypeAdjustmentIn,
+	transaction.TypeFeeRefund,
+	transaction.TypeInterest,
+}
+
+// DebitTypes are the transaction types that decrease a balance
+var DebitTypes = []transaction.Type{
+	transaction.TypeWithdrawal,
+	transaction.TypeTransferOut,
+	transaction.TypeExchangeOut,
+	transaction.TypeAdjustmentOut,
+	transaction.TypeFee,
+}
+
+// AppliedStatuses are the statuses of transactions whose effect the balance holds.
+// A reversed transaction still counts; its reversal is a transaction of its own.
+var AppliedStatuses = []transaction.Status{
+	transaction.StatusPosted,
+	transaction.StatusPartiallyReversed,
+	transaction.StatusReversed,
+}
+
+// ReconciliationRepository represents a repository for checking balances against transactions
+type ReconciliationRepository struct {
+	client      *ent.Client
+	accountRepo *AccountRepository
+}
+
+// NewReconciliationRepository creates a new reconciliation repository
+func NewReconciliationRepository(client *ent.Client, accountRepo *AccountRepository) *ReconciliationRepository {
+	return &ReconciliationRepository{
+		client:      client,
+		accountRepo: accountRepo,
+	}
+}
+
+// BalanceCheck is a balance together with the signed sum of its applied transactions
+type BalanceCheck struct {
+	Balance  *ent.Balance
+	Expected decimal.Decimal
+}
+
+// Drift returns how much the balance amount exceeds the sum of its transactions
+func (c BalanceCheck) Drift() decimal.Decimal {
+	return c.Balance.Amount.Sub(c.Expected)
+}
+
+// balanceKey identifies the balance of a user in a currency
+type balanceKey struct {
+	userID   int
+	currency string
+}
+
+// CheckBalances recomputes up to limit balances with an ID greater than afterID
+// from their transactions. Balances and transactions are read from the same
+// snapshot, so concurrent transactions cannot show up as drift.
+func (r *ReconciliationRepository) CheckBalances(ctx context.Context, afterID int, limit int) ([]BalanceCheck, error) {
+	var checks []BalanceCheck
+	err := withSnapshot(ctx, r.client, func(tx *ent.Tx) error {
+		balances, err := tx.Balance.
+			Query().
+			Where(balance.IDGT(afterID)).
+			Order(ent.Asc(balance.FieldID)).
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed querying balances: %w", err)
+		}
+		if len(balances) == 0 {
+			return nil
+		}
+
+		userIDs := make([]int, 0, len(balances))
+		for _, b := range balances {
+			userIDs = append(userIDs, b.UserID)
+		}
+
+		sums, err := sumTransactions(ctx, tx.Transaction, transaction.UserIDIn(userIDs...))
+		if err != nil {
+			return err
+		}
+
+		checks = make([]BalanceCheck, 0, len(balances))
+		for _, b := range balances {
+			checks = append(checks, BalanceCheck{
+				Balance:  b,
+				Expected: sums[balanceKey{userID: b.UserID, currency: b.Currency}],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
+// Repair rebuilds a drifted balance from the postings to the wallet account of
+// its user, which the transactions are recorded with. The balance is locked and
+// checked again first; nil is returned when it no longer drifts. The amount is
+// set to the sum of the postings and the available funds move by the same
+// delta, so active holds stay reserved. No transaction is recorded, as the
+// ledger is left alone, and the balance sequence is not incremented, so the
+// last transaction still has it. When the postings disagree with the
+// transactions as well, the ledger itself is inconsistent and the drift is
+// left for manual handling.
+func (r *ReconciliationRepository) Repair(ctx context.Context, balanceID int) (*ent.Balance, error) {
+	var repaired *ent.Balance
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		b, err := tx.Balance.
+			Query().
+			Where(balance.ID(balanceID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("failed locking balance: %w", err)
+		}
+
+		sums, err := sumTransactions(ctx, tx.Transaction,
+			transaction.UserID(b.UserID),
+			transaction.CurrencyEQ(b.Currency),
+		)
+		if err != nil {
+			return err
+		}
+
+		expected := sums[balanceKey{userID: b.UserID, currency: b.Currency}]
+		drift := BalanceCheck{Balance: b, Expected: expected}.Drift()
+		if drift.IsZero() {
+			return nil
+		}
+
+		userAccount, err := r.accountRepo.GetOrCreateUserAccountWithTx(ctx, tx, b.UserID, b.Currency)
+		if err != nil {
+			return err
+		}
+		posted, err := sumAccountPostings(ctx, tx.Posting, userAccount.ID)
+		if err != nil {
+			return err
+		}
+		if !posted.Equal(expected) {
+			return errors.WithDetails(errors.ErrInvalidState,
+				"postings of balance %d sum up to %s, its transactions to %s", b.ID, posted, expected)
+		}
+
+		repaired, err = tx.Balance.
+			UpdateOne(b).
+			SetAmount(posted).
+			AddAvailable(drift.Neg()).
+			SetUpdatedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed repairing %s balance: %w", b.Currency, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return repaired, nil
+}
+
+// sumAccountPostings returns the sum of all postings to an account, whenever they take effect
+func sumAccountPostings(ctx context.Context, postings *ent.PostingClient, accountID int) (decimal.Decimal, error) {
+	var rows []struct {
+		Sum decimal.NullDecimal `json:"sum"`
+	}
+	err := postings.
+		Query().
+		Where(posting.AccountID(accountID)).
+		Aggregate(ent.As(ent.Sum(posting.FieldAmount), "sum")).
+		Scan(ctx, &rows)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed summing postings: %w", err)
+	}
+	if len(rows) == 0 {
+		return decimal.Zero, nil
+	}
+
+	return rows[0].Sum.Decimal, nil
+}
+
+// sumTransactions returns the signed sum of the applied transactions matching
+// the predicates per user and currency
+func sumTransactions(ctx context.Context, client *ent.TransactionClient,
+	predicates ...predicate.Transaction) (map[balanceKey]decimal.Decimal, error) {
+
+	sums := make(map[balanceKey]decimal.Decimal)
+	for _, side := range []struct {
+		types []transaction.Type
+		sign  decimal.Decimal
+	}{
+		{types: CreditTypes, sign: decimal.NewFromInt(1)},
+		{types: DebitTypes, sign: decimal.NewFromInt(-1)},
+	} {
+		var rows []struct {
+			UserID   int                 `json:"user_id"`
+			Currency string              `json:"currency"`
+			Sum      decimal.NullDecimal `json:"sum"`
+		}
+		err := client.
+			Query().
+			Where(predicates...).
+			Where(
+				transaction.StatusIn(AppliedStatuses...),
+				transaction.TypeIn(side.types...),
+			).
+			GroupBy(transaction.FieldUserID, transaction.FieldCurrency).
+			Aggregate(ent.As(ent.Sum(transaction.FieldAmount), "sum")).
+			Scan(ctx, &rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed summing transactions: %w", err)
+		}
+
+		for _, row := range rows {
+			key := balanceKey{userID: row.UserID, currency: row.Currency}
+			sums[key] = sums[key].Add(row.Sum.Decimal.Mul(side.sign))
+		}
+	}
+
+	return sums, nil
+}
@@ -0,0 +1,254 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/account"
+	"accounting/ent/posting"
+	"accounting/ent/predicate"
+	"accounting/ent/transaction"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// ReportRepository represents a repository for computing financial reports.
+// Reports aggregate in the database, so their cost does not depend on the
+// number of rows returned to the application.
+type ReportRepository struct {
+	client *ent.Client
+}
+
+// NewReportRepository creates a new report repository
+func NewReportRepository(client *ent.Client) *ReportRepository {
+	return &ReportRepository{
+		client: client,
+	}
+}
+
+// balanceColumn is the column of the balance of an account in accountBalances
+const balanceColumn = "balance"
+
+// accountBalances returns a subquery summing the postings of every account
+// effective up to and including an instant, like the as-of balances; it is
+// the source of the reports on balances
+func accountBalances(asOf time.Time) *sql.Selector {
+	t := sql.Table(posting.Table)
+	return sql.Select(t.C(posting.FieldAccountID), sql.As(sql.Sum(t.C(posting.FieldAmount)), balanceColumn)).
+		From(t).
+		Where(sql.LTE(t.C(posting.FieldEffectiveAt), asOf)).
+		GroupBy(t.C(posting.FieldAccountID)).
+		As("balances")
+}
+
+// joinBalances joins the balances of accountBalances to an account query
+func joinBalances(balances *sql.Selector) predicate.Account {
+	return func(s *sql.Selector) {
+		s.Join(balances).On(s.C(account.FieldID), balances.C(posting.FieldAccountID))
+	}
+}
+
+// countOfSign aggregates the number of rows whose column compares to zero by
+// op, e.g. "<" counts the negative ones
+func countOfSign(column string, op string) ent.AggregateFunc {
+	return func(*sql.Selector) string {
+		return fmt.Sprintf("COUNT(*) FILTER (WHERE %s %s 0)", column, op)
+	}
+}
+
+// sumOfSign aggregates the sum of the rows whose column compares to zero by
+// op, or zero when there are none
+func sumOfSign(column string, op string) ent.AggregateFunc {
+	return func(*sql.Selector) string {
+		return fmt.Sprintf("COALESCE(SUM(%s) FILTER (WHERE %s %s 0), 0)", column, column, op)
+	}
+}
+
+// TrialBalanceRow is the balance of a group of accounts in the trial balance:
+// a system account, or all user wallets of a currency together. Negative
+// account balances are reported as debits, positive ones as credits.
+type TrialBalanceRow struct {
+	Currency    string          `json:"currency"`
+	AccountType string          `json:"account_type"`
+	AccountName string          `json:"account_name"`
+	Accounts    int             `json:"accounts"`
+	Debit       decimal.Decimal `json:"debit"`
+	Credit      decimal.Decimal `json:"credit"`
+}
+
+// TrialBalance returns the trial balance at an instant, ordered by currency and account
+func (r *ReportRepository) TrialBalance(ctx context.Context, asOf time.Time) ([]TrialBalanceRow, error) {
+	balances := accountBalances(asOf)
+	column := balances.C(balanceColumn)
+
+	var rows []struct {
+		Currency string          `json:"currency"`
+		Type     string          `json:"type"`
+		Name     string          `json:"name"`
+		Accounts int             `json:"accounts"`
+		Debit    decimal.Decimal `json:"debit"`
+		Credit   decimal.Decimal `json:"credit"`
+	}
+	err := r.client.Account.
+		Query().
+		Where(joinBalances(balances)).
+		Order(ent.Asc(account.FieldCurrency), ent.Asc(account.FieldType), ent.Asc(account.FieldName)).
+		GroupBy(account.FieldCurrency, account.FieldType, account.FieldName).
+		Aggregate(
+			ent.As(ent.Count(), "accounts"),
+			ent.As(sumOfSign(column, "<"), "debit"),
+			ent.As(sumOfSign(column, ">"), "credit"),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying trial balance: %w", err)
+	}
+
+	result := make([]TrialBalanceRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, TrialBalanceRow{
+			Currency:    row.Currency,
+			AccountType: row.Type,
+			AccountName: row.Name,
+			Accounts:    row.Accounts,
+			Debit:       row.Debit.Neg(),
+			Credit:      row.Credit,
+		})
+	}
+
+	return result, nil
+}
+
+// LiabilitiesRow is the money owed to users in a currency. Overdrawn wallets
+// are money users owe instead; they are reported apart and not netted.
+type LiabilitiesRow struct {
+	Currency          string          `json:"currency"`
+	Accounts          int             `json:"accounts"`
+	Liabilities       decimal.Decimal `json:"liabilities"`
+	OverdrawnAccounts int             `json:"overdrawn_accounts"`
+	Overdrafts        decimal.Decimal `json:"overdrafts"`
+	Net               decimal.Decimal `json:"net"`
+}
+
+// Liabilities returns the total of the user wallets per currency at an instant
+func (r *ReportRepository) Liabilities(ctx context.Context, asOf time.Time) ([]LiabilitiesRow, error) {
+	balances := accountBalances(asOf)
+	column := balances.C(balanceColumn)
+
+	rows := []LiabilitiesRow{}
+	err := r.client.Account.
+		Query().
+		Where(
+			account.TypeEQ(account.TypeUser),
+			joinBalances(balances),
+		).
+		Order(ent.Asc(account.FieldCurrency)).
+		GroupBy(account.FieldCurrency).
+		Aggregate(
+			ent.As(countOfSign(column, ">"), "accounts"),
+			ent.As(sumOfSign(column, ">"), "liabilities"),
+			ent.As(countOfSign(column, "<"), "overdrawn_accounts"),
+			ent.As(sumOfSign(column, "<"), "overdrafts"),
+			ent.As(func(*sql.Selector) string { return sql.Sum(column) }, "net"),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying liabilities: %w", err)
+	}
+
+	for i := range rows {
+		rows[i].Overdrafts = rows[i].Overdrafts.Neg()
+	}
+
+	return rows, nil
+}
+
+// FlowRow sums the deposits and withdrawals of a currency within a time range.
+// Reversals are counted apart from the deposits and withdrawals they
+// compensate; the net flow includes them.
+type FlowRow struct {
+	Currency         string          `json:"currency"`
+	Deposits         int             `json:"deposits"`
+	DepositAmount    decimal.Decimal `json:"deposit_amount"`
+	Withdrawals      int             `json:"withdrawals"`
+	WithdrawalAmount decimal.Decimal `json:"withdrawal_amount"`
+	Reversals        int             `json:"reversals"`
+	NetFlow          decimal.Decimal `json:"net_flow"`
+}
+
+// Flows returns the deposits and withdrawals per currency that took effect
+// from from (inclusive) until until (exclusive)
+func (r *ReportRepository) Flows(ctx context.Context, from time.Time, until time.Time) ([]FlowRow, error) {
+	flows := make(map[string]*FlowRow)
+	for _, reversals := range []bool{false, true} {
+		var rows []struct {
+			Currency string           `json:"currency"`
+			Type     transaction.Type `json:"type"`
+			Count    int              `json:"count"`
+			Sum      decimal.Decimal  `json:"sum"`
+		}
+		query := r.client.Transaction.
+			Query().
+			Where(
+				transaction.TypeIn(transaction.TypeDeposit, transaction.TypeWithdrawal),
+				transaction.StatusIn(AppliedStatuses...),
+				transaction.EffectiveAtGTE(from),
+				transaction.EffectiveAtLT(until),
+			)
+		if reversals {
+			query = query.Where(transaction.ReversalOfIDNotNil())
+		} else {
+			query = query.Where(transaction.ReversalOfIDIsNil())
+		}
+
+		err := query.
+			GroupBy(transaction.FieldCurrency, transaction.FieldType).
+			Aggregate(
+				ent.As(ent.Count(), "count"),
+				ent.As(ent.Sum(transaction.FieldAmount), "sum"),
+			).
+			Scan(ctx, &rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed querying flows: %w", err)
+		}
+
+		for _, row := range rows {
+			flow, ok := flows[row.Currency]
+			if !ok {
+				flow = &FlowRow{Currency: row.Currency}
+				flows[row.Currency] = flow
+			}
+
+			amount := row.Sum
+			if row.Type == transaction.TypeWithdrawal {
+				amount = amount.Neg()
+			}
+			flow.NetFlow = flow.NetFlow.Add(amount)
+
+			switch {
+			case reversals:
+				flow.Reversals += row.Count
+			case row.Type == transaction.TypeDeposit:
+				flow.Deposits = row.Count
+				flow.DepositAmount = row.Sum
+			default:
+				flow.Withdrawals = row.Count
+				flow.WithdrawalAmount = row.Sum
+			}
+		}
+	}
+
+	result := make([]FlowRow, 0, len(flows))
+	for _, flow := range flows {
+		result = append(result, *flow)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Currency < result[j].Currency
+	})
+
+	return result, nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"accounting/ent/account"
+	"accounting/ent/posting"
+	"accounting/ent/transaction"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TestReportsIncludePostingAtAsOf checks that a posting effective exactly at
+// as_of counts towards the reports as it does towards the as-of balances
+func TestReportsIncludePostingAtAsOf(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	if err := NewCurrencyRepository(client).Seed(ctx, ISO4217Currencies); err != nil {
+		t.Fatal(err)
+	}
+	user, err := NewUserRepository(client).Create(ctx, "Depositor", uuid.NewString()+"@example.com", 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	amount := decimal.NewFromInt(10)
+	_, err = newTestTransactionRepository(client).Create(ctx, uuid.NewString(), user.ID, amount, "USD",
+		transaction.TypeDeposit, TransactionDetails{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wallet, err := NewAccountRepository(client).GetByCode(ctx, UserAccountCode(user.ID, "USD"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := client.Posting.Query().Where(posting.AccountID(wallet.ID)).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	asOf := p.EffectiveAt
+	before := asOf.Add(-time.Microsecond)
+
+	sum, err := NewLedgerRepository(client, NewBalanceRepository(client)).SumPostings(ctx, wallet.ID, &before, asOf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sum.Amount.Equal(amount) {
+		t.Fatalf("postings up to as_of: got %s, want %s", sum.Amount, amount)
+	}
+
+	reports := NewReportRepository(client)
+	liabilitiesNet := func(at time.Time) decimal.Decimal {
+		rows, err := reports.Liabilities(ctx, at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, row := range rows {
+			if row.Currency == "USD" {
+				return row.Net
+			}
+		}
+		return decimal.Zero
+	}
+	if got := liabilitiesNet(asOf).Sub(liabilitiesNet(before)); !got.Equal(amount) {
+		t.Fatalf("liabilities change at as_of: got %s, want %s", got, amount)
+	}
+
+	walletsNet := func(at time.Time) decimal.Decimal {
+		rows, err := reports.TrialBalance(ctx, at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, row := range rows {
+			if row.Currency == "USD" && row.AccountType == string(account.TypeUser) {
+				return row.Credit.Sub(row.Debit)
+			}
+		}
+		return decimal.Zero
+	}
+	if got := walletsNet(asOf).Sub(walletsNet(before)); !got.Equal(amount) {
+		t.Fatalf("trial balance change of the user wallets at as_of: got %s, want %s", got, amount)
+	}
+}
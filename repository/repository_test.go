@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"accounting/ent"
+	_ "accounting/ent/runtime"
+
+	_ "github.com/lib/pq"
+)
+
+// testDSNEnv names the environment variable holding the connection string of
+// an empty PostgreSQL database for the repository tests; without it they are skipped
+const testDSNEnv = "ACCOUNTING_TEST_DSN"
+
+// newTestClient connects to the test database and creates the schema
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+
+	client, err := ent.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("failed opening connection to postgres: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema resources: %v", err)
+	}
+	return client
+}
+
+// newTestTransactionRepository wires a transaction repository like the services do
+func newTestTransactionRepository(client *ent.Client) *TransactionRepository {
+	balanceRepo := NewBalanceRepository(client)
+	return NewTransactionRepository(client, NewAccountRepository(client), NewLedgerRepository(client, balanceRepo),
+		NewExchangeRateRepository(client), NewCurrencyRepository(client), NewFeeRuleRepository(client))
+}
@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/schedule"
+	"accounting/ent/schedulerun"
+	"accounting/errors"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+)
+
+// ScheduleRepository represents a repository for working with scheduled transactions
+type ScheduleRepository struct {
+	client *ent.Client
+}
+
+// NewScheduleRepository creates a new schedule repository
+func NewScheduleRepository(client *ent.Client) *ScheduleRepository {
+	return &ScheduleRepository{
+		client: client,
+	}
+}
+
+// ScheduleTransactionID returns the ID of the transaction created for an
+// occurrence of a schedule. It is the same on every attempt, so an occurrence
+// cannot create more than one transaction.
+func ScheduleTransactionID(scheduleID int, occurrence int) string {
+	return fmt.Sprintf("schedule:%d:%d", scheduleID, occurrence)
+}
+
+// CreateScheduleParams represents the parameters for the Create method
+type CreateScheduleParams struct {
+	UserID    int
+	Type      schedule.Type
+	Amount    decimal.Decimal
+	Currency  string
+	Frequency schedule.Frequency
+	Interval  int
+	Count     *int
+	StartAt   time.Time
+	EndAt     *time.Time
+}
+
+// Create creates an active schedule whose first occurrence is due at its start
+func (r *ScheduleRepository) Create(ctx context.Context, params CreateScheduleParams) (*ent.Schedule, error) {
+	s, err := r.client.Schedule.
+		Create().
+		SetUserID(params.UserID).
+		SetType(params.Type).
+		SetAmount(params.Amount).
+		SetCurrency(params.Currency).
+		SetFrequency(params.Frequency).
+		SetInterval(params.Interval).
+		SetNillableCount(params.Count).
+		SetStartAt(params.StartAt).
+		SetNillableEndAt(params.EndAt).
+		SetNextRunAt(params.StartAt).
+		SetCreatedAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating schedule: %w", err)
+	}
+
+	return s, nil
+}
+
+// GetByID gets a schedule by its ID together with its runs
+func (r *ScheduleRepository) GetByID(ctx context.Context, id int) (*ent.Schedule, error) {
+	s, err := r.client.Schedule.
+		Query().
+		Where(schedule.ID(id)).
+		WithRuns(func(q *ent.ScheduleRunQuery) {
+			q.Order(ent.Asc(schedulerun.FieldID))
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying schedule by ID: %w", err)
+	}
+
+	return s, nil
+}
+
+// Cancel stops an active schedule; occurrences already executed are kept
+func (r *ScheduleRepository) Cancel(ctx context.Context, id int) (*ent.Schedule, error) {
+	var result *ent.Schedule
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		s, err := tx.Schedule.
+			Query().
+			Where(schedule.ID(id)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("failed querying schedule by ID: %w", err)
+		}
+		if s.Status != schedule.StatusActive {
+			return errors.WithDetails(errors.ErrInvalidState, "schedule %d is %s", id, s.Status)
+		}
+
+		result, err = tx.Schedule.
+			UpdateOne(s).
+			SetStatus(schedule.StatusCancelled).
+			ClearNextRunAt().
+			SetUpdatedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed cancelling schedule: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ClaimDue returns up to limit active schedules due at now and postpones them by
+// the lease, so concurrent workers do not pick the same occurrence while it is
+// executed. Schedules locked by a concurrent claim are skipped.
+func (r *ScheduleRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration,
+	limit int) ([]*ent.Schedule, error) {
+
+	var claimed []*ent.Schedule
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		schedules, err := tx.Schedule.
+			Query().
+			Where(
+				schedule.StatusEQ(schedule.StatusActive),
+				schedule.NextRunAtLTE(now),
+			).
+			Order(ent.Asc(schedule.FieldNextRunAt)).
+			Limit(limit).
+			ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed querying due schedules: %w", err)
+		}
+		if len(schedules) == 0 {
+			return nil
+		}
+
+		ids := make([]int, 0, len(schedules))
+		for _, s := range schedules {
+			ids = append(ids, s.ID)
+		}
+		err = tx.Schedule.
+			Update().
+			Where(schedule.IDIn(ids...)).
+			SetNextRunAt(now.Add(lease)).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed claiming due schedules: %w", err)
+		}
+
+		claimed = schedules
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+// RecordRunParams represents the parameters for the RecordRun method
+type RecordRunParams struct {
+	// Schedule as claimed; the run is for its next occurrence
+	Schedule    *ent.Schedule
+	ScheduledAt time.Time
+	// TransactionID is set when the occurrence was executed
+	TransactionID *string
+	// Error is set when the attempt failed
+	Error *string
+	// Advance moves the schedule on to the following occurrence
+	Advance bool
+	// NextRunAt is when the schedule is due again; nil completes the schedule
+	NextRunAt *time.Time
+}
+
+// RecordRun stores the outcome of an attempt to execute an occurrence and moves
+// the schedule on. The schedule is only updated if it is still active and at the
+// same occurrence, so a cancellation in the meantime is kept.
+func (r *ScheduleRepository) RecordRun(ctx context.Context, params RecordRunParams) error {
+	s := params.Schedule
+	return withTx(ctx, r.client, func(tx *ent.Tx) error {
+		status := schedulerun.StatusSucceeded
+		if params.Error != nil {
+			status = schedulerun.StatusFailed
+		}
+
+		err := tx.ScheduleRun.
+			Create().
+			SetScheduleID(s.ID).
+			SetOccurrence(s.NextOccurrence).
+			SetScheduledAt(params.ScheduledAt).
+			SetStatus(status).
+			SetNillableTransactionID(params.TransactionID).
+			SetNillableError(params.Error).
+			SetCreatedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed creating schedule run: %w", err)
+		}
+
+		update := tx.Schedule.
+			UpdateOne(s).
+			Where(
+				schedule.StatusEQ(schedule.StatusActive),
+				schedule.NextOccurrence(s.NextOccurrence),
+			).
+			SetNillableLastError(params.Error).
+			SetUpdatedAt(time.Now())
+		if params.Error == nil {
+			update.ClearLastError()
+		}
+		if params.Advance {
+			update.AddNextOccurrence(1).SetAttempts(0)
+		} else {
+			update.AddAttempts(1)
+		}
+		if params.NextRunAt != nil {
+			update.SetNextRunAt(*params.NextRunAt)
+		} else {
+			update.ClearNextRunAt().SetStatus(schedule.StatusCompleted)
+		}
+
+		if err := update.Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed updating schedule: %w", err)
+		}
+		return nil
+	})
+}
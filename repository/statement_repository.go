@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/transaction"
+
+	"github.com/shopspring/decimal"
+)
+
+// StatementRepository represents a repository for reading the transactions of account statements
+type StatementRepository struct {
+	client *ent.Client
+}
+
+// NewStatementRepository creates a new statement repository
+func NewStatementRepository(client *ent.Client) *StatementRepository {
+	return &StatementRepository{
+		client: client,
+	}
+}
+
+// StatementData is what a statement is built from: the balance at the start of
+// the period and the transactions that took effect within it
+type StatementData struct {
+	Opening      decimal.Decimal
+	Transactions []*ent.Transaction
+}
+
+// Get returns the balance of a user in a currency from the transactions
+// effective before from, and the applied transactions effective from from
+// until until in the order they took effect. Both are read from the same
+// snapshot, so the closing balance follows from them.
+func (r *StatementRepository) Get(ctx context.Context, userID int, currency string, from time.Time,
+	until time.Time) (*StatementData, error) {
+
+	data := &StatementData{Opening: decimal.Zero}
+	err := withSnapshot(ctx, r.client, func(tx *ent.Tx) error {
+		sums, err := sumTransactions(ctx, tx.Transaction,
+			transaction.UserID(userID),
+			transaction.CurrencyEQ(currency),
+			transaction.EffectiveAtLT(from),
+		)
+		if err != nil {
+			return err
+		}
+		if opening, ok := sums[balanceKey{userID: userID, currency: currency}]; ok {
+			data.Opening = opening
+		}
+
+		data.Transactions, err = tx.Transaction.
+			Query().
+			Where(
+				transaction.UserID(userID),
+				transaction.CurrencyEQ(currency),
+				transaction.StatusIn(AppliedStatuses...),
+				transaction.EffectiveAtGTE(from),
+				transaction.EffectiveAtLT(until),
+			).
+			Order(ent.Asc(transaction.FieldEffectiveAt), ent.Asc(transaction.FieldID)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed querying statement transactions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
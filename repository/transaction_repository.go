@@ -3,30 +3,52 @@ package repository
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"accounting/ent"
+	"accounting/ent/balance"
+	"accounting/ent/feerule"
+	"accounting/ent/predicate"
 	"accounting/ent/transaction"
 	"accounting/ent/user"
+	"accounting/errors"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/shopspring/decimal"
 )
 
 // TransactionRepository presents a repository for working with transactions
 type TransactionRepository struct {
-	client      *ent.Client
-	balanceRepo *BalanceRepository
+	client           *ent.Client
+	accountRepo      *AccountRepository
+	ledgerRepo       *LedgerRepository
+	exchangeRateRepo *ExchangeRateRepository
+	currencyRepo     *CurrencyRepository
+	feeRuleRepo      *FeeRuleRepository
 }
 
 // NewTransactionRepository creates a new transaction repository
-func NewTransactionRepository(client *ent.Client, balanceRepo *BalanceRepository) *TransactionRepository {
+func NewTransactionRepository(client *ent.Client, accountRepo *AccountRepository, ledgerRepo *LedgerRepository,
+	exchangeRateRepo *ExchangeRateRepository, currencyRepo *CurrencyRepository,
+	feeRuleRepo *FeeRuleRepository) *TransactionRepository {
+
 	return &TransactionRepository{
-		client:      client,
-		balanceRepo: balanceRepo,
+		client:           client,
+		accountRepo:      accountRepo,
+		ledgerRepo:       ledgerRepo,
+		exchangeRateRepo: exchangeRateRepo,
+		currencyRepo:     currencyRepo,
+		feeRuleRepo:      feeRuleRepo,
 	}
 }
 
-// Create creates a new transaction with SQL transaction
-func (r *TransactionRepository) Create(ctx context.Context, id string, userID int, amount float64,
-	currency string, txType transaction.Type) (*ent.Transaction, error) {
+// Create creates a new transaction with SQL transaction. The transaction is
+// rejected if it would exceed any of the given velocity limits.
+func (r *TransactionRepository) Create(ctx context.Context, id string, userID int, amount decimal.Decimal,
+	currency string, txType transaction.Type, details TransactionDetails,
+	limits []*ent.VelocityLimit) (*ent.Transaction, error) {
 
 	// Start a transaction
 	tx, err := r.client.Tx(ctx)
@@ -35,7 +57,15 @@ func (r *TransactionRepository) Create(ctx context.Context, id string, userID in
 	}
 
 	// Execute the actual logic within the transaction
-	transaction, err := r.createWithTx(ctx, tx, id, userID, amount, currency, txType)
+	transaction, err := r.createWithTx(ctx, tx, CreateTransactionParams{
+		ID:       id,
+		UserID:   userID,
+		Amount:   amount,
+		Currency: currency,
+		Type:     txType,
+		Details:  details,
+		Limits:   limits,
+	})
 	if err != nil {
 		// Rollback the transaction in case of error
 		if err := tx.Rollback(); err != nil {
@@ -52,42 +82,1091 @@ func (r *TransactionRepository) Create(ctx context.Context, id string, userID in
 	return transaction, nil
 }
 
-// CreateWithTx creates a new transaction within an existing DB transaction
-func (r *TransactionRepository) createWithTx(ctx context.Context, tx *ent.Tx, id string, userID int, amount float64,
-	currency string, txType transaction.Type) (*ent.Transaction, error) {
+// TransactionDetails describe why money moved; all of them are optional
+type TransactionDetails struct {
+	Description *string
+	Category    *string
+	// Source is the system the transaction originates from; together with
+	// ExternalRef it identifies the transaction in that system
+	Source      *string
+	ExternalRef *string
+	Metadata    map[string]any
+	// EffectiveAt back-dates a posted transaction into an open accounting
+	// period; nil means it takes effect when it is posted
+	EffectiveAt *time.Time
+}
+
+// CreateTransactionParams represents the parameters for the createWithTx method
+type CreateTransactionParams struct {
+	ID       string
+	UserID   int
+	Amount   decimal.Decimal
+	Currency string
+	Type     transaction.Type
+	Details  TransactionDetails
+	// ReversalOfID links a compensating transaction to the transaction it reverses
+	ReversalOfID *string
+	// Pending records the transaction without applying it; it is posted or failed later
+	Pending bool
+	// Limits are the velocity limits the transaction has to stay within
+	Limits []*ent.VelocityLimit
+	// SkipFee records the transaction without charging a fee, e.g. for
+	// historical transactions whose fees the legacy system already charged
+	SkipFee bool
+	// InEffectiveOrder rejects the transaction when it takes effect before the
+	// latest transaction of its balance, whose running balance it would precede.
+	// The balance has to be locked already, as CreateBatch does.
+	InEffectiveOrder bool
+}
+
+// CreatePending creates a pending deposit or withdrawal. Pending transactions
+// have no journal entry and do not change the balance until they are posted,
+// but they count towards the given velocity limits.
+func (r *TransactionRepository) CreatePending(ctx context.Context, id string, userID int, amount decimal.Decimal,
+	currency string, txType transaction.Type, details TransactionDetails,
+	limits []*ent.VelocityLimit) (*ent.Transaction, error) {
+
+	var result *ent.Transaction
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		var err error
+		result, err = r.createWithTx(ctx, tx, CreateTransactionParams{
+			ID:       id,
+			UserID:   userID,
+			Amount:   amount,
+			Currency: currency,
+			Type:     txType,
+			Details:  details,
+			Pending:  true,
+			Limits:   limits,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// BatchItemResult is the outcome of one item of a batch. An item that was
+// neither applied nor failed was rolled back because another item failed.
+type BatchItemResult struct {
+	Transaction *ent.Transaction
+	Err         error
+}
+
+// CreateBatch creates deposits and withdrawals of several users and currencies
+// in one DB transaction. By default the batch is all-or-nothing: the first
+// failing item rolls back every item and its error is returned. In best-effort
+// mode every item runs in its own savepoint, so a failing item is rolled back
+// alone and the others are committed.
+func (r *TransactionRepository) CreateBatch(ctx context.Context, items []CreateTransactionParams,
+	bestEffort bool) ([]BatchItemResult, error) {
+
+	results := make([]BatchItemResult, len(items))
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := r.lockBatchWithTx(ctx, tx, items); err != nil {
+			return err
+		}
+
+		for i, item := range items {
+			if !bestEffort {
+				results[i].Transaction, results[i].Err = r.createWithTx(ctx, tx, item)
+				if results[i].Err != nil {
+					return fmt.Errorf("batch item %d: %w", i, results[i].Err)
+				}
+				continue
+			}
+
+			results[i].Err = withSavepoint(ctx, tx, func() error {
+				var err error
+				results[i].Transaction, err = r.createWithTx(ctx, tx, item)
+				return err
+			})
+			if results[i].Err != nil {
+				results[i].Transaction = nil
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		for i := range results {
+			results[i].Transaction = nil
+		}
+		return results, err
+	}
+
+	return results, nil
+}
+
+// lockBatchWithTx takes the locks of all items of a batch up front, in the
+// order single operations take them: the users for share, then the velocity
+// locks by user ID, then the existing balances by user ID and currency. Locking
+// them while processing the items in request order could deadlock with
+// concurrent batches, and taking the velocity locks before the users could
+// deadlock with a single operation and a status change of the same user.
+func (r *TransactionRepository) lockBatchWithTx(ctx context.Context, tx *ent.Tx,
+	items []CreateTransactionParams) error {
+
+	userIDs := make([]int, 0, len(items))
+	seen := make(map[int]bool, len(items))
+	pairs := make([]predicate.Balance, 0, len(items))
+	for _, item := range items {
+		pairs = append(pairs, balance.And(balance.UserID(item.UserID), balance.CurrencyEQ(item.Currency)))
+		if !seen[item.UserID] {
+			seen[item.UserID] = true
+			userIDs = append(userIDs, item.UserID)
+		}
+	}
+	sort.Ints(userIDs)
+
+	_, err := tx.User.
+		Query().
+		Where(user.IDIn(userIDs...)).
+		Order(ent.Asc(user.FieldID)).
+		ForShare().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed locking users: %w", err)
+	}
+
+	for _, userID := range userIDs {
+		if err := lockVelocityChecksWithTx(ctx, tx, userID); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Balance.
+		Query().
+		Where(balance.Or(pairs...)).
+		Order(ent.Asc(balance.FieldUserID), ent.Asc(balance.FieldCurrency)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed locking balances: %w", err)
+	}
+
+	return nil
+}
+
+// CreateWithTx creates a new transaction within an existing DB transaction.
+// Unless the transaction is pending, its balance change is posted right away.
+func (r *TransactionRepository) createWithTx(ctx context.Context, tx *ent.Tx,
+	params CreateTransactionParams) (*ent.Transaction, error) {
+
+	txType := params.Type
+	if txType != transaction.TypeDeposit && txType != transaction.TypeWithdrawal {
+		return nil, errors.WithDetails(errors.ErrInvalidInput, "unsupported transaction type %s", txType)
+	}
+	if err := checkUserStatusWithTx(ctx, tx, params.UserID, txType); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	effectiveAt := now
+	if params.Details.EffectiveAt != nil {
+		effectiveAt = *params.Details.EffectiveAt
+	}
+	if err := checkPeriodOpenWithTx(ctx, tx, effectiveAt); err != nil {
+		return nil, err
+	}
+	if params.InEffectiveOrder && !params.Pending {
+		if err := checkEffectiveOrderWithTx(ctx, tx, params.UserID, params.Currency, effectiveAt); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := enforceVelocityLimitsWithTx(ctx, tx, params); err != nil {
+		return nil, err
+	}
 
 	builder := tx.Transaction.
 		Create().
-		SetID(id).
-		SetUserID(userID).
-		SetAmount(amount).
-		SetCurrency(currency).
+		SetID(params.ID).
+		SetUserID(params.UserID).
+		SetAmount(params.Amount).
+		SetCurrency(params.Currency).
 		SetType(txType).
-		SetCreatedAt(time.Now())
+		SetNillableReversalOfID(params.ReversalOfID).
+		SetNillableDescription(params.Details.Description).
+		SetNillableCategory(params.Details.Category).
+		SetNillableSource(params.Details.Source).
+		SetNillableExternalRef(params.Details.ExternalRef).
+		SetEffectiveAt(effectiveAt).
+		SetCreatedAt(now)
+	if params.Details.Metadata != nil {
+		builder.SetMetadata(params.Details.Metadata)
+	}
+
+	if params.Pending {
+		builder.SetStatus(transaction.StatusPending)
+	} else {
+		entry, after, err := r.postCashEntryWithTx(ctx, tx, params.ID, params.UserID, params.Amount, params.Currency,
+			txType, effectiveAt)
+		if err != nil {
+			return nil, err
+		}
+		builder.
+			SetStatus(transaction.StatusPosted).
+			SetJournalEntryID(entry.ID).
+			SetBalanceAfter(after.Amount).
+			SetBalanceSequence(after.Sequence).
+			SetPostedAt(now)
+	}
 
 	transaction, err := builder.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed creating %s transaction: %w", txType, err)
 	}
 
-	var amountWithSign float64
-	if txType == "deposit" {
+	if !params.Pending && !params.SkipFee {
+		if _, err := r.chargeFeeWithTx(ctx, tx, transaction); err != nil {
+			return nil, err
+		}
+	}
+
+	return transaction, nil
+}
+
+// checkEffectiveOrderWithTx checks that a transaction taking effect at the given
+// instant does not precede the latest transaction applied to the balance of the
+// user in the currency. The database stores instants with microsecond precision.
+func checkEffectiveOrderWithTx(ctx context.Context, tx *ent.Tx, userID int, currency string,
+	effectiveAt time.Time) error {
+
+	latest, err := tx.Transaction.
+		Query().
+		Where(
+			transaction.UserID(userID),
+			transaction.CurrencyEQ(currency),
+			transaction.BalanceSequenceNotNil(),
+		).
+		Order(ent.Desc(transaction.FieldBalanceSequence)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed querying latest %s transaction: %w", currency, err)
+	}
+
+	if effectiveAt.Truncate(time.Microsecond).Before(latest.EffectiveAt) {
+		return errors.WithDetails(errors.ErrInvalidState, "effective_at %s precedes transaction %s of the %s balance at %s",
+			effectiveAt.Format(time.RFC3339Nano), latest.ID, currency, latest.EffectiveAt.Format(time.RFC3339Nano))
+	}
+	return nil
+}
+
+// postCashEntryWithTx writes the balance change of a deposit or withdrawal as a
+// journal entry against the external cash system account: a deposit credits the
+// user's wallet and debits external cash, a withdrawal does the opposite.
+// The resulting user balance is returned together with the entry.
+func (r *TransactionRepository) postCashEntryWithTx(ctx context.Context, tx *ent.Tx, id string, userID int,
+	amount decimal.Decimal, currency string, txType transaction.Type,
+	effectiveAt time.Time) (*ent.JournalEntry, *ent.Balance, error) {
+
+	userAccount, err := r.accountRepo.GetOrCreateUserAccountWithTx(ctx, tx, userID, currency)
+	if err != nil {
+		return nil, nil, err
+	}
+	cashAccount, err := r.accountRepo.GetOrCreateSystemAccountWithTx(ctx, tx, SystemAccountExternalCash, currency)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var amountWithSign decimal.Decimal
+	if txType == transaction.TypeDeposit {
 		amountWithSign = amount
 	} else {
-		amountWithSign = -amount
+		amountWithSign = amount.Neg()
 	}
 
-	// Use the BalanceRepository with the transaction context
-	err = r.balanceRepo.UpsertWithTx(ctx, tx, UpsertBalanceParams{
-		UserID:   userID,
-		Currency: currency,
-		Amount:   amountWithSign,
+	entry, err := r.ledgerRepo.PostWithTx(ctx, tx, PostEntryParams{
+		Description: fmt.Sprintf("%s %s", txType, id),
+		Postings: []PostingParams{
+			{Account: userAccount, Amount: amountWithSign},
+			{Account: cashAccount, Amount: amountWithSign.Neg()},
+		},
+		EffectiveAt: &effectiveAt,
 	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entry.JournalEntry, entry.Balances[userAccount.ID], nil
+}
+
+// DerivedIDSeparator separates the parts of the IDs derived from other IDs,
+// e.g. "<id>:fee". Client-supplied IDs and idempotency keys must not contain
+// it, so a derived ID cannot collide with an ID chosen by a client.
+const DerivedIDSeparator = ":"
+
+// FeeTransactionID returns the ID of the fee charged on a transaction
+func FeeTransactionID(id string) string {
+	return id + DerivedIDSeparator + "fee"
+}
+
+// chargeFeeWithTx charges the fee of the rule applying to a posted transaction
+// within the DB transaction that posted it. The fee is a separate transaction
+// linked to the charged one, debiting the user's wallet and crediting the fee
+// revenue system account. Nil is returned when no fee applies; reversals are
+// never charged.
+func (r *TransactionRepository) chargeFeeWithTx(ctx context.Context, tx *ent.Tx,
+	charged *ent.Transaction) (*ent.Transaction, error) {
+
+	if charged.ReversalOfID != nil {
+		return nil, nil
+	}
+
+	u, err := tx.User.Get(ctx, charged.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying user: %w", err)
+	}
+	rule, err := r.feeRuleRepo.FindWithTx(ctx, tx, feerule.TransactionType(charged.Type), charged.Currency, u.Tier)
+	if err != nil || rule == nil {
+		return nil, err
+	}
+	currency, err := r.currencyRepo.GetByCodeWithTx(ctx, tx, charged.Currency)
 	if err != nil {
 		return nil, err
 	}
 
-	return transaction, nil
+	amount := ComputeFee(rule, charged.Amount, int32(currency.Exponent))
+	if !amount.IsPositive() {
+		return nil, nil
+	}
+
+	userAccount, err := r.accountRepo.GetOrCreateUserAccountWithTx(ctx, tx, charged.UserID, charged.Currency)
+	if err != nil {
+		return nil, err
+	}
+	revenueAccount, err := r.accountRepo.GetOrCreateSystemAccountWithTx(ctx, tx, SystemAccountFeeRevenue,
+		charged.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	id := FeeTransactionID(charged.ID)
+	entry, err := r.ledgerRepo.PostWithTx(ctx, tx, PostEntryParams{
+		Description: fmt.Sprintf("fee %s (%s)", id, rule.Name),
+		Postings: []PostingParams{
+			{Account: userAccount, Amount: amount.Neg()},
+			{Account: revenueAccount, Amount: amount},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	feeTx, err := tx.Transaction.
+		Create().
+		SetID(id).
+		SetUserID(charged.UserID).
+		SetAmount(amount).
+		SetCurrency(charged.Currency).
+		SetType(transaction.TypeFee).
+		SetFeeOfID(charged.ID).
+		SetJournalEntryID(entry.ID).
+		SetBalanceAfter(entry.Balances[userAccount.ID].Amount).
+		SetBalanceSequence(entry.Balances[userAccount.ID].Sequence).
+		SetCreatedAt(now).
+		SetPostedAt(now).
+		SetEffectiveAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating %s transaction: %w", transaction.TypeFee, err)
+	}
+
+	return feeTx, nil
+}
+
+// Post applies a pending transaction: its journal entry is written and the
+// balance changes. A withdrawal that the balance cannot cover is rejected and
+// the transaction stays pending.
+func (r *TransactionRepository) Post(ctx context.Context, id string) (*ent.Transaction, error) {
+	var result *ent.Transaction
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		pending, err := r.lockPendingWithTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkUserStatusWithTx(ctx, tx, pending.UserID, pending.Type); err != nil {
+			return err
+		}
+
+		// A pending transaction takes effect when it is posted, not when it was created
+		now := time.Now()
+		if err := checkPeriodOpenWithTx(ctx, tx, now); err != nil {
+			return err
+		}
+
+		entry, after, err := r.postCashEntryWithTx(ctx, tx, pending.ID, pending.UserID, pending.Amount,
+			pending.Currency, pending.Type, now)
+		if err != nil {
+			return err
+		}
+
+		result, err = tx.Transaction.
+			UpdateOne(pending).
+			SetStatus(transaction.StatusPosted).
+			SetJournalEntryID(entry.ID).
+			SetBalanceAfter(after.Amount).
+			SetBalanceSequence(after.Sequence).
+			SetPostedAt(now).
+			SetEffectiveAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed posting transaction: %w", err)
+		}
+
+		_, err = r.chargeFeeWithTx(ctx, tx, result)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Fail marks a pending transaction as failed. Failed transactions never affect the balance.
+func (r *TransactionRepository) Fail(ctx context.Context, id string, reason string) (*ent.Transaction, error) {
+	var result *ent.Transaction
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		pending, err := r.lockPendingWithTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		result, err = tx.Transaction.
+			UpdateOne(pending).
+			SetStatus(transaction.StatusFailed).
+			SetFailureReason(reason).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed marking transaction as failed: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// lockPendingWithTx locks a transaction and checks that it is still pending
+func (r *TransactionRepository) lockPendingWithTx(ctx context.Context, tx *ent.Tx, id string) (*ent.Transaction, error) {
+	t, err := tx.Transaction.
+		Query().
+		Where(transaction.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying transaction: %w", err)
+	}
+	if t.Status != transaction.StatusPending {
+		return nil, errors.WithDetails(errors.ErrInvalidState, "transaction %s is %s", t.ID, t.Status)
+	}
+
+	return t, nil
+}
+
+// ReverseParams represents the parameters for the Reverse method
+type ReverseParams struct {
+	// ID is the ID of the compensating transaction
+	ID         string
+	OriginalID string
+	// Amount to reverse; nil reverses the whole remaining amount
+	Amount *decimal.Decimal
+}
+
+// Reversal holds a compensating transaction together with the updated original
+type Reversal struct {
+	Reversal *ent.Transaction
+	Original *ent.Transaction
+}
+
+// Reverse fully or partially compensates a deposit or withdrawal in a single SQL transaction
+func (r *TransactionRepository) Reverse(ctx context.Context, params ReverseParams) (*Reversal, error) {
+	var result *Reversal
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		var err error
+		result, err = r.reverseWithTx(ctx, tx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// reverseWithTx creates a compensating transaction of the opposite type within an
+// existing DB transaction. The original is locked, so concurrent reversals cannot
+// compensate more than its amount in total.
+func (r *TransactionRepository) reverseWithTx(ctx context.Context, tx *ent.Tx, params ReverseParams) (*Reversal, error) {
+	if err := checkPeriodOpenWithTx(ctx, tx, time.Now()); err != nil {
+		return nil, err
+	}
+
+	original, err := tx.Transaction.
+		Query().
+		Where(transaction.ID(params.OriginalID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying transaction to reverse: %w", err)
+	}
+
+	var compensatingType transaction.Type
+	switch original.Type {
+	case transaction.TypeDeposit:
+		compensatingType = transaction.TypeWithdrawal
+	case transaction.TypeWithdrawal:
+		compensatingType = transaction.TypeDeposit
+	default:
+		return nil, errors.WithDetails(errors.ErrInvalidInput, "%s transactions cannot be reversed", original.Type)
+	}
+	if original.ReversalOfID != nil {
+		return nil, errors.WithDetails(errors.ErrInvalidInput, "transaction %s is itself a reversal", original.ID)
+	}
+	if original.Status != transaction.StatusPosted && original.Status != transaction.StatusPartiallyReversed {
+		return nil, errors.WithDetails(errors.ErrInvalidState, "transaction %s is %s", original.ID, original.Status)
+	}
+
+	remaining := original.Amount.Sub(original.ReversedAmount)
+	amount := remaining
+	if params.Amount != nil {
+		amount = *params.Amount
+	}
+	if !amount.IsPositive() {
+		return nil, errors.WithDetails(errors.ErrInvalidInput, "reversal amount must be positive")
+	}
+	if amount.GreaterThan(remaining) {
+		return nil, errors.WithDetails(errors.ErrInvalidInput, "reversal amount %s exceeds remaining amount %s",
+			amount, remaining)
+	}
+
+	reversal, err := r.createWithTx(ctx, tx, CreateTransactionParams{
+		ID:           params.ID,
+		UserID:       original.UserID,
+		Amount:       amount,
+		Currency:     original.Currency,
+		Type:         compensatingType,
+		ReversalOfID: &original.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reversedAmount := original.ReversedAmount.Add(amount)
+	status := transaction.StatusPartiallyReversed
+	if reversedAmount.Equal(original.Amount) {
+		status = transaction.StatusReversed
+	}
+
+	if _, err := r.refundFeeWithTx(ctx, tx, original, reversal, status == transaction.StatusReversed); err != nil {
+		return nil, err
+	}
+
+	original, err = tx.Transaction.
+		UpdateOne(original).
+		SetReversedAmount(reversedAmount).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed updating reversed transaction: %w", err)
+	}
+
+	return &Reversal{Reversal: reversal, Original: original}, nil
+}
+
+// refundFeeWithTx refunds the fee charged on a transaction in proportion to a
+// reversal of it, rounded down to the minor unit; the reversal completing it
+// refunds the rest of the fee. The refund is a fee_refund transaction with the
+// ID <reversal_id>:fee compensating the fee, so the fee is reversed along with
+// the transaction it was charged on. Nil is returned when there is no fee to refund.
+func (r *TransactionRepository) refundFeeWithTx(ctx context.Context, tx *ent.Tx, original *ent.Transaction,
+	reversal *ent.Transaction, complete bool) (*ent.Transaction, error) {
+
+	fee, err := tx.Transaction.
+		Query().
+		Where(
+			transaction.FeeOfID(original.ID),
+			transaction.TypeEQ(transaction.TypeFee),
+		).
+		ForUpdate().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed querying fee to refund: %w", err)
+	}
+
+	remaining := fee.Amount.Sub(fee.ReversedAmount)
+	amount := remaining
+	if !complete {
+		currency, err := r.currencyRepo.GetByCodeWithTx(ctx, tx, fee.Currency)
+		if err != nil {
+			return nil, err
+		}
+		amount = decimal.Min(remaining,
+			fee.Amount.Mul(reversal.Amount).Div(original.Amount).RoundDown(int32(currency.Exponent)))
+	}
+	if !amount.IsPositive() {
+		return nil, nil
+	}
+
+	userAccount, err := r.accountRepo.GetOrCreateUserAccountWithTx(ctx, tx, fee.UserID, fee.Currency)
+	if err != nil {
+		return nil, err
+	}
+	revenueAccount, err := r.accountRepo.GetOrCreateSystemAccountWithTx(ctx, tx, SystemAccountFeeRevenue,
+		fee.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	id := FeeTransactionID(reversal.ID)
+	entry, err := r.ledgerRepo.PostWithTx(ctx, tx, PostEntryParams{
+		Description: fmt.Sprintf("fee refund %s of %s", id, fee.ID),
+		Postings: []PostingParams{
+			{Account: userAccount, Amount: amount},
+			{Account: revenueAccount, Amount: amount.Neg()},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	refund, err := tx.Transaction.
+		Create().
+		SetID(id).
+		SetUserID(fee.UserID).
+		SetAmount(amount).
+		SetCurrency(fee.Currency).
+		SetType(transaction.TypeFeeRefund).
+		SetReversalOfID(fee.ID).
+		SetJournalEntryID(entry.ID).
+		SetBalanceAfter(entry.Balances[userAccount.ID].Amount).
+		SetBalanceSequence(entry.Balances[userAccount.ID].Sequence).
+		SetCreatedAt(now).
+		SetPostedAt(now).
+		SetEffectiveAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating %s transaction: %w", transaction.TypeFeeRefund, err)
+	}
+
+	refunded := fee.ReversedAmount.Add(amount)
+	status := transaction.StatusPartiallyReversed
+	if refunded.Equal(fee.Amount) {
+		status = transaction.StatusReversed
+	}
+	err = tx.Transaction.
+		UpdateOne(fee).
+		SetReversedAmount(refunded).
+		SetStatus(status).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed updating refunded fee: %w", err)
+	}
+
+	return refund, nil
+}
+
+// GetReversals gets all reversals of a transaction
+func (r *TransactionRepository) GetReversals(ctx context.Context, id string) ([]*ent.Transaction, error) {
+	txs, err := r.client.Transaction.
+		Query().
+		Where(transaction.ReversalOfID(id)).
+		Order(ent.Asc(transaction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying reversals: %w", err)
+	}
+	return txs, nil
+}
+
+// CreateTransferParams represents the parameters for the CreateTransfer method
+type CreateTransferParams struct {
+	TransferID string
+	FromUserID int
+	ToUserID   int
+	Currency   string
+	Amount     decimal.Decimal
+	// Sweep moves the balance of an account being closed: the statuses of the
+	// users are checked by the caller and no transfer fee is charged
+	Sweep bool
+}
+
+// TransferLegs holds both transactions recorded for a transfer
+type TransferLegs struct {
+	Out *ent.Transaction
+	In  *ent.Transaction
+}
+
+// LegIDs returns the IDs of the outgoing and incoming legs of a transfer or an exchange
+func LegIDs(operationID string) (outID string, inID string) {
+	return operationID + DerivedIDSeparator + "out", operationID + DerivedIDSeparator + "in"
+}
+
+// CreateTransfer moves money from one user to another in a single SQL transaction
+func (r *TransactionRepository) CreateTransfer(ctx context.Context, params CreateTransferParams) (*TransferLegs, error) {
+	// Start a transaction
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed starting transaction: %w", err)
+	}
+
+	// Execute the actual logic within the transaction
+	legs, err := r.createTransferWithTx(ctx, tx, params)
+	if err != nil {
+		// Rollback the transaction in case of error
+		if rerr := tx.Rollback(); rerr != nil {
+			return nil, fmt.Errorf("rolling back transaction: %w (%v)", rerr, err)
+		}
+		return nil, err
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return legs, nil
+}
+
+// createTransferWithTx records both legs of a transfer within an existing DB transaction.
+// Balance rows of both users are locked in ascending user ID order first, so
+// concurrent transfers between the same users in opposite directions cannot deadlock.
+func (r *TransactionRepository) createTransferWithTx(ctx context.Context, tx *ent.Tx,
+	params CreateTransferParams) (*TransferLegs, error) {
+
+	if params.FromUserID == params.ToUserID {
+		return nil, errors.WithDetails(errors.ErrInvalidInput, "cannot transfer to the same user")
+	}
+
+	if !params.Sweep {
+		// Users are locked in the same ascending ID order as their balances
+		checks := []struct {
+			userID int
+			txType transaction.Type
+		}{
+			{params.FromUserID, transaction.TypeTransferOut},
+			{params.ToUserID, transaction.TypeTransferIn},
+		}
+		if params.ToUserID < params.FromUserID {
+			checks[0], checks[1] = checks[1], checks[0]
+		}
+		for _, check := range checks {
+			if err := checkUserStatusWithTx(ctx, tx, check.userID, check.txType); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := checkPeriodOpenWithTx(ctx, tx, time.Now()); err != nil {
+		return nil, err
+	}
+
+	_, err := tx.Balance.
+		Query().
+		Where(
+			balance.UserIDIn(params.FromUserID, params.ToUserID),
+			balance.CurrencyEQ(params.Currency),
+		).
+		Order(ent.Asc(balance.FieldUserID)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed locking %s balances: %w", params.Currency, err)
+	}
+
+	fromAccount, err := r.accountRepo.GetOrCreateUserAccountWithTx(ctx, tx, params.FromUserID, params.Currency)
+	if err != nil {
+		return nil, err
+	}
+	toAccount, err := r.accountRepo.GetOrCreateUserAccountWithTx(ctx, tx, params.ToUserID, params.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// Postings are applied in the same user ID order the balances were locked in
+	postings := []PostingParams{
+		{Account: fromAccount, Amount: params.Amount.Neg()},
+		{Account: toAccount, Amount: params.Amount},
+	}
+	if params.ToUserID < params.FromUserID {
+		postings[0], postings[1] = postings[1], postings[0]
+	}
+
+	entry, err := r.ledgerRepo.PostWithTx(ctx, tx, PostEntryParams{
+		Description: fmt.Sprintf("transfer %s", params.TransferID),
+		Postings:    postings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outID, inID := LegIDs(params.TransferID)
+	now := time.Now()
+
+	out, err := tx.Transaction.
+		Create().
+		SetID(outID).
+		SetUserID(params.FromUserID).
+		SetAmount(params.Amount).
+		SetCurrency(params.Currency).
+		SetType(transaction.TypeTransferOut).
+		SetTransferID(params.TransferID).
+		SetJournalEntryID(entry.ID).
+		SetBalanceAfter(entry.Balances[fromAccount.ID].Amount).
+		SetBalanceSequence(entry.Balances[fromAccount.ID].Sequence).
+		SetCreatedAt(now).
+		SetPostedAt(now).
+		SetEffectiveAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating %s transaction: %w", transaction.TypeTransferOut, err)
+	}
+	if !params.Sweep {
+		if _, err := r.chargeFeeWithTx(ctx, tx, out); err != nil {
+			return nil, err
+		}
+	}
+
+	in, err := tx.Transaction.
+		Create().
+		SetID(inID).
+		SetUserID(params.ToUserID).
+		SetAmount(params.Amount).
+		SetCurrency(params.Currency).
+		SetType(transaction.TypeTransferIn).
+		SetTransferID(params.TransferID).
+		SetJournalEntryID(entry.ID).
+		SetBalanceAfter(entry.Balances[toAccount.ID].Amount).
+		SetBalanceSequence(entry.Balances[toAccount.ID].Sequence).
+		SetCreatedAt(now).
+		SetPostedAt(now).
+		SetEffectiveAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating %s transaction: %w", transaction.TypeTransferIn, err)
+	}
+
+	return &TransferLegs{Out: out, In: in}, nil
+}
+
+// CreateExchangeParams represents the parameters for the CreateExchange method
+type CreateExchangeParams struct {
+	ExchangeID   string
+	UserID       int
+	FromCurrency string
+	ToCurrency   string
+	// Amount is the amount of FromCurrency being sold
+	Amount decimal.Decimal
+	At     time.Time
+}
+
+// ExchangeLegs holds both transactions recorded for a currency exchange
+// together with the rate applied to it
+type ExchangeLegs struct {
+	Out  *ent.Transaction
+	In   *ent.Transaction
+	Rate *ent.ExchangeRate
+}
+
+// CreateExchange converts money between two currency balances of a user in a single SQL transaction
+func (r *TransactionRepository) CreateExchange(ctx context.Context, params CreateExchangeParams) (*ExchangeLegs, error) {
+	// Start a transaction
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed starting transaction: %w", err)
+	}
+
+	// Execute the actual logic within the transaction
+	legs, err := r.createExchangeWithTx(ctx, tx, params)
+	if err != nil {
+		// Rollback the transaction in case of error
+		if rerr := tx.Rollback(); rerr != nil {
+			return nil, fmt.Errorf("rolling back transaction: %w (%v)", rerr, err)
+		}
+		return nil, err
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return legs, nil
+}
+
+// createExchangeWithTx records both legs of a currency exchange within an existing DB transaction.
+// The sold amount goes to the currency exchange system account of the source
+// currency, and the bought amount comes from the one of the target currency,
+// so postings balance in each currency.
+func (r *TransactionRepository) createExchangeWithTx(ctx context.Context, tx *ent.Tx,
+	params CreateExchangeParams) (*ExchangeLegs, error) {
+
+	if params.FromCurrency == params.ToCurrency {
+		return nil, errors.WithDetails(errors.ErrInvalidInput, "cannot exchange %s to itself", params.FromCurrency)
+	}
+	if err := checkUserStatusWithTx(ctx, tx, params.UserID, transaction.TypeExchangeOut); err != nil {
+		return nil, err
+	}
+	if err := checkPeriodOpenWithTx(ctx, tx, time.Now()); err != nil {
+		return nil, err
+	}
+
+	rate, err := r.exchangeRateRepo.GetValidAtWithTx(ctx, tx, params.FromCurrency, params.ToCurrency, params.At)
+	if err != nil {
+		return nil, err
+	}
+	toCurrency, err := r.currencyRepo.GetByCodeWithTx(ctx, tx, params.ToCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	// The bought amount is rounded down to the minor unit of the target currency
+	appliedRate := AppliedRate(rate)
+	converted := params.Amount.Mul(appliedRate).RoundDown(int32(toCurrency.Exponent))
+	if !converted.IsPositive() {
+		return nil, errors.WithDetails(errors.ErrInvalidInput, "amount %s %s is too small to exchange",
+			params.Amount, params.FromCurrency)
+	}
+
+	// Lock both balances of the user in currency order, so concurrent exchanges
+	// in opposite directions cannot deadlock
+	_, err = tx.Balance.
+		Query().
+		Where(
+			balance.UserID(params.UserID),
+			balance.CurrencyIn(params.FromCurrency, params.ToCurrency),
+		).
+		Order(ent.Asc(balance.FieldCurrency)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed locking balances: %w", err)
+	}
+
+	fromAccount, err := r.accountRepo.GetOrCreateUserAccountWithTx(ctx, tx, params.UserID, params.FromCurrency)
+	if err != nil {
+		return nil, err
+	}
+	fromExchange, err := r.accountRepo.GetOrCreateSystemAccountWithTx(ctx, tx, SystemAccountCurrencyExchange, params.FromCurrency)
+	if err != nil {
+		return nil, err
+	}
+	toAccount, err := r.accountRepo.GetOrCreateUserAccountWithTx(ctx, tx, params.UserID, params.ToCurrency)
+	if err != nil {
+		return nil, err
+	}
+	toExchange, err := r.accountRepo.GetOrCreateSystemAccountWithTx(ctx, tx, SystemAccountCurrencyExchange, params.ToCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	// Postings are applied in the same currency order the balances were locked in
+	fromPostings := []PostingParams{
+		{Account: fromAccount, Amount: params.Amount.Neg()},
+		{Account: fromExchange, Amount: params.Amount},
+	}
+	toPostings := []PostingParams{
+		{Account: toAccount, Amount: converted},
+		{Account: toExchange, Amount: converted.Neg()},
+	}
+	postings := append(fromPostings, toPostings...)
+	if params.ToCurrency < params.FromCurrency {
+		postings = append(toPostings, fromPostings...)
+	}
+
+	entry, err := r.ledgerRepo.PostWithTx(ctx, tx, PostEntryParams{
+		Description: fmt.Sprintf("exchange %s", params.ExchangeID),
+		Postings:    postings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outID, inID := LegIDs(params.ExchangeID)
+	now := time.Now()
+
+	out, err := tx.Transaction.
+		Create().
+		SetID(outID).
+		SetUserID(params.UserID).
+		SetAmount(params.Amount).
+		SetCurrency(params.FromCurrency).
+		SetType(transaction.TypeExchangeOut).
+		SetExchangeID(params.ExchangeID).
+		SetExchangeRateID(rate.ID).
+		SetAppliedRate(appliedRate).
+		SetJournalEntryID(entry.ID).
+		SetBalanceAfter(entry.Balances[fromAccount.ID].Amount).
+		SetBalanceSequence(entry.Balances[fromAccount.ID].Sequence).
+		SetCreatedAt(now).
+		SetPostedAt(now).
+		SetEffectiveAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating %s transaction: %w", transaction.TypeExchangeOut, err)
+	}
+	if _, err := r.chargeFeeWithTx(ctx, tx, out); err != nil {
+		return nil, err
+	}
+
+	in, err := tx.Transaction.
+		Create().
+		SetID(inID).
+		SetUserID(params.UserID).
+		SetAmount(converted).
+		SetCurrency(params.ToCurrency).
+		SetType(transaction.TypeExchangeIn).
+		SetExchangeID(params.ExchangeID).
+		SetExchangeRateID(rate.ID).
+		SetAppliedRate(appliedRate).
+		SetJournalEntryID(entry.ID).
+		SetBalanceAfter(entry.Balances[toAccount.ID].Amount).
+		SetBalanceSequence(entry.Balances[toAccount.ID].Sequence).
+		SetCreatedAt(now).
+		SetPostedAt(now).
+		SetEffectiveAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating %s transaction: %w", transaction.TypeExchangeIn, err)
+	}
+
+	return &ExchangeLegs{Out: out, In: in, Rate: rate}, nil
+}
+
+// GetByExchangeID gets both legs of a currency exchange
+func (r *TransactionRepository) GetByExchangeID(ctx context.Context, exchangeID string) ([]*ent.Transaction, error) {
+	txs, err := r.client.Transaction.
+		Query().
+		Where(transaction.ExchangeID(exchangeID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying transactions by exchange_id: %w", err)
+	}
+	return txs, nil
+}
+
+// GetByTransferID gets both legs of a transfer
+func (r *TransactionRepository) GetByTransferID(ctx context.Context, transferID string) ([]*ent.Transaction, error) {
+	txs, err := r.client.Transaction.
+		Query().
+		Where(transaction.TransferID(transferID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying transactions by transfer_id: %w", err)
+	}
+	return txs, nil
 }
 
 // GetByID gets a transaction by its ID
@@ -111,6 +1190,79 @@ func (r *TransactionRepository) GetAllByUserID(ctx context.Context, userID int)
 	return txs, nil
 }
 
+// ImportTransactionID returns the ID of a transaction imported from a source
+// system, derived from its reference there
+func ImportTransactionID(source string, externalRef string) string {
+	return fmt.Sprintf("import:%s:%s", source, externalRef)
+}
+
+// GetByExternalRefs returns the transactions of a source system with any of the given references
+func (r *TransactionRepository) GetByExternalRefs(ctx context.Context, source string,
+	externalRefs []string) ([]*ent.Transaction, error) {
+
+	txs, err := r.client.Transaction.
+		Query().
+		Where(
+			transaction.SourceEQ(source),
+			transaction.ExternalRefIn(externalRefs...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying transactions by external_ref: %w", err)
+	}
+	return txs, nil
+}
+
+// TransactionFilter represents the criteria of the Find method; empty criteria match every transaction
+type TransactionFilter struct {
+	UserID      *int
+	Type        *transaction.Type
+	Currency    *string
+	Category    *string
+	Source      *string
+	ExternalRef *string
+	// Metadata matches transactions whose metadata has all the given keys set to the given string values
+	Metadata map[string]string
+	Limit    int
+}
+
+// Find returns up to filter.Limit transactions matching the filter, newest first
+func (r *TransactionRepository) Find(ctx context.Context, filter TransactionFilter) ([]*ent.Transaction, error) {
+	query := r.client.Transaction.Query()
+	if filter.UserID != nil {
+		query.Where(transaction.UserID(*filter.UserID))
+	}
+	if filter.Type != nil {
+		query.Where(transaction.TypeEQ(*filter.Type))
+	}
+	if filter.Currency != nil {
+		query.Where(transaction.CurrencyEQ(*filter.Currency))
+	}
+	if filter.Category != nil {
+		query.Where(transaction.CategoryEQ(*filter.Category))
+	}
+	if filter.Source != nil {
+		query.Where(transaction.SourceEQ(*filter.Source))
+	}
+	if filter.ExternalRef != nil {
+		query.Where(transaction.ExternalRefEQ(*filter.ExternalRef))
+	}
+	for key, value := range filter.Metadata {
+		query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ(transaction.FieldMetadata, value, sqljson.Path(key)))
+		})
+	}
+
+	txs, err := query.
+		Order(ent.Desc(transaction.FieldCreatedAt), ent.Desc(transaction.FieldID)).
+		Limit(filter.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying transactions: %w", err)
+	}
+	return txs, nil
+}
+
 // GetAllByUserIDUsingEdge gets all transactions of a user using the edge in the graph
 func (r *TransactionRepository) GetAllByUserIDUsingEdge(ctx context.Context, userID int) ([]*ent.Transaction, error) {
 	txs, err := r.client.User.
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"accounting/ent/transaction"
+	"accounting/errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestBatchInEffectiveOrderRejectsEarlierItem(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	if err := NewCurrencyRepository(client).Seed(ctx, ISO4217Currencies); err != nil {
+		t.Fatal(err)
+	}
+	user, err := NewUserRepository(client).Create(ctx, "Importer", uuid.NewString()+"@example.com", 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deposit := func(effectiveAt time.Time) CreateTransactionParams {
+		return CreateTransactionParams{
+			ID:               uuid.NewString(),
+			UserID:           user.ID,
+			Amount:           decimal.NewFromInt(10),
+			Currency:         "USD",
+			Type:             transaction.TypeDeposit,
+			Details:          TransactionDetails{EffectiveAt: &effectiveAt},
+			SkipFee:          true,
+			InEffectiveOrder: true,
+		}
+	}
+
+	now := time.Now()
+	results, err := newTestTransactionRepository(client).CreateBatch(ctx, []CreateTransactionParams{
+		deposit(now.Add(-2 * time.Hour)),
+		deposit(now.Add(-time.Hour)),
+		deposit(now.Add(-3 * time.Hour)),
+	}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, result := range results[:2] {
+		if result.Err != nil {
+			t.Fatalf("item %d: %v", i, result.Err)
+		}
+		if *result.Transaction.BalanceSequence != int64(i+1) {
+			t.Fatalf("item %d: got balance sequence %d, want %d", i, *result.Transaction.BalanceSequence, i+1)
+		}
+	}
+	if !errors.IsInvalidState(results[2].Err) {
+		t.Fatalf("item taking effect before the latest transaction: got error %v, want invalid state", results[2].Err)
+	}
+}
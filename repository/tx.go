@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"accounting/ent"
+)
+
+// withTx runs fn within an SQL transaction, committing on success and rolling back on error
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return withTxOptions(ctx, client, nil, fn)
+}
+
+// withSnapshot runs fn within a read-only SQL transaction whose queries all see
+// the same snapshot of the database
+func withSnapshot(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return withTxOptions(ctx, client, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}, fn)
+}
+
+// withTxOptions runs fn within an SQL transaction started with opts, committing
+// on success and rolling back on error
+func withTxOptions(ctx context.Context, client *ent.Client, opts *sql.TxOptions, fn func(tx *ent.Tx) error) error {
+	// Start a transaction
+	tx, err := client.BeginTx(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("failed starting transaction: %w", err)
+	}
+
+	// Execute the actual logic within the transaction
+	if err := fn(tx); err != nil {
+		// Rollback the transaction in case of error
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("rolling back transaction: %w (%v)", rerr, err)
+		}
+		return err
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// withSavepoint runs fn within a savepoint of tx. If fn fails, only its changes
+// are rolled back and tx stays usable for further statements.
+func withSavepoint(ctx context.Context, tx *ent.Tx, fn func() error) error {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT sp"); err != nil {
+		return fmt.Errorf("failed creating savepoint: %w", err)
+	}
+
+	if err := fn(); err != nil {
+		if _, rerr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT sp"); rerr != nil {
+			return fmt.Errorf("rolling back to savepoint: %w (%v)", rerr, err)
+		}
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT sp"); err != nil {
+		return fmt.Errorf("failed releasing savepoint: %w", err)
+	}
+
+	return nil
+}
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"accounting/ent"
+	"accounting/ent/schema"
 	"accounting/ent/user"
+	"accounting/errors"
 
 	"github.com/google/uuid"
 )
@@ -94,3 +96,91 @@ func (r *UserRepository) GetWithTransactions(ctx context.Context, id int) (*ent.
 
 	return userWithTx, nil
 }
+
+// SetTier sets the pricing tier of a user
+func (r *UserRepository) SetTier(ctx context.Context, id int, tier string) (*ent.User, error) {
+	u, err := r.client.User.
+		UpdateOneID(id).
+		SetTier(tier).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed updating user tier: %w", err)
+	}
+	return u, nil
+}
+
+// Delete soft-deletes a closed account: the user is hidden from queries, while
+// its transactions, balances and ledger accounts are kept
+func (r *UserRepository) Delete(ctx context.Context, id int) error {
+	return withTx(ctx, r.client, func(tx *ent.Tx) error {
+		u, err := lockUserWithTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if u.Status != user.StatusClosed {
+			return errors.WithDetails(errors.ErrInvalidState, "account of user %d is %s, only closed accounts can be deleted",
+				id, u.Status)
+		}
+
+		if err := tx.User.DeleteOne(u).Exec(ctx); err != nil {
+			return fmt.Errorf("failed deleting user: %w", err)
+		}
+		return nil
+	})
+}
+
+// ErasedName returns the pseudonym replacing the name of an erased user
+func ErasedName(id int) string {
+	return fmt.Sprintf("Erased user %d", id)
+}
+
+// ErasedEmail returns the pseudonym replacing the email of an erased user
+func ErasedEmail(id int) string {
+	return fmt.Sprintf("erased-%d@erased.invalid", id)
+}
+
+// Erase pseudonymizes the personal data of a closed account in place and
+// soft-deletes it if it is not deleted yet. The user row stays, so the
+// transactions, balances and ledger accounts referencing it remain intact and
+// reconcilable. Erasing an erased user again changes nothing.
+func (r *UserRepository) Erase(ctx context.Context, id int) (*ent.User, error) {
+	ctx = schema.SkipSoftDelete(ctx)
+
+	var u *ent.User
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		var err error
+		u, err = lockUserWithTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if u.ErasedAt != nil {
+			return nil
+		}
+		if u.Status != user.StatusClosed {
+			return errors.WithDetails(errors.ErrInvalidState, "account of user %d is %s, only closed accounts can be erased",
+				id, u.Status)
+		}
+
+		now := time.Now()
+		update := tx.User.
+			UpdateOne(u).
+			SetName(ErasedName(id)).
+			SetEmail(ErasedEmail(id)).
+			ClearAge().
+			SetErasedAt(now)
+		if u.DeletedAt == nil {
+			update.SetDeletedAt(now)
+		}
+
+		u, err = update.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed erasing user: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
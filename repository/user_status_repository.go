@@ -0,0 +1,280 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/balance"
+	"accounting/ent/schedule"
+	"accounting/ent/transaction"
+	"accounting/ent/user"
+	"accounting/errors"
+)
+
+// UserStatusRepository represents a repository for freezing, unfreezing and closing user accounts
+type UserStatusRepository struct {
+	client       *ent.Client
+	txRepo       *TransactionRepository
+	interestRepo *InterestRepository
+}
+
+// NewUserStatusRepository creates a new user status repository
+func NewUserStatusRepository(client *ent.Client, txRepo *TransactionRepository) *UserStatusRepository {
+	return &UserStatusRepository{
+		client:       client,
+		txRepo:       txRepo,
+		interestRepo: NewInterestRepository(client, txRepo.accountRepo, txRepo.ledgerRepo, txRepo.currencyRepo),
+	}
+}
+
+// SweepTransferID returns the ID of the transfer sweeping a balance of a user
+// whose account is closed
+func SweepTransferID(userID int, currency string) string {
+	return fmt.Sprintf("close:%d:%s", userID, currency)
+}
+
+// Freeze restricts an active account to incoming money
+func (r *UserStatusRepository) Freeze(ctx context.Context, id int, reason string) (*ent.User, error) {
+	return r.setStatus(ctx, id, user.StatusActive, user.StatusFrozen, reason)
+}
+
+// Unfreeze lifts the restrictions of a frozen account
+func (r *UserStatusRepository) Unfreeze(ctx context.Context, id int, reason string) (*ent.User, error) {
+	return r.setStatus(ctx, id, user.StatusFrozen, user.StatusActive, reason)
+}
+
+// setStatus moves a user from one status to another. Taking the row lock of
+// the update waits for the operations that checked the old status to finish.
+func (r *UserStatusRepository) setStatus(ctx context.Context, id int, from user.Status, to user.Status,
+	reason string) (*ent.User, error) {
+
+	var u *ent.User
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		var err error
+		u, err = lockUserWithTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if u.Status != from {
+			return errors.WithDetails(errors.ErrInvalidState, "account of user %d is %s", id, u.Status)
+		}
+
+		u, err = tx.User.
+			UpdateOne(u).
+			SetStatus(to).
+			SetStatusReason(reason).
+			SetStatusChangedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed updating user status: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// CloseUserParams represents the parameters for the Close method
+type CloseUserParams struct {
+	UserID int
+	Reason string
+	// SweepToUserID is the user receiving the positive balances of the closed
+	// account; without it every balance has to be zero
+	SweepToUserID *int
+}
+
+// ClosedUser holds a closed user together with the transfers sweeping its balances
+type ClosedUser struct {
+	User   *ent.User
+	Sweeps []*TransferLegs
+}
+
+// Close closes an active or frozen account. Its balances must not have active
+// holds and must be zero, unless a sweep user is given: positive balances are
+// then transferred to that user. Interest accrued but not paid out yet is paid
+// out first, effective on the last accrued day, as the interest plans are
+// removed from the balances. Active schedules of the user are cancelled.
+func (r *UserStatusRepository) Close(ctx context.Context, params CloseUserParams) (*ClosedUser, error) {
+	result := &ClosedUser{}
+	err := withTx(ctx, r.client, func(tx *ent.Tx) error {
+		if params.SweepToUserID != nil && *params.SweepToUserID == params.UserID {
+			return errors.WithDetails(errors.ErrInvalidInput, "cannot sweep balances to the closed account")
+		}
+
+		// Users are locked in ascending ID order, as transfers between them do
+		if params.SweepToUserID != nil && *params.SweepToUserID < params.UserID {
+			if err := checkUserStatusWithTx(ctx, tx, *params.SweepToUserID, transaction.TypeTransferIn); err != nil {
+				return err
+			}
+		}
+		u, err := lockUserWithTx(ctx, tx, params.UserID)
+		if err != nil {
+			return err
+		}
+		if u.Status == user.StatusClosed {
+			return errors.WithDetails(errors.ErrInvalidState, "account of user %d is closed", u.ID)
+		}
+		if params.SweepToUserID != nil && *params.SweepToUserID > params.UserID {
+			if err := checkUserStatusWithTx(ctx, tx, *params.SweepToUserID, transaction.TypeTransferIn); err != nil {
+				return err
+			}
+		}
+
+		// The periods of the interest payouts are locked before the balances
+		unpaid, err := lastUnpaidAccrualsWithTx(ctx, tx, u.ID)
+		if err != nil {
+			return err
+		}
+		for _, periodEnd := range unpaid {
+			if err := checkPeriodOpenWithTx(ctx, tx, periodEnd); err != nil {
+				return err
+			}
+		}
+
+		balances, err := tx.Balance.
+			Query().
+			Where(balance.UserID(u.ID)).
+			Order(ent.Asc(balance.FieldCurrency)).
+			ForUpdate().
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed locking balances: %w", err)
+		}
+
+		for i, b := range balances {
+			periodEnd, ok := unpaid[b.ID]
+			if !ok {
+				continue
+			}
+			paid, err := r.interestRepo.capitalizeWithTx(ctx, tx, b, periodEnd)
+			if err != nil {
+				return err
+			}
+			if paid == nil {
+				continue
+			}
+			if balances[i], err = tx.Balance.Get(ctx, b.ID); err != nil {
+				return fmt.Errorf("failed querying %s balance: %w", b.Currency, err)
+			}
+		}
+
+		for _, b := range balances {
+			if !b.Available.Equal(b.Amount) {
+				return errors.WithDetails(errors.ErrInvalidState, "%s balance has active holds", b.Currency)
+			}
+			if b.Amount.IsZero() {
+				continue
+			}
+			if params.SweepToUserID == nil || b.Amount.IsNegative() {
+				return errors.WithDetails(errors.ErrInvalidState, "%s balance is %s; it must be zero or swept",
+					b.Currency, b.Amount)
+			}
+
+			legs, err := r.txRepo.createTransferWithTx(ctx, tx, CreateTransferParams{
+				TransferID: SweepTransferID(u.ID, b.Currency),
+				FromUserID: u.ID,
+				ToUserID:   *params.SweepToUserID,
+				Currency:   b.Currency,
+				Amount:     b.Amount,
+				Sweep:      true,
+			})
+			if err != nil {
+				return err
+			}
+			result.Sweeps = append(result.Sweeps, legs)
+		}
+
+		err = tx.Schedule.
+			Update().
+			Where(
+				schedule.UserID(u.ID),
+				schedule.StatusEQ(schedule.StatusActive),
+			).
+			SetStatus(schedule.StatusCancelled).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed cancelling schedules: %w", err)
+		}
+
+		err = tx.Balance.
+			Update().
+			Where(balance.UserID(u.ID)).
+			ClearInterestPlanID().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed removing interest plans: %w", err)
+		}
+
+		result.User, err = tx.User.
+			UpdateOne(u).
+			SetStatus(user.StatusClosed).
+			SetStatusReason(params.Reason).
+			SetStatusChangedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed closing account: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// lockUserWithTx locks a user for a status change
+func lockUserWithTx(ctx context.Context, tx *ent.Tx, id int) (*ent.User, error) {
+	u, err := tx.User.
+		Query().
+		Where(user.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.WithDetails(errors.ErrNotFound, "user %d not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed locking user: %w", err)
+	}
+
+	return u, nil
+}
+
+// checkUserStatusWithTx checks that the status of a user allows a transaction
+// of a type: frozen accounts only receive money and closed accounts allow
+// nothing. The user row is locked for share until the DB transaction ends, so
+// the status cannot change while the transaction is being written. It must be
+// called before any balance of the user is locked, as status changes lock the
+// user first.
+func checkUserStatusWithTx(ctx context.Context, tx *ent.Tx, userID int, txType transaction.Type) error {
+	u, err := tx.User.
+		Query().
+		Where(user.ID(userID)).
+		ForShare().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return errors.WithDetails(errors.ErrNotFound, "user %d not found", userID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed querying user status: %w", err)
+	}
+
+	switch u.Status {
+	case user.StatusClosed:
+		return errors.WithDetails(errors.ErrInvalidState, "account of user %d is closed", userID)
+	case user.StatusFrozen:
+		if txType != transaction.TypeDeposit && txType != transaction.TypeTransferIn &&
+			txType != transaction.TypeInterest {
+			return errors.WithDetails(errors.ErrInvalidState, "account of user %d is frozen, only deposits are allowed",
+				userID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/transaction"
+	"accounting/ent/user"
+	"accounting/ent/velocitylimit"
+	"accounting/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// velocityLockNamespace is the first key of the advisory locks that serialize
+// the velocity checks of a user; the second key is the user ID
+const velocityLockNamespace = 1
+
+// VelocityLimitRepository represents a repository for working with velocity limits
+type VelocityLimitRepository struct {
+	client *ent.Client
+}
+
+// NewVelocityLimitRepository creates a new velocity limit repository
+func NewVelocityLimitRepository(client *ent.Client) *VelocityLimitRepository {
+	return &VelocityLimitRepository{
+		client: client,
+	}
+}
+
+// CreateVelocityLimitParams represents the parameters for the Create method
+type CreateVelocityLimitParams struct {
+	Name            string
+	TransactionType velocitylimit.TransactionType
+	Currency        string
+	Tier            *string
+	Window          time.Duration
+	MaxCount        *int
+	MaxAmount       *decimal.Decimal
+}
+
+// Create creates an enabled velocity limit
+func (r *VelocityLimitRepository) Create(ctx context.Context,
+	params CreateVelocityLimitParams) (*ent.VelocityLimit, error) {
+
+	limit, err := r.client.VelocityLimit.
+		Create().
+		SetName(params.Name).
+		SetTransactionType(params.TransactionType).
+		SetCurrency(params.Currency).
+		SetNillableTier(params.Tier).
+		SetWindowSeconds(int64(params.Window / time.Second)).
+		SetNillableMaxCount(params.MaxCount).
+		SetNillableMaxAmount(params.MaxAmount).
+		SetCreatedAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating velocity limit: %w", err)
+	}
+
+	return limit, nil
+}
+
+// GetAll returns all velocity limits
+func (r *VelocityLimitRepository) GetAll(ctx context.Context) ([]*ent.VelocityLimit, error) {
+	limits, err := r.client.VelocityLimit.
+		Query().
+		Order(ent.Asc(velocitylimit.FieldTransactionType), ent.Asc(velocitylimit.FieldCurrency),
+			ent.Asc(velocitylimit.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying velocity limits: %w", err)
+	}
+
+	return limits, nil
+}
+
+// SetEnabled turns a velocity limit on or off
+func (r *VelocityLimitRepository) SetEnabled(ctx context.Context, id int, enabled bool) (*ent.VelocityLimit, error) {
+	limit, err := r.client.VelocityLimit.
+		UpdateOneID(id).
+		SetEnabled(enabled).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.WithDetails(errors.ErrNotFound, "unknown velocity limit %d", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed updating velocity limit %d: %w", id, err)
+	}
+
+	return limit, nil
+}
+
+// FindApplicable returns the enabled limits applying to a deposit or withdrawal
+// of a user in a currency; every one of them has to be satisfied
+func (r *VelocityLimitRepository) FindApplicable(ctx context.Context, userID int, txType transaction.Type,
+	currency string) ([]*ent.VelocityLimit, error) {
+
+	return findApplicableLimits(ctx, r.client.VelocityLimit, r.client.User, userID, txType, currency)
+}
+
+// findApplicableLimitsWithTx returns the limits applying to a transaction within
+// an existing DB transaction
+func findApplicableLimitsWithTx(ctx context.Context, tx *ent.Tx, userID int, txType transaction.Type,
+	currency string) ([]*ent.VelocityLimit, error) {
+
+	return findApplicableLimits(ctx, tx.VelocityLimit, tx.User, userID, txType, currency)
+}
+
+// findApplicableLimits returns the enabled limits of a transaction type and
+// currency that apply to the tier of a user
+func findApplicableLimits(ctx context.Context, velocityLimits *ent.VelocityLimitClient, users *ent.UserClient,
+	userID int, txType transaction.Type, currency string) ([]*ent.VelocityLimit, error) {
+
+	limits, err := velocityLimits.
+		Query().
+		Where(
+			velocitylimit.TransactionTypeEQ(velocitylimit.TransactionType(txType)),
+			velocitylimit.CurrencyEQ(currency),
+			velocitylimit.Enabled(true),
+		).
+		Order(ent.Asc(velocitylimit.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying velocity limits: %w", err)
+	}
+	if len(limits) == 0 {
+		return nil, nil
+	}
+
+	u, err := users.
+		Query().
+		Where(user.ID(userID)).
+		Select(user.FieldTier).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.WithDetails(errors.ErrNotFound, "user %d not found", userID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed querying user tier: %w", err)
+	}
+
+	applicable := limits[:0]
+	for _, limit := range limits {
+		if limit.Tier == nil || *limit.Tier == u.Tier {
+			applicable = append(applicable, limit)
+		}
+	}
+
+	return applicable, nil
+}
+
+// enforceVelocityLimitsWithTx checks that a transaction keeps the user within
+// the limits in its params. The velocity checks of a user are serialized with an
+// advisory lock held until the DB transaction ends, so parallel transactions
+// cannot both pass a check against the same window. Failed transactions and
+// reversals do not count towards a limit; pending ones do.
+func enforceVelocityLimitsWithTx(ctx context.Context, tx *ent.Tx, params CreateTransactionParams) error {
+	if len(params.Limits) == 0 {
+		return nil
+	}
+
+	if err := lockVelocityChecksWithTx(ctx, tx, params.UserID); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, limit := range params.Limits {
+		window := time.Duration(limit.WindowSeconds) * time.Second
+
+		var rows []struct {
+			Sum   decimal.NullDecimal `json:"sum"`
+			Count int                 `json:"count"`
+		}
+		err := tx.Transaction.
+			Query().
+			Where(
+				transaction.UserID(params.UserID),
+				transaction.CurrencyEQ(params.Currency),
+				transaction.TypeEQ(params.Type),
+				transaction.StatusNEQ(transaction.StatusFailed),
+				transaction.ReversalOfIDIsNil(),
+				transaction.CreatedAtGT(now.Add(-window)),
+			).
+			Aggregate(
+				ent.As(ent.Sum(transaction.FieldAmount), "sum"),
+				ent.As(ent.Count(), "count"),
+			).
+			Scan(ctx, &rows)
+		if err != nil {
+			return fmt.Errorf("failed summing transactions for velocity limit %d: %w", limit.ID, err)
+		}
+
+		count := 1
+		amount := params.Amount
+		if len(rows) > 0 {
+			count += rows[0].Count
+			amount = amount.Add(rows[0].Sum.Decimal)
+		}
+
+		if (limit.MaxCount != nil && count > *limit.MaxCount) ||
+			(limit.MaxAmount != nil && amount.GreaterThan(*limit.MaxAmount)) {
+
+			return &errors.VelocityLimitError{
+				Limit:     limit.Name,
+				Currency:  limit.Currency,
+				Window:    window,
+				Count:     count,
+				MaxCount:  limit.MaxCount,
+				Amount:    amount,
+				MaxAmount: limit.MaxAmount,
+			}
+		}
+	}
+
+	return nil
+}
+
+// lockVelocityChecksWithTx takes the advisory lock serializing the velocity
+// checks of a user. The lock is held until the DB transaction ends and can be
+// taken again by the same DB transaction.
+func lockVelocityChecksWithTx(ctx context.Context, tx *ent.Tx, userID int) error {
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1, $2)", velocityLockNamespace, userID); err != nil {
+		return fmt.Errorf("failed locking velocity checks: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"accounting/ent"
+	"accounting/errors"
+	"accounting/repository"
+)
+
+// AccountingPeriodService represents a service for opening and closing accounting periods
+type AccountingPeriodService struct {
+	periodRepo *repository.AccountingPeriodRepository
+}
+
+// NewAccountingPeriodService creates a new accounting period service
+func NewAccountingPeriodService(client *ent.Client) *AccountingPeriodService {
+	return &AccountingPeriodService{
+		periodRepo: repository.NewAccountingPeriodRepository(client),
+	}
+}
+
+// CreatePeriod validates and creates an open accounting period
+func (s *AccountingPeriodService) CreatePeriod(ctx context.Context, name string, startsAt time.Time,
+	endsAt time.Time) (*ent.AccountingPeriod, error) {
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("accounting period service - create period: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "name is required"))
+	}
+	if !endsAt.After(startsAt) {
+		return nil, fmt.Errorf("accounting period service - create period: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "period must end after it starts"))
+	}
+
+	period, err := s.periodRepo.Create(ctx, name, startsAt.UTC(), endsAt.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("accounting period service - create period: %w", err)
+	}
+	return period, nil
+}
+
+// GetPeriods gets all accounting periods in chronological order
+func (s *AccountingPeriodService) GetPeriods(ctx context.Context) ([]*ent.AccountingPeriod, error) {
+	periods, err := s.periodRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("accounting period service - get periods: %w", err)
+	}
+	return periods, nil
+}
+
+// GetPeriod gets an accounting period together with its trial balance
+func (s *AccountingPeriodService) GetPeriod(ctx context.Context, id int) (*ent.AccountingPeriod, error) {
+	period, err := s.periodRepo.GetWithTrialBalance(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("accounting period service - get period: %w", err)
+	}
+	return period, nil
+}
+
+// StartClose locks an ended period for review, rejecting transactions effective inside it
+func (s *AccountingPeriodService) StartClose(ctx context.Context, id int) (*ent.AccountingPeriod, error) {
+	period, err := s.periodRepo.StartClose(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("accounting period service - start close: %w", err)
+	}
+	return period, nil
+}
+
+// Reopen opens a period under review again
+func (s *AccountingPeriodService) Reopen(ctx context.Context, id int) (*ent.AccountingPeriod, error) {
+	period, err := s.periodRepo.Reopen(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("accounting period service - reopen: %w", err)
+	}
+	return period, nil
+}
+
+// Close closes a period under review for good and captures its trial balance
+func (s *AccountingPeriodService) Close(ctx context.Context, id int) (*ent.AccountingPeriod, error) {
+	if _, err := s.periodRepo.Close(ctx, id); err != nil {
+		return nil, fmt.Errorf("accounting period service - close: %w", err)
+	}
+
+	period, err := s.periodRepo.GetWithTrialBalance(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("accounting period service - close: %w", err)
+	}
+	return period, nil
+}
@@ -3,21 +3,38 @@ package service
 import (
 	"context"
 	"accounting/ent"
+	"accounting/errors"
 	"accounting/repository"
 	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
 )
 
+// snapshotBatchSize is how many accounts are snapshotted per page
+const snapshotBatchSize = 500
+
 // BalanceService represents a service for working with balances
 type BalanceService struct {
-	client      *ent.Client
-	balanceRepo *repository.BalanceRepository
+	client       *ent.Client
+	balanceRepo  *repository.BalanceRepository
+	accountRepo  *repository.AccountRepository
+	ledgerRepo   *repository.LedgerRepository
+	snapshotRepo *repository.BalanceSnapshotRepository
+	currencyRepo *repository.CurrencyRepository
 }
 
 // NewBalanceService creates a new balance service
 func NewBalanceService(client *ent.Client) *BalanceService {
+	balanceRepo := repository.NewBalanceRepository(client)
+
 	return &BalanceService{
-		client:      client,
-		balanceRepo: repository.NewBalanceRepository(client),
+		client:       client,
+		balanceRepo:  balanceRepo,
+		accountRepo:  repository.NewAccountRepository(client),
+		ledgerRepo:   repository.NewLedgerRepository(client, balanceRepo),
+		snapshotRepo: repository.NewBalanceSnapshotRepository(client),
+		currencyRepo: repository.NewCurrencyRepository(client),
 	}
 }
 
@@ -38,3 +55,122 @@ func (s *BalanceService) GetUserBalance(ctx context.Context, userID int, currenc
 	}
 	return balance, nil
 }
+
+// SetOverdraftLimit sets how far below zero a user's balance in a currency may go
+func (s *BalanceService) SetOverdraftLimit(ctx context.Context, userID int, currency string,
+	limit decimal.Decimal) (*ent.Balance, error) {
+
+	if limit.IsNegative() {
+		return nil, fmt.Errorf("balance service - set overdraft limit: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "overdraft limit must not be negative"))
+	}
+	if err := validateAmount(ctx, s.currencyRepo, currency, limit); err != nil {
+		return nil, fmt.Errorf("balance service - set overdraft limit: %w", err)
+	}
+
+	b, err := s.balanceRepo.SetOverdraftLimit(ctx, userID, currency, limit)
+	if err != nil {
+		return nil, fmt.Errorf("balance service - set overdraft limit: %w", err)
+	}
+	return b, nil
+}
+
+// BalanceAsOf is the balance of a user in a currency at a past instant
+type BalanceAsOf struct {
+	Currency string
+	Amount   decimal.Decimal
+	AsOf     time.Time
+}
+
+// GetUserBalancesAsOf gets the balances of a user at an instant. Each balance is
+// the latest snapshot taken at or before the instant plus the postings taking
+// effect after the snapshot, so only a bounded part of the history is summed.
+func (s *BalanceService) GetUserBalancesAsOf(ctx context.Context, userID int, at time.Time) ([]BalanceAsOf, error) {
+	accounts, err := s.accountRepo.GetUserAccountsPostedBy(ctx, userID, at)
+	if err != nil {
+		return nil, fmt.Errorf("balance service - get user balances as of: %w", err)
+	}
+
+	balances := make([]BalanceAsOf, 0, len(accounts))
+	for _, a := range accounts {
+		amount, err := s.accountBalanceAt(ctx, a.ID, at)
+		if err != nil {
+			return nil, fmt.Errorf("balance service - get user balances as of: %w", err)
+		}
+		balances = append(balances, BalanceAsOf{Currency: a.Currency, Amount: amount, AsOf: at})
+	}
+
+	return balances, nil
+}
+
+// UserBalanceAt gets the balance of a user in a currency at an instant; a user
+// without an account in the currency has a zero balance
+func (s *BalanceService) UserBalanceAt(ctx context.Context, userID int, currency string,
+	at time.Time) (decimal.Decimal, error) {
+
+	a, err := s.accountRepo.GetByCode(ctx, repository.UserAccountCode(userID, currency))
+	if ent.IsNotFound(err) {
+		return decimal.Zero, nil
+	}
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("balance service - user balance at: %w", err)
+	}
+
+	amount, err := s.accountBalanceAt(ctx, a.ID, at)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("balance service - user balance at: %w", err)
+	}
+	return amount, nil
+}
+
+// TakeSnapshots captures the balance of every user account at the cutoff and
+// returns the number of snapshots taken. Accounts without postings since their
+// previous snapshot are skipped. Postings back-dated before the cutoff later
+// are added to the snapshot when they are written.
+func (s *BalanceService) TakeSnapshots(ctx context.Context, cutoff time.Time) (int, error) {
+	taken := 0
+	afterID := 0
+	for {
+		accounts, err := s.accountRepo.ListUserAccounts(ctx, afterID, snapshotBatchSize)
+		if err != nil {
+			return taken, fmt.Errorf("balance service - take snapshots: %w", err)
+		}
+
+		for _, a := range accounts {
+			captured, err := s.snapshotRepo.Capture(ctx, a, cutoff)
+			if err != nil {
+				return taken, fmt.Errorf("balance service - take snapshots: %w", err)
+			}
+			if captured {
+				taken++
+			}
+		}
+
+		if len(accounts) < snapshotBatchSize {
+			return taken, nil
+		}
+		afterID = accounts[len(accounts)-1].ID
+	}
+}
+
+// accountBalanceAt computes the balance of an account at an instant
+func (s *BalanceService) accountBalanceAt(ctx context.Context, accountID int, at time.Time) (decimal.Decimal, error) {
+	snapshot, err := s.snapshotRepo.GetLatestAt(ctx, accountID, at)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	amount := decimal.Zero
+	var from *time.Time
+	if snapshot != nil {
+		amount = snapshot.Amount
+		from = &snapshot.TakenAt
+	}
+
+	sum, err := s.ledgerRepo.SumPostings(ctx, accountID, from, at)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return amount.Add(sum.Amount), nil
+}
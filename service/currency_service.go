@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"accounting/ent"
+	"accounting/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+// CurrencyService represents a service for working with the currency registry
+type CurrencyService struct {
+	currencyRepo *repository.CurrencyRepository
+}
+
+// NewCurrencyService creates a new currency service
+func NewCurrencyService(client *ent.Client) *CurrencyService {
+	return &CurrencyService{
+		currencyRepo: repository.NewCurrencyRepository(client),
+	}
+}
+
+// SeedISO4217 registers all ISO 4217 currencies that are not registered yet
+func (s *CurrencyService) SeedISO4217(ctx context.Context) error {
+	if err := s.currencyRepo.Seed(ctx, repository.ISO4217Currencies); err != nil {
+		return fmt.Errorf("currency service - seed iso 4217: %w", err)
+	}
+	return nil
+}
+
+// GetCurrencies gets all registered currencies
+func (s *CurrencyService) GetCurrencies(ctx context.Context) ([]*ent.Currency, error) {
+	currencies, err := s.currencyRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("currency service - get currencies: %w", err)
+	}
+	return currencies, nil
+}
+
+// SetEnabled enables or disables new transactions in a currency
+func (s *CurrencyService) SetEnabled(ctx context.Context, code string, enabled bool) (*ent.Currency, error) {
+	c, err := s.currencyRepo.SetEnabled(ctx, code, enabled)
+	if err != nil {
+		return nil, fmt.Errorf("currency service - set enabled: %w", err)
+	}
+	return c, nil
+}
+
+// validateAmount checks an amount against the currency registry
+func validateAmount(ctx context.Context, currencyRepo *repository.CurrencyRepository, code string,
+	amount decimal.Decimal) error {
+
+	c, err := currencyRepo.GetByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+	return repository.ValidateAmount(c, amount)
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/errors"
+	"accounting/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRateService represents a service for working with exchange rates
+type ExchangeRateService struct {
+	rateRepo     *repository.ExchangeRateRepository
+	currencyRepo *repository.CurrencyRepository
+}
+
+// NewExchangeRateService creates a new exchange rate service
+func NewExchangeRateService(client *ent.Client) *ExchangeRateService {
+	return &ExchangeRateService{
+		rateRepo:     repository.NewExchangeRateRepository(client),
+		currencyRepo: repository.NewCurrencyRepository(client),
+	}
+}
+
+// CreateRate validates and stores a new exchange rate
+func (s *ExchangeRateService) CreateRate(ctx context.Context, params repository.CreateExchangeRateParams) (*ent.ExchangeRate, error) {
+	switch {
+	case params.BaseCurrency == params.QuoteCurrency:
+		return nil, errors.WithDetails(errors.ErrInvalidInput, "base and quote currencies must differ")
+	case !params.Rate.IsPositive():
+		return nil, errors.WithDetails(errors.ErrInvalidInput, "rate must be positive")
+	case params.Spread.IsNegative() || params.Spread.GreaterThanOrEqual(decimal.NewFromInt(1)):
+		return nil, errors.WithDetails(errors.ErrInvalidInput, "spread must be in [0, 1)")
+	case params.ValidTo != nil && !params.ValidTo.After(params.ValidFrom):
+		return nil, errors.WithDetails(errors.ErrInvalidInput, "valid_to must be after valid_from")
+	}
+
+	for _, code := range []string{params.BaseCurrency, params.QuoteCurrency} {
+		if _, err := s.currencyRepo.GetByCode(ctx, code); err != nil {
+			return nil, fmt.Errorf("exchange rate service - create rate: %w", err)
+		}
+	}
+
+	rate, err := s.rateRepo.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("exchange rate service - create rate: %w", err)
+	}
+	return rate, nil
+}
+
+// GetRate gets the exchange rate of a currency pair valid at the given time
+func (s *ExchangeRateService) GetRate(ctx context.Context, baseCurrency, quoteCurrency string,
+	at time.Time) (*ent.ExchangeRate, error) {
+
+	rate, err := s.rateRepo.GetValidAt(ctx, baseCurrency, quoteCurrency, at)
+	if err != nil {
+		return nil, fmt.Errorf("exchange rate service - get rate: %w", err)
+	}
+	return rate, nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"accounting/ent"
+	"accounting/ent/feerule"
+	"accounting/errors"
+	"accounting/repository"
+)
+
+// FeeService represents a service for working with fee rules
+type FeeService struct {
+	feeRuleRepo  *repository.FeeRuleRepository
+	currencyRepo *repository.CurrencyRepository
+}
+
+// NewFeeService creates a new fee service
+func NewFeeService(client *ent.Client) *FeeService {
+	return &FeeService{
+		feeRuleRepo:  repository.NewFeeRuleRepository(client),
+		currencyRepo: repository.NewCurrencyRepository(client),
+	}
+}
+
+// CreateRule validates and creates a fee rule
+func (s *FeeService) CreateRule(ctx context.Context, params repository.CreateFeeRuleParams) (*ent.FeeRule, error) {
+	if err := s.validateRule(ctx, params); err != nil {
+		return nil, fmt.Errorf("fee service - create rule: %w", err)
+	}
+
+	rule, err := s.feeRuleRepo.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("fee service - create rule: %w", err)
+	}
+	return rule, nil
+}
+
+// GetRules gets all fee rules
+func (s *FeeService) GetRules(ctx context.Context) ([]*ent.FeeRule, error) {
+	rules, err := s.feeRuleRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fee service - get rules: %w", err)
+	}
+	return rules, nil
+}
+
+// SetEnabled turns a fee rule on or off
+func (s *FeeService) SetEnabled(ctx context.Context, id int, enabled bool) (*ent.FeeRule, error) {
+	rule, err := s.feeRuleRepo.SetEnabled(ctx, id, enabled)
+	if err != nil {
+		return nil, fmt.Errorf("fee service - set enabled: %w", err)
+	}
+	return rule, nil
+}
+
+// validateRule checks that a rule has the parameters its kind needs and that
+// its amounts, percentages and caps are consistent
+func (s *FeeService) validateRule(ctx context.Context, params repository.CreateFeeRuleParams) error {
+	if params.Currency != nil {
+		if err := validateAmount(ctx, s.currencyRepo, *params.Currency, params.FixedAmount); err != nil {
+			return err
+		}
+	}
+	if params.FixedAmount.IsNegative() || params.Percentage.IsNegative() {
+		return errors.WithDetails(errors.ErrInvalidInput, "fixed amount and percentage must not be negative")
+	}
+	if params.MinFee != nil && params.MinFee.IsNegative() {
+		return errors.WithDetails(errors.ErrInvalidInput, "min fee must not be negative")
+	}
+	if params.MinFee != nil && params.MaxFee != nil && params.MinFee.GreaterThan(*params.MaxFee) {
+		return errors.WithDetails(errors.ErrInvalidInput, "min fee must not exceed max fee")
+	}
+
+	switch params.Kind {
+	case feerule.KindFixed:
+		if !params.FixedAmount.IsPositive() {
+			return errors.WithDetails(errors.ErrInvalidInput, "fixed rules need a positive fixed amount")
+		}
+	case feerule.KindPercentage:
+		if !params.Percentage.IsPositive() {
+			return errors.WithDetails(errors.ErrInvalidInput, "percentage rules need a positive percentage")
+		}
+	case feerule.KindTiered:
+		if len(params.Tiers) == 0 {
+			return errors.WithDetails(errors.ErrInvalidInput, "tiered rules need at least one tier")
+		}
+		for i, tier := range params.Tiers {
+			if tier.Fixed.IsNegative() || tier.Percentage.IsNegative() {
+				return errors.WithDetails(errors.ErrInvalidInput, "tier %d has a negative fee", i)
+			}
+			if tier.UpTo == nil && i != len(params.Tiers)-1 {
+				return errors.WithDetails(errors.ErrInvalidInput, "only the last tier may be unbounded")
+			}
+			if i > 0 && tier.UpTo != nil && !tier.UpTo.GreaterThan(*params.Tiers[i-1].UpTo) {
+				return errors.WithDetails(errors.ErrInvalidInput, "tiers must be ordered by up_to")
+			}
+		}
+	default:
+		return errors.WithDetails(errors.ErrInvalidInput, "unknown fee rule kind %q", params.Kind)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/errors"
+	"accounting/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultHoldTTL is the lifetime of a hold when no TTL is given
+const DefaultHoldTTL = 7 * 24 * time.Hour
+
+// expireHoldsBatchSize is the maximum number of holds expired in one DB transaction
+const expireHoldsBatchSize = 100
+
+// HoldService represents a service for working with authorization holds
+type HoldService struct {
+	holdRepo     *repository.HoldRepository
+	currencyRepo *repository.CurrencyRepository
+}
+
+// NewHoldService creates a new hold service
+func NewHoldService(client *ent.Client) *HoldService {
+	balanceRepo := repository.NewBalanceRepository(client)
+	accountRepo := repository.NewAccountRepository(client)
+	ledgerRepo := repository.NewLedgerRepository(client, balanceRepo)
+	currencyRepo := repository.NewCurrencyRepository(client)
+	txRepo := repository.NewTransactionRepository(client, accountRepo, ledgerRepo,
+		repository.NewExchangeRateRepository(client), currencyRepo, repository.NewFeeRuleRepository(client))
+
+	return &HoldService{
+		holdRepo:     repository.NewHoldRepository(client, balanceRepo, txRepo),
+		currencyRepo: currencyRepo,
+	}
+}
+
+// PlaceHold reserves funds of a user's balance for the given TTL
+func (s *HoldService) PlaceHold(ctx context.Context, id string, userID int, currency string, amount decimal.Decimal,
+	ttl time.Duration) (*ent.Hold, error) {
+
+	if err := validateAmount(ctx, s.currencyRepo, currency, amount); err != nil {
+		return nil, fmt.Errorf("hold service - place hold: %w", err)
+	}
+	if ttl <= 0 {
+		ttl = DefaultHoldTTL
+	}
+
+	h, err := s.holdRepo.Place(ctx, repository.PlaceHoldParams{
+		ID:        id,
+		UserID:    userID,
+		Currency:  currency,
+		Amount:    amount,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("hold service - place hold: %w", err)
+	}
+	return h, nil
+}
+
+// CaptureHold turns a hold into a withdrawal. A nil amount captures the full held amount.
+func (s *HoldService) CaptureHold(ctx context.Context, id string, amount *decimal.Decimal) (*ent.Hold, error) {
+	h, err := s.holdRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("hold service - capture hold: %w", err)
+	}
+
+	captured := h.Amount
+	if amount != nil {
+		captured = *amount
+	}
+	if !captured.IsPositive() {
+		return nil, fmt.Errorf("hold service - capture hold: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "capture amount must be positive"))
+	}
+	if err := validateAmount(ctx, s.currencyRepo, h.Currency, captured); err != nil {
+		return nil, fmt.Errorf("hold service - capture hold: %w", err)
+	}
+
+	h, err = s.holdRepo.Capture(ctx, id, captured)
+	if err != nil {
+		return nil, fmt.Errorf("hold service - capture hold: %w", err)
+	}
+	return h, nil
+}
+
+// VoidHold releases a hold without moving any money
+func (s *HoldService) VoidHold(ctx context.Context, id string) (*ent.Hold, error) {
+	h, err := s.holdRepo.Void(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("hold service - void hold: %w", err)
+	}
+	return h, nil
+}
+
+// GetHold gets a hold by its ID
+func (s *HoldService) GetHold(ctx context.Context, id string) (*ent.Hold, error) {
+	h, err := s.holdRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("hold service - get hold: %w", err)
+	}
+	return h, nil
+}
+
+// ExpireHolds releases all active holds whose TTL has passed and returns their number
+func (s *HoldService) ExpireHolds(ctx context.Context, now time.Time) (int, error) {
+	total := 0
+	for {
+		expired, err := s.holdRepo.ExpireDue(ctx, now, expireHoldsBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("hold service - expire holds: %w", err)
+		}
+		total += expired
+		if expired < expireHoldsBatchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/errors"
+	"accounting/repository"
+)
+
+// IdempotencyService represents a service for replaying responses of requests
+// retried with the same client-supplied idempotency key
+type IdempotencyService struct {
+	idempotencyRepo *repository.IdempotencyRepository
+}
+
+// NewIdempotencyService creates a new idempotency service
+func NewIdempotencyService(client *ent.Client) *IdempotencyService {
+	return &IdempotencyService{
+		idempotencyRepo: repository.NewIdempotencyRepository(client),
+	}
+}
+
+// StoredResponse represents the response stored for an idempotency key
+type StoredResponse struct {
+	Status int
+	Body   []byte
+}
+
+// ScopedIdempotencyKey returns the stored key of a request: the client-supplied
+// key prefixed with the method and route, so a key is only replayed on the
+// endpoint it was first used on
+func ScopedIdempotencyKey(method, route, key string) string {
+	return method + " " + route + " " + key
+}
+
+// Fingerprint returns the hash identifying a request made with an idempotency key
+func Fingerprint(method, path string, normalizedBody []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write(normalizedBody)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Begin claims a key for a request. It returns the stored response when the
+// same request was already completed, nil when the caller should execute the
+// request, and ErrDuplicateResource when the key was used with a different
+// request or the first request is still in progress. A request left in
+// progress past its lease, e.g. by a crash, is taken over by the caller.
+func (s *IdempotencyService) Begin(ctx context.Context, key string, requestHash string) (*StoredResponse, error) {
+	k, created, err := s.idempotencyRepo.Acquire(ctx, key, requestHash)
+	if err != nil {
+		return nil, fmt.Errorf("idempotency service - begin: %w", err)
+	}
+	if created {
+		return nil, nil
+	}
+
+	if k.RequestHash != requestHash {
+		return nil, errors.WithDetails(errors.ErrDuplicateResource,
+			"idempotency key %q was already used with a different request", key)
+	}
+	if k.ResponseStatus == nil {
+		if k.LockedUntil == nil || k.LockedUntil.Before(time.Now()) {
+			taken, err := s.idempotencyRepo.TakeOver(ctx, key, requestHash)
+			if err != nil {
+				return nil, fmt.Errorf("idempotency service - begin: %w", err)
+			}
+			if taken {
+				return nil, nil
+			}
+		}
+		return nil, errors.WithDetails(errors.ErrDuplicateResource,
+			"request with idempotency key %q is still in progress", key)
+	}
+
+	return &StoredResponse{Status: *k.ResponseStatus, Body: k.ResponseBody}, nil
+}
+
+// Complete stores the response of a request so retries can replay it
+func (s *IdempotencyService) Complete(ctx context.Context, key string, response StoredResponse) error {
+	if err := s.idempotencyRepo.Complete(ctx, key, response.Status, response.Body); err != nil {
+		return fmt.Errorf("idempotency service - complete: %w", err)
+	}
+	return nil
+}
+
+// Abort releases a key after a failed request so the client can retry it
+func (s *IdempotencyService) Abort(ctx context.Context, key string) error {
+	if err := s.idempotencyRepo.Release(ctx, key); err != nil {
+		return fmt.Errorf("idempotency service - abort: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/interestplan"
+	"accounting/errors"
+	"accounting/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+// interestBatchSize is how many balances are accrued per page
+const interestBatchSize = 500
+
+// InterestService represents a service for accruing and paying out interest on balances
+type InterestService struct {
+	interestRepo   *repository.InterestRepository
+	balanceService *BalanceService
+}
+
+// NewInterestService creates a new interest service
+func NewInterestService(client *ent.Client) *InterestService {
+	balanceRepo := repository.NewBalanceRepository(client)
+
+	return &InterestService{
+		interestRepo: repository.NewInterestRepository(
+			client,
+			repository.NewAccountRepository(client),
+			repository.NewLedgerRepository(client, balanceRepo),
+			repository.NewCurrencyRepository(client),
+		),
+		balanceService: NewBalanceService(client),
+	}
+}
+
+// CreatePlan validates and creates an interest plan
+func (s *InterestService) CreatePlan(ctx context.Context, name string, annualPercentage decimal.Decimal,
+	dayCount interestplan.DayCount) (*ent.InterestPlan, error) {
+
+	if annualPercentage.IsNegative() {
+		return nil, fmt.Errorf("interest service - create plan: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "annual percentage must not be negative"))
+	}
+
+	plan, err := s.interestRepo.CreatePlan(ctx, name, annualPercentage, dayCount)
+	if err != nil {
+		return nil, fmt.Errorf("interest service - create plan: %w", err)
+	}
+	return plan, nil
+}
+
+// GetPlans gets all interest plans
+func (s *InterestService) GetPlans(ctx context.Context) ([]*ent.InterestPlan, error) {
+	plans, err := s.interestRepo.GetPlans(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("interest service - get plans: %w", err)
+	}
+	return plans, nil
+}
+
+// AssignPlan sets or clears the interest plan of a user's balance in a currency
+func (s *InterestService) AssignPlan(ctx context.Context, userID int, currency string,
+	planID *int) (*ent.Balance, error) {
+
+	b, err := s.interestRepo.AssignPlan(ctx, userID, currency, planID)
+	if err != nil {
+		return nil, fmt.Errorf("interest service - assign plan: %w", err)
+	}
+	return b, nil
+}
+
+// AccrualResult summarizes an accrual run for a day
+type AccrualResult struct {
+	Date        time.Time `json:"date"`
+	Accrued     int       `json:"accrued"`
+	Capitalized int       `json:"capitalized"`
+}
+
+// AccrueDay accrues a day of interest on every balance with a plan, based on
+// the balance at the end of the day. Balances that already accrued the day are
+// skipped, so a day can be re-run after a failure. On the last day of a month
+// the month's accruals are paid out as interest transactions.
+func (s *InterestService) AccrueDay(ctx context.Context, date time.Time) (*AccrualResult, error) {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	endOfDay := date.AddDate(0, 0, 1)
+	if endOfDay.After(time.Now()) {
+		return nil, fmt.Errorf("interest service - accrue day: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "interest can only be accrued for days that have ended"))
+	}
+	monthEnd := endOfDay.Day() == 1
+
+	result := &AccrualResult{Date: date}
+	afterID := 0
+	for {
+		balances, err := s.interestRepo.ListBalancesWithPlan(ctx, afterID, interestBatchSize)
+		if err != nil {
+			return result, fmt.Errorf("interest service - accrue day: %w", err)
+		}
+
+		for _, b := range balances {
+			accrued, err := s.accrueBalance(ctx, b, date, endOfDay)
+			if err != nil {
+				return result, fmt.Errorf("interest service - accrue day: %w", err)
+			}
+			if accrued {
+				result.Accrued++
+			}
+
+			if !monthEnd {
+				continue
+			}
+			tx, err := s.interestRepo.Capitalize(ctx, b.ID, date)
+			if err != nil {
+				return result, fmt.Errorf("interest service - accrue day: %w", err)
+			}
+			if tx != nil {
+				result.Capitalized++
+			}
+		}
+
+		if len(balances) < interestBatchSize {
+			return result, nil
+		}
+		afterID = balances[len(balances)-1].ID
+	}
+}
+
+// AccrueMissedDays accrues every day from the last day with accruals up to the
+// day before now, in order, so days missed while no accrual ran are caught up
+// together with their month-end payouts. The last accrued day is run again in
+// case a run stopped midway through it; without any accruals only the previous
+// day is accrued. It stops at the first failing day.
+func (s *InterestService) AccrueMissedDays(ctx context.Context, now time.Time) ([]*AccrualResult, error) {
+	now = now.UTC()
+	yesterday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+
+	date := yesterday
+	last, err := s.interestRepo.LastAccrualDate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("interest service - accrue missed days: %w", err)
+	}
+	if last != nil && last.Before(yesterday) {
+		date = time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	results := make([]*AccrualResult, 0, 1)
+	for ; !date.After(yesterday); date = date.AddDate(0, 0, 1) {
+		result, err := s.AccrueDay(ctx, date)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// accrueBalance stores the interest a balance earned on a day and reports
+// whether a new accrual was made
+func (s *InterestService) accrueBalance(ctx context.Context, b *ent.Balance, date time.Time,
+	endOfDay time.Time) (bool, error) {
+
+	exists, err := s.interestRepo.HasAccrual(ctx, b.ID, date)
+	if err != nil || exists {
+		return false, err
+	}
+
+	principal, err := s.balanceService.UserBalanceAt(ctx, b.UserID, b.Currency, endOfDay.Add(-time.Microsecond))
+	if err != nil {
+		return false, err
+	}
+
+	plan := b.Edges.InterestPlan
+	err = s.interestRepo.CreateAccrual(ctx, repository.CreateAccrualParams{
+		BalanceID:        b.ID,
+		Date:             date,
+		Principal:        principal,
+		AnnualPercentage: plan.AnnualPercentage,
+		DayCount:         plan.DayCount,
+		Amount:           repository.DailyInterest(principal, plan.AnnualPercentage, plan.DayCount, date),
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
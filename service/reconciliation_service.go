@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/errors"
+	"accounting/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+// reconcileBatchSize is the number of balances checked against one snapshot of the database
+const reconcileBatchSize = 500
+
+// ReconciliationService represents a service for detecting and repairing balance drift
+type ReconciliationService struct {
+	reconciliationRepo *repository.ReconciliationRepository
+}
+
+// NewReconciliationService creates a new reconciliation service
+func NewReconciliationService(client *ent.Client) *ReconciliationService {
+	accountRepo := repository.NewAccountRepository(client)
+
+	return &ReconciliationService{
+		reconciliationRepo: repository.NewReconciliationRepository(client, accountRepo),
+	}
+}
+
+// BalanceMismatch is a balance whose amount differs from the signed sum of its transactions
+type BalanceMismatch struct {
+	BalanceID int             `json:"balance_id"`
+	UserID    int             `json:"user_id"`
+	Currency  string          `json:"currency"`
+	Amount    decimal.Decimal `json:"amount"`
+	Expected  decimal.Decimal `json:"expected"`
+	Drift     decimal.Decimal `json:"drift"`
+	// Repaired is set when the balance was rebuilt from its postings
+	Repaired bool `json:"repaired"`
+	// Error explains why the mismatch was left for manual handling
+	Error string `json:"error,omitempty"`
+}
+
+// ReconciliationReport is the outcome of a reconciliation run
+type ReconciliationReport struct {
+	StartedAt  time.Time         `json:"started_at"`
+	FinishedAt time.Time         `json:"finished_at"`
+	Checked    int               `json:"checked"`
+	Mismatches []BalanceMismatch `json:"mismatches"`
+	Repaired   int               `json:"repaired"`
+}
+
+// Reconcile recomputes every balance from its transactions and reports the
+// balances that drifted. With repair set, every drifted balance is rebuilt
+// from its postings; a balance whose postings disagree with its transactions
+// is reported with the reason and left for manual handling.
+func (s *ReconciliationService) Reconcile(ctx context.Context, repair bool) (*ReconciliationReport, error) {
+	report := &ReconciliationReport{
+		StartedAt:  time.Now(),
+		Mismatches: []BalanceMismatch{},
+	}
+
+	afterID := 0
+	for {
+		checks, err := s.reconciliationRepo.CheckBalances(ctx, afterID, reconcileBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("reconciliation service - reconcile: %w", err)
+		}
+
+		for _, check := range checks {
+			report.Checked++
+			drift := check.Drift()
+			if drift.IsZero() {
+				continue
+			}
+
+			mismatch := BalanceMismatch{
+				BalanceID: check.Balance.ID,
+				UserID:    check.Balance.UserID,
+				Currency:  check.Balance.Currency,
+				Amount:    check.Balance.Amount,
+				Expected:  check.Expected,
+				Drift:     drift,
+			}
+			if repair {
+				repaired, err := s.reconciliationRepo.Repair(ctx, check.Balance.ID)
+				switch {
+				case errors.IsInvalidState(err):
+					mismatch.Error = err.Error()
+				case err != nil:
+					return nil, fmt.Errorf("reconciliation service - repair balance %d: %w", check.Balance.ID, err)
+				case repaired != nil:
+					mismatch.Repaired = true
+					report.Repaired++
+				}
+			}
+			report.Mismatches = append(report.Mismatches, mismatch)
+		}
+
+		if len(checks) < reconcileBatchSize {
+			break
+		}
+		afterID = checks[len(checks)-1].Balance.ID
+	}
+
+	report.FinishedAt = time.Now()
+	return report, nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"accounting/ent"
+	"accounting/errors"
+	"accounting/repository"
+
+	"github.com/shopspring/decimal"
+)
+
+// ReportService represents a service for producing financial reports
+type ReportService struct {
+	reportRepo *repository.ReportRepository
+}
+
+// NewReportService creates a new report service
+func NewReportService(client *ent.Client) *ReportService {
+	return &ReportService{
+		reportRepo: repository.NewReportRepository(client),
+	}
+}
+
+// TrialBalanceTotal holds the total debits and credits of a currency; they are
+// equal unless the ledger is broken
+type TrialBalanceTotal struct {
+	Currency string          `json:"currency"`
+	Debit    decimal.Decimal `json:"debit"`
+	Credit   decimal.Decimal `json:"credit"`
+	Balanced bool            `json:"balanced"`
+}
+
+// TrialBalanceReport is the trial balance at an instant
+type TrialBalanceReport struct {
+	AsOf   time.Time                    `json:"as_of"`
+	Rows   []repository.TrialBalanceRow `json:"rows"`
+	Totals []TrialBalanceTotal          `json:"totals"`
+}
+
+// WriteCSV writes the rows of the report as CSV; the totals follow from them
+func (r *TrialBalanceReport) WriteCSV(w io.Writer) error {
+	records := make([][]string, 0, len(r.Rows))
+	for _, row := range r.Rows {
+		records = append(records, []string{
+			row.Currency, row.AccountType, row.AccountName, strconv.Itoa(row.Accounts),
+			row.Debit.String(), row.Credit.String(),
+		})
+	}
+	return writeCSV(w, []string{"currency", "account_type", "account_name", "accounts", "debit", "credit"}, records)
+}
+
+// LiabilitiesReport is the money owed to users at an instant
+type LiabilitiesReport struct {
+	AsOf time.Time                   `json:"as_of"`
+	Rows []repository.LiabilitiesRow `json:"rows"`
+}
+
+// WriteCSV writes the rows of the report as CSV
+func (r *LiabilitiesReport) WriteCSV(w io.Writer) error {
+	records := make([][]string, 0, len(r.Rows))
+	for _, row := range r.Rows {
+		records = append(records, []string{
+			row.Currency, strconv.Itoa(row.Accounts), row.Liabilities.String(),
+			strconv.Itoa(row.OverdrawnAccounts), row.Overdrafts.String(), row.Net.String(),
+		})
+	}
+	return writeCSV(w, []string{"currency", "accounts", "liabilities", "overdrawn_accounts", "overdrafts", "net"},
+		records)
+}
+
+// FlowReport sums the deposits and withdrawals within a time range
+type FlowReport struct {
+	From  time.Time            `json:"from"`
+	Until time.Time            `json:"until"`
+	Rows  []repository.FlowRow `json:"rows"`
+}
+
+// WriteCSV writes the rows of the report as CSV
+func (r *FlowReport) WriteCSV(w io.Writer) error {
+	records := make([][]string, 0, len(r.Rows))
+	for _, row := range r.Rows {
+		records = append(records, []string{
+			row.Currency, strconv.Itoa(row.Deposits), row.DepositAmount.String(),
+			strconv.Itoa(row.Withdrawals), row.WithdrawalAmount.String(),
+			strconv.Itoa(row.Reversals), row.NetFlow.String(),
+		})
+	}
+	return writeCSV(w, []string{"currency", "deposits", "deposit_amount", "withdrawals", "withdrawal_amount",
+		"reversals", "net_flow"}, records)
+}
+
+// writeCSV writes a header and its records as CSV
+func writeCSV(w io.Writer, header []string, records [][]string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed writing CSV header: %w", err)
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return fmt.Errorf("failed writing CSV records: %w", err)
+	}
+	return nil
+}
+
+// TrialBalance reports the balance of every system account and of the user
+// wallets per currency at an instant, with the debit and credit totals
+func (s *ReportService) TrialBalance(ctx context.Context, asOf time.Time) (*TrialBalanceReport, error) {
+	rows, err := s.reportRepo.TrialBalance(ctx, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("report service - trial balance: %w", err)
+	}
+
+	report := &TrialBalanceReport{AsOf: asOf, Rows: rows, Totals: []TrialBalanceTotal{}}
+	for _, row := range rows {
+		if n := len(report.Totals); n == 0 || report.Totals[n-1].Currency != row.Currency {
+			report.Totals = append(report.Totals, TrialBalanceTotal{Currency: row.Currency})
+		}
+		total := &report.Totals[len(report.Totals)-1]
+		total.Debit = total.Debit.Add(row.Debit)
+		total.Credit = total.Credit.Add(row.Credit)
+	}
+	for i := range report.Totals {
+		report.Totals[i].Balanced = report.Totals[i].Debit.Equal(report.Totals[i].Credit)
+	}
+
+	return report, nil
+}
+
+// Liabilities reports the money owed to users per currency at an instant
+func (s *ReportService) Liabilities(ctx context.Context, asOf time.Time) (*LiabilitiesReport, error) {
+	rows, err := s.reportRepo.Liabilities(ctx, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("report service - liabilities: %w", err)
+	}
+	return &LiabilitiesReport{AsOf: asOf, Rows: rows}, nil
+}
+
+// Flows reports the deposits and withdrawals per currency that took effect
+// from from (inclusive) until until (exclusive)
+func (s *ReportService) Flows(ctx context.Context, from time.Time, until time.Time) (*FlowReport, error) {
+	if !until.After(from) {
+		return nil, fmt.Errorf("report service - flows: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "the range must end after it starts"))
+	}
+
+	rows, err := s.reportRepo.Flows(ctx, from, until)
+	if err != nil {
+		return nil, fmt.Errorf("report service - flows: %w", err)
+	}
+	return &FlowReport{From: from, Until: until, Rows: rows}, nil
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/schedule"
+	"accounting/ent/transaction"
+	"accounting/errors"
+	"accounting/repository"
+)
+
+const (
+	// scheduleLease is how long a claimed schedule is hidden from other workers
+	scheduleLease = 5 * time.Minute
+
+	// maxScheduleAttempts is the number of failed attempts after which an occurrence is skipped
+	maxScheduleAttempts = 5
+
+	// scheduleRetryDelay is the delay before the first retry; it doubles with every attempt
+	scheduleRetryDelay = time.Minute
+
+	// dueSchedulesBatchSize is the maximum number of schedules claimed at once
+	dueSchedulesBatchSize = 100
+)
+
+// ScheduleService represents a service for working with scheduled and recurring transactions
+type ScheduleService struct {
+	scheduleRepo *repository.ScheduleRepository
+	currencyRepo *repository.CurrencyRepository
+	txService    *TransactionService
+}
+
+// NewScheduleService creates a new schedule service
+func NewScheduleService(client *ent.Client) *ScheduleService {
+	return &ScheduleService{
+		scheduleRepo: repository.NewScheduleRepository(client),
+		currencyRepo: repository.NewCurrencyRepository(client),
+		txService:    NewTransactionService(client),
+	}
+}
+
+// CreateSchedule creates a schedule of deposits or withdrawals
+func (s *ScheduleService) CreateSchedule(ctx context.Context,
+	params repository.CreateScheduleParams) (*ent.Schedule, error) {
+
+	if err := validateAmount(ctx, s.currencyRepo, params.Currency, params.Amount); err != nil {
+		return nil, fmt.Errorf("schedule service - create schedule: %w", err)
+	}
+	if !params.Amount.IsPositive() {
+		return nil, fmt.Errorf("schedule service - create schedule: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "amount must be positive"))
+	}
+	if params.Interval == 0 {
+		params.Interval = 1
+	}
+	if params.Interval < 0 || (params.Count != nil && *params.Count <= 0) {
+		return nil, fmt.Errorf("schedule service - create schedule: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "interval and count must be positive"))
+	}
+	if params.EndAt != nil && params.EndAt.Before(params.StartAt) {
+		return nil, fmt.Errorf("schedule service - create schedule: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "end_at must not be before start_at"))
+	}
+
+	sch, err := s.scheduleRepo.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("schedule service - create schedule: %w", err)
+	}
+	return sch, nil
+}
+
+// GetSchedule gets a schedule with its runs by ID
+func (s *ScheduleService) GetSchedule(ctx context.Context, id int) (*ent.Schedule, error) {
+	sch, err := s.scheduleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("schedule service - get schedule: %w", err)
+	}
+	return sch, nil
+}
+
+// CancelSchedule stops an active schedule
+func (s *ScheduleService) CancelSchedule(ctx context.Context, id int) (*ent.Schedule, error) {
+	sch, err := s.scheduleRepo.Cancel(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("schedule service - cancel schedule: %w", err)
+	}
+	return sch, nil
+}
+
+// RunDue executes the next occurrence of every schedule due at now and returns
+// the number of attempts made. Every occurrence creates its transaction with a
+// deterministic ID, so it is executed at most once even when retried.
+func (s *ScheduleService) RunDue(ctx context.Context, now time.Time) (int, error) {
+	attempts := 0
+	for {
+		schedules, err := s.scheduleRepo.ClaimDue(ctx, now, scheduleLease, dueSchedulesBatchSize)
+		if err != nil {
+			return attempts, fmt.Errorf("schedule service - run due: %w", err)
+		}
+
+		for _, sch := range schedules {
+			if err := s.executeOccurrence(ctx, sch, now); err != nil {
+				return attempts, fmt.Errorf("schedule service - run due: %w", err)
+			}
+			attempts++
+		}
+
+		if len(schedules) < dueSchedulesBatchSize {
+			return attempts, nil
+		}
+	}
+}
+
+// executeOccurrence creates the transaction of the next occurrence of a claimed
+// schedule and records the outcome. A failed attempt is retried with an
+// exponential backoff until maxScheduleAttempts is reached, then the occurrence
+// is skipped.
+func (s *ScheduleService) executeOccurrence(ctx context.Context, sch *ent.Schedule, now time.Time) error {
+	occurrence := sch.NextOccurrence
+	scheduledAt, _ := occurrenceAt(sch, occurrence)
+	txID := repository.ScheduleTransactionID(sch.ID, occurrence)
+
+	description := fmt.Sprintf("Occurrence %d of schedule %d", occurrence, sch.ID)
+	_, err := s.txService.Create(ctx, txID, sch.UserID, sch.Currency, sch.Amount, transaction.Type(sch.Type),
+		repository.TransactionDetails{Description: &description})
+	if err != nil && ent.IsConstraintError(err) {
+		// The occurrence was executed by an earlier attempt whose outcome was not recorded
+		if existing, gerr := s.txService.GetTransactionByID(ctx, txID); gerr == nil && existing.UserID == sch.UserID {
+			err = nil
+		}
+	}
+
+	params := repository.RecordRunParams{
+		Schedule:    sch,
+		ScheduledAt: scheduledAt,
+		Advance:     true,
+	}
+	if err == nil {
+		params.TransactionID = &txID
+	} else {
+		message := err.Error()
+		params.Error = &message
+		if sch.Attempts+1 < maxScheduleAttempts {
+			retryAt := now.Add(scheduleRetryDelay << sch.Attempts)
+			params.Advance = false
+			params.NextRunAt = &retryAt
+		}
+	}
+	if params.Advance {
+		if next, ok := occurrenceAt(sch, occurrence+1); ok {
+			params.NextRunAt = &next
+		}
+	}
+
+	return s.scheduleRepo.RecordRun(ctx, params)
+}
+
+// occurrenceAt returns the time of the zero-based occurrence n of a schedule and
+// whether the recurrence has such an occurrence. Every occurrence is computed
+// from the start, so month-end dates do not drift.
+func occurrenceAt(sch *ent.Schedule, n int) (time.Time, bool) {
+	if sch.Count != nil && n >= *sch.Count {
+		return time.Time{}, false
+	}
+
+	steps := n * sch.Interval
+	var at time.Time
+	switch sch.Frequency {
+	case schedule.FrequencyOnce:
+		if n > 0 {
+			return time.Time{}, false
+		}
+		at = sch.StartAt
+	case schedule.FrequencyDaily:
+		at = sch.StartAt.AddDate(0, 0, steps)
+	case schedule.FrequencyWeekly:
+		at = sch.StartAt.AddDate(0, 0, 7*steps)
+	case schedule.FrequencyMonthly:
+		at = addMonths(sch.StartAt, steps)
+	case schedule.FrequencyYearly:
+		at = addMonths(sch.StartAt, 12*steps)
+	default:
+		return time.Time{}, false
+	}
+
+	if sch.EndAt != nil && at.After(*sch.EndAt) {
+		return time.Time{}, false
+	}
+	return at, true
+}
+
+// addMonths adds months to t keeping its day of month, clamped to the last day
+// of shorter months (e.g. Jan 31 + 1 month is Feb 28 or 29)
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
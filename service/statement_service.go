@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/ent"
+	"accounting/errors"
+	"accounting/repository"
+	"accounting/statement"
+)
+
+// StatementService represents a service for producing account statements
+type StatementService struct {
+	statementRepo *repository.StatementRepository
+	userRepo      *repository.UserRepository
+	balanceRepo   *repository.BalanceRepository
+	currencyRepo  *repository.CurrencyRepository
+}
+
+// NewStatementService creates a new statement service
+func NewStatementService(client *ent.Client) *StatementService {
+	return &StatementService{
+		statementRepo: repository.NewStatementRepository(client),
+		userRepo:      repository.NewUserRepository(client),
+		balanceRepo:   repository.NewBalanceRepository(client),
+		currencyRepo:  repository.NewCurrencyRepository(client),
+	}
+}
+
+// GetMonthlyStatement builds the statement of a user in a currency for a
+// calendar month (YYYY-MM, in UTC). The statement of the current month covers
+// the transactions made so far.
+func (s *StatementService) GetMonthlyStatement(ctx context.Context, userID int, currency string,
+	month string) (*statement.Statement, error) {
+
+	from, err := time.Parse(statement.MonthLayout, month)
+	if err != nil {
+		return nil, fmt.Errorf("statement service - get monthly statement: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "month must have the format YYYY-MM"))
+	}
+	if from.After(time.Now()) {
+		return nil, fmt.Errorf("statement service - get monthly statement: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "month %s has not started yet", month))
+	}
+	until := from.AddDate(0, 1, 0)
+
+	u, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("statement service - get monthly statement: %w", err)
+	}
+	if _, err := s.currencyRepo.GetByCode(ctx, currency); err != nil {
+		return nil, fmt.Errorf("statement service - get monthly statement: %w", err)
+	}
+
+	data, err := s.statementRepo.Get(ctx, userID, currency, from, until)
+	if err != nil {
+		return nil, fmt.Errorf("statement service - get monthly statement: %w", err)
+	}
+
+	return statement.New(u, currency, from, until, data.Opening, data.Transactions), nil
+}
+
+// GetBalances returns up to limit balances with an ID greater than afterID, the
+// user and currency pairs statements are produced for in bulk
+func (s *StatementService) GetBalances(ctx context.Context, afterID int, limit int) ([]*ent.Balance, error) {
+	balances, err := s.balanceRepo.GetPage(ctx, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("statement service - get balances: %w", err)
+	}
+	return balances, nil
+}
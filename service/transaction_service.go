@@ -4,37 +4,474 @@ import (
 	"context"
 	"accounting/ent"
 	"accounting/ent/transaction"
+	"accounting/errors"
 	"accounting/repository"
-	"errors"
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"sort"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// maxBatchSize is the maximum number of items of a batch
+	maxBatchSize = 1000
+
+	// defaultFindLimit and maxFindLimit bound the number of transactions returned by a search
+	defaultFindLimit = 100
+	maxFindLimit     = 1000
+
+	// maxMetadataKeys, maxMetadataKeyLength and maxMetadataSize bound the metadata
+	// of a transaction; the size is measured on its JSON encoding
+	maxMetadataKeys      = 50
+	maxMetadataKeyLength = 64
+	maxMetadataSize      = 4096
 )
 
 // TransactionService presents a service for working with transactions
 type TransactionService struct {
-	txRepo      *repository.TransactionRepository
-	balanceRepo *repository.BalanceRepository
+	txRepo            *repository.TransactionRepository
+	balanceRepo       *repository.BalanceRepository
+	currencyRepo      *repository.CurrencyRepository
+	velocityLimitRepo *repository.VelocityLimitRepository
 }
 
 // NewTransactionService creates a new transaction service
 func NewTransactionService(client *ent.Client) *TransactionService {
 	balanceRepo := repository.NewBalanceRepository(client)
+	accountRepo := repository.NewAccountRepository(client)
+	ledgerRepo := repository.NewLedgerRepository(client, balanceRepo)
+	currencyRepo := repository.NewCurrencyRepository(client)
 
 	return &TransactionService{
-		balanceRepo: balanceRepo,
-		txRepo:      repository.NewTransactionRepository(client, balanceRepo),
+		balanceRepo:       balanceRepo,
+		currencyRepo:      currencyRepo,
+		velocityLimitRepo: repository.NewVelocityLimitRepository(client),
+		txRepo: repository.NewTransactionRepository(client, accountRepo, ledgerRepo,
+			repository.NewExchangeRateRepository(client), currencyRepo, repository.NewFeeRuleRepository(client)),
 	}
 }
 
-func (s *TransactionService) Create(ctx context.Context, id string, userID int, currency string, amount float64, txType transaction.Type) (*ent.Transaction, error) {
-	tx, err := s.txRepo.Create(ctx, id, userID, amount, currency, txType)
+func (s *TransactionService) Create(ctx context.Context, id string, userID int, currency string, amount decimal.Decimal, txType transaction.Type, details repository.TransactionDetails) (*ent.Transaction, error) {
+	if err := validateAmount(ctx, s.currencyRepo, currency, amount); err != nil {
+		return nil, fmt.Errorf("transaction service - create transaction: %w", err)
+	}
+	if err := validateDetails(details); err != nil {
+		return nil, fmt.Errorf("transaction service - create transaction: %w", err)
+	}
+
+	limits, err := s.velocityLimitRepo.FindApplicable(ctx, userID, txType, currency)
+	if err != nil {
+		return nil, fmt.Errorf("transaction service - create transaction: %w", err)
+	}
+
+	tx, err := s.txRepo.Create(ctx, id, userID, amount, currency, txType, details, limits)
 	if err != nil {
 		return nil, fmt.Errorf("transaction service - create transaction: %w", err)
 	}
 	return tx, nil
 }
 
+// CreatePending records a deposit or withdrawal that is applied to the balance
+// only when it is posted, e.g. once an external payment settles
+func (s *TransactionService) CreatePending(ctx context.Context, id string, userID int, currency string,
+	amount decimal.Decimal, txType transaction.Type, details repository.TransactionDetails) (*ent.Transaction, error) {
+
+	if err := validateAmount(ctx, s.currencyRepo, currency, amount); err != nil {
+		return nil, fmt.Errorf("transaction service - create pending transaction: %w", err)
+	}
+	if err := validateDetails(details); err != nil {
+		return nil, fmt.Errorf("transaction service - create pending transaction: %w", err)
+	}
+	if details.EffectiveAt != nil {
+		return nil, fmt.Errorf("transaction service - create pending transaction: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "pending transactions take effect when they are posted"))
+	}
+
+	limits, err := s.velocityLimitRepo.FindApplicable(ctx, userID, txType, currency)
+	if err != nil {
+		return nil, fmt.Errorf("transaction service - create pending transaction: %w", err)
+	}
+
+	tx, err := s.txRepo.CreatePending(ctx, id, userID, amount, currency, txType, details, limits)
+	if err != nil {
+		return nil, fmt.Errorf("transaction service - create pending transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// CreateBatch validates a batch of deposits and withdrawals and creates them in
+// one DB transaction. Every item is validated before any is applied; if one is
+// invalid, nothing is applied and the per-item errors are returned together
+// with ErrInvalidInput. In best-effort mode the valid items that succeed are
+// committed even if others fail.
+func (s *TransactionService) CreateBatch(ctx context.Context, items []repository.CreateTransactionParams,
+	bestEffort bool) ([]repository.BatchItemResult, error) {
+
+	if len(items) == 0 || len(items) > maxBatchSize {
+		return nil, fmt.Errorf("transaction service - create batch: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "a batch must have between 1 and %d items", maxBatchSize))
+	}
+
+	results := make([]repository.BatchItemResult, len(items))
+	invalid := 0
+	ids := make(map[string]int, len(items))
+	currencies := make(map[string]*ent.Currency)
+	limits := make(map[string][]*ent.VelocityLimit)
+	for i := range items {
+		item := &items[i]
+		if first, ok := ids[item.ID]; ok {
+			results[i].Err = errors.WithDetails(errors.ErrInvalidInput, "duplicate ID %q of item %d", item.ID, first)
+			invalid++
+			continue
+		}
+		ids[item.ID] = i
+
+		if err := s.validateBatchItem(ctx, *item, currencies); err != nil {
+			results[i].Err = err
+			invalid++
+			continue
+		}
+
+		key := fmt.Sprintf("%d:%s:%s", item.UserID, item.Type, item.Currency)
+		if _, ok := limits[key]; !ok {
+			found, err := s.velocityLimitRepo.FindApplicable(ctx, item.UserID, item.Type, item.Currency)
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, fmt.Errorf("transaction service - create batch: %w", err)
+			}
+			if err != nil {
+				results[i].Err = err
+				invalid++
+				continue
+			}
+			limits[key] = found
+		}
+		item.Limits = limits[key]
+	}
+	if invalid > 0 {
+		return results, fmt.Errorf("transaction service - create batch: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "%d of %d items are invalid", invalid, len(items)))
+	}
+
+	results, err := s.txRepo.CreateBatch(ctx, items, bestEffort)
+	if err != nil {
+		return results, fmt.Errorf("transaction service - create batch: %w", err)
+	}
+	return results, nil
+}
+
+// ImportResult is the outcome of importing one historical transaction. An item
+// imported before, e.g. by a run that crashed before saving its progress, is
+// reported as a duplicate together with the existing transaction.
+type ImportResult struct {
+	Transaction *ent.Transaction
+	Duplicate   bool
+	Err         error
+}
+
+// Import records historical deposits and withdrawals of a source system in one
+// DB transaction, back-dated to when they took effect there. Every item needs
+// an external reference, which makes importing it again a no-op; fees and
+// velocity limits do not apply. The items are posted sorted by when they take
+// effect; an item taking effect before the latest transaction of its balance is
+// rejected, as its running balance would be wrong. Invalid and failing items
+// are rejected alone with their error while the others are imported.
+func (s *TransactionService) Import(ctx context.Context, source string,
+	items []repository.CreateTransactionParams) ([]ImportResult, error) {
+
+	if len(items) == 0 || len(items) > maxBatchSize {
+		return nil, fmt.Errorf("transaction service - import: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "an import chunk must have between 1 and %d items", maxBatchSize))
+	}
+	if source == "" {
+		return nil, fmt.Errorf("transaction service - import: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "source is required"))
+	}
+
+	results := make([]ImportResult, len(items))
+	refs := make([]string, 0, len(items))
+	for i := range items {
+		item := &items[i]
+		if item.Details.ExternalRef == nil || *item.Details.ExternalRef == "" {
+			results[i].Err = errors.WithDetails(errors.ErrInvalidInput, "external_ref is required")
+			continue
+		}
+		if item.Details.EffectiveAt == nil {
+			results[i].Err = errors.WithDetails(errors.ErrInvalidInput, "timestamp is required")
+			continue
+		}
+		item.ID = repository.ImportTransactionID(source, *item.Details.ExternalRef)
+		item.Details.Source = &source
+		item.SkipFee = true
+		item.InEffectiveOrder = true
+		refs = append(refs, *item.Details.ExternalRef)
+	}
+
+	imported := make(map[string]*ent.Transaction)
+	if len(refs) > 0 {
+		existing, err := s.txRepo.GetByExternalRefs(ctx, source, refs)
+		if err != nil {
+			return nil, fmt.Errorf("transaction service - import: %w", err)
+		}
+		for _, tx := range existing {
+			imported[*tx.ExternalRef] = tx
+		}
+	}
+
+	valid := make([]repository.CreateTransactionParams, 0, len(items))
+	positions := make([]int, 0, len(items))
+	seen := make(map[string]int, len(items))
+	currencies := make(map[string]*ent.Currency)
+	for i, item := range items {
+		if results[i].Err != nil {
+			continue
+		}
+
+		ref := *item.Details.ExternalRef
+		if first, ok := seen[ref]; ok {
+			results[i].Err = errors.WithDetails(errors.ErrInvalidInput, "duplicate external_ref %q of item %d", ref, first)
+			continue
+		}
+		seen[ref] = i
+
+		if tx, ok := imported[ref]; ok {
+			if !sameImport(tx, item) {
+				results[i].Err = errors.WithDetails(errors.ErrDuplicateResource,
+					"external_ref %q was imported with different data as transaction %s", ref, tx.ID)
+				continue
+			}
+			results[i] = ImportResult{Transaction: tx, Duplicate: true}
+			continue
+		}
+
+		if err := s.validateBatchItem(ctx, item, currencies); err != nil {
+			results[i].Err = err
+			continue
+		}
+		valid = append(valid, item)
+		positions = append(positions, i)
+	}
+	if len(valid) == 0 {
+		return results, nil
+	}
+
+	// Items are posted in effective order, so the running balances follow it
+	sort.SliceStable(positions, func(a, b int) bool {
+		return items[positions[a]].Details.EffectiveAt.Before(*items[positions[b]].Details.EffectiveAt)
+	})
+	for j, i := range positions {
+		valid[j] = items[i]
+	}
+
+	created, err := s.txRepo.CreateBatch(ctx, valid, true)
+	if err != nil {
+		return nil, fmt.Errorf("transaction service - import: %w", err)
+	}
+	for j, result := range created {
+		results[positions[j]].Transaction = result.Transaction
+		results[positions[j]].Err = result.Err
+	}
+	return results, nil
+}
+
+// sameImport reports whether an existing transaction records an import item.
+// The database stores instants with microsecond precision.
+func sameImport(tx *ent.Transaction, item repository.CreateTransactionParams) bool {
+	return tx.UserID == item.UserID &&
+		tx.Currency == item.Currency &&
+		tx.Type == item.Type &&
+		tx.Amount.Equal(item.Amount) &&
+		tx.EffectiveAt.Equal(item.Details.EffectiveAt.Truncate(time.Microsecond))
+}
+
+// validateDetails checks the lengths of the descriptive fields of a transaction,
+// the size of its metadata and that it does not take effect in the future
+func validateDetails(details repository.TransactionDetails) error {
+	if details.EffectiveAt != nil && details.EffectiveAt.After(time.Now()) {
+		return errors.WithDetails(errors.ErrInvalidInput, "effective_at must not be in the future")
+	}
+	if details.Description != nil && utf8.RuneCountInString(*details.Description) > 500 {
+		return errors.WithDetails(errors.ErrInvalidInput, "description must not exceed 500 characters")
+	}
+	if details.Category != nil && (*details.Category == "" || utf8.RuneCountInString(*details.Category) > 64) {
+		return errors.WithDetails(errors.ErrInvalidInput, "category must have 1 to 64 characters")
+	}
+	if (details.Source == nil) != (details.ExternalRef == nil) {
+		return errors.WithDetails(errors.ErrInvalidInput, "source and external_ref must be set together")
+	}
+	if details.Source != nil && (*details.Source == "" || utf8.RuneCountInString(*details.Source) > 64) {
+		return errors.WithDetails(errors.ErrInvalidInput, "source must have 1 to 64 characters")
+	}
+	if details.ExternalRef != nil && (*details.ExternalRef == "" || utf8.RuneCountInString(*details.ExternalRef) > 255) {
+		return errors.WithDetails(errors.ErrInvalidInput, "external_ref must have 1 to 255 characters")
+	}
+
+	if len(details.Metadata) > maxMetadataKeys {
+		return errors.WithDetails(errors.ErrInvalidInput, "metadata must not have more than %d keys", maxMetadataKeys)
+	}
+	for key := range details.Metadata {
+		if key == "" || utf8.RuneCountInString(key) > maxMetadataKeyLength {
+			return errors.WithDetails(errors.ErrInvalidInput, "metadata keys must have 1 to %d characters",
+				maxMetadataKeyLength)
+		}
+	}
+	if details.Metadata != nil {
+		data, err := json.Marshal(details.Metadata)
+		if err != nil {
+			return errors.WithDetails(errors.ErrInvalidInput, "metadata is not valid JSON: %v", err)
+		}
+		if len(data) > maxMetadataSize {
+			return errors.WithDetails(errors.ErrInvalidInput, "metadata must not exceed %d bytes", maxMetadataSize)
+		}
+	}
+
+	return nil
+}
+
+// validateBatchItem checks the type and amount of a batch item, caching the
+// currencies it looks up
+func (s *TransactionService) validateBatchItem(ctx context.Context, item repository.CreateTransactionParams,
+	currencies map[string]*ent.Currency) error {
+
+	if item.Type != transaction.TypeDeposit && item.Type != transaction.TypeWithdrawal {
+		return errors.WithDetails(errors.ErrInvalidInput, "unsupported transaction type %s", item.Type)
+	}
+	if !item.Amount.IsPositive() {
+		return errors.WithDetails(errors.ErrInvalidInput, "amount must be positive")
+	}
+
+	if err := validateDetails(item.Details); err != nil {
+		return err
+	}
+	if item.Pending && item.Details.EffectiveAt != nil {
+		return errors.WithDetails(errors.ErrInvalidInput, "pending transactions take effect when they are posted")
+	}
+
+	c, ok := currencies[item.Currency]
+	if !ok {
+		var err error
+		c, err = s.currencyRepo.GetByCode(ctx, item.Currency)
+		if err != nil {
+			return err
+		}
+		currencies[item.Currency] = c
+	}
+	return repository.ValidateAmount(c, item.Amount)
+}
+
+// Post applies a pending transaction to the balance
+func (s *TransactionService) Post(ctx context.Context, id string) (*ent.Transaction, error) {
+	tx, err := s.txRepo.Post(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("transaction service - post transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// Fail marks a pending transaction as failed
+func (s *TransactionService) Fail(ctx context.Context, id string, reason string) (*ent.Transaction, error) {
+	tx, err := s.txRepo.Fail(ctx, id, reason)
+	if err != nil {
+		return nil, fmt.Errorf("transaction service - fail transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// Reverse creates a compensating transaction for a deposit or withdrawal.
+// A nil amount reverses the whole remaining amount of the original.
+func (s *TransactionService) Reverse(ctx context.Context, id string, originalID string,
+	amount *decimal.Decimal) (*repository.Reversal, error) {
+
+	if amount != nil {
+		original, err := s.txRepo.GetByID(ctx, originalID)
+		if err != nil {
+			return nil, fmt.Errorf("transaction service - reverse: %w", err)
+		}
+		if err := validateAmount(ctx, s.currencyRepo, original.Currency, *amount); err != nil {
+			return nil, fmt.Errorf("transaction service - reverse: %w", err)
+		}
+	}
+
+	reversal, err := s.txRepo.Reverse(ctx, repository.ReverseParams{
+		ID:         id,
+		OriginalID: originalID,
+		Amount:     amount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transaction service - reverse: %w", err)
+	}
+	return reversal, nil
+}
+
+// Transfer atomically moves money from one user's balance to another's
+func (s *TransactionService) Transfer(ctx context.Context, transferID string, fromUserID, toUserID int,
+	currency string, amount decimal.Decimal) (*repository.TransferLegs, error) {
+
+	if err := validateAmount(ctx, s.currencyRepo, currency, amount); err != nil {
+		return nil, fmt.Errorf("transaction service - transfer: %w", err)
+	}
+
+	legs, err := s.txRepo.CreateTransfer(ctx, repository.CreateTransferParams{
+		TransferID: transferID,
+		FromUserID: fromUserID,
+		ToUserID:   toUserID,
+		Currency:   currency,
+		Amount:     amount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transaction service - transfer: %w", err)
+	}
+	return legs, nil
+}
+
+// GetTransfer gets both legs of a transfer by the transfer ID
+func (s *TransactionService) GetTransfer(ctx context.Context, transferID string) ([]*ent.Transaction, error) {
+	txs, err := s.txRepo.GetByTransferID(ctx, transferID)
+	if err != nil {
+		return nil, fmt.Errorf("transaction service - get transfer: %w", err)
+	}
+	return txs, nil
+}
+
+// Exchange converts an amount of one currency balance of a user into another
+// currency using the exchange rate valid at the moment
+func (s *TransactionService) Exchange(ctx context.Context, exchangeID string, userID int, fromCurrency, toCurrency string,
+	amount decimal.Decimal) (*repository.ExchangeLegs, error) {
+
+	if err := validateAmount(ctx, s.currencyRepo, fromCurrency, amount); err != nil {
+		return nil, fmt.Errorf("transaction service - exchange: %w", err)
+	}
+	if err := validateAmount(ctx, s.currencyRepo, toCurrency, decimal.Zero); err != nil {
+		return nil, fmt.Errorf("transaction service - exchange: %w", err)
+	}
+
+	legs, err := s.txRepo.CreateExchange(ctx, repository.CreateExchangeParams{
+		ExchangeID:   exchangeID,
+		UserID:       userID,
+		FromCurrency: fromCurrency,
+		ToCurrency:   toCurrency,
+		Amount:       amount,
+		At:           time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transaction service - exchange: %w", err)
+	}
+	return legs, nil
+}
+
+// GetExchange gets both legs of a currency exchange by the exchange ID
+func (s *TransactionService) GetExchange(ctx context.Context, exchangeID string) ([]*ent.Transaction, error) {
+	txs, err := s.txRepo.GetByExchangeID(ctx, exchangeID)
+	if err != nil {
+		return nil, fmt.Errorf("transaction service - get exchange: %w", err)
+	}
+	return txs, nil
+}
+
 // GetTransactionByID gets a transaction by its ID
 func (s *TransactionService) GetTransactionByID(ctx context.Context, id string) (*ent.Transaction, error) {
 	tx, err := s.txRepo.GetByID(ctx, id)
@@ -44,6 +481,30 @@ func (s *TransactionService) GetTransactionByID(ctx context.Context, id string)
 	return tx, nil
 }
 
+// FindTransactions gets the transactions matching a filter, newest first. A
+// zero limit returns the default number of transactions.
+func (s *TransactionService) FindTransactions(ctx context.Context,
+	filter repository.TransactionFilter) ([]*ent.Transaction, error) {
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultFindLimit
+	}
+	if filter.Limit < 0 || filter.Limit > maxFindLimit {
+		return nil, fmt.Errorf("transaction service - find transactions: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "limit must be between 1 and %d", maxFindLimit))
+	}
+	if filter.ExternalRef != nil && filter.Source == nil {
+		return nil, fmt.Errorf("transaction service - find transactions: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "external_ref is only unique within a source"))
+	}
+
+	txs, err := s.txRepo.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("transaction service - find transactions: %w", err)
+	}
+	return txs, nil
+}
+
 // GetAllTransactionsByUserID gets all transactions of a user
 func (s *TransactionService) GetAllTransactionsByUserID(ctx context.Context, userID int) ([]*ent.Transaction, error) {
 	txs, err := s.txRepo.GetAllByUserID(ctx, userID)
@@ -62,7 +523,7 @@ func (s *TransactionService) TestIdempotency(ctx context.Context, user *ent.User
 	fmt.Println("\n--- Testing Idempotency ---")
 	fmt.Println("First attempt with fixed transaction ID:", fixedID)
 
-	tx1, err := s.txRepo.Create(ctx, fixedID, user.ID, 500.00, "USD", transaction.TypeDeposit)
+	tx1, err := s.txRepo.Create(ctx, fixedID, user.ID, decimal.NewFromInt(500), "USD", transaction.TypeDeposit, repository.TransactionDetails{}, nil)
 	if err != nil {
 		return fmt.Errorf("failed first attempt: %w", err)
 	}
@@ -70,7 +531,7 @@ func (s *TransactionService) TestIdempotency(ctx context.Context, user *ent.User
 
 	// Second attempt with the same ID - should fail due to the constraint
 	fmt.Println("\nSecond attempt with same transaction ID:", fixedID)
-	_, err = s.txRepo.Create(ctx, fixedID, user.ID, 500.00, "USD", transaction.TypeDeposit)
+	_, err = s.txRepo.Create(ctx, fixedID, user.ID, decimal.NewFromInt(500), "USD", transaction.TypeDeposit, repository.TransactionDetails{}, nil)
 
 	if err != nil {
 		fmt.Printf("Second attempt failed as expected: %v\n", err)
@@ -112,11 +573,11 @@ func (s *TransactionService) DemonstrateBalanceOperations(ctx context.Context, u
 	}
 
 	initialAmount := eurBalance.Amount
-	fmt.Printf("Initial EUR balance: %.2f\n", initialAmount)
+	fmt.Printf("Initial EUR balance: %s\n", initialAmount.StringFixed(2))
 
 	// Increment the balance by 100 EUR
-	incrementAmount := 100.0
-	_, err = s.txRepo.Create(ctx, uuid.New().String(), userID, incrementAmount, "EUR", transaction.TypeDeposit)
+	incrementAmount := decimal.NewFromInt(100)
+	_, err = s.txRepo.Create(ctx, uuid.New().String(), userID, incrementAmount, "EUR", transaction.TypeDeposit, repository.TransactionDetails{}, nil)
 	if err != nil {
 		return fmt.Errorf("failed incrementing balance: %w", err)
 	}
@@ -127,11 +588,11 @@ func (s *TransactionService) DemonstrateBalanceOperations(ctx context.Context, u
 		return fmt.Errorf("failed querying updated EUR balance: %w", err)
 	}
 
-	fmt.Printf("EUR balance after increment of %.2f: %.2f\n", incrementAmount, eurBalance.Amount)
+	fmt.Printf("EUR balance after increment of %s: %s\n", incrementAmount.StringFixed(2), eurBalance.Amount.StringFixed(2))
 
 	// Decrement the balance
-	decrementAmount := 50.0
-	_, err = s.txRepo.Create(ctx, uuid.New().String(), userID, decrementAmount, "EUR", transaction.TypeWithdrawal)
+	decrementAmount := decimal.NewFromInt(50)
+	_, err = s.txRepo.Create(ctx, uuid.New().String(), userID, decrementAmount, "EUR", transaction.TypeWithdrawal, repository.TransactionDetails{}, nil)
 	if err != nil {
 		return fmt.Errorf("failed decrementing balance: %w", err)
 	}
@@ -142,15 +603,15 @@ func (s *TransactionService) DemonstrateBalanceOperations(ctx context.Context, u
 		return fmt.Errorf("failed querying final EUR balance: %w", err)
 	}
 
-	fmt.Printf("EUR balance after decrement of %.2f: %.2f\n", decrementAmount, eurBalance.Amount)
+	fmt.Printf("EUR balance after decrement of %s: %s\n", decrementAmount.StringFixed(2), eurBalance.Amount.StringFixed(2))
 
 	// Try to decrement too much (should fail)
-	tooMuchAmount := eurBalance.Amount + 1000.0
-	_, err = s.txRepo.Create(ctx, uuid.New().String(), userID, tooMuchAmount, "EUR", transaction.TypeWithdrawal)
+	tooMuchAmount := eurBalance.Amount.Add(decimal.NewFromInt(1000))
+	_, err = s.txRepo.Create(ctx, uuid.New().String(), userID, tooMuchAmount, "EUR", transaction.TypeWithdrawal, repository.TransactionDetails{}, nil)
 	if err != nil {
-		fmt.Printf("As expected, decrementing too much (%.2f) failed: %v\n", tooMuchAmount, err)
+		fmt.Printf("As expected, decrementing too much (%s) failed: %v\n", tooMuchAmount.StringFixed(2), err)
 	} else {
-		return errors.New("large withdrawal should have failed but didn't")
+		return stderrors.New("large withdrawal should have failed but didn't")
 	}
 
 	return nil
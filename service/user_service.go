@@ -9,13 +9,22 @@ import (
 
 // UserService represents a service for working with users
 type UserService struct {
-	userRepo *repository.UserRepository
+	userRepo       *repository.UserRepository
+	userStatusRepo *repository.UserStatusRepository
 }
 
 // NewUserService creates a new user service
 func NewUserService(client *ent.Client) *UserService {
+	balanceRepo := repository.NewBalanceRepository(client)
+	accountRepo := repository.NewAccountRepository(client)
+	ledgerRepo := repository.NewLedgerRepository(client, balanceRepo)
+	currencyRepo := repository.NewCurrencyRepository(client)
+	txRepo := repository.NewTransactionRepository(client, accountRepo, ledgerRepo,
+		repository.NewExchangeRateRepository(client), currencyRepo, repository.NewFeeRuleRepository(client))
+
 	return &UserService{
-		userRepo: repository.NewUserRepository(client),
+		userRepo:       repository.NewUserRepository(client),
+		userStatusRepo: repository.NewUserStatusRepository(client, txRepo),
 	}
 }
 
@@ -54,3 +63,57 @@ func (s *UserService) GetUserWithTransactions(ctx context.Context, id int) (*ent
 	}
 	return user, nil
 }
+
+// SetTier sets the pricing tier of a user, which selects the fee rules applied to them
+func (s *UserService) SetTier(ctx context.Context, id int, tier string) (*ent.User, error) {
+	user, err := s.userRepo.SetTier(ctx, id, tier)
+	if err != nil {
+		return nil, fmt.Errorf("user service - set tier: %w", err)
+	}
+	return user, nil
+}
+
+// Freeze restricts the account of a user to incoming money, e.g. while compliance reviews it
+func (s *UserService) Freeze(ctx context.Context, id int, reason string) (*ent.User, error) {
+	user, err := s.userStatusRepo.Freeze(ctx, id, reason)
+	if err != nil {
+		return nil, fmt.Errorf("user service - freeze: %w", err)
+	}
+	return user, nil
+}
+
+// Unfreeze lifts the restrictions of a frozen account
+func (s *UserService) Unfreeze(ctx context.Context, id int, reason string) (*ent.User, error) {
+	user, err := s.userStatusRepo.Unfreeze(ctx, id, reason)
+	if err != nil {
+		return nil, fmt.Errorf("user service - unfreeze: %w", err)
+	}
+	return user, nil
+}
+
+// Close closes the account of a user for good. Balances must be zero unless
+// they are swept to another user.
+func (s *UserService) Close(ctx context.Context, params repository.CloseUserParams) (*repository.ClosedUser, error) {
+	closed, err := s.userStatusRepo.Close(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("user service - close: %w", err)
+	}
+	return closed, nil
+}
+
+// Delete soft-deletes a closed account, keeping its financial history
+func (s *UserService) Delete(ctx context.Context, id int) error {
+	if err := s.userRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("user service - delete: %w", err)
+	}
+	return nil
+}
+
+// Erase pseudonymizes the personal data of a closed account, keeping its financial history
+func (s *UserService) Erase(ctx context.Context, id int) (*ent.User, error) {
+	user, err := s.userRepo.Erase(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("user service - erase: %w", err)
+	}
+	return user, nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"accounting/ent"
+	"accounting/errors"
+	"accounting/repository"
+)
+
+// VelocityLimitService represents a service for working with velocity limits
+type VelocityLimitService struct {
+	velocityLimitRepo *repository.VelocityLimitRepository
+	currencyRepo      *repository.CurrencyRepository
+}
+
+// NewVelocityLimitService creates a new velocity limit service
+func NewVelocityLimitService(client *ent.Client) *VelocityLimitService {
+	return &VelocityLimitService{
+		velocityLimitRepo: repository.NewVelocityLimitRepository(client),
+		currencyRepo:      repository.NewCurrencyRepository(client),
+	}
+}
+
+// CreateLimit validates and creates a velocity limit
+func (s *VelocityLimitService) CreateLimit(ctx context.Context,
+	params repository.CreateVelocityLimitParams) (*ent.VelocityLimit, error) {
+
+	if params.MaxCount == nil && params.MaxAmount == nil {
+		return nil, fmt.Errorf("velocity limit service - create limit: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "a limit needs a max count, a max amount or both"))
+	}
+	if params.Window <= 0 {
+		return nil, fmt.Errorf("velocity limit service - create limit: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "window must be positive"))
+	}
+	if params.MaxCount != nil && *params.MaxCount <= 0 {
+		return nil, fmt.Errorf("velocity limit service - create limit: %w",
+			errors.WithDetails(errors.ErrInvalidInput, "max count must be positive"))
+	}
+	if params.MaxAmount != nil {
+		if err := validateAmount(ctx, s.currencyRepo, params.Currency, *params.MaxAmount); err != nil {
+			return nil, fmt.Errorf("velocity limit service - create limit: %w", err)
+		}
+		if !params.MaxAmount.IsPositive() {
+			return nil, fmt.Errorf("velocity limit service - create limit: %w",
+				errors.WithDetails(errors.ErrInvalidInput, "max amount must be positive"))
+		}
+	}
+
+	limit, err := s.velocityLimitRepo.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("velocity limit service - create limit: %w", err)
+	}
+	return limit, nil
+}
+
+// GetLimits gets all velocity limits
+func (s *VelocityLimitService) GetLimits(ctx context.Context) ([]*ent.VelocityLimit, error) {
+	limits, err := s.velocityLimitRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("velocity limit service - get limits: %w", err)
+	}
+	return limits, nil
+}
+
+// SetEnabled turns a velocity limit on or off
+func (s *VelocityLimitService) SetEnabled(ctx context.Context, id int, enabled bool) (*ent.VelocityLimit, error) {
+	limit, err := s.velocityLimitRepo.SetEnabled(ctx, id, enabled)
+	if err != nil {
+		return nil, fmt.Errorf("velocity limit service - set enabled: %w", err)
+	}
+	return limit, nil
+}
@@ -0,0 +1,222 @@
+// Package statement builds account statements of a user in a currency and
+// renders them as CSV and PDF.
+package statement
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"accounting/ent"
+	"accounting/ent/transaction"
+	"accounting/repository"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/shopspring/decimal"
+)
+
+// MonthLayout is the layout of the month a monthly statement covers
+const MonthLayout = "2006-01"
+
+// Line is a transaction on a statement
+type Line struct {
+	TransactionID string           `json:"transaction_id"`
+	EffectiveAt   time.Time        `json:"effective_at"`
+	Type          transaction.Type `json:"type"`
+	Description   string           `json:"description"`
+	// Amount is signed: positive for credits, negative for debits
+	Amount decimal.Decimal `json:"amount"`
+	// Balance is the running balance right after the transaction
+	Balance decimal.Decimal `json:"balance"`
+}
+
+// Statement lists the transactions of a user in a currency that took effect
+// within a period, in order, between the opening and the closing balance
+type Statement struct {
+	UserID      int             `json:"user_id"`
+	UserName    string          `json:"user_name"`
+	Currency    string          `json:"currency"`
+	From        time.Time       `json:"from"`
+	Until       time.Time       `json:"until"`
+	Opening     decimal.Decimal `json:"opening_balance"`
+	Lines       []Line          `json:"lines"`
+	CreditCount int             `json:"credit_count"`
+	Credits     decimal.Decimal `json:"total_credits"`
+	DebitCount  int             `json:"debit_count"`
+	Debits      decimal.Decimal `json:"total_debits"`
+	Closing     decimal.Decimal `json:"closing_balance"`
+}
+
+// New builds the statement of a user in a currency from the balance at the
+// start of the period and the transactions that took effect within it, in
+// the order they took effect
+func New(u *ent.User, currency string, from time.Time, until time.Time, opening decimal.Decimal,
+	transactions []*ent.Transaction) *Statement {
+
+	s := &Statement{
+		UserID:   u.ID,
+		UserName: u.Name,
+		Currency: currency,
+		From:     from,
+		Until:    until,
+		Opening:  opening,
+		Lines:    make([]Line, 0, len(transactions)),
+		Credits:  decimal.Zero,
+		Debits:   decimal.Zero,
+	}
+
+	balance := opening
+	for _, tx := range transactions {
+		amount := tx.Amount
+		if slices.Contains(repository.CreditTypes, tx.Type) {
+			s.CreditCount++
+			s.Credits = s.Credits.Add(amount)
+		} else {
+			amount = amount.Neg()
+			s.DebitCount++
+			s.Debits = s.Debits.Add(tx.Amount)
+		}
+		balance = balance.Add(amount)
+
+		description := ""
+		if tx.Description != nil {
+			description = *tx.Description
+		}
+		s.Lines = append(s.Lines, Line{
+			TransactionID: tx.ID,
+			EffectiveAt:   tx.EffectiveAt,
+			Type:          tx.Type,
+			Description:   description,
+			Amount:        amount,
+			Balance:       balance,
+		})
+	}
+	s.Closing = balance
+
+	return s
+}
+
+// WriteCSV writes the statement as CSV: a row for the opening balance, a row
+// per transaction, and rows for the totals and the closing balance
+func (s *Statement) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	records := [][]string{
+		{"date", "transaction_id", "type", "description", "amount", "balance"},
+		{formatTime(s.From), "", "", "Opening balance", "", s.Opening.String()},
+	}
+	for _, line := range s.Lines {
+		records = append(records, []string{
+			formatTime(line.EffectiveAt), line.TransactionID, string(line.Type), line.Description,
+			line.Amount.String(), line.Balance.String(),
+		})
+	}
+	records = append(records,
+		[]string{"", "", "", fmt.Sprintf("Total credits (%d)", s.CreditCount), s.Credits.String(), ""},
+		[]string{"", "", "", fmt.Sprintf("Total debits (%d)", s.DebitCount), s.Debits.Neg().String(), ""},
+		[]string{formatTime(s.lastDay()), "", "", "Closing balance", "", s.Closing.String()},
+	)
+
+	if err := writer.WriteAll(records); err != nil {
+		return fmt.Errorf("failed writing statement CSV: %w", err)
+	}
+	return nil
+}
+
+// WritePDF writes the statement as a simple A4 PDF with a table of the transactions
+func (s *Statement) WritePDF(w io.Writer) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.SetTitle(fmt.Sprintf("Statement %s %s", s.Currency, s.From.Format(MonthLayout)), true)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-12)
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.CellFormat(0, 5, fmt.Sprintf("Page %d", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+
+	// Column widths of the transaction table, 190mm in total
+	widths := []float64{20, 56, 24, 42, 24, 24}
+	header := func() {
+		pdf.SetFont("Helvetica", "B", 9)
+		for i, title := range []string{"Date", "Transaction", "Type", "Description", "Amount", "Balance"} {
+			align := "L"
+			if i >= 4 {
+				align = "R"
+			}
+			pdf.CellFormat(widths[i], 7, title, "B", 0, align, false, 0, "")
+		}
+		pdf.Ln(-1)
+		pdf.SetFont("Helvetica", "", 8)
+	}
+	row := func(cells ...string) {
+		if pdf.GetY() > 270 {
+			pdf.AddPage()
+			header()
+		}
+		for i, cell := range cells {
+			align := "L"
+			if i >= 4 {
+				align = "R"
+			}
+			pdf.CellFormat(widths[i], 6, fit(pdf, tr(cell), widths[i]), "", 0, align, false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+
+	pdf.AddPage()
+	pdf.SetFont("Helvetica", "B", 14)
+	pdf.CellFormat(0, 8, "Account statement", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 6, tr(fmt.Sprintf("%s (user %d)", s.UserName, s.UserID)), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 6, fmt.Sprintf("Currency: %s", s.Currency), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 6, fmt.Sprintf("Period: %s to %s (UTC)", formatTime(s.From), formatTime(s.lastDay())),
+		"", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	header()
+	row(formatTime(s.From), "", "", "Opening balance", "", s.Opening.String())
+	for _, line := range s.Lines {
+		row(formatTime(line.EffectiveAt), line.TransactionID, string(line.Type), line.Description,
+			line.Amount.String(), line.Balance.String())
+	}
+
+	pdf.SetFont("Helvetica", "B", 8)
+	row("", "", "", fmt.Sprintf("Total credits (%d)", s.CreditCount), s.Credits.String(), "")
+	row("", "", "", fmt.Sprintf("Total debits (%d)", s.DebitCount), s.Debits.Neg().String(), "")
+	row(formatTime(s.lastDay()), "", "", "Closing balance", "", s.Closing.String())
+
+	if err := pdf.Output(w); err != nil {
+		return fmt.Errorf("failed writing statement PDF: %w", err)
+	}
+	return nil
+}
+
+// FileName returns the name of the statement file with an extension, e.g. csv or pdf
+func (s *Statement) FileName(ext string) string {
+	return fmt.Sprintf("statement-%d-%s-%s.%s", s.UserID, s.Currency, s.From.Format(MonthLayout), ext)
+}
+
+// lastDay returns an instant on the last day of the period, which ends exclusively
+func (s *Statement) lastDay() time.Time {
+	return s.Until.Add(-time.Nanosecond)
+}
+
+// formatTime formats the date of an instant in UTC
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.DateOnly)
+}
+
+// fit shortens a text with an ellipsis until it fits into a cell of a width.
+// The text is already translated to the single-byte encoding of the core fonts.
+func fit(pdf *fpdf.Fpdf, text string, width float64) string {
+	const padding = 2
+	if pdf.GetStringWidth(text) <= width-padding {
+		return text
+	}
+	for len(text) > 0 && pdf.GetStringWidth(text+"...") > width-padding {
+		text = text[:len(text)-1]
+	}
+	return text + "..."
+}